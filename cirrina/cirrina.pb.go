@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
@@ -164,6 +165,55 @@ func (DiskDevType) EnumDescriptor() ([]byte, []int) {
 	return file_cirrina_proto_rawDescGZIP(), []int{2}
 }
 
+type StorageType int32
+
+const (
+	StorageType_STORAGE_DISK_FILE StorageType = 0
+	StorageType_STORAGE_DISK_ZVOL StorageType = 1
+	StorageType_STORAGE_ISO       StorageType = 2
+)
+
+// Enum value maps for StorageType.
+var (
+	StorageType_name = map[int32]string{
+		0: "STORAGE_DISK_FILE",
+		1: "STORAGE_DISK_ZVOL",
+		2: "STORAGE_ISO",
+	}
+	StorageType_value = map[string]int32{
+		"STORAGE_DISK_FILE": 0,
+		"STORAGE_DISK_ZVOL": 1,
+		"STORAGE_ISO":       2,
+	}
+)
+
+func (x StorageType) Enum() *StorageType {
+	p := new(StorageType)
+	*p = x
+	return p
+}
+
+func (x StorageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StorageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cirrina_proto_enumTypes[3].Descriptor()
+}
+
+func (StorageType) Type() protoreflect.EnumType {
+	return &file_cirrina_proto_enumTypes[3]
+}
+
+func (x StorageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StorageType.Descriptor instead.
+func (StorageType) EnumDescriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{3}
+}
+
 type SwitchType int32
 
 const (
@@ -194,11 +244,11 @@ func (x SwitchType) String() string {
 }
 
 func (SwitchType) Descriptor() protoreflect.EnumDescriptor {
-	return file_cirrina_proto_enumTypes[3].Descriptor()
+	return file_cirrina_proto_enumTypes[4].Descriptor()
 }
 
 func (SwitchType) Type() protoreflect.EnumType {
-	return &file_cirrina_proto_enumTypes[3]
+	return &file_cirrina_proto_enumTypes[4]
 }
 
 func (x SwitchType) Number() protoreflect.EnumNumber {
@@ -207,7 +257,7 @@ func (x SwitchType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SwitchType.Descriptor instead.
 func (SwitchType) EnumDescriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{3}
+	return file_cirrina_proto_rawDescGZIP(), []int{4}
 }
 
 type NetDevType int32
@@ -243,11 +293,11 @@ func (x NetDevType) String() string {
 }
 
 func (NetDevType) Descriptor() protoreflect.EnumDescriptor {
-	return file_cirrina_proto_enumTypes[4].Descriptor()
+	return file_cirrina_proto_enumTypes[5].Descriptor()
 }
 
 func (NetDevType) Type() protoreflect.EnumType {
-	return &file_cirrina_proto_enumTypes[4]
+	return &file_cirrina_proto_enumTypes[5]
 }
 
 func (x NetDevType) Number() protoreflect.EnumNumber {
@@ -256,16 +306,17 @@ func (x NetDevType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetDevType.Descriptor instead.
 func (NetDevType) EnumDescriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{4}
+	return file_cirrina_proto_rawDescGZIP(), []int{5}
 }
 
 type VmStatus int32
 
 const (
-	VmStatus_STATUS_STOPPED  VmStatus = 0
-	VmStatus_STATUS_STARTING VmStatus = 1
-	VmStatus_STATUS_RUNNING  VmStatus = 2
-	VmStatus_STATUS_STOPPING VmStatus = 3
+	VmStatus_STATUS_STOPPED   VmStatus = 0
+	VmStatus_STATUS_STARTING  VmStatus = 1
+	VmStatus_STATUS_RUNNING   VmStatus = 2
+	VmStatus_STATUS_STOPPING  VmStatus = 3
+	VmStatus_STATUS_CRASHLOOP VmStatus = 4
 )
 
 // Enum value maps for VmStatus.
@@ -275,12 +326,14 @@ var (
 		1: "STATUS_STARTING",
 		2: "STATUS_RUNNING",
 		3: "STATUS_STOPPING",
+		4: "STATUS_CRASHLOOP",
 	}
 	VmStatus_value = map[string]int32{
-		"STATUS_STOPPED":  0,
-		"STATUS_STARTING": 1,
-		"STATUS_RUNNING":  2,
-		"STATUS_STOPPING": 3,
+		"STATUS_STOPPED":   0,
+		"STATUS_STARTING":  1,
+		"STATUS_RUNNING":   2,
+		"STATUS_STOPPING":  3,
+		"STATUS_CRASHLOOP": 4,
 	}
 )
 
@@ -295,11 +348,11 @@ func (x VmStatus) String() string {
 }
 
 func (VmStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_cirrina_proto_enumTypes[5].Descriptor()
+	return file_cirrina_proto_enumTypes[6].Descriptor()
 }
 
 func (VmStatus) Type() protoreflect.EnumType {
-	return &file_cirrina_proto_enumTypes[5]
+	return &file_cirrina_proto_enumTypes[6]
 }
 
 func (x VmStatus) Number() protoreflect.EnumNumber {
@@ -308,7 +361,7 @@ func (x VmStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VmStatus.Descriptor instead.
 func (VmStatus) EnumDescriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{5}
+	return file_cirrina_proto_rawDescGZIP(), []int{6}
 }
 
 type VMID struct {
@@ -1083,26 +1136,28 @@ func (x *DiskInfoUpdate) GetDirect() bool {
 	return false
 }
 
-type NetInterfacesReq struct {
+type DiskRemoveReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diskid        *DiskId                `protobuf:"bytes,1,opt,name=diskid,proto3" json:"diskid,omitempty"`
+	Purge         *bool                  `protobuf:"varint,2,opt,name=purge,proto3,oneof" json:"purge,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetInterfacesReq) Reset() {
-	*x = NetInterfacesReq{}
+func (x *DiskRemoveReq) Reset() {
+	*x = DiskRemoveReq{}
 	mi := &file_cirrina_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetInterfacesReq) String() string {
+func (x *DiskRemoveReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetInterfacesReq) ProtoMessage() {}
+func (*DiskRemoveReq) ProtoMessage() {}
 
-func (x *NetInterfacesReq) ProtoReflect() protoreflect.Message {
+func (x *DiskRemoveReq) ProtoReflect() protoreflect.Message {
 	mi := &file_cirrina_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1114,32 +1169,48 @@ func (x *NetInterfacesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetInterfacesReq.ProtoReflect.Descriptor instead.
-func (*NetInterfacesReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiskRemoveReq.ProtoReflect.Descriptor instead.
+func (*DiskRemoveReq) Descriptor() ([]byte, []int) {
 	return file_cirrina_proto_rawDescGZIP(), []int{13}
 }
 
-type NetIf struct {
+func (x *DiskRemoveReq) GetDiskid() *DiskId {
+	if x != nil {
+		return x.Diskid
+	}
+	return nil
+}
+
+func (x *DiskRemoveReq) GetPurge() bool {
+	if x != nil && x.Purge != nil {
+		return *x.Purge
+	}
+	return false
+}
+
+type DiskResizeReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InterfaceName string                 `protobuf:"bytes,1,opt,name=InterfaceName,proto3" json:"InterfaceName,omitempty"`
+	Diskid        *DiskId                `protobuf:"bytes,1,opt,name=diskid,proto3" json:"diskid,omitempty"`
+	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetIf) Reset() {
-	*x = NetIf{}
+func (x *DiskResizeReq) Reset() {
+	*x = DiskResizeReq{}
 	mi := &file_cirrina_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetIf) String() string {
+func (x *DiskResizeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetIf) ProtoMessage() {}
+func (*DiskResizeReq) ProtoMessage() {}
 
-func (x *NetIf) ProtoReflect() protoreflect.Message {
+func (x *DiskResizeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_cirrina_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1151,42 +1222,53 @@ func (x *NetIf) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetIf.ProtoReflect.Descriptor instead.
-func (*NetIf) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiskResizeReq.ProtoReflect.Descriptor instead.
+func (*DiskResizeReq) Descriptor() ([]byte, []int) {
 	return file_cirrina_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *NetIf) GetInterfaceName() string {
+func (x *DiskResizeReq) GetDiskid() *DiskId {
 	if x != nil {
-		return x.InterfaceName
+		return x.Diskid
+	}
+	return nil
+}
+
+func (x *DiskResizeReq) GetSize() string {
+	if x != nil {
+		return x.Size
 	}
 	return ""
 }
 
-type SwitchInfo struct {
+func (x *DiskResizeReq) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DiskSnapshotId struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	SwitchType    *SwitchType            `protobuf:"varint,3,opt,name=switch_type,json=switchType,proto3,enum=cirrina.SwitchType,oneof" json:"switch_type,omitempty"`
-	Uplink        *string                `protobuf:"bytes,4,opt,name=uplink,proto3,oneof" json:"uplink,omitempty"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SwitchInfo) Reset() {
-	*x = SwitchInfo{}
+func (x *DiskSnapshotId) Reset() {
+	*x = DiskSnapshotId{}
 	mi := &file_cirrina_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SwitchInfo) String() string {
+func (x *DiskSnapshotId) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwitchInfo) ProtoMessage() {}
+func (*DiskSnapshotId) ProtoMessage() {}
 
-func (x *SwitchInfo) ProtoReflect() protoreflect.Message {
+func (x *DiskSnapshotId) ProtoReflect() protoreflect.Message {
 	mi := &file_cirrina_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1198,64 +1280,43 @@ func (x *SwitchInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwitchInfo.ProtoReflect.Descriptor instead.
-func (*SwitchInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiskSnapshotId.ProtoReflect.Descriptor instead.
+func (*DiskSnapshotId) Descriptor() ([]byte, []int) {
 	return file_cirrina_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SwitchInfo) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *SwitchInfo) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *SwitchInfo) GetSwitchType() SwitchType {
-	if x != nil && x.SwitchType != nil {
-		return *x.SwitchType
-	}
-	return SwitchType_IF
-}
-
-func (x *SwitchInfo) GetUplink() string {
-	if x != nil && x.Uplink != nil {
-		return *x.Uplink
+func (x *DiskSnapshotId) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
 
-type SwitchInfoUpdate struct {
+type DiskSnapshotInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	SwitchType    *SwitchType            `protobuf:"varint,4,opt,name=switch_type,json=switchType,proto3,enum=cirrina.SwitchType,oneof" json:"switch_type,omitempty"`
-	Uplink        *string                `protobuf:"bytes,5,opt,name=uplink,proto3,oneof" json:"uplink,omitempty"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	DiskId        *string                `protobuf:"bytes,2,opt,name=disk_id,json=diskId,proto3,oneof" json:"disk_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created,proto3,oneof" json:"created,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SwitchInfoUpdate) Reset() {
-	*x = SwitchInfoUpdate{}
+func (x *DiskSnapshotInfo) Reset() {
+	*x = DiskSnapshotInfo{}
 	mi := &file_cirrina_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SwitchInfoUpdate) String() string {
+func (x *DiskSnapshotInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwitchInfoUpdate) ProtoMessage() {}
+func (*DiskSnapshotInfo) ProtoMessage() {}
 
-func (x *SwitchInfoUpdate) ProtoReflect() protoreflect.Message {
+func (x *DiskSnapshotInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_cirrina_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1267,76 +1328,68 @@ func (x *SwitchInfoUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwitchInfoUpdate.ProtoReflect.Descriptor instead.
-func (*SwitchInfoUpdate) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiskSnapshotInfo.ProtoReflect.Descriptor instead.
+func (*DiskSnapshotInfo) Descriptor() ([]byte, []int) {
 	return file_cirrina_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SwitchInfoUpdate) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *DiskSnapshotInfo) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return ""
 }
 
-func (x *SwitchInfoUpdate) GetName() string {
+func (x *DiskSnapshotInfo) GetDiskId() string {
+	if x != nil && x.DiskId != nil {
+		return *x.DiskId
+	}
+	return ""
+}
+
+func (x *DiskSnapshotInfo) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-func (x *SwitchInfoUpdate) GetDescription() string {
+func (x *DiskSnapshotInfo) GetDescription() string {
 	if x != nil && x.Description != nil {
 		return *x.Description
 	}
 	return ""
 }
 
-func (x *SwitchInfoUpdate) GetSwitchType() SwitchType {
-	if x != nil && x.SwitchType != nil {
-		return *x.SwitchType
-	}
-	return SwitchType_IF
-}
-
-func (x *SwitchInfoUpdate) GetUplink() string {
-	if x != nil && x.Uplink != nil {
-		return *x.Uplink
+func (x *DiskSnapshotInfo) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
 	}
-	return ""
+	return nil
 }
 
-type VmNicInfo struct {
+type DiskSnapshotReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Mac           *string                `protobuf:"bytes,3,opt,name=mac,proto3,oneof" json:"mac,omitempty"`
-	Netdevtype    *NetDevType            `protobuf:"varint,4,opt,name=netdevtype,proto3,enum=cirrina.NetDevType,oneof" json:"netdevtype,omitempty"`
-	Nettype       *NetType               `protobuf:"varint,5,opt,name=nettype,proto3,enum=cirrina.NetType,oneof" json:"nettype,omitempty"`
-	Vmid          *string                `protobuf:"bytes,6,opt,name=vmid,proto3,oneof" json:"vmid,omitempty"`
-	Switchid      *string                `protobuf:"bytes,7,opt,name=switchid,proto3,oneof" json:"switchid,omitempty"`
-	Ratelimit     *bool                  `protobuf:"varint,8,opt,name=ratelimit,proto3,oneof" json:"ratelimit,omitempty"`
-	Ratein        *uint64                `protobuf:"varint,9,opt,name=ratein,proto3,oneof" json:"ratein,omitempty"`
-	Rateout       *uint64                `protobuf:"varint,10,opt,name=rateout,proto3,oneof" json:"rateout,omitempty"`
+	Diskid        *DiskId                `protobuf:"bytes,1,opt,name=diskid,proto3" json:"diskid,omitempty"`
+	Snapshotid    *DiskSnapshotId        `protobuf:"bytes,2,opt,name=snapshotid,proto3" json:"snapshotid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VmNicInfo) Reset() {
-	*x = VmNicInfo{}
+func (x *DiskSnapshotReq) Reset() {
+	*x = DiskSnapshotReq{}
 	mi := &file_cirrina_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VmNicInfo) String() string {
+func (x *DiskSnapshotReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VmNicInfo) ProtoMessage() {}
+func (*DiskSnapshotReq) ProtoMessage() {}
 
-func (x *VmNicInfo) ProtoReflect() protoreflect.Message {
+func (x *DiskSnapshotReq) ProtoReflect() protoreflect.Message {
 	mi := &file_cirrina_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1348,79 +1401,2617 @@ func (x *VmNicInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VmNicInfo.ProtoReflect.Descriptor instead.
-func (*VmNicInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiskSnapshotReq.ProtoReflect.Descriptor instead.
+func (*DiskSnapshotReq) Descriptor() ([]byte, []int) {
 	return file_cirrina_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *VmNicInfo) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *DiskSnapshotReq) GetDiskid() *DiskId {
+	if x != nil {
+		return x.Diskid
 	}
-	return ""
+	return nil
 }
 
-func (x *VmNicInfo) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *DiskSnapshotReq) GetSnapshotid() *DiskSnapshotId {
+	if x != nil {
+		return x.Snapshotid
 	}
-	return ""
+	return nil
 }
 
-func (x *VmNicInfo) GetMac() string {
-	if x != nil && x.Mac != nil {
-		return *x.Mac
-	}
-	return ""
+type VMSnapshotId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VmNicInfo) GetNetdevtype() NetDevType {
-	if x != nil && x.Netdevtype != nil {
-		return *x.Netdevtype
-	}
-	return NetDevType_TAP
+func (x *VMSnapshotId) Reset() {
+	*x = VMSnapshotId{}
+	mi := &file_cirrina_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *VmNicInfo) GetNettype() NetType {
-	if x != nil && x.Nettype != nil {
-		return *x.Nettype
-	}
-	return NetType_VIRTIONET
+func (x *VMSnapshotId) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *VmNicInfo) GetVmid() string {
-	if x != nil && x.Vmid != nil {
-		return *x.Vmid
+func (*VMSnapshotId) ProtoMessage() {}
+
+func (x *VMSnapshotId) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *VmNicInfo) GetSwitchid() string {
-	if x != nil && x.Switchid != nil {
-		return *x.Switchid
+// Deprecated: Use VMSnapshotId.ProtoReflect.Descriptor instead.
+func (*VMSnapshotId) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VMSnapshotId) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
 
-func (x *VmNicInfo) GetRatelimit() bool {
-	if x != nil && x.Ratelimit != nil {
-		return *x.Ratelimit
-	}
-	return false
+type VMSnapshotInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	VmId            *string                `protobuf:"bytes,2,opt,name=vm_id,json=vmId,proto3,oneof" json:"vm_id,omitempty"`
+	Name            *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description     *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Created         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created,proto3,oneof" json:"created,omitempty"`
+	DiskSnapshotIds []string               `protobuf:"bytes,6,rep,name=disk_snapshot_ids,json=diskSnapshotIds,proto3" json:"disk_snapshot_ids,omitempty"`
+	UefiVars        *bool                  `protobuf:"varint,7,opt,name=uefi_vars,json=uefiVars,proto3,oneof" json:"uefi_vars,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *VmNicInfo) GetRatein() uint64 {
-	if x != nil && x.Ratein != nil {
-		return *x.Ratein
-	}
-	return 0
+func (x *VMSnapshotInfo) Reset() {
+	*x = VMSnapshotInfo{}
+	mi := &file_cirrina_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *VmNicInfo) GetRateout() uint64 {
-	if x != nil && x.Rateout != nil {
-		return *x.Rateout
-	}
-	return 0
+func (x *VMSnapshotInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMSnapshotInfo) ProtoMessage() {}
+
+func (x *VMSnapshotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMSnapshotInfo.ProtoReflect.Descriptor instead.
+func (*VMSnapshotInfo) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VMSnapshotInfo) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *VMSnapshotInfo) GetVmId() string {
+	if x != nil && x.VmId != nil {
+		return *x.VmId
+	}
+	return ""
+}
+
+func (x *VMSnapshotInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *VMSnapshotInfo) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *VMSnapshotInfo) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *VMSnapshotInfo) GetDiskSnapshotIds() []string {
+	if x != nil {
+		return x.DiskSnapshotIds
+	}
+	return nil
+}
+
+func (x *VMSnapshotInfo) GetUefiVars() bool {
+	if x != nil && x.UefiVars != nil {
+		return *x.UefiVars
+	}
+	return false
+}
+
+type VMRestartRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Restarted     bool                   `protobuf:"varint,4,opt,name=restarted,proto3" json:"restarted,omitempty"`
+	Delay         uint32                 `protobuf:"varint,5,opt,name=delay,proto3" json:"delay,omitempty"`
+	CrashLoop     bool                   `protobuf:"varint,6,opt,name=crash_loop,json=crashLoop,proto3" json:"crash_loop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMRestartRecord) Reset() {
+	*x = VMRestartRecord{}
+	mi := &file_cirrina_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMRestartRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMRestartRecord) ProtoMessage() {}
+
+func (x *VMRestartRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMRestartRecord.ProtoReflect.Descriptor instead.
+func (*VMRestartRecord) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *VMRestartRecord) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *VMRestartRecord) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *VMRestartRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VMRestartRecord) GetRestarted() bool {
+	if x != nil {
+		return x.Restarted
+	}
+	return false
+}
+
+func (x *VMRestartRecord) GetDelay() uint32 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *VMRestartRecord) GetCrashLoop() bool {
+	if x != nil {
+		return x.CrashLoop
+	}
+	return false
+}
+
+type VMDevice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Emulation     string                 `protobuf:"bytes,3,opt,name=emulation,proto3" json:"emulation,omitempty"`
+	Port          *uint32                `protobuf:"varint,4,opt,name=port,proto3,oneof" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMDevice) Reset() {
+	*x = VMDevice{}
+	mi := &file_cirrina_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMDevice) ProtoMessage() {}
+
+func (x *VMDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMDevice.ProtoReflect.Descriptor instead.
+func (*VMDevice) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *VMDevice) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VMDevice) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *VMDevice) GetEmulation() string {
+	if x != nil {
+		return x.Emulation
+	}
+	return ""
+}
+
+func (x *VMDevice) GetPort() uint32 {
+	if x != nil && x.Port != nil {
+		return *x.Port
+	}
+	return 0
+}
+
+type VMControllerInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Maxq          uint32                 `protobuf:"varint,4,opt,name=maxq,proto3" json:"maxq,omitempty"`
+	Qsz           uint32                 `protobuf:"varint,5,opt,name=qsz,proto3" json:"qsz,omitempty"`
+	Ser           string                 `protobuf:"bytes,6,opt,name=ser,proto3" json:"ser,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMControllerInfo) Reset() {
+	*x = VMControllerInfo{}
+	mi := &file_cirrina_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMControllerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMControllerInfo) ProtoMessage() {}
+
+func (x *VMControllerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMControllerInfo.ProtoReflect.Descriptor instead.
+func (*VMControllerInfo) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *VMControllerInfo) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *VMControllerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VMControllerInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VMControllerInfo) GetMaxq() uint32 {
+	if x != nil {
+		return x.Maxq
+	}
+	return 0
+}
+
+func (x *VMControllerInfo) GetQsz() uint32 {
+	if x != nil {
+		return x.Qsz
+	}
+	return 0
+}
+
+func (x *VMControllerInfo) GetSer() string {
+	if x != nil {
+		return x.Ser
+	}
+	return ""
+}
+
+type VMControllerReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMControllerReq) Reset() {
+	*x = VMControllerReq{}
+	mi := &file_cirrina_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMControllerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMControllerReq) ProtoMessage() {}
+
+func (x *VMControllerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMControllerReq.ProtoReflect.Descriptor instead.
+func (*VMControllerReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VMControllerReq) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *VMControllerReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type VMAttachmentInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	Controller    string                 `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	DeviceType    string                 `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Port          uint32                 `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	Boot          bool                   `protobuf:"varint,6,opt,name=boot,proto3" json:"boot,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,7,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	SectorSize    string                 `protobuf:"bytes,8,opt,name=sector_size,json=sectorSize,proto3" json:"sector_size,omitempty"`
+	Cache         *bool                  `protobuf:"varint,9,opt,name=cache,proto3,oneof" json:"cache,omitempty"`
+	Direct        *bool                  `protobuf:"varint,10,opt,name=direct,proto3,oneof" json:"direct,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMAttachmentInfo) Reset() {
+	*x = VMAttachmentInfo{}
+	mi := &file_cirrina_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMAttachmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMAttachmentInfo) ProtoMessage() {}
+
+func (x *VMAttachmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMAttachmentInfo.ProtoReflect.Descriptor instead.
+func (*VMAttachmentInfo) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VMAttachmentInfo) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *VMAttachmentInfo) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *VMAttachmentInfo) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *VMAttachmentInfo) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *VMAttachmentInfo) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *VMAttachmentInfo) GetBoot() bool {
+	if x != nil {
+		return x.Boot
+	}
+	return false
+}
+
+func (x *VMAttachmentInfo) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *VMAttachmentInfo) GetSectorSize() string {
+	if x != nil {
+		return x.SectorSize
+	}
+	return ""
+}
+
+func (x *VMAttachmentInfo) GetCache() bool {
+	if x != nil && x.Cache != nil {
+		return *x.Cache
+	}
+	return false
+}
+
+func (x *VMAttachmentInfo) GetDirect() bool {
+	if x != nil && x.Direct != nil {
+		return *x.Direct
+	}
+	return false
+}
+
+type VMAttachmentReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	DeviceType    string                 `protobuf:"bytes,2,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMAttachmentReq) Reset() {
+	*x = VMAttachmentReq{}
+	mi := &file_cirrina_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMAttachmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMAttachmentReq) ProtoMessage() {}
+
+func (x *VMAttachmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMAttachmentReq.ProtoReflect.Descriptor instead.
+func (*VMAttachmentReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VMAttachmentReq) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *VMAttachmentReq) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *VMAttachmentReq) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type VMPassthruReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMPassthruReq) Reset() {
+	*x = VMPassthruReq{}
+	mi := &file_cirrina_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMPassthruReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMPassthruReq) ProtoMessage() {}
+
+func (x *VMPassthruReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMPassthruReq.ProtoReflect.Descriptor instead.
+func (*VMPassthruReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VMPassthruReq) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *VMPassthruReq) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type VMShareInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,4,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMShareInfo) Reset() {
+	*x = VMShareInfo{}
+	mi := &file_cirrina_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMShareInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMShareInfo) ProtoMessage() {}
+
+func (x *VMShareInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMShareInfo.ProtoReflect.Descriptor instead.
+func (*VMShareInfo) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VMShareInfo) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *VMShareInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VMShareInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *VMShareInfo) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type VMShareReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMShareReq) Reset() {
+	*x = VMShareReq{}
+	mi := &file_cirrina_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMShareReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMShareReq) ProtoMessage() {}
+
+func (x *VMShareReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMShareReq.ProtoReflect.Descriptor instead.
+func (*VMShareReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *VMShareReq) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *VMShareReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ConsolePortReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsolePortReq) Reset() {
+	*x = ConsolePortReq{}
+	mi := &file_cirrina_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsolePortReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolePortReq) ProtoMessage() {}
+
+func (x *ConsolePortReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolePortReq.ProtoReflect.Descriptor instead.
+func (*ConsolePortReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ConsolePortReq) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *ConsolePortReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ConsolePortDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*ConsolePortDataRequest_Port
+	//	*ConsolePortDataRequest_PortInBytes
+	Data          isConsolePortDataRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsolePortDataRequest) Reset() {
+	*x = ConsolePortDataRequest{}
+	mi := &file_cirrina_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsolePortDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolePortDataRequest) ProtoMessage() {}
+
+func (x *ConsolePortDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolePortDataRequest.ProtoReflect.Descriptor instead.
+func (*ConsolePortDataRequest) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ConsolePortDataRequest) GetData() isConsolePortDataRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ConsolePortDataRequest) GetPort() *ConsolePortReq {
+	if x != nil {
+		if x, ok := x.Data.(*ConsolePortDataRequest_Port); ok {
+			return x.Port
+		}
+	}
+	return nil
+}
+
+func (x *ConsolePortDataRequest) GetPortInBytes() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*ConsolePortDataRequest_PortInBytes); ok {
+			return x.PortInBytes
+		}
+	}
+	return nil
+}
+
+type isConsolePortDataRequest_Data interface {
+	isConsolePortDataRequest_Data()
+}
+
+type ConsolePortDataRequest_Port struct {
+	Port *ConsolePortReq `protobuf:"bytes,1,opt,name=port,proto3,oneof"`
+}
+
+type ConsolePortDataRequest_PortInBytes struct {
+	PortInBytes []byte `protobuf:"bytes,2,opt,name=port_in_bytes,json=portInBytes,proto3,oneof"`
+}
+
+func (*ConsolePortDataRequest_Port) isConsolePortDataRequest_Data() {}
+
+func (*ConsolePortDataRequest_PortInBytes) isConsolePortDataRequest_Data() {}
+
+type GuestIPAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Prefix        uint32                 `protobuf:"varint,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestIPAddress) Reset() {
+	*x = GuestIPAddress{}
+	mi := &file_cirrina_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestIPAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestIPAddress) ProtoMessage() {}
+
+func (x *GuestIPAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestIPAddress.ProtoReflect.Descriptor instead.
+func (*GuestIPAddress) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GuestIPAddress) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GuestIPAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GuestIPAddress) GetPrefix() uint32 {
+	if x != nil {
+		return x.Prefix
+	}
+	return 0
+}
+
+type GuestInterface struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	HardwareAddress string                 `protobuf:"bytes,2,opt,name=hardware_address,json=hardwareAddress,proto3" json:"hardware_address,omitempty"`
+	Addresses       []*GuestIPAddress      `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GuestInterface) Reset() {
+	*x = GuestInterface{}
+	mi := &file_cirrina_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestInterface) ProtoMessage() {}
+
+func (x *GuestInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestInterface.ProtoReflect.Descriptor instead.
+func (*GuestInterface) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GuestInterface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GuestInterface) GetHardwareAddress() string {
+	if x != nil {
+		return x.HardwareAddress
+	}
+	return ""
+}
+
+func (x *GuestInterface) GetAddresses() []*GuestIPAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type GuestFSFreezeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filesystems   uint32                 `protobuf:"varint,1,opt,name=filesystems,proto3" json:"filesystems,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestFSFreezeReply) Reset() {
+	*x = GuestFSFreezeReply{}
+	mi := &file_cirrina_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestFSFreezeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestFSFreezeReply) ProtoMessage() {}
+
+func (x *GuestFSFreezeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestFSFreezeReply.ProtoReflect.Descriptor instead.
+func (*GuestFSFreezeReply) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GuestFSFreezeReply) GetFilesystems() uint32 {
+	if x != nil {
+		return x.Filesystems
+	}
+	return 0
+}
+
+func (x *GuestFSFreezeReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type HostPCIDevice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	Ppt           bool                   `protobuf:"varint,3,opt,name=ppt,proto3" json:"ppt,omitempty"`
+	Vendor        string                 `protobuf:"bytes,4,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	Device        string                 `protobuf:"bytes,5,opt,name=device,proto3" json:"device,omitempty"`
+	Class         string                 `protobuf:"bytes,6,opt,name=class,proto3" json:"class,omitempty"`
+	Subclass      string                 `protobuf:"bytes,7,opt,name=subclass,proto3" json:"subclass,omitempty"`
+	HeldBy        string                 `protobuf:"bytes,8,opt,name=held_by,json=heldBy,proto3" json:"held_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostPCIDevice) Reset() {
+	*x = HostPCIDevice{}
+	mi := &file_cirrina_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostPCIDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostPCIDevice) ProtoMessage() {}
+
+func (x *HostPCIDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostPCIDevice.ProtoReflect.Descriptor instead.
+func (*HostPCIDevice) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *HostPCIDevice) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *HostPCIDevice) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *HostPCIDevice) GetPpt() bool {
+	if x != nil {
+		return x.Ppt
+	}
+	return false
+}
+
+func (x *HostPCIDevice) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *HostPCIDevice) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *HostPCIDevice) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *HostPCIDevice) GetSubclass() string {
+	if x != nil {
+		return x.Subclass
+	}
+	return ""
+}
+
+func (x *HostPCIDevice) GetHeldBy() string {
+	if x != nil {
+		return x.HeldBy
+	}
+	return ""
+}
+
+type FirmwareInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	VarsTemplate  string                 `protobuf:"bytes,3,opt,name=vars_template,json=varsTemplate,proto3" json:"vars_template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FirmwareInfo) Reset() {
+	*x = FirmwareInfo{}
+	mi := &file_cirrina_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FirmwareInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirmwareInfo) ProtoMessage() {}
+
+func (x *FirmwareInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirmwareInfo.ProtoReflect.Descriptor instead.
+func (*FirmwareInfo) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FirmwareInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FirmwareInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FirmwareInfo) GetVarsTemplate() string {
+	if x != nil {
+		return x.VarsTemplate
+	}
+	return ""
+}
+
+type VMUEFIBootEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        uint32                 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Active        bool                   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	DevicePath    string                 `protobuf:"bytes,4,opt,name=device_path,json=devicePath,proto3" json:"device_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMUEFIBootEntry) Reset() {
+	*x = VMUEFIBootEntry{}
+	mi := &file_cirrina_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMUEFIBootEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMUEFIBootEntry) ProtoMessage() {}
+
+func (x *VMUEFIBootEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMUEFIBootEntry.ProtoReflect.Descriptor instead.
+func (*VMUEFIBootEntry) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VMUEFIBootEntry) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *VMUEFIBootEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VMUEFIBootEntry) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *VMUEFIBootEntry) GetDevicePath() string {
+	if x != nil {
+		return x.DevicePath
+	}
+	return ""
+}
+
+type VMUEFIBootOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BootOrder     []uint32               `protobuf:"varint,1,rep,packed,name=boot_order,json=bootOrder,proto3" json:"boot_order,omitempty"`
+	BootNext      *uint32                `protobuf:"varint,2,opt,name=boot_next,json=bootNext,proto3,oneof" json:"boot_next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMUEFIBootOrder) Reset() {
+	*x = VMUEFIBootOrder{}
+	mi := &file_cirrina_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMUEFIBootOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMUEFIBootOrder) ProtoMessage() {}
+
+func (x *VMUEFIBootOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMUEFIBootOrder.ProtoReflect.Descriptor instead.
+func (*VMUEFIBootOrder) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VMUEFIBootOrder) GetBootOrder() []uint32 {
+	if x != nil {
+		return x.BootOrder
+	}
+	return nil
+}
+
+func (x *VMUEFIBootOrder) GetBootNext() uint32 {
+	if x != nil && x.BootNext != nil {
+		return *x.BootNext
+	}
+	return 0
+}
+
+type VMUEFIBootOrderReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	BootOrder     []uint32               `protobuf:"varint,2,rep,packed,name=boot_order,json=bootOrder,proto3" json:"boot_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMUEFIBootOrderReq) Reset() {
+	*x = VMUEFIBootOrderReq{}
+	mi := &file_cirrina_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMUEFIBootOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMUEFIBootOrderReq) ProtoMessage() {}
+
+func (x *VMUEFIBootOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMUEFIBootOrderReq.ProtoReflect.Descriptor instead.
+func (*VMUEFIBootOrderReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *VMUEFIBootOrderReq) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *VMUEFIBootOrderReq) GetBootOrder() []uint32 {
+	if x != nil {
+		return x.BootOrder
+	}
+	return nil
+}
+
+type VMUEFIBootNextReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	BootNext      *uint32                `protobuf:"varint,2,opt,name=boot_next,json=bootNext,proto3,oneof" json:"boot_next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMUEFIBootNextReq) Reset() {
+	*x = VMUEFIBootNextReq{}
+	mi := &file_cirrina_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMUEFIBootNextReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMUEFIBootNextReq) ProtoMessage() {}
+
+func (x *VMUEFIBootNextReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMUEFIBootNextReq.ProtoReflect.Descriptor instead.
+func (*VMUEFIBootNextReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VMUEFIBootNextReq) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *VMUEFIBootNextReq) GetBootNext() uint32 {
+	if x != nil && x.BootNext != nil {
+		return *x.BootNext
+	}
+	return 0
+}
+
+type VMUEFIBootISOReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	IsoId         *ISOID                 `protobuf:"bytes,2,opt,name=iso_id,json=isoId,proto3" json:"iso_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMUEFIBootISOReq) Reset() {
+	*x = VMUEFIBootISOReq{}
+	mi := &file_cirrina_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMUEFIBootISOReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMUEFIBootISOReq) ProtoMessage() {}
+
+func (x *VMUEFIBootISOReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMUEFIBootISOReq.ProtoReflect.Descriptor instead.
+func (*VMUEFIBootISOReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VMUEFIBootISOReq) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *VMUEFIBootISOReq) GetIsoId() *ISOID {
+	if x != nil {
+		return x.IsoId
+	}
+	return nil
+}
+
+type VMUEFIVars struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMUEFIVars) Reset() {
+	*x = VMUEFIVars{}
+	mi := &file_cirrina_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMUEFIVars) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMUEFIVars) ProtoMessage() {}
+
+func (x *VMUEFIVars) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMUEFIVars.ProtoReflect.Descriptor instead.
+func (*VMUEFIVars) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VMUEFIVars) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *VMUEFIVars) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type VMSnapshotReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vmid          *VMID                  `protobuf:"bytes,1,opt,name=vmid,proto3" json:"vmid,omitempty"`
+	Snapshotid    *VMSnapshotId          `protobuf:"bytes,2,opt,name=snapshotid,proto3" json:"snapshotid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VMSnapshotReq) Reset() {
+	*x = VMSnapshotReq{}
+	mi := &file_cirrina_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMSnapshotReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMSnapshotReq) ProtoMessage() {}
+
+func (x *VMSnapshotReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMSnapshotReq.ProtoReflect.Descriptor instead.
+func (*VMSnapshotReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *VMSnapshotReq) GetVmid() *VMID {
+	if x != nil {
+		return x.Vmid
+	}
+	return nil
+}
+
+func (x *VMSnapshotReq) GetSnapshotid() *VMSnapshotId {
+	if x != nil {
+		return x.Snapshotid
+	}
+	return nil
+}
+
+type ScheduleId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleId) Reset() {
+	*x = ScheduleId{}
+	mi := &file_cirrina_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleId) ProtoMessage() {}
+
+func (x *ScheduleId) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleId.ProtoReflect.Descriptor instead.
+func (*ScheduleId) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ScheduleId) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ScheduleInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Cron          *string                `protobuf:"bytes,4,opt,name=cron,proto3,oneof" json:"cron,omitempty"`
+	Action        *string                `protobuf:"bytes,5,opt,name=action,proto3,oneof" json:"action,omitempty"`
+	VmId          *string                `protobuf:"bytes,6,opt,name=vm_id,json=vmId,proto3,oneof" json:"vm_id,omitempty"`
+	Selector      *string                `protobuf:"bytes,7,opt,name=selector,proto3,oneof" json:"selector,omitempty"`
+	LastRun       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_run,json=lastRun,proto3,oneof" json:"last_run,omitempty"`
+	NextRun       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=next_run,json=nextRun,proto3,oneof" json:"next_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleInfo) Reset() {
+	*x = ScheduleInfo{}
+	mi := &file_cirrina_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleInfo) ProtoMessage() {}
+
+func (x *ScheduleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleInfo.ProtoReflect.Descriptor instead.
+func (*ScheduleInfo) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ScheduleInfo) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetCron() string {
+	if x != nil && x.Cron != nil {
+		return *x.Cron
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetVmId() string {
+	if x != nil && x.VmId != nil {
+		return *x.VmId
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetSelector() string {
+	if x != nil && x.Selector != nil {
+		return *x.Selector
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetLastRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+func (x *ScheduleInfo) GetNextRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRun
+	}
+	return nil
+}
+
+type WebhookId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookId) Reset() {
+	*x = WebhookId{}
+	mi := &file_cirrina_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookId) ProtoMessage() {}
+
+func (x *WebhookId) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookId.ProtoReflect.Descriptor instead.
+func (*WebhookId) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WebhookId) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type WebhookInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Url           *string                `protobuf:"bytes,4,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	Secret        *string                `protobuf:"bytes,5,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	Events        *string                `protobuf:"bytes,6,opt,name=events,proto3,oneof" json:"events,omitempty"`
+	MaxRetries    *uint32                `protobuf:"varint,7,opt,name=max_retries,json=maxRetries,proto3,oneof" json:"max_retries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookInfo) Reset() {
+	*x = WebhookInfo{}
+	mi := &file_cirrina_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookInfo) ProtoMessage() {}
+
+func (x *WebhookInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookInfo.ProtoReflect.Descriptor instead.
+func (*WebhookInfo) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WebhookInfo) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *WebhookInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *WebhookInfo) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *WebhookInfo) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *WebhookInfo) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookInfo) GetEvents() string {
+	if x != nil && x.Events != nil {
+		return *x.Events
+	}
+	return ""
+}
+
+func (x *WebhookInfo) GetMaxRetries() uint32 {
+	if x != nil && x.MaxRetries != nil {
+		return *x.MaxRetries
+	}
+	return 0
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Event         string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Attempts      uint32                 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Success       bool                   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_cirrina_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WebhookDelivery) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type OrphanedStorage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          StorageType            `protobuf:"varint,2,opt,name=type,proto3,enum=cirrina.StorageType" json:"type,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Size          uint64                 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrphanedStorage) Reset() {
+	*x = OrphanedStorage{}
+	mi := &file_cirrina_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrphanedStorage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrphanedStorage) ProtoMessage() {}
+
+func (x *OrphanedStorage) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrphanedStorage.ProtoReflect.Descriptor instead.
+func (*OrphanedStorage) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *OrphanedStorage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrphanedStorage) GetType() StorageType {
+	if x != nil {
+		return x.Type
+	}
+	return StorageType_STORAGE_DISK_FILE
+}
+
+func (x *OrphanedStorage) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *OrphanedStorage) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type AdoptStorageReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          StorageType            `protobuf:"varint,2,opt,name=type,proto3,enum=cirrina.StorageType" json:"type,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	DiskType      *DiskType              `protobuf:"varint,4,opt,name=disk_type,json=diskType,proto3,enum=cirrina.DiskType,oneof" json:"disk_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdoptStorageReq) Reset() {
+	*x = AdoptStorageReq{}
+	mi := &file_cirrina_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdoptStorageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptStorageReq) ProtoMessage() {}
+
+func (x *AdoptStorageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptStorageReq.ProtoReflect.Descriptor instead.
+func (*AdoptStorageReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AdoptStorageReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdoptStorageReq) GetType() StorageType {
+	if x != nil {
+		return x.Type
+	}
+	return StorageType_STORAGE_DISK_FILE
+}
+
+func (x *AdoptStorageReq) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *AdoptStorageReq) GetDiskType() DiskType {
+	if x != nil && x.DiskType != nil {
+		return *x.DiskType
+	}
+	return DiskType_NVME
+}
+
+type PurgeStorageReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          StorageType            `protobuf:"varint,2,opt,name=type,proto3,enum=cirrina.StorageType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeStorageReq) Reset() {
+	*x = PurgeStorageReq{}
+	mi := &file_cirrina_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeStorageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeStorageReq) ProtoMessage() {}
+
+func (x *PurgeStorageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeStorageReq.ProtoReflect.Descriptor instead.
+func (*PurgeStorageReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PurgeStorageReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PurgeStorageReq) GetType() StorageType {
+	if x != nil {
+		return x.Type
+	}
+	return StorageType_STORAGE_DISK_FILE
+}
+
+type NetInterfacesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetInterfacesReq) Reset() {
+	*x = NetInterfacesReq{}
+	mi := &file_cirrina_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetInterfacesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetInterfacesReq) ProtoMessage() {}
+
+func (x *NetInterfacesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetInterfacesReq.ProtoReflect.Descriptor instead.
+func (*NetInterfacesReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{51}
+}
+
+type NetIf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InterfaceName string                 `protobuf:"bytes,1,opt,name=InterfaceName,proto3" json:"InterfaceName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetIf) Reset() {
+	*x = NetIf{}
+	mi := &file_cirrina_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetIf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetIf) ProtoMessage() {}
+
+func (x *NetIf) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetIf.ProtoReflect.Descriptor instead.
+func (*NetIf) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *NetIf) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+type SwitchInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	SwitchType    *SwitchType            `protobuf:"varint,3,opt,name=switch_type,json=switchType,proto3,enum=cirrina.SwitchType,oneof" json:"switch_type,omitempty"`
+	Uplink        *string                `protobuf:"bytes,4,opt,name=uplink,proto3,oneof" json:"uplink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwitchInfo) Reset() {
+	*x = SwitchInfo{}
+	mi := &file_cirrina_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchInfo) ProtoMessage() {}
+
+func (x *SwitchInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchInfo.ProtoReflect.Descriptor instead.
+func (*SwitchInfo) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SwitchInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *SwitchInfo) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SwitchInfo) GetSwitchType() SwitchType {
+	if x != nil && x.SwitchType != nil {
+		return *x.SwitchType
+	}
+	return SwitchType_IF
+}
+
+func (x *SwitchInfo) GetUplink() string {
+	if x != nil && x.Uplink != nil {
+		return *x.Uplink
+	}
+	return ""
+}
+
+type SwitchInfoUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	SwitchType    *SwitchType            `protobuf:"varint,4,opt,name=switch_type,json=switchType,proto3,enum=cirrina.SwitchType,oneof" json:"switch_type,omitempty"`
+	Uplink        *string                `protobuf:"bytes,5,opt,name=uplink,proto3,oneof" json:"uplink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwitchInfoUpdate) Reset() {
+	*x = SwitchInfoUpdate{}
+	mi := &file_cirrina_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchInfoUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchInfoUpdate) ProtoMessage() {}
+
+func (x *SwitchInfoUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchInfoUpdate.ProtoReflect.Descriptor instead.
+func (*SwitchInfoUpdate) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SwitchInfoUpdate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SwitchInfoUpdate) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *SwitchInfoUpdate) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *SwitchInfoUpdate) GetSwitchType() SwitchType {
+	if x != nil && x.SwitchType != nil {
+		return *x.SwitchType
+	}
+	return SwitchType_IF
+}
+
+func (x *SwitchInfoUpdate) GetUplink() string {
+	if x != nil && x.Uplink != nil {
+		return *x.Uplink
+	}
+	return ""
+}
+
+type VmNicInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Mac           *string                `protobuf:"bytes,3,opt,name=mac,proto3,oneof" json:"mac,omitempty"`
+	Netdevtype    *NetDevType            `protobuf:"varint,4,opt,name=netdevtype,proto3,enum=cirrina.NetDevType,oneof" json:"netdevtype,omitempty"`
+	Nettype       *NetType               `protobuf:"varint,5,opt,name=nettype,proto3,enum=cirrina.NetType,oneof" json:"nettype,omitempty"`
+	Vmid          *string                `protobuf:"bytes,6,opt,name=vmid,proto3,oneof" json:"vmid,omitempty"`
+	Switchid      *string                `protobuf:"bytes,7,opt,name=switchid,proto3,oneof" json:"switchid,omitempty"`
+	Ratelimit     *bool                  `protobuf:"varint,8,opt,name=ratelimit,proto3,oneof" json:"ratelimit,omitempty"`
+	Ratein        *uint64                `protobuf:"varint,9,opt,name=ratein,proto3,oneof" json:"ratein,omitempty"`
+	Rateout       *uint64                `protobuf:"varint,10,opt,name=rateout,proto3,oneof" json:"rateout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VmNicInfo) Reset() {
+	*x = VmNicInfo{}
+	mi := &file_cirrina_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VmNicInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VmNicInfo) ProtoMessage() {}
+
+func (x *VmNicInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VmNicInfo.ProtoReflect.Descriptor instead.
+func (*VmNicInfo) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *VmNicInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *VmNicInfo) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *VmNicInfo) GetMac() string {
+	if x != nil && x.Mac != nil {
+		return *x.Mac
+	}
+	return ""
+}
+
+func (x *VmNicInfo) GetNetdevtype() NetDevType {
+	if x != nil && x.Netdevtype != nil {
+		return *x.Netdevtype
+	}
+	return NetDevType_TAP
+}
+
+func (x *VmNicInfo) GetNettype() NetType {
+	if x != nil && x.Nettype != nil {
+		return *x.Nettype
+	}
+	return NetType_VIRTIONET
+}
+
+func (x *VmNicInfo) GetVmid() string {
+	if x != nil && x.Vmid != nil {
+		return *x.Vmid
+	}
+	return ""
+}
+
+func (x *VmNicInfo) GetSwitchid() string {
+	if x != nil && x.Switchid != nil {
+		return *x.Switchid
+	}
+	return ""
+}
+
+func (x *VmNicInfo) GetRatelimit() bool {
+	if x != nil && x.Ratelimit != nil {
+		return *x.Ratelimit
+	}
+	return false
+}
+
+func (x *VmNicInfo) GetRatein() uint64 {
+	if x != nil && x.Ratein != nil {
+		return *x.Ratein
+	}
+	return 0
+}
+
+func (x *VmNicInfo) GetRateout() uint64 {
+	if x != nil && x.Rateout != nil {
+		return *x.Rateout
+	}
+	return 0
+}
+
+type VmNicAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	NicId         string                 `protobuf:"bytes,4,opt,name=nic_id,json=nicId,proto3" json:"nic_id,omitempty"` // only set by GetVMAddresses
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VmNicAddress) Reset() {
+	*x = VmNicAddress{}
+	mi := &file_cirrina_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VmNicAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VmNicAddress) ProtoMessage() {}
+
+func (x *VmNicAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VmNicAddress.ProtoReflect.Descriptor instead.
+func (*VmNicAddress) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *VmNicAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VmNicAddress) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *VmNicAddress) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *VmNicAddress) GetNicId() string {
+	if x != nil {
+		return x.NicId
+	}
+	return ""
 }
 
 type VmNicInfoUpdate struct {
@@ -1441,7 +4032,7 @@ type VmNicInfoUpdate struct {
 
 func (x *VmNicInfoUpdate) Reset() {
 	*x = VmNicInfoUpdate{}
-	mi := &file_cirrina_proto_msgTypes[18]
+	mi := &file_cirrina_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1453,7 +4044,7 @@ func (x *VmNicInfoUpdate) String() string {
 func (*VmNicInfoUpdate) ProtoMessage() {}
 
 func (x *VmNicInfoUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[18]
+	mi := &file_cirrina_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,7 +4057,7 @@ func (x *VmNicInfoUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VmNicInfoUpdate.ProtoReflect.Descriptor instead.
 func (*VmNicInfoUpdate) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{18}
+	return file_cirrina_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *VmNicInfoUpdate) GetVmnicid() *VmNicId {
@@ -1597,13 +4188,47 @@ type VMConfig struct {
 	Wbps           *uint32                `protobuf:"varint,58,opt,name=wbps,proto3,oneof" json:"wbps,omitempty"`
 	Riops          *uint32                `protobuf:"varint,59,opt,name=riops,proto3,oneof" json:"riops,omitempty"`
 	Wiops          *uint32                `protobuf:"varint,60,opt,name=wiops,proto3,oneof" json:"wiops,omitempty"`
+	StopPolicy     *string                `protobuf:"bytes,61,opt,name=stop_policy,json=stopPolicy,proto3,oneof" json:"stop_policy,omitempty"`
+	RestartPolicy  *string                `protobuf:"bytes,62,opt,name=restart_policy,json=restartPolicy,proto3,oneof" json:"restart_policy,omitempty"`
+	RestartMax     *uint32                `protobuf:"varint,63,opt,name=restart_max,json=restartMax,proto3,oneof" json:"restart_max,omitempty"`
+	RestartWindow  *uint32                `protobuf:"varint,64,opt,name=restart_window,json=restartWindow,proto3,oneof" json:"restart_window,omitempty"`
+	BootGroup      *uint32                `protobuf:"varint,65,opt,name=boot_group,json=bootGroup,proto3,oneof" json:"boot_group,omitempty"`
+	DependsOn      *string                `protobuf:"bytes,66,opt,name=depends_on,json=dependsOn,proto3,oneof" json:"depends_on,omitempty"` // comma separated VM IDs
+	ReadyPattern   *string                `protobuf:"bytes,67,opt,name=ready_pattern,json=readyPattern,proto3,oneof" json:"ready_pattern,omitempty"`
+	ReadyTcp       *string                `protobuf:"bytes,68,opt,name=ready_tcp,json=readyTcp,proto3,oneof" json:"ready_tcp,omitempty"`
+	ReadyHttp      *string                `protobuf:"bytes,69,opt,name=ready_http,json=readyHttp,proto3,oneof" json:"ready_http,omitempty"`
+	ReadyDeadline  *uint32                `protobuf:"varint,70,opt,name=ready_deadline,json=readyDeadline,proto3,oneof" json:"ready_deadline,omitempty"`
+	ReadyInterval  *uint32                `protobuf:"varint,71,opt,name=ready_interval,json=readyInterval,proto3,oneof" json:"ready_interval,omitempty"`
+	ReadyTimeout   *uint32                `protobuf:"varint,72,opt,name=ready_timeout,json=readyTimeout,proto3,oneof" json:"ready_timeout,omitempty"`
+	LivePattern    *string                `protobuf:"bytes,73,opt,name=live_pattern,json=livePattern,proto3,oneof" json:"live_pattern,omitempty"`
+	LiveTcp        *string                `protobuf:"bytes,74,opt,name=live_tcp,json=liveTcp,proto3,oneof" json:"live_tcp,omitempty"`
+	LiveHttp       *string                `protobuf:"bytes,75,opt,name=live_http,json=liveHttp,proto3,oneof" json:"live_http,omitempty"`
+	LiveInterval   *uint32                `protobuf:"varint,76,opt,name=live_interval,json=liveInterval,proto3,oneof" json:"live_interval,omitempty"`
+	LiveTimeout    *uint32                `protobuf:"varint,77,opt,name=live_timeout,json=liveTimeout,proto3,oneof" json:"live_timeout,omitempty"`
+	LiveFailures   *uint32                `protobuf:"varint,78,opt,name=live_failures,json=liveFailures,proto3,oneof" json:"live_failures,omitempty"`
+	LiveAction     *string                `protobuf:"bytes,79,opt,name=live_action,json=liveAction,proto3,oneof" json:"live_action,omitempty"`
+	Labels         *string                `protobuf:"bytes,80,opt,name=labels,proto3,oneof" json:"labels,omitempty"`
+	HookPreStart   *string                `protobuf:"bytes,81,opt,name=hook_pre_start,json=hookPreStart,proto3,oneof" json:"hook_pre_start,omitempty"`
+	HookPostStart  *string                `protobuf:"bytes,82,opt,name=hook_post_start,json=hookPostStart,proto3,oneof" json:"hook_post_start,omitempty"`
+	HookPreStop    *string                `protobuf:"bytes,83,opt,name=hook_pre_stop,json=hookPreStop,proto3,oneof" json:"hook_pre_stop,omitempty"`
+	HookPostStop   *string                `protobuf:"bytes,84,opt,name=hook_post_stop,json=hookPostStop,proto3,oneof" json:"hook_post_stop,omitempty"`
+	HookTimeout    *uint32                `protobuf:"varint,85,opt,name=hook_timeout,json=hookTimeout,proto3,oneof" json:"hook_timeout,omitempty"`
+	PciSlots       *string                `protobuf:"bytes,86,opt,name=pci_slots,json=pciSlots,proto3,oneof" json:"pci_slots,omitempty"`
+	Sockets        *uint32                `protobuf:"varint,87,opt,name=sockets,proto3,oneof" json:"sockets,omitempty"`
+	Cores          *uint32                `protobuf:"varint,88,opt,name=cores,proto3,oneof" json:"cores,omitempty"`
+	Threads        *uint32                `protobuf:"varint,89,opt,name=threads,proto3,oneof" json:"threads,omitempty"`
+	CpuPins        *string                `protobuf:"bytes,90,opt,name=cpu_pins,json=cpuPins,proto3,oneof" json:"cpu_pins,omitempty"`
+	ConsolePorts   *string                `protobuf:"bytes,91,opt,name=console_ports,json=consolePorts,proto3,oneof" json:"console_ports,omitempty"` // comma separated port names
+	GuestAgent     *bool                  `protobuf:"varint,92,opt,name=guest_agent,json=guestAgent,proto3,oneof" json:"guest_agent,omitempty"`
+	Firmware       *string                `protobuf:"bytes,93,opt,name=firmware,proto3,oneof" json:"firmware,omitempty"`               // name in the firmware catalog, or none
+	PciPack        *bool                  `protobuf:"varint,94,opt,name=pci_pack,json=pciPack,proto3,oneof" json:"pci_pack,omitempty"` // share one AHCI controller and pack disks into PCI functions
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *VMConfig) Reset() {
 	*x = VMConfig{}
-	mi := &file_cirrina_proto_msgTypes[19]
+	mi := &file_cirrina_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1615,7 +4240,7 @@ func (x *VMConfig) String() string {
 func (*VMConfig) ProtoMessage() {}
 
 func (x *VMConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[19]
+	mi := &file_cirrina_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1628,7 +4253,7 @@ func (x *VMConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VMConfig.ProtoReflect.Descriptor instead.
 func (*VMConfig) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{19}
+	return file_cirrina_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *VMConfig) GetId() string {
@@ -1890,137 +4515,375 @@ func (x *VMConfig) GetExtraArgs() string {
 	return ""
 }
 
-func (x *VMConfig) GetCom1Log() bool {
-	if x != nil && x.Com1Log != nil {
-		return *x.Com1Log
+func (x *VMConfig) GetCom1Log() bool {
+	if x != nil && x.Com1Log != nil {
+		return *x.Com1Log
+	}
+	return false
+}
+
+func (x *VMConfig) GetCom2Log() bool {
+	if x != nil && x.Com2Log != nil {
+		return *x.Com2Log
+	}
+	return false
+}
+
+func (x *VMConfig) GetCom3Log() bool {
+	if x != nil && x.Com3Log != nil {
+		return *x.Com3Log
+	}
+	return false
+}
+
+func (x *VMConfig) GetCom4Log() bool {
+	if x != nil && x.Com4Log != nil {
+		return *x.Com4Log
+	}
+	return false
+}
+
+func (x *VMConfig) GetCom1Speed() uint32 {
+	if x != nil && x.Com1Speed != nil {
+		return *x.Com1Speed
+	}
+	return 0
+}
+
+func (x *VMConfig) GetCom2Speed() uint32 {
+	if x != nil && x.Com2Speed != nil {
+		return *x.Com2Speed
+	}
+	return 0
+}
+
+func (x *VMConfig) GetCom3Speed() uint32 {
+	if x != nil && x.Com3Speed != nil {
+		return *x.Com3Speed
+	}
+	return 0
+}
+
+func (x *VMConfig) GetCom4Speed() uint32 {
+	if x != nil && x.Com4Speed != nil {
+		return *x.Com4Speed
+	}
+	return 0
+}
+
+func (x *VMConfig) GetAutostartDelay() uint32 {
+	if x != nil && x.AutostartDelay != nil {
+		return *x.AutostartDelay
+	}
+	return 0
+}
+
+func (x *VMConfig) GetDebug() bool {
+	if x != nil && x.Debug != nil {
+		return *x.Debug
+	}
+	return false
+}
+
+func (x *VMConfig) GetDebugWait() bool {
+	if x != nil && x.DebugWait != nil {
+		return *x.DebugWait
+	}
+	return false
+}
+
+func (x *VMConfig) GetDebugPort() string {
+	if x != nil && x.DebugPort != nil {
+		return *x.DebugPort
+	}
+	return ""
+}
+
+func (x *VMConfig) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *VMConfig) GetProtect() bool {
+	if x != nil && x.Protect != nil {
+		return *x.Protect
+	}
+	return false
+}
+
+func (x *VMConfig) GetPcpu() uint32 {
+	if x != nil && x.Pcpu != nil {
+		return *x.Pcpu
+	}
+	return 0
+}
+
+func (x *VMConfig) GetRbps() uint32 {
+	if x != nil && x.Rbps != nil {
+		return *x.Rbps
+	}
+	return 0
+}
+
+func (x *VMConfig) GetWbps() uint32 {
+	if x != nil && x.Wbps != nil {
+		return *x.Wbps
+	}
+	return 0
+}
+
+func (x *VMConfig) GetRiops() uint32 {
+	if x != nil && x.Riops != nil {
+		return *x.Riops
+	}
+	return 0
+}
+
+func (x *VMConfig) GetWiops() uint32 {
+	if x != nil && x.Wiops != nil {
+		return *x.Wiops
+	}
+	return 0
+}
+
+func (x *VMConfig) GetStopPolicy() string {
+	if x != nil && x.StopPolicy != nil {
+		return *x.StopPolicy
+	}
+	return ""
+}
+
+func (x *VMConfig) GetRestartPolicy() string {
+	if x != nil && x.RestartPolicy != nil {
+		return *x.RestartPolicy
+	}
+	return ""
+}
+
+func (x *VMConfig) GetRestartMax() uint32 {
+	if x != nil && x.RestartMax != nil {
+		return *x.RestartMax
+	}
+	return 0
+}
+
+func (x *VMConfig) GetRestartWindow() uint32 {
+	if x != nil && x.RestartWindow != nil {
+		return *x.RestartWindow
+	}
+	return 0
+}
+
+func (x *VMConfig) GetBootGroup() uint32 {
+	if x != nil && x.BootGroup != nil {
+		return *x.BootGroup
+	}
+	return 0
+}
+
+func (x *VMConfig) GetDependsOn() string {
+	if x != nil && x.DependsOn != nil {
+		return *x.DependsOn
+	}
+	return ""
+}
+
+func (x *VMConfig) GetReadyPattern() string {
+	if x != nil && x.ReadyPattern != nil {
+		return *x.ReadyPattern
+	}
+	return ""
+}
+
+func (x *VMConfig) GetReadyTcp() string {
+	if x != nil && x.ReadyTcp != nil {
+		return *x.ReadyTcp
+	}
+	return ""
+}
+
+func (x *VMConfig) GetReadyHttp() string {
+	if x != nil && x.ReadyHttp != nil {
+		return *x.ReadyHttp
+	}
+	return ""
+}
+
+func (x *VMConfig) GetReadyDeadline() uint32 {
+	if x != nil && x.ReadyDeadline != nil {
+		return *x.ReadyDeadline
+	}
+	return 0
+}
+
+func (x *VMConfig) GetReadyInterval() uint32 {
+	if x != nil && x.ReadyInterval != nil {
+		return *x.ReadyInterval
+	}
+	return 0
+}
+
+func (x *VMConfig) GetReadyTimeout() uint32 {
+	if x != nil && x.ReadyTimeout != nil {
+		return *x.ReadyTimeout
+	}
+	return 0
+}
+
+func (x *VMConfig) GetLivePattern() string {
+	if x != nil && x.LivePattern != nil {
+		return *x.LivePattern
 	}
-	return false
+	return ""
 }
 
-func (x *VMConfig) GetCom2Log() bool {
-	if x != nil && x.Com2Log != nil {
-		return *x.Com2Log
+func (x *VMConfig) GetLiveTcp() string {
+	if x != nil && x.LiveTcp != nil {
+		return *x.LiveTcp
 	}
-	return false
+	return ""
 }
 
-func (x *VMConfig) GetCom3Log() bool {
-	if x != nil && x.Com3Log != nil {
-		return *x.Com3Log
+func (x *VMConfig) GetLiveHttp() string {
+	if x != nil && x.LiveHttp != nil {
+		return *x.LiveHttp
 	}
-	return false
+	return ""
 }
 
-func (x *VMConfig) GetCom4Log() bool {
-	if x != nil && x.Com4Log != nil {
-		return *x.Com4Log
+func (x *VMConfig) GetLiveInterval() uint32 {
+	if x != nil && x.LiveInterval != nil {
+		return *x.LiveInterval
 	}
-	return false
+	return 0
 }
 
-func (x *VMConfig) GetCom1Speed() uint32 {
-	if x != nil && x.Com1Speed != nil {
-		return *x.Com1Speed
+func (x *VMConfig) GetLiveTimeout() uint32 {
+	if x != nil && x.LiveTimeout != nil {
+		return *x.LiveTimeout
 	}
 	return 0
 }
 
-func (x *VMConfig) GetCom2Speed() uint32 {
-	if x != nil && x.Com2Speed != nil {
-		return *x.Com2Speed
+func (x *VMConfig) GetLiveFailures() uint32 {
+	if x != nil && x.LiveFailures != nil {
+		return *x.LiveFailures
 	}
 	return 0
 }
 
-func (x *VMConfig) GetCom3Speed() uint32 {
-	if x != nil && x.Com3Speed != nil {
-		return *x.Com3Speed
+func (x *VMConfig) GetLiveAction() string {
+	if x != nil && x.LiveAction != nil {
+		return *x.LiveAction
 	}
-	return 0
+	return ""
 }
 
-func (x *VMConfig) GetCom4Speed() uint32 {
-	if x != nil && x.Com4Speed != nil {
-		return *x.Com4Speed
+func (x *VMConfig) GetLabels() string {
+	if x != nil && x.Labels != nil {
+		return *x.Labels
 	}
-	return 0
+	return ""
 }
 
-func (x *VMConfig) GetAutostartDelay() uint32 {
-	if x != nil && x.AutostartDelay != nil {
-		return *x.AutostartDelay
+func (x *VMConfig) GetHookPreStart() string {
+	if x != nil && x.HookPreStart != nil {
+		return *x.HookPreStart
 	}
-	return 0
+	return ""
 }
 
-func (x *VMConfig) GetDebug() bool {
-	if x != nil && x.Debug != nil {
-		return *x.Debug
+func (x *VMConfig) GetHookPostStart() string {
+	if x != nil && x.HookPostStart != nil {
+		return *x.HookPostStart
 	}
-	return false
+	return ""
 }
 
-func (x *VMConfig) GetDebugWait() bool {
-	if x != nil && x.DebugWait != nil {
-		return *x.DebugWait
+func (x *VMConfig) GetHookPreStop() string {
+	if x != nil && x.HookPreStop != nil {
+		return *x.HookPreStop
 	}
-	return false
+	return ""
 }
 
-func (x *VMConfig) GetDebugPort() string {
-	if x != nil && x.DebugPort != nil {
-		return *x.DebugPort
+func (x *VMConfig) GetHookPostStop() string {
+	if x != nil && x.HookPostStop != nil {
+		return *x.HookPostStop
 	}
 	return ""
 }
 
-func (x *VMConfig) GetPriority() int32 {
-	if x != nil && x.Priority != nil {
-		return *x.Priority
+func (x *VMConfig) GetHookTimeout() uint32 {
+	if x != nil && x.HookTimeout != nil {
+		return *x.HookTimeout
 	}
 	return 0
 }
 
-func (x *VMConfig) GetProtect() bool {
-	if x != nil && x.Protect != nil {
-		return *x.Protect
+func (x *VMConfig) GetPciSlots() string {
+	if x != nil && x.PciSlots != nil {
+		return *x.PciSlots
 	}
-	return false
+	return ""
 }
 
-func (x *VMConfig) GetPcpu() uint32 {
-	if x != nil && x.Pcpu != nil {
-		return *x.Pcpu
+func (x *VMConfig) GetSockets() uint32 {
+	if x != nil && x.Sockets != nil {
+		return *x.Sockets
 	}
 	return 0
 }
 
-func (x *VMConfig) GetRbps() uint32 {
-	if x != nil && x.Rbps != nil {
-		return *x.Rbps
+func (x *VMConfig) GetCores() uint32 {
+	if x != nil && x.Cores != nil {
+		return *x.Cores
 	}
 	return 0
 }
 
-func (x *VMConfig) GetWbps() uint32 {
-	if x != nil && x.Wbps != nil {
-		return *x.Wbps
+func (x *VMConfig) GetThreads() uint32 {
+	if x != nil && x.Threads != nil {
+		return *x.Threads
 	}
 	return 0
 }
 
-func (x *VMConfig) GetRiops() uint32 {
-	if x != nil && x.Riops != nil {
-		return *x.Riops
+func (x *VMConfig) GetCpuPins() string {
+	if x != nil && x.CpuPins != nil {
+		return *x.CpuPins
 	}
-	return 0
+	return ""
 }
 
-func (x *VMConfig) GetWiops() uint32 {
-	if x != nil && x.Wiops != nil {
-		return *x.Wiops
+func (x *VMConfig) GetConsolePorts() string {
+	if x != nil && x.ConsolePorts != nil {
+		return *x.ConsolePorts
 	}
-	return 0
+	return ""
+}
+
+func (x *VMConfig) GetGuestAgent() bool {
+	if x != nil && x.GuestAgent != nil {
+		return *x.GuestAgent
+	}
+	return false
+}
+
+func (x *VMConfig) GetFirmware() string {
+	if x != nil && x.Firmware != nil {
+		return *x.Firmware
+	}
+	return ""
+}
+
+func (x *VMConfig) GetPciPack() bool {
+	if x != nil && x.PciPack != nil {
+		return *x.PciPack
+	}
+	return false
 }
 
 type VMsQuery struct {
@@ -2031,7 +4894,7 @@ type VMsQuery struct {
 
 func (x *VMsQuery) Reset() {
 	*x = VMsQuery{}
-	mi := &file_cirrina_proto_msgTypes[20]
+	mi := &file_cirrina_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2043,7 +4906,7 @@ func (x *VMsQuery) String() string {
 func (*VMsQuery) ProtoMessage() {}
 
 func (x *VMsQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[20]
+	mi := &file_cirrina_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2056,7 +4919,7 @@ func (x *VMsQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VMsQuery.ProtoReflect.Descriptor instead.
 func (*VMsQuery) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{20}
+	return file_cirrina_proto_rawDescGZIP(), []int{59}
 }
 
 type ISOsQuery struct {
@@ -2067,7 +4930,7 @@ type ISOsQuery struct {
 
 func (x *ISOsQuery) Reset() {
 	*x = ISOsQuery{}
-	mi := &file_cirrina_proto_msgTypes[21]
+	mi := &file_cirrina_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2079,7 +4942,7 @@ func (x *ISOsQuery) String() string {
 func (*ISOsQuery) ProtoMessage() {}
 
 func (x *ISOsQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[21]
+	mi := &file_cirrina_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2092,7 +4955,7 @@ func (x *ISOsQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ISOsQuery.ProtoReflect.Descriptor instead.
 func (*ISOsQuery) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{21}
+	return file_cirrina_proto_rawDescGZIP(), []int{60}
 }
 
 type KbdQuery struct {
@@ -2103,7 +4966,7 @@ type KbdQuery struct {
 
 func (x *KbdQuery) Reset() {
 	*x = KbdQuery{}
-	mi := &file_cirrina_proto_msgTypes[22]
+	mi := &file_cirrina_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2115,7 +4978,7 @@ func (x *KbdQuery) String() string {
 func (*KbdQuery) ProtoMessage() {}
 
 func (x *KbdQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[22]
+	mi := &file_cirrina_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2128,7 +4991,7 @@ func (x *KbdQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KbdQuery.ProtoReflect.Descriptor instead.
 func (*KbdQuery) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{22}
+	return file_cirrina_proto_rawDescGZIP(), []int{61}
 }
 
 type DisksQuery struct {
@@ -2139,7 +5002,7 @@ type DisksQuery struct {
 
 func (x *DisksQuery) Reset() {
 	*x = DisksQuery{}
-	mi := &file_cirrina_proto_msgTypes[23]
+	mi := &file_cirrina_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2151,7 +5014,7 @@ func (x *DisksQuery) String() string {
 func (*DisksQuery) ProtoMessage() {}
 
 func (x *DisksQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[23]
+	mi := &file_cirrina_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,7 +5027,7 @@ func (x *DisksQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisksQuery.ProtoReflect.Descriptor instead.
 func (*DisksQuery) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{23}
+	return file_cirrina_proto_rawDescGZIP(), []int{62}
 }
 
 type SwitchesQuery struct {
@@ -2175,7 +5038,7 @@ type SwitchesQuery struct {
 
 func (x *SwitchesQuery) Reset() {
 	*x = SwitchesQuery{}
-	mi := &file_cirrina_proto_msgTypes[24]
+	mi := &file_cirrina_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2187,7 +5050,7 @@ func (x *SwitchesQuery) String() string {
 func (*SwitchesQuery) ProtoMessage() {}
 
 func (x *SwitchesQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[24]
+	mi := &file_cirrina_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2200,7 +5063,7 @@ func (x *SwitchesQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwitchesQuery.ProtoReflect.Descriptor instead.
 func (*SwitchesQuery) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{24}
+	return file_cirrina_proto_rawDescGZIP(), []int{63}
 }
 
 type VmNicsQuery struct {
@@ -2211,7 +5074,7 @@ type VmNicsQuery struct {
 
 func (x *VmNicsQuery) Reset() {
 	*x = VmNicsQuery{}
-	mi := &file_cirrina_proto_msgTypes[25]
+	mi := &file_cirrina_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2223,7 +5086,7 @@ func (x *VmNicsQuery) String() string {
 func (*VmNicsQuery) ProtoMessage() {}
 
 func (x *VmNicsQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[25]
+	mi := &file_cirrina_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2236,7 +5099,7 @@ func (x *VmNicsQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VmNicsQuery.ProtoReflect.Descriptor instead.
 func (*VmNicsQuery) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{25}
+	return file_cirrina_proto_rawDescGZIP(), []int{64}
 }
 
 type VmNicCloneReq struct {
@@ -2249,7 +5112,7 @@ type VmNicCloneReq struct {
 
 func (x *VmNicCloneReq) Reset() {
 	*x = VmNicCloneReq{}
-	mi := &file_cirrina_proto_msgTypes[26]
+	mi := &file_cirrina_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2261,7 +5124,7 @@ func (x *VmNicCloneReq) String() string {
 func (*VmNicCloneReq) ProtoMessage() {}
 
 func (x *VmNicCloneReq) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[26]
+	mi := &file_cirrina_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2274,7 +5137,7 @@ func (x *VmNicCloneReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VmNicCloneReq.ProtoReflect.Descriptor instead.
 func (*VmNicCloneReq) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{26}
+	return file_cirrina_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *VmNicCloneReq) GetVmnicid() *VmNicId {
@@ -2300,7 +5163,7 @@ type RequestID struct {
 
 func (x *RequestID) Reset() {
 	*x = RequestID{}
-	mi := &file_cirrina_proto_msgTypes[27]
+	mi := &file_cirrina_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2312,7 +5175,7 @@ func (x *RequestID) String() string {
 func (*RequestID) ProtoMessage() {}
 
 func (x *RequestID) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[27]
+	mi := &file_cirrina_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2325,7 +5188,7 @@ func (x *RequestID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestID.ProtoReflect.Descriptor instead.
 func (*RequestID) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{27}
+	return file_cirrina_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *RequestID) GetValue() string {
@@ -2339,13 +5202,14 @@ type ReqStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Complete      bool                   `protobuf:"varint,1,opt,name=complete,proto3" json:"complete,omitempty"`
 	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Result        string                 `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReqStatus) Reset() {
 	*x = ReqStatus{}
-	mi := &file_cirrina_proto_msgTypes[28]
+	mi := &file_cirrina_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2357,7 +5221,7 @@ func (x *ReqStatus) String() string {
 func (*ReqStatus) ProtoMessage() {}
 
 func (x *ReqStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[28]
+	mi := &file_cirrina_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2370,7 +5234,7 @@ func (x *ReqStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqStatus.ProtoReflect.Descriptor instead.
 func (*ReqStatus) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{28}
+	return file_cirrina_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *ReqStatus) GetComplete() bool {
@@ -2387,18 +5251,29 @@ func (x *ReqStatus) GetSuccess() bool {
 	return false
 }
 
+func (x *ReqStatus) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
 type VMState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        VmStatus               `protobuf:"varint,1,opt,name=status,proto3,enum=cirrina.VmStatus" json:"status,omitempty"`
 	VncPort       int32                  `protobuf:"varint,2,opt,name=VncPort,proto3" json:"VncPort,omitempty"`
 	DebugPort     int32                  `protobuf:"varint,3,opt,name=DebugPort,proto3" json:"DebugPort,omitempty"`
+	StopStage     string                 `protobuf:"bytes,4,opt,name=stop_stage,json=stopStage,proto3" json:"stop_stage,omitempty"`
+	Ready         bool                   `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`
+	LiveState     string                 `protobuf:"bytes,6,opt,name=live_state,json=liveState,proto3" json:"live_state,omitempty"`
+	LiveFailures  uint32                 `protobuf:"varint,7,opt,name=live_failures,json=liveFailures,proto3" json:"live_failures,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VMState) Reset() {
 	*x = VMState{}
-	mi := &file_cirrina_proto_msgTypes[29]
+	mi := &file_cirrina_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2410,7 +5285,7 @@ func (x *VMState) String() string {
 func (*VMState) ProtoMessage() {}
 
 func (x *VMState) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[29]
+	mi := &file_cirrina_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2423,7 +5298,7 @@ func (x *VMState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VMState.ProtoReflect.Descriptor instead.
 func (*VMState) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{29}
+	return file_cirrina_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *VMState) GetStatus() VmStatus {
@@ -2447,6 +5322,34 @@ func (x *VMState) GetDebugPort() int32 {
 	return 0
 }
 
+func (x *VMState) GetStopStage() string {
+	if x != nil {
+		return x.StopStage
+	}
+	return ""
+}
+
+func (x *VMState) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *VMState) GetLiveState() string {
+	if x != nil {
+		return x.LiveState
+	}
+	return ""
+}
+
+func (x *VMState) GetLiveFailures() uint32 {
+	if x != nil {
+		return x.LiveFailures
+	}
+	return 0
+}
+
 type ReqBool struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -2456,7 +5359,7 @@ type ReqBool struct {
 
 func (x *ReqBool) Reset() {
 	*x = ReqBool{}
-	mi := &file_cirrina_proto_msgTypes[30]
+	mi := &file_cirrina_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2468,7 +5371,7 @@ func (x *ReqBool) String() string {
 func (*ReqBool) ProtoMessage() {}
 
 func (x *ReqBool) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[30]
+	mi := &file_cirrina_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2481,7 +5384,7 @@ func (x *ReqBool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReqBool.ProtoReflect.Descriptor instead.
 func (*ReqBool) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{30}
+	return file_cirrina_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *ReqBool) GetSuccess() bool {
@@ -2500,7 +5403,7 @@ type ISOID struct {
 
 func (x *ISOID) Reset() {
 	*x = ISOID{}
-	mi := &file_cirrina_proto_msgTypes[31]
+	mi := &file_cirrina_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2512,7 +5415,7 @@ func (x *ISOID) String() string {
 func (*ISOID) ProtoMessage() {}
 
 func (x *ISOID) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[31]
+	mi := &file_cirrina_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2525,7 +5428,7 @@ func (x *ISOID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ISOID.ProtoReflect.Descriptor instead.
 func (*ISOID) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{31}
+	return file_cirrina_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ISOID) GetValue() string {
@@ -2535,6 +5438,58 @@ func (x *ISOID) GetValue() string {
 	return ""
 }
 
+type ISORemoveReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isoid         *ISOID                 `protobuf:"bytes,1,opt,name=isoid,proto3" json:"isoid,omitempty"`
+	Purge         *bool                  `protobuf:"varint,2,opt,name=purge,proto3,oneof" json:"purge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ISORemoveReq) Reset() {
+	*x = ISORemoveReq{}
+	mi := &file_cirrina_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ISORemoveReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ISORemoveReq) ProtoMessage() {}
+
+func (x *ISORemoveReq) ProtoReflect() protoreflect.Message {
+	mi := &file_cirrina_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ISORemoveReq.ProtoReflect.Descriptor instead.
+func (*ISORemoveReq) Descriptor() ([]byte, []int) {
+	return file_cirrina_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ISORemoveReq) GetIsoid() *ISOID {
+	if x != nil {
+		return x.Isoid
+	}
+	return nil
+}
+
+func (x *ISORemoveReq) GetPurge() bool {
+	if x != nil && x.Purge != nil {
+		return *x.Purge
+	}
+	return false
+}
+
 type ISOInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
@@ -2546,7 +5501,7 @@ type ISOInfo struct {
 
 func (x *ISOInfo) Reset() {
 	*x = ISOInfo{}
-	mi := &file_cirrina_proto_msgTypes[32]
+	mi := &file_cirrina_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2558,7 +5513,7 @@ func (x *ISOInfo) String() string {
 func (*ISOInfo) ProtoMessage() {}
 
 func (x *ISOInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[32]
+	mi := &file_cirrina_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2571,7 +5526,7 @@ func (x *ISOInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ISOInfo.ProtoReflect.Descriptor instead.
 func (*ISOInfo) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{32}
+	return file_cirrina_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *ISOInfo) GetName() string {
@@ -2606,7 +5561,7 @@ type ISOUploadInfo struct {
 
 func (x *ISOUploadInfo) Reset() {
 	*x = ISOUploadInfo{}
-	mi := &file_cirrina_proto_msgTypes[33]
+	mi := &file_cirrina_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2618,7 +5573,7 @@ func (x *ISOUploadInfo) String() string {
 func (*ISOUploadInfo) ProtoMessage() {}
 
 func (x *ISOUploadInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[33]
+	mi := &file_cirrina_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2631,7 +5586,7 @@ func (x *ISOUploadInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ISOUploadInfo.ProtoReflect.Descriptor instead.
 func (*ISOUploadInfo) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{33}
+	return file_cirrina_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *ISOUploadInfo) GetIsoid() *ISOID {
@@ -2668,7 +5623,7 @@ type ISOImageRequest struct {
 
 func (x *ISOImageRequest) Reset() {
 	*x = ISOImageRequest{}
-	mi := &file_cirrina_proto_msgTypes[34]
+	mi := &file_cirrina_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2680,7 +5635,7 @@ func (x *ISOImageRequest) String() string {
 func (*ISOImageRequest) ProtoMessage() {}
 
 func (x *ISOImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[34]
+	mi := &file_cirrina_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2693,7 +5648,7 @@ func (x *ISOImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ISOImageRequest.ProtoReflect.Descriptor instead.
 func (*ISOImageRequest) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{34}
+	return file_cirrina_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ISOImageRequest) GetData() isISOImageRequest_Data {
@@ -2748,7 +5703,7 @@ type DiskUploadInfo struct {
 
 func (x *DiskUploadInfo) Reset() {
 	*x = DiskUploadInfo{}
-	mi := &file_cirrina_proto_msgTypes[35]
+	mi := &file_cirrina_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2760,7 +5715,7 @@ func (x *DiskUploadInfo) String() string {
 func (*DiskUploadInfo) ProtoMessage() {}
 
 func (x *DiskUploadInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[35]
+	mi := &file_cirrina_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2773,7 +5728,7 @@ func (x *DiskUploadInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskUploadInfo.ProtoReflect.Descriptor instead.
 func (*DiskUploadInfo) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{35}
+	return file_cirrina_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *DiskUploadInfo) GetDiskid() *DiskId {
@@ -2810,7 +5765,7 @@ type DiskImageRequest struct {
 
 func (x *DiskImageRequest) Reset() {
 	*x = DiskImageRequest{}
-	mi := &file_cirrina_proto_msgTypes[36]
+	mi := &file_cirrina_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2822,7 +5777,7 @@ func (x *DiskImageRequest) String() string {
 func (*DiskImageRequest) ProtoMessage() {}
 
 func (x *DiskImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[36]
+	mi := &file_cirrina_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2835,7 +5790,7 @@ func (x *DiskImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskImageRequest.ProtoReflect.Descriptor instead.
 func (*DiskImageRequest) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{36}
+	return file_cirrina_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *DiskImageRequest) GetData() isDiskImageRequest_Data {
@@ -2892,7 +5847,7 @@ type ComDataRequest struct {
 
 func (x *ComDataRequest) Reset() {
 	*x = ComDataRequest{}
-	mi := &file_cirrina_proto_msgTypes[37]
+	mi := &file_cirrina_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2904,7 +5859,7 @@ func (x *ComDataRequest) String() string {
 func (*ComDataRequest) ProtoMessage() {}
 
 func (x *ComDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[37]
+	mi := &file_cirrina_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2917,7 +5872,7 @@ func (x *ComDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComDataRequest.ProtoReflect.Descriptor instead.
 func (*ComDataRequest) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{37}
+	return file_cirrina_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *ComDataRequest) GetData() isComDataRequest_Data {
@@ -2970,7 +5925,7 @@ type ComDataResponse struct {
 
 func (x *ComDataResponse) Reset() {
 	*x = ComDataResponse{}
-	mi := &file_cirrina_proto_msgTypes[38]
+	mi := &file_cirrina_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2982,7 +5937,7 @@ func (x *ComDataResponse) String() string {
 func (*ComDataResponse) ProtoMessage() {}
 
 func (x *ComDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cirrina_proto_msgTypes[38]
+	mi := &file_cirrina_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2995,7 +5950,7 @@ func (x *ComDataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComDataResponse.ProtoReflect.Descriptor instead.
 func (*ComDataResponse) Descriptor() ([]byte, []int) {
-	return file_cirrina_proto_rawDescGZIP(), []int{38}
+	return file_cirrina_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *ComDataResponse) GetComOutBytes() []byte {
@@ -3013,620 +5968,1320 @@ var file_cirrina_proto_rawDesc = string([]byte{
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1c, 0x0a, 0x04, 0x56, 0x4d, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1c, 0x0a, 0x04, 0x56, 0x4d, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x1e, 0x0a, 0x06, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x20, 0x0a, 0x08, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1f, 0x0a, 0x07, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x31, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x49, 0x53,
+	0x4f, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x6f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x73, 0x6f, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x0a, 0x53, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x6b,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x69, 0x64,
+	0x22, 0x39, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x76, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x6d, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x22, 0x6e, 0x0a, 0x11, 0x53,
+	0x65, 0x74, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x12, 0x2a, 0x0a, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69,
+	0x63, 0x49, 0x64, 0x52, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x08,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49,
+	0x64, 0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x22, 0x68, 0x0a, 0x0f, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x2d,
+	0x0a, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x49, 0x64, 0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75,
+	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x41, 0x0a, 0x09, 0x4b, 0x62, 0x64, 0x4c, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe8, 0x03, 0x0a, 0x08, 0x44, 0x69, 0x73,
+	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33,
+	0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x54, 0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x04, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1e,
+	0x0a, 0x08, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x05, 0x52, 0x07, 0x73, 0x69, 0x7a, 0x65, 0x4e, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x09, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x06, 0x52, 0x08, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x88, 0x01, 0x01,
+	0x12, 0x3d, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x48, 0x07, 0x52,
+	0x0b, 0x64, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x19, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x08,
+	0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x09, 0x52, 0x06, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x07, 0x73,
+	0x69, 0x7a, 0x65, 0x4e, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x08,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x22, 0xfc, 0x02, 0x0a, 0x0e, 0x44, 0x69,
+	0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
+	0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x44, 0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x48, 0x04, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x44,
+	0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x22, 0x5d, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x6b,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x06, 0x64, 0x69, 0x73,
+	0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x52, 0x06, 0x64, 0x69, 0x73, 0x6b,
+	0x69, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x75, 0x72, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x05, 0x70, 0x75, 0x72, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x70, 0x75, 0x72, 0x67, 0x65, 0x22, 0x62, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x6b, 0x52,
+	0x65, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x6b,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
+	0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x52, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x26, 0x0a, 0x0e, 0x44,
+	0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x1e, 0x0a, 0x06, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x6c, 0x75, 0x65, 0x22, 0xf8, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a,
+	0x07, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x73,
+	0x0a, 0x0f, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x27, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x49, 0x64, 0x52, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x69, 0x64, 0x22, 0x24, 0x0a, 0x0c, 0x56, 0x4d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xcc, 0x02, 0x0a, 0x0e, 0x56, 0x4d,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x18, 0x0a, 0x05, 0x76, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x04, 0x76, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
+	0x64, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x75, 0x65, 0x66, 0x69, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x08, 0x75, 0x65, 0x66, 0x69, 0x56, 0x61, 0x72,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x76, 0x6d, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75,
+	0x65, 0x66, 0x69, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x0f, 0x56, 0x4d, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x61, 0x73, 0x68, 0x5f, 0x6c,
+	0x6f, 0x6f, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x72, 0x61, 0x73, 0x68,
+	0x4c, 0x6f, 0x6f, 0x70, 0x22, 0x7c, 0x0a, 0x08, 0x56, 0x4d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x17, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x56, 0x4d, 0x49, 0x44, 0x52, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x78, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x6d, 0x61, 0x78, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x73, 0x7a, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x71, 0x73, 0x7a, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x0f, 0x56, 0x4d,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a,
+	0x04, 0x76, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x52, 0x04, 0x76, 0x6d, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xc6, 0x02, 0x0a, 0x10, 0x56, 0x4d, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x04, 0x76, 0x6d, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x52, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x62, 0x6f,
+	0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x19, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x06, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x22, 0x72, 0x0a,
+	0x0f, 0x56, 0x4d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x21, 0x0a, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x52, 0x04, 0x76,
+	0x6d, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0x4a, 0x0a, 0x0d, 0x56, 0x4d, 0x50, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x75, 0x52,
+	0x65, 0x71, 0x12, 0x21, 0x0a, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x52,
+	0x04, 0x76, 0x6d, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x75, 0x0a,
+	0x0b, 0x56, 0x4d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x04,
+	0x76, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x52, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x43, 0x0a, 0x0a, 0x56, 0x4d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x21, 0x0a, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x52,
+	0x04, 0x76, 0x6d, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x0e, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x04, 0x76,
+	0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x52, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x75, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x56, 0x0a, 0x0e, 0x47, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x68, 0x61, 0x72, 0x64,
+	0x77, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x12, 0x47, 0x75,
+	0x65, 0x73, 0x74, 0x46, 0x53, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x0d, 0x48,
+	0x6f, 0x73, 0x74, 0x50, 0x43, 0x49, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x70, 0x70, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x6c, 0x64, 0x42, 0x79, 0x22, 0x5b, 0x0a, 0x0c, 0x46,
+	0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x72, 0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x61, 0x72, 0x73,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0f, 0x56, 0x4d, 0x55,
+	0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22,
+	0x60, 0x0a, 0x0f, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x20, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x74, 0x4e, 0x65, 0x78, 0x74,
+	0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x6e, 0x65, 0x78,
+	0x74, 0x22, 0x56, 0x0a, 0x12, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x56, 0x4d, 0x49, 0x44, 0x52, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f,
+	0x6f, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x09,
+	0x62, 0x6f, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x11, 0x56, 0x4d, 0x55,
+	0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x12, 0x21,
+	0x0a, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63,
+	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x52, 0x04, 0x76, 0x6d, 0x69,
+	0x64, 0x12, 0x20, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x74, 0x4e, 0x65, 0x78, 0x74,
+	0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x6e, 0x65, 0x78,
+	0x74, 0x22, 0x5c, 0x0a, 0x10, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x49,
+	0x53, 0x4f, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x49, 0x44, 0x52, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x69, 0x73, 0x6f, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
+	0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x44, 0x52, 0x05, 0x69, 0x73, 0x6f, 0x49, 0x64, 0x22,
+	0x43, 0x0a, 0x0a, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x56, 0x61, 0x72, 0x73, 0x12, 0x21, 0x0a,
+	0x04, 0x76, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x52, 0x04, 0x76, 0x6d, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x69, 0x0a, 0x0d, 0x56, 0x4d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x49, 0x44, 0x52, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
+	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x49, 0x64, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x69, 0x64, 0x22,
+	0x22, 0x0a, 0x0a, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x20, 0x0a, 0x08, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1f, 0x0a, 0x07, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x31, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x49, 0x53, 0x4f,
-	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x6f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x05, 0x69, 0x73, 0x6f, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x0a, 0x53, 0x65, 0x74,
-	0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x69, 0x64, 0x22,
-	0x39, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
-	0x76, 0x6d, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x76, 0x6d, 0x69, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x22, 0x6e, 0x0a, 0x11, 0x53, 0x65,
-	0x74, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12,
-	0x2a, 0x0a, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63,
-	0x49, 0x64, 0x52, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x73,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x64,
-	0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x22, 0x68, 0x0a, 0x0f, 0x53, 0x77,
-	0x69, 0x74, 0x63, 0x68, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a,
-	0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x49, 0x64, 0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x06,
-	0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
-	0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x70,
-	0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x41, 0x0a, 0x09, 0x4b, 0x62, 0x64, 0x4c, 0x61, 0x79, 0x6f, 0x75,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe8, 0x03, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x6b,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a,
-	0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54,
-	0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x04, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a,
-	0x08, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48,
-	0x05, 0x52, 0x07, 0x73, 0x69, 0x7a, 0x65, 0x4e, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
-	0x09, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
-	0x48, 0x06, 0x52, 0x08, 0x75, 0x73, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12,
-	0x3d, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
-	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x48, 0x07, 0x52, 0x0b,
-	0x64, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19,
-	0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x08, 0x52,
-	0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x09, 0x52, 0x06, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
+	0x6c, 0x75, 0x65, 0x22, 0xb1, 0x03, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x72, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x18, 0x0a, 0x05, 0x76, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05,
+	0x52, 0x04, 0x76, 0x6d, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x07, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74,
+	0x52, 0x75, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x08, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x88,
+	0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x72, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x6d, 0x5f, 0x69, 0x64,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x22, 0x21, 0x0a, 0x09, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa7, 0x02, 0x0a, 0x0b, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x06, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x77, 0x0a, 0x0f, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0xc9, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x48, 0x01,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x4f, 0x0a, 0x0f, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x12, 0x0a, 0x10,
+	0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x22, 0x2d, 0x0a, 0x05, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0xd8, 0x01, 0x0a, 0x0a, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x0b, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x48, 0x02, 0x52, 0x0a, 0x73, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x70, 0x6c,
+	0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x75, 0x70, 0x6c,
+	0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
 	0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x73,
-	0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
-	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x55,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
-	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05,
-	0x75, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x73, 0x69, 0x7a, 0x65,
-	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x07, 0x73, 0x69,
-	0x7a, 0x65, 0x4e, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x75, 0x73, 0x61, 0x67,
-	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x08, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x22, 0xfc, 0x02, 0x0a, 0x0e, 0x44, 0x69, 0x73,
-	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xee, 0x01, 0x0a, 0x10, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x39, 0x0a, 0x0b, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x48, 0x02, 0x52, 0x0a, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x75, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xdd, 0x03, 0x0a, 0x09,
+	0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x88, 0x01, 0x01,
+	0x12, 0x38, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x4e,
+	0x65, 0x74, 0x44, 0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x74,
+	0x64, 0x65, 0x76, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x07, 0x6e, 0x65,
+	0x74, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x48, 0x04, 0x52,
+	0x07, 0x6e, 0x65, 0x74, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x76,
+	0x6d, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x04, 0x76, 0x6d, 0x69,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x65,
+	0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x48, 0x08, 0x52, 0x06, 0x72, 0x61, 0x74, 0x65,
+	0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x48, 0x09, 0x52, 0x07, 0x72, 0x61, 0x74, 0x65, 0x6f, 0x75,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x6d, 0x61, 0x63, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76,
+	0x74, 0x79, 0x70, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x65, 0x74, 0x74, 0x79, 0x70, 0x65,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x76, 0x6d, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x69, 0x6e, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x0c,
+	0x56, 0x6d, 0x4e, 0x69, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x37,
+	0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x69, 0x63, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x69, 0x63, 0x49, 0x64, 0x22, 0xed,
+	0x03, 0x0a, 0x0f, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d,
+	0x4e, 0x69, 0x63, 0x49, 0x64, 0x52, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15,
+	0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x03, 0x6d,
+	0x61, 0x63, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x44, 0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x48, 0x03,
+	0x52, 0x0a, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2f, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x48, 0x04, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x05, 0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x21, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x65, 0x69, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x07, 0x52, 0x06, 0x72, 0x61, 0x74, 0x65, 0x69, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x08, 0x52, 0x07, 0x72, 0x61, 0x74, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61,
+	0x63, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76, 0x74, 0x79, 0x70, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x65, 0x74, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x69, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xee,
+	0x20, 0x0a, 0x08, 0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
 	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
-	0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x08, 0x64, 0x69,
-	0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0d, 0x64, 0x69, 0x73,
-	0x6b, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x14, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x44,
-	0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x48, 0x04, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x44, 0x65,
-	0x76, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22, 0x2d, 0x0a, 0x05, 0x4e,
-	0x65, 0x74, 0x49, 0x66, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x0a, 0x53,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x54,
-	0x79, 0x70, 0x65, 0x48, 0x02, 0x52, 0x0a, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x88, 0x01,
-	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75,
-	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xee, 0x01, 0x0a, 0x10, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x77,
-	0x69, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x54, 0x79, 0x70, 0x65, 0x48, 0x02, 0x52, 0x0a, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x54, 0x79,
-	0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x88,
-	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xdd, 0x03, 0x0a, 0x09, 0x56, 0x6d, 0x4e, 0x69, 0x63,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0a, 0x6e,
-	0x65, 0x74, 0x64, 0x65, 0x76, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x44, 0x65, 0x76,
-	0x54, 0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76, 0x74, 0x79,
-	0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
-	0x2e, 0x4e, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x48, 0x04, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x74,
-	0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x04, 0x76, 0x6d, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12,
-	0x1f, 0x0a, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x06, 0x52, 0x08, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x21, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x65, 0x69, 0x6e, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x04, 0x48, 0x08, 0x52, 0x06, 0x72, 0x61, 0x74, 0x65, 0x69, 0x6e, 0x88, 0x01, 0x01,
-	0x12, 0x1d, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x04, 0x48, 0x09, 0x52, 0x07, 0x72, 0x61, 0x74, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x63,
+	0x70, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x03, 0x63, 0x70, 0x75, 0x88,
+	0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x03, 0x52, 0x03, 0x6d, 0x65, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x61, 0x78,
+	0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x07, 0x6d,
+	0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x06, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x07, 0x52, 0x06, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x26, 0x0a, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x08, 0x52, 0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x57,
+	0x69, 0x64, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x73, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x09,
+	0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x6e, 0x63, 0x77, 0x61, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x0a, 0x52, 0x07, 0x76, 0x6e, 0x63, 0x77, 0x61, 0x69, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x27, 0x0a, 0x0c, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6d,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0b, 0x52, 0x0c, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75,
+	0x65, 0x73, 0x74, 0x6d, 0x65, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0c, 0x52, 0x06, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x75,
+	0x65, 0x66, 0x69, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x75, 0x65, 0x66, 0x69, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x74, 0x63,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0e, 0x52, 0x03, 0x75, 0x74, 0x63, 0x88, 0x01, 0x01,
+	0x12, 0x23, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x0f, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x61, 0x63, 0x70, 0x69, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x10, 0x52, 0x04, 0x61, 0x63, 0x70, 0x69, 0x88, 0x01, 0x01, 0x12, 0x15,
+	0x0a, 0x03, 0x68, 0x6c, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x48, 0x11, 0x52, 0x03, 0x68,
+	0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x65, 0x6f, 0x70, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x12, 0x52, 0x03, 0x65, 0x6f, 0x70, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03,
+	0x64, 0x70, 0x6f, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x48, 0x13, 0x52, 0x03, 0x64, 0x70, 0x6f,
+	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x69, 0x75, 0x6d, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x14, 0x52, 0x03, 0x69, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x6e,
+	0x63, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x48, 0x15, 0x52, 0x07, 0x76,
+	0x6e, 0x63, 0x70, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6b, 0x65, 0x79,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x16, 0x52, 0x08, 0x6b,
+	0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x17, 0x52,
+	0x09, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x48, 0x18, 0x52, 0x05,
+	0x73, 0x6f, 0x75, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x73, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x69, 0x6e, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x19, 0x52, 0x07, 0x73, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x48, 0x1a, 0x52, 0x08, 0x73,
+	0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f,
+	0x6d, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x48, 0x1b, 0x52, 0x04, 0x63, 0x6f, 0x6d, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x31, 0x64, 0x65, 0x76, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x1c, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x31, 0x64, 0x65, 0x76, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x6d, 0x32, 0x18, 0x23, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x1d, 0x52, 0x04, 0x63, 0x6f, 0x6d, 0x32, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x32, 0x64, 0x65, 0x76, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x48, 0x1e, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x32, 0x64, 0x65, 0x76, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f,
+	0x6d, 0x33, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08, 0x48, 0x1f, 0x52, 0x04, 0x63, 0x6f, 0x6d, 0x33,
+	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x33, 0x64, 0x65, 0x76, 0x18, 0x26,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x20, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x33, 0x64, 0x65, 0x76, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x6d, 0x34, 0x18, 0x27, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x21, 0x52, 0x04, 0x63, 0x6f, 0x6d, 0x34, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x34, 0x64, 0x65, 0x76, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x48, 0x22, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x34, 0x64, 0x65, 0x76, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x48, 0x23,
+	0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x41, 0x72, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x31, 0x6c, 0x6f, 0x67, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x24, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x31, 0x6c, 0x6f, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x32, 0x6c, 0x6f, 0x67, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x25,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x32, 0x6c, 0x6f, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x33, 0x6c, 0x6f, 0x67, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x26, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x33, 0x6c, 0x6f, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x34, 0x6c, 0x6f, 0x67, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x27, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x34, 0x6c, 0x6f, 0x67, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x31, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x28, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x31, 0x73, 0x70, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x32, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x29, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x32, 0x73, 0x70, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x33, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x30, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x2a, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x33, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x34, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x31, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x2b, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x34, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x2c, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x33, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x2d, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x88, 0x01, 0x01, 0x12,
+	0x22, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x34, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x2e, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x57, 0x61, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x35, 0x20, 0x01, 0x28, 0x09, 0x48, 0x2f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x50, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x36, 0x20, 0x01, 0x28, 0x05, 0x48, 0x30, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x18, 0x37, 0x20, 0x01, 0x28, 0x08, 0x48, 0x31, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x63, 0x70, 0x75, 0x18,
+	0x38, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x32, 0x52, 0x04, 0x70, 0x63, 0x70, 0x75, 0x88, 0x01, 0x01,
+	0x12, 0x17, 0x0a, 0x04, 0x72, 0x62, 0x70, 0x73, 0x18, 0x39, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x33,
+	0x52, 0x04, 0x72, 0x62, 0x70, 0x73, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x77, 0x62, 0x70,
+	0x73, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x34, 0x52, 0x04, 0x77, 0x62, 0x70, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x72, 0x69, 0x6f, 0x70, 0x73, 0x18, 0x3b, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x35, 0x52, 0x05, 0x72, 0x69, 0x6f, 0x70, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x77, 0x69, 0x6f, 0x70, 0x73, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x36, 0x52, 0x05,
+	0x77, 0x69, 0x6f, 0x70, 0x73, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x70,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x37, 0x52,
+	0x0a, 0x73, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2a,
+	0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x3e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x38, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x39, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x78, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x40, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x3a, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
+	0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x41, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x3b, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x88, 0x01, 0x01,
+	0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x42,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x3c, 0x52, 0x09, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x43, 0x20, 0x01, 0x28, 0x09, 0x48, 0x3d, 0x52, 0x0c, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x74, 0x63, 0x70, 0x18, 0x44, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x3e, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x79, 0x54, 0x63, 0x70, 0x88, 0x01, 0x01,
+	0x12, 0x22, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x18, 0x45,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x3f, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x79, 0x48, 0x74, 0x74,
+	0x70, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x64, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x46, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x40, 0x52, 0x0d,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x47, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x41, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x48, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x42, 0x52, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x49, 0x20, 0x01, 0x28, 0x09, 0x48, 0x43, 0x52, 0x0b,
+	0x6c, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1e,
+	0x0a, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x63, 0x70, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x44, 0x52, 0x07, 0x6c, 0x69, 0x76, 0x65, 0x54, 0x63, 0x70, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x09, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x18, 0x4b, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x45, 0x52, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x48, 0x74, 0x74, 0x70, 0x88, 0x01, 0x01,
+	0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x46, 0x52, 0x0c, 0x6c, 0x69, 0x76, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6c, 0x69,
+	0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x47, 0x52, 0x0b, 0x6c, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x4e, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x48, 0x52, 0x0c, 0x6c, 0x69, 0x76,
+	0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b,
+	0x6c, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x4f, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x49, 0x52, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x50, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x4a, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x29, 0x0a, 0x0e, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x51, 0x20, 0x01, 0x28, 0x09, 0x48, 0x4b, 0x52, 0x0c, 0x68, 0x6f, 0x6f, 0x6b, 0x50,
+	0x72, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x68, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x52, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x4c, 0x52, 0x0d, 0x68, 0x6f, 0x6f, 0x6b, 0x50, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x68, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x70, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x53, 0x20, 0x01, 0x28, 0x09, 0x48, 0x4d,
+	0x52, 0x0b, 0x68, 0x6f, 0x6f, 0x6b, 0x50, 0x72, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x88, 0x01, 0x01,
+	0x12, 0x29, 0x0a, 0x0e, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74,
+	0x6f, 0x70, 0x18, 0x54, 0x20, 0x01, 0x28, 0x09, 0x48, 0x4e, 0x52, 0x0c, 0x68, 0x6f, 0x6f, 0x6b,
+	0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x68,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x55, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x4f, 0x52, 0x0b, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x63, 0x69, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x18, 0x56, 0x20, 0x01, 0x28, 0x09, 0x48, 0x50, 0x52, 0x08, 0x70, 0x63, 0x69, 0x53, 0x6c, 0x6f,
+	0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x57, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x51, 0x52, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x58, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x52, 0x52, 0x05, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x59, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x53, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1e,
+	0x0a, 0x08, 0x63, 0x70, 0x75, 0x5f, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x54, 0x52, 0x07, 0x63, 0x70, 0x75, 0x50, 0x69, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x28,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x5b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x55, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x67, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x5c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x56, 0x52,
+	0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x18, 0x5d, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x57, 0x52, 0x08, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1e, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x5e, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x58, 0x52, 0x07, 0x70, 0x63, 0x69, 0x50, 0x61, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x42,
 	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x63,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76, 0x74, 0x79, 0x70, 0x65, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x65, 0x74, 0x74, 0x79, 0x70, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x76, 0x6d, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69,
-	0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x69, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xed, 0x03, 0x0a, 0x0f, 0x56, 0x6d, 0x4e, 0x69, 0x63,
-	0x49, 0x6e, 0x66, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x76, 0x6d,
-	0x6e, 0x69, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69,
-	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64, 0x52, 0x07, 0x76,
-	0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a,
-	0x0a, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x44,
-	0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x48, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x64, 0x65, 0x76,
-	0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
-	0x6e, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x48, 0x04, 0x52, 0x07, 0x6e, 0x65,
-	0x74, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x08, 0x73, 0x77,
-	0x69, 0x74, 0x63, 0x68, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x72, 0x61, 0x74,
-	0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x09,
-	0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
-	0x72, 0x61, 0x74, 0x65, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x48, 0x07, 0x52, 0x06,
-	0x72, 0x61, 0x74, 0x65, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x61, 0x74,
-	0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x48, 0x08, 0x52, 0x07, 0x72, 0x61,
-	0x74, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x63, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65,
-	0x74, 0x64, 0x65, 0x76, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x65, 0x74,
-	0x74, 0x79, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x69,
-	0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x69, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xca, 0x12, 0x0a, 0x08, 0x56, 0x4d, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x48, 0x02, 0x52, 0x03, 0x63, 0x70, 0x75, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x65,
-	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x03, 0x6d, 0x65, 0x6d, 0x88, 0x01,
-	0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x05, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x28, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61,
-	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x06, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x63,
-	0x72, 0x65, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x06, 0x73, 0x63,
-	0x72, 0x65, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65,
-	0x6e, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x08, 0x52,
-	0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x57, 0x69, 0x64, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12,
-	0x28, 0x0a, 0x0d, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x09, 0x52, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x6e, 0x63,
-	0x77, 0x61, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0a, 0x52, 0x07, 0x76, 0x6e,
-	0x63, 0x77, 0x61, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x77, 0x69, 0x72, 0x65,
-	0x67, 0x75, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0b,
-	0x52, 0x0c, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6d, 0x88, 0x01,
-	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x0c, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21,
-	0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x75, 0x65, 0x66, 0x69, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x75, 0x65, 0x66, 0x69, 0x88, 0x01,
-	0x01, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x74, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0e,
-	0x52, 0x03, 0x75, 0x74, 0x63, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0f, 0x52, 0x0a,
-	0x68, 0x6f, 0x73, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
-	0x04, 0x61, 0x63, 0x70, 0x69, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x48, 0x10, 0x52, 0x04, 0x61,
-	0x63, 0x70, 0x69, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x68, 0x6c, 0x74, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x11, 0x52, 0x03, 0x68, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
-	0x03, 0x65, 0x6f, 0x70, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x48, 0x12, 0x52, 0x03, 0x65, 0x6f,
-	0x70, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x64, 0x70, 0x6f, 0x18, 0x15, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x13, 0x52, 0x03, 0x64, 0x70, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x69,
-	0x75, 0x6d, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x48, 0x14, 0x52, 0x03, 0x69, 0x75, 0x6d, 0x88,
-	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x6e, 0x63, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x18, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x15, 0x52, 0x07, 0x76, 0x6e, 0x63, 0x70, 0x6f, 0x72, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x1a, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x16, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
-	0x1b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x17, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x1e,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x18, 0x52, 0x05, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x1e, 0x0a, 0x08, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x1f, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x19, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x88, 0x01, 0x01,
-	0x12, 0x20, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x20, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x1a, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x75, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x6d, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x1b, 0x52, 0x04, 0x63, 0x6f, 0x6d, 0x31, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63,
-	0x6f, 0x6d, 0x31, 0x64, 0x65, 0x76, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x48, 0x1c, 0x52, 0x07,
-	0x63, 0x6f, 0x6d, 0x31, 0x64, 0x65, 0x76, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f,
-	0x6d, 0x32, 0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x48, 0x1d, 0x52, 0x04, 0x63, 0x6f, 0x6d, 0x32,
-	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x32, 0x64, 0x65, 0x76, 0x18, 0x24,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x1e, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x32, 0x64, 0x65, 0x76, 0x88,
-	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x6d, 0x33, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x1f, 0x52, 0x04, 0x63, 0x6f, 0x6d, 0x33, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63,
-	0x6f, 0x6d, 0x33, 0x64, 0x65, 0x76, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x48, 0x20, 0x52, 0x07,
-	0x63, 0x6f, 0x6d, 0x33, 0x64, 0x65, 0x76, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f,
-	0x6d, 0x34, 0x18, 0x27, 0x20, 0x01, 0x28, 0x08, 0x48, 0x21, 0x52, 0x04, 0x63, 0x6f, 0x6d, 0x34,
-	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x34, 0x64, 0x65, 0x76, 0x18, 0x28,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x22, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x34, 0x64, 0x65, 0x76, 0x88,
-	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x61, 0x72, 0x67, 0x73,
-	0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x48, 0x23, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x41,
-	0x72, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x31, 0x6c, 0x6f,
-	0x67, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x24, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x31, 0x6c,
-	0x6f, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x32, 0x6c, 0x6f, 0x67,
-	0x18, 0x2b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x25, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x32, 0x6c, 0x6f,
-	0x67, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x33, 0x6c, 0x6f, 0x67, 0x18,
-	0x2c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x26, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x33, 0x6c, 0x6f, 0x67,
-	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x34, 0x6c, 0x6f, 0x67, 0x18, 0x2d,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x27, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x34, 0x6c, 0x6f, 0x67, 0x88,
-	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x31, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18,
-	0x2e, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x28, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x31, 0x73, 0x70, 0x65,
-	0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x32, 0x73, 0x70, 0x65,
-	0x65, 0x64, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x29, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x32,
-	0x73, 0x70, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x33,
-	0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x2a, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x33, 0x73, 0x70, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63,
-	0x6f, 0x6d, 0x34, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x2b,
-	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x34, 0x73, 0x70, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2c,
-	0x0a, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61,
-	0x79, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x2c, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
-	0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x33, 0x20, 0x01, 0x28, 0x08, 0x48, 0x2d, 0x52, 0x05, 0x64,
-	0x65, 0x62, 0x75, 0x67, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67,
-	0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x34, 0x20, 0x01, 0x28, 0x08, 0x48, 0x2e, 0x52, 0x09, 0x64,
-	0x65, 0x62, 0x75, 0x67, 0x57, 0x61, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x64,
-	0x65, 0x62, 0x75, 0x67, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x35, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x2f, 0x52, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x1f, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x36, 0x20, 0x01, 0x28,
-	0x05, 0x48, 0x30, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01,
-	0x12, 0x1d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x18, 0x37, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x31, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x17, 0x0a, 0x04, 0x70, 0x63, 0x70, 0x75, 0x18, 0x38, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x32, 0x52,
-	0x04, 0x70, 0x63, 0x70, 0x75, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x72, 0x62, 0x70, 0x73,
-	0x18, 0x39, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x33, 0x52, 0x04, 0x72, 0x62, 0x70, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x17, 0x0a, 0x04, 0x77, 0x62, 0x70, 0x73, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x0d, 0x48,
-	0x34, 0x52, 0x04, 0x77, 0x62, 0x70, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x72, 0x69,
-	0x6f, 0x70, 0x73, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x35, 0x52, 0x05, 0x72, 0x69, 0x6f,
-	0x70, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x77, 0x69, 0x6f, 0x70, 0x73, 0x18, 0x3c,
-	0x20, 0x01, 0x28, 0x0d, 0x48, 0x36, 0x52, 0x05, 0x77, 0x69, 0x6f, 0x70, 0x73, 0x88, 0x01, 0x01,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x63, 0x70,
-	0x75, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x65, 0x6d, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61,
-	0x78, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64,
-	0x65, 0x6c, 0x61, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x6e, 0x63, 0x77, 0x61, 0x69, 0x74, 0x42, 0x0f,
-	0x0a, 0x0d, 0x5f, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6d, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x75, 0x65, 0x66, 0x69, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x74, 0x63,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x61, 0x63, 0x70, 0x69, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x68, 0x6c, 0x74,
-	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6f, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x64, 0x70, 0x6f,
-	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x69, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x6e, 0x63,
-	0x70, 0x6f, 0x72, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x6f,
-	0x75, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x6f, 0x75, 0x6e, 0x64,
-	0x5f, 0x6f, 0x75, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x6d, 0x31, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x31, 0x64, 0x65, 0x76, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f,
-	0x6d, 0x32, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x32, 0x64, 0x65, 0x76, 0x42, 0x07,
-	0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x6d, 0x33, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x33,
-	0x64, 0x65, 0x76, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x6d, 0x34, 0x42, 0x0a, 0x0a, 0x08,
-	0x5f, 0x63, 0x6f, 0x6d, 0x34, 0x64, 0x65, 0x76, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x31,
-	0x6c, 0x6f, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x32, 0x6c, 0x6f, 0x67, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x33, 0x6c, 0x6f, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x63, 0x6f, 0x6d, 0x34, 0x6c, 0x6f, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d, 0x31,
-	0x73, 0x70, 0x65, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d, 0x32, 0x73, 0x70,
-	0x65, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d, 0x33, 0x73, 0x70, 0x65, 0x65,
-	0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d, 0x34, 0x73, 0x70, 0x65, 0x65, 0x64, 0x42,
-	0x12, 0x0a, 0x10, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x65,
-	0x6c, 0x61, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f,
-	0x74, 0x65, 0x63, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x63, 0x70, 0x75, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x72, 0x62, 0x70, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x77, 0x62, 0x70, 0x73, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x72, 0x69, 0x6f, 0x70, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x77, 0x69,
-	0x6f, 0x70, 0x73, 0x22, 0x0a, 0x0a, 0x08, 0x56, 0x4d, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22,
-	0x0b, 0x0a, 0x09, 0x49, 0x53, 0x4f, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x0a, 0x0a, 0x08,
-	0x4b, 0x62, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x0c, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b,
-	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x65, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x0d, 0x0a, 0x0b, 0x56, 0x6d, 0x4e, 0x69, 0x63,
-	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x7d, 0x0a, 0x0d, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x43,
-	0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2a, 0x0a, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
-	0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64, 0x52, 0x07, 0x76, 0x6d, 0x6e, 0x69,
-	0x63, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x4e, 0x65, 0x77, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x4e, 0x65, 0x77, 0x56, 0x6d, 0x4e, 0x69,
-	0x63, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x21, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x41, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x6c, 0x0a, 0x07, 0x56,
-	0x4d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
-	0x2e, 0x76, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x6e, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x56, 0x6e, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x44,
-	0x65, 0x62, 0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x23, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x1d,
-	0x0a, 0x05, 0x49, 0x53, 0x4f, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x84, 0x01,
-	0x0a, 0x07, 0x49, 0x53, 0x4f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88,
-	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x22, 0x67, 0x0a, 0x0d, 0x49, 0x53, 0x4f, 0x55, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x73, 0x6f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49,
-	0x53, 0x4f, 0x49, 0x44, 0x52, 0x05, 0x69, 0x73, 0x6f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x35, 0x31, 0x32, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x61, 0x35, 0x31, 0x32, 0x73, 0x75, 0x6d, 0x22, 0x71, 0x0a,
-	0x0f, 0x49, 0x53, 0x4f, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x3e, 0x0a, 0x0d, 0x69, 0x73, 0x6f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
-	0x61, 0x2e, 0x49, 0x53, 0x4f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x48,
-	0x00, 0x52, 0x0d, 0x69, 0x73, 0x6f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x66, 0x6f,
-	0x12, 0x16, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48,
-	0x00, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x6b, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x27, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73,
-	0x6b, 0x49, 0x64, 0x52, 0x06, 0x64, 0x69, 0x73, 0x6b, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x35, 0x31, 0x32, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x61, 0x35, 0x31, 0x32, 0x73, 0x75, 0x6d, 0x22, 0x75, 0x0a,
-	0x10, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x6b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x72,
-	0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e,
-	0x66, 0x6f, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x6b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x62, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x6d, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
-	0x56, 0x4d, 0x49, 0x44, 0x48, 0x00, 0x52, 0x04, 0x76, 0x6d, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
-	0x63, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x35, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6d, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x4f, 0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x2a,
-	0x23, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x49,
-	0x52, 0x54, 0x49, 0x4f, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x31, 0x30,
-	0x30, 0x30, 0x10, 0x01, 0x2a, 0x2f, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x56, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x48,
-	0x43, 0x49, 0x48, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x49, 0x52, 0x54, 0x49, 0x4f,
-	0x42, 0x4c, 0x4b, 0x10, 0x02, 0x2a, 0x21, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x76,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x08,
-	0x0a, 0x04, 0x5a, 0x56, 0x4f, 0x4c, 0x10, 0x01, 0x2a, 0x1c, 0x0a, 0x0a, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x46, 0x10, 0x00, 0x12, 0x06,
-	0x0a, 0x02, 0x4e, 0x47, 0x10, 0x01, 0x2a, 0x2e, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x44, 0x65, 0x76,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x50, 0x10, 0x00, 0x12, 0x09, 0x0a,
-	0x05, 0x56, 0x4d, 0x4e, 0x45, 0x54, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x45, 0x54, 0x47,
-	0x52, 0x41, 0x50, 0x48, 0x10, 0x02, 0x2a, 0x5c, 0x0a, 0x08, 0x76, 0x6d, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x4f,
-	0x50, 0x50, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12,
-	0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x49,
-	0x4e, 0x47, 0x10, 0x03, 0x32, 0x80, 0x18, 0x0a, 0x06, 0x56, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x29, 0x0a, 0x05, 0x41, 0x64, 0x64, 0x56, 0x4d, 0x12, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
-	0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x0d, 0x2e, 0x63, 0x69,
-	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x06, 0x47, 0x65,
-	0x74, 0x56, 0x4d, 0x73, 0x12, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56,
-	0x4d, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
-	0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x30, 0x01, 0x12, 0x2f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x56,
-	0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
-	0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
-	0x2e, 0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x56, 0x4d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
-	0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x63, 0x70, 0x75,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x65, 0x6d, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x77, 0x61, 0x69, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x6e, 0x63, 0x77, 0x61, 0x69, 0x74, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x65, 0x73, 0x74, 0x6d, 0x65, 0x6d, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x75, 0x65, 0x66, 0x69, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x74, 0x63, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x61, 0x63, 0x70, 0x69, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x68, 0x6c, 0x74, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6f, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x64, 0x70, 0x6f, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x69, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x6e, 0x63, 0x70,
+	0x6f, 0x72, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x6f, 0x75, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x6d, 0x31, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x63, 0x6f, 0x6d, 0x31, 0x64, 0x65, 0x76, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x6d,
+	0x32, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x32, 0x64, 0x65, 0x76, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x63, 0x6f, 0x6d, 0x33, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x33, 0x64,
+	0x65, 0x76, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x6d, 0x34, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x63, 0x6f, 0x6d, 0x34, 0x64, 0x65, 0x76, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x31, 0x6c,
+	0x6f, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x32, 0x6c, 0x6f, 0x67, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x33, 0x6c, 0x6f, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63,
+	0x6f, 0x6d, 0x34, 0x6c, 0x6f, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d, 0x31, 0x73,
+	0x70, 0x65, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d, 0x32, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d, 0x33, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d, 0x34, 0x73, 0x70, 0x65, 0x65, 0x64, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70,
+	0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x63, 0x70, 0x75, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x72, 0x62, 0x70, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x77, 0x62, 0x70, 0x73, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x72, 0x69, 0x6f, 0x70, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x77, 0x69, 0x6f,
+	0x70, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x6d, 0x61, 0x78, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x62, 0x6f, 0x6f,
+	0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x5f, 0x74, 0x63, 0x70, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x5f, 0x68, 0x74, 0x74, 0x70, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x63, 0x70, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x6c, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x69,
+	0x76, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x6c, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x68, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x70, 0x72, 0x65, 0x5f, 0x73, 0x74,
+	0x6f, 0x70, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x73, 0x74, 0x6f, 0x70, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x63, 0x69, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x70,
+	0x69, 0x6e, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61,
+	0x72, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x63, 0x69, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x22,
+	0x0a, 0x0a, 0x08, 0x56, 0x4d, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x0b, 0x0a, 0x09, 0x49,
+	0x53, 0x4f, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x0a, 0x0a, 0x08, 0x4b, 0x62, 0x64, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x22, 0x0c, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x22, 0x0d, 0x0a, 0x0b, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x73, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x22, 0x7d, 0x0a, 0x0d, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x2a, 0x0a, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56,
+	0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64, 0x52, 0x07, 0x76, 0x6d, 0x6e, 0x69, 0x63, 0x69, 0x64, 0x12,
+	0x40, 0x0a, 0x0c, 0x4e, 0x65, 0x77, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x49, 0x44, 0x12, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x0d, 0x2e, 0x63,
-	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72,
-	0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
-	0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x56, 0x4d, 0x12, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
-	0x2e, 0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72,
-	0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2c, 0x0a, 0x07, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x56, 0x4d, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
-	0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x06, 0x53, 0x74, 0x6f,
-	0x70, 0x56, 0x4d, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x6c, 0x75, 0x65, 0x52, 0x0c, 0x4e, 0x65, 0x77, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x21, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x59, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0xe5, 0x01, 0x0a, 0x07, 0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x76, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x6e, 0x63, 0x50, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x56, 0x6e, 0x63, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x69, 0x76, 0x65, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x42, 0x6f,
+	0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x1d, 0x0a, 0x05,
+	0x49, 0x53, 0x4f, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x59, 0x0a, 0x0c, 0x49,
+	0x53, 0x4f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x05, 0x69,
+	0x73, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x44, 0x52, 0x05, 0x69, 0x73, 0x6f, 0x69,
+	0x64, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x75, 0x72, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x05, 0x70, 0x75, 0x72, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x70, 0x75, 0x72, 0x67, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x07, 0x49, 0x53, 0x4f, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x02, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x67, 0x0a,
+	0x0d, 0x49, 0x53, 0x4f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24,
+	0x0a, 0x05, 0x69, 0x73, 0x6f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x44, 0x52, 0x05, 0x69,
+	0x73, 0x6f, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x35,
+	0x31, 0x32, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x61,
+	0x35, 0x31, 0x32, 0x73, 0x75, 0x6d, 0x22, 0x71, 0x0a, 0x0f, 0x49, 0x53, 0x4f, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x69, 0x73, 0x6f,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x73, 0x6f, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6b, 0x0a, 0x0e, 0x44, 0x69, 0x73,
+	0x6b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x06, 0x64,
+	0x69, 0x73, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x52, 0x06, 0x64, 0x69,
+	0x73, 0x6b, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x35,
+	0x31, 0x32, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x61,
+	0x35, 0x31, 0x32, 0x73, 0x75, 0x6d, 0x22, 0x75, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x69,
+	0x73, 0x6b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73,
+	0x6b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0e, 0x64,
+	0x69, 0x73, 0x6b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x62, 0x0a,
+	0x0e, 0x43, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x05, 0x76, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x48, 0x00, 0x52,
+	0x04, 0x76, 0x6d, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x35, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x5f, 0x6f, 0x75, 0x74, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x4f, 0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x2a, 0x23, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x49, 0x52, 0x54, 0x49, 0x4f, 0x4e, 0x45, 0x54,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x31, 0x30, 0x30, 0x30, 0x10, 0x01, 0x2a, 0x2f, 0x0a,
+	0x08, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x56, 0x4d,
+	0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x48, 0x43, 0x49, 0x48, 0x44, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x56, 0x49, 0x52, 0x54, 0x49, 0x4f, 0x42, 0x4c, 0x4b, 0x10, 0x02, 0x2a, 0x21,
+	0x0a, 0x0b, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x56, 0x4f, 0x4c, 0x10,
+	0x01, 0x2a, 0x4c, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x4b,
+	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x4f, 0x52, 0x41,
+	0x47, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x5a, 0x56, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x49, 0x53, 0x4f, 0x10, 0x02, 0x2a,
+	0x1c, 0x0a, 0x0a, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x06, 0x0a,
+	0x02, 0x49, 0x46, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x47, 0x10, 0x01, 0x2a, 0x2e, 0x0a,
+	0x0a, 0x4e, 0x65, 0x74, 0x44, 0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x54,
+	0x41, 0x50, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x4d, 0x4e, 0x45, 0x54, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x4e, 0x45, 0x54, 0x47, 0x52, 0x41, 0x50, 0x48, 0x10, 0x02, 0x2a, 0x72, 0x0a,
+	0x08, 0x76, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e,
+	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x41, 0x53, 0x48, 0x4c, 0x4f, 0x4f, 0x50, 0x10,
+	0x04, 0x32, 0xe3, 0x34, 0x0a, 0x06, 0x56, 0x4d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x05,
+	0x41, 0x64, 0x64, 0x56, 0x4d, 0x12, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
+	0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x56, 0x4d,
+	0x73, 0x12, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x73, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x1a, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56,
+	0x4d, 0x49, 0x44, 0x30, 0x01, 0x12, 0x2f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56,
+	0x4d, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x49, 0x44, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x36, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x49, 0x44, 0x12, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56,
+	0x4d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x56, 0x4d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x4d, 0x12, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2c, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x56, 0x4d, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
 	0x49, 0x44, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x56, 0x4d, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49,
-	0x44, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x0e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x55, 0x45,
-	0x46, 0x49, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x06, 0x53, 0x74, 0x6f, 0x70, 0x56, 0x4d,
+	0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a,
+	0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x0a, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x56,
+	0x4d, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44,
+	0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x65, 0x74, 0x56, 0x4d, 0x12,
+	0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x12,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x2d, 0x0a, 0x08, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x56, 0x4d, 0x12, 0x0d,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x12, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x2d, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x4d, 0x12, 0x0d, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x63,
+	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44,
+	0x12, 0x40, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x18, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x56, 0x4d, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x30, 0x01, 0x12, 0x34, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x4d, 0x61, 0x70, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56,
+	0x4d, 0x49, 0x44, 0x1a, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x56,
+	0x4d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x3f, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x0d, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x19, 0x2e, 0x63,
+	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x12, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x18, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x3e, 0x0a, 0x0f, 0x53,
+	0x65, 0x74, 0x56, 0x4d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x3f, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x4d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a,
+	0x19, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x12,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x4d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63,
+	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x39,
+	0x0a, 0x0d, 0x41, 0x64, 0x64, 0x56, 0x4d, 0x50, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x75, 0x12,
+	0x16, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x50, 0x61, 0x73, 0x73,
+	0x74, 0x68, 0x72, 0x75, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x4d, 0x50, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x75, 0x73, 0x12, 0x0d, 0x2e, 0x63,
+	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x50, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x75,
+	0x52, 0x65, 0x71, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56,
+	0x4d, 0x50, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x75, 0x12, 0x16, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x50, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x75, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42,
+	0x6f, 0x6f, 0x6c, 0x12, 0x34, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x56, 0x4d, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x14, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x35, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x4d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
+	0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01,
+	0x12, 0x36, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x4d, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x57, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x43, 0x6f,
+	0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x31, 0x0a, 0x0e, 0x47, 0x75, 0x65, 0x73, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x50,
+	0x69, 0x6e, 0x67, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x49, 0x44, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71,
+	0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x35, 0x0a, 0x12, 0x47, 0x75, 0x65, 0x73, 0x74, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x40, 0x0a, 0x14, 0x47,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x49, 0x44, 0x1a, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x47, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x30, 0x01, 0x12, 0x40, 0x0a,
+	0x12, 0x47, 0x75, 0x65, 0x73, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x46, 0x53, 0x46, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x49, 0x44, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x47, 0x75, 0x65,
+	0x73, 0x74, 0x46, 0x53, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x3e, 0x0a, 0x10, 0x47, 0x75, 0x65, 0x73, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x46, 0x53, 0x54,
+	0x68, 0x61, 0x77, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x49, 0x44, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x47, 0x75, 0x65,
+	0x73, 0x74, 0x46, 0x53, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x40, 0x0a, 0x12, 0x47, 0x75, 0x65, 0x73, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x46, 0x53, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x56, 0x4d, 0x49, 0x44, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x47,
+	0x75, 0x65, 0x73, 0x74, 0x46, 0x53, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x35, 0x0a, 0x12, 0x47, 0x75, 0x65, 0x73, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
 	0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
-	0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x42, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c,
+	0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x3f, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x4d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x17, 0x2e, 0x63,
+	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x3b, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x4d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x0d, 0x2e, 0x63,
+	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x17, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x56, 0x4d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x3c, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x56, 0x4d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65,
+	0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x39, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x13, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x12, 0x40, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x30, 0x01, 0x12, 0x37, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x36, 0x0a, 0x0a, 0x41,
+	0x64, 0x64, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x14, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x1a,
+	0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
+	0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x33, 0x0a, 0x0b, 0x54, 0x65,
+	0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x1a, 0x10, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12,
+	0x46, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x1a, 0x18, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x0e, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x55, 0x45, 0x46, 0x49, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
+	0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x42, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x49, 0x44, 0x1a, 0x18, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x55,
+	0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x30, 0x01, 0x12, 0x3d,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56,
+	0x4d, 0x49, 0x44, 0x1a, 0x18, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x55, 0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x43, 0x0a,
+	0x12, 0x53, 0x65, 0x74, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
+	0x55, 0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f,
+	0x6f, 0x6c, 0x12, 0x41, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x42,
+	0x6f, 0x6f, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x4e, 0x65, 0x78, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65,
+	0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x40, 0x0a, 0x11, 0x42, 0x6f, 0x6f, 0x74, 0x56, 0x4d, 0x46,
+	0x72, 0x6f, 0x6d, 0x49, 0x53, 0x4f, 0x4f, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x42, 0x6f, 0x6f, 0x74, 0x49,
+	0x53, 0x4f, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x33, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56, 0x4d,
+	0x55, 0x45, 0x46, 0x49, 0x56, 0x61, 0x72, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
+	0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x56, 0x61, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x0d,
+	0x53, 0x65, 0x74, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x56, 0x61, 0x72, 0x73, 0x12, 0x13, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x55, 0x45, 0x46, 0x49, 0x56, 0x61,
+	0x72, 0x73, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71,
+	0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x42, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e,
+	0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x63,
+	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x48, 0x6f, 0x73, 0x74, 0x50, 0x43, 0x49, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x12, 0x19, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x69,
-	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x66, 0x30, 0x01, 0x12, 0x37, 0x0a,
-	0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
-	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x49, 0x44, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x63,
-	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x4b, 0x62, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a,
-	0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x4b, 0x62, 0x64, 0x4c, 0x61, 0x79,
-	0x6f, 0x75, 0x74, 0x30, 0x01, 0x12, 0x2f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x53, 0x4f, 0x73,
-	0x12, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x73, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x1a, 0x0e, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49,
-	0x53, 0x4f, 0x49, 0x44, 0x30, 0x01, 0x12, 0x2e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x53, 0x4f,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49,
-	0x53, 0x4f, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49,
-	0x53, 0x4f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x49, 0x53, 0x4f,
-	0x12, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x6e,
-	0x66, 0x6f, 0x1a, 0x0e, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f,
-	0x49, 0x44, 0x12, 0x2d, 0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x53, 0x4f, 0x12,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x50, 0x43, 0x49, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x30, 0x01,
+	0x12, 0x3f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
+	0x6e, 0x61, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x30,
+	0x01, 0x12, 0x45, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x1a, 0x12, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x3d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x4b, 0x62, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x4b, 0x62, 0x64, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x30, 0x01,
+	0x12, 0x2f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x53, 0x4f, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a,
+	0x0e, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x44, 0x30,
+	0x01, 0x12, 0x2e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x53, 0x4f, 0x49, 0x6e, 0x66, 0x6f, 0x12,
 	0x0e, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x44, 0x1a,
-	0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f,
-	0x6c, 0x12, 0x31, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x56, 0x4d, 0x49, 0x53, 0x4f, 0x73, 0x12, 0x12,
-	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x53, 0x4f, 0x52,
-	0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71,
-	0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x49, 0x53, 0x4f,
-	0x73, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44,
-	0x1a, 0x0e, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x44,
-	0x30, 0x01, 0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x49, 0x53, 0x4f, 0x56, 0x4d, 0x73, 0x12,
-	0x0e, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x44, 0x1a,
-	0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x30, 0x01,
-	0x12, 0x39, 0x0a, 0x09, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x73, 0x6f, 0x12, 0x18, 0x2e,
-	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
-	0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x28, 0x01, 0x12, 0x32, 0x0a, 0x08, 0x47,
-	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
-	0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x0f, 0x2e, 0x63,
-	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x30, 0x01, 0x12,
-	0x31, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0f,
-	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x1a,
-	0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x38, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b,
-	0x49, 0x6e, 0x66, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72,
-	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2d, 0x0a, 0x07,
-	0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
-	0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x63, 0x69, 0x72,
-	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x0f, 0x2e, 0x63, 0x69, 0x72, 0x72,
-	0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72,
-	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x33, 0x0a, 0x0a,
-	0x53, 0x65, 0x74, 0x56, 0x4d, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x72,
-	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x1a,
-	0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f,
-	0x6c, 0x12, 0x2e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12,
-	0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x0f,
-	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x30,
-	0x01, 0x12, 0x2b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x56, 0x4d, 0x12, 0x0f,
-	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x1a,
-	0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x12, 0x3b,
-	0x0a, 0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x19, 0x2e, 0x63,
-	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
-	0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x28, 0x01, 0x12, 0x2f, 0x0a, 0x08, 0x57,
-	0x69, 0x70, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x0f, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
-	0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
-	0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x3b, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x0f, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49,
-	0x64, 0x1a, 0x16, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b,
-	0x53, 0x69, 0x7a, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
-	0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x1a, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x49, 0x64, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
-	0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x64, 0x1a, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72,
-	0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x33,
-	0x0a, 0x09, 0x41, 0x64, 0x64, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x13, 0x2e, 0x63, 0x69,
-	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f,
-	0x1a, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a,
-	0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f,
-	0x6c, 0x12, 0x33, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x12, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52,
-	0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x3d, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x53, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x18, 0x2e, 0x63, 0x69, 0x72, 0x72,
-	0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
-	0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65,
-	0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x38, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69,
-	0x63, 0x73, 0x41, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
-	0x56, 0x6d, 0x4e, 0x69, 0x63, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x10, 0x2e, 0x63, 0x69,
-	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64, 0x30, 0x01, 0x12,
-	0x3e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49,
-	0x64, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x3c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x49, 0x44, 0x12, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x10, 0x2e, 0x63, 0x69,
-	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64, 0x12, 0x34, 0x0a,
-	0x0c, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x2e,
-	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64, 0x1a,
-	0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x12,
-	0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d,
-	0x4e, 0x69, 0x63, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
-	0x4d, 0x4e, 0x69, 0x63, 0x12, 0x18, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56,
-	0x6d, 0x4e, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x10,
+	0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x2a, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x49, 0x53, 0x4f, 0x12, 0x10, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0e, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x44, 0x12, 0x2d, 0x0a,
+	0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x53, 0x4f, 0x12, 0x0e, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x3f, 0x0a, 0x14,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x53, 0x4f, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49,
+	0x53, 0x4f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x31, 0x0a,
+	0x09, 0x53, 0x65, 0x74, 0x56, 0x4d, 0x49, 0x53, 0x4f, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x53, 0x4f, 0x52, 0x65, 0x71, 0x1a, 0x10,
 	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c,
-	0x12, 0x31, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x12,
-	0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49,
-	0x64, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42,
-	0x6f, 0x6f, 0x6c, 0x12, 0x3e, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x53,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1a, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
-	0x53, 0x65, 0x74, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42,
-	0x6f, 0x6f, 0x6c, 0x12, 0x2d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x56,
-	0x4d, 0x12, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69,
-	0x63, 0x49, 0x64, 0x1a, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d,
-	0x49, 0x44, 0x12, 0x38, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x56, 0x4d, 0x4e, 0x69, 0x63,
-	0x12, 0x16, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63,
-	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
-	0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x09,
-	0x53, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x73, 0x12, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72,
-	0x69, 0x6e, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e,
+	0x12, 0x2c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x49, 0x53, 0x4f, 0x73, 0x12, 0x0d, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x63,
+	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x44, 0x30, 0x01, 0x12, 0x2c,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x49, 0x53, 0x4f, 0x56, 0x4d, 0x73, 0x12, 0x0e, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x44, 0x1a, 0x0d, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x09,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x73, 0x6f, 0x12, 0x18, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x49, 0x53, 0x4f, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65,
+	0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x28, 0x01, 0x12, 0x32, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69,
+	0x73, 0x6b, 0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69,
+	0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0f, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x1a, 0x11, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38,
+	0x0a, 0x0b, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2d, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x44,
+	0x69, 0x73, 0x6b, 0x12, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69,
+	0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x0f, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x0f, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x41, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x16, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x33, 0x0a, 0x0a, 0x53,
+	0x65, 0x74, 0x56, 0x4d, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c,
+	0x12, 0x2e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x0d,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x0f, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x30, 0x01,
+	0x12, 0x2b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x56, 0x4d, 0x12, 0x0f, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x1a, 0x0d,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x12, 0x3b, 0x0a,
+	0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x19, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x28, 0x01, 0x12, 0x2f, 0x0a, 0x08, 0x57, 0x69,
+	0x70, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x0f, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x3b, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x0f, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64,
+	0x1a, 0x16, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53,
+	0x69, 0x7a, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x69,
+	0x7a, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x16, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c,
+	0x12, 0x43, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x19, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x41, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73,
+	0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x1a, 0x19, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01, 0x12, 0x44, 0x0a, 0x14, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x18, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x40,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c,
+	0x12, 0x49, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x18, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x46, 0x0a, 0x0c, 0x41,
+	0x64, 0x6f, 0x70, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x50, 0x75, 0x72, 0x67, 0x65, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x50, 0x75,
+	0x72, 0x67, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e,
 	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12,
-	0x2e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x73, 0x12, 0x0d, 0x2e, 0x63,
-	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x63, 0x69,
-	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64, 0x30, 0x01, 0x12,
+	0x3a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x16,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x64, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x11, 0x2e, 0x63,
+	0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x64, 0x1a,
+	0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x33, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x53, 0x65, 0x74,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x33, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x3d, 0x0a, 0x0f,
+	0x53, 0x65, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12,
+	0x18, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x38, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x73, 0x41, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x73, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69,
+	0x63, 0x49, 0x64, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69,
+	0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64, 0x1a, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69,
+	0x63, 0x49, 0x44, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69,
+	0x63, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d,
+	0x4e, 0x69, 0x63, 0x49, 0x64, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x08, 0x41, 0x64, 0x64,
+	0x56, 0x4d, 0x4e, 0x69, 0x63, 0x12, 0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x12, 0x18, 0x2e, 0x63, 0x69, 0x72,
+	0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52,
+	0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x31, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x56, 0x4d, 0x4e, 0x69, 0x63, 0x12, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e,
+	0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x3e, 0x0a, 0x0e, 0x53, 0x65, 0x74,
+	0x56, 0x4d, 0x4e, 0x69, 0x63, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1a, 0x2e, 0x63, 0x69,
+	0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x52, 0x65, 0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2d, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x56, 0x4d, 0x4e, 0x69, 0x63, 0x56, 0x4d, 0x12, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64, 0x1a, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72,
+	0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56,
+	0x4d, 0x4e, 0x69, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x10, 0x2e,
+	0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x49, 0x64, 0x1a,
+	0x15, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x6e,
+	0x65, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x12, 0x16, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x31, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69, 0x63, 0x73, 0x12,
+	0x12, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x52, 0x65,
+	0x71, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x4e, 0x69,
+	0x63, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x4d, 0x49,
+	0x44, 0x1a, 0x10, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x56, 0x6d, 0x4e, 0x69,
+	0x63, 0x49, 0x64, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x4d, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e,
+	0x61, 0x2e, 0x56, 0x4d, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61,
+	0x2e, 0x56, 0x6d, 0x4e, 0x69, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x30, 0x01, 0x12,
 	0x48, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x31, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
 	0x76, 0x65, 0x12, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x72, 0x69, 0x6e, 0x61, 0x2e, 0x43, 0x6f, 0x6d,
 	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x69,
@@ -3663,197 +7318,391 @@ func file_cirrina_proto_rawDescGZIP() []byte {
 	return file_cirrina_proto_rawDescData
 }
 
-var file_cirrina_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_cirrina_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_cirrina_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_cirrina_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
 var file_cirrina_proto_goTypes = []any{
 	(NetType)(0),                   // 0: cirrina.NetType
 	(DiskType)(0),                  // 1: cirrina.DiskType
 	(DiskDevType)(0),               // 2: cirrina.DiskDevType
-	(SwitchType)(0),                // 3: cirrina.SwitchType
-	(NetDevType)(0),                // 4: cirrina.NetDevType
-	(VmStatus)(0),                  // 5: cirrina.vmStatus
-	(*VMID)(nil),                   // 6: cirrina.VMID
-	(*DiskId)(nil),                 // 7: cirrina.DiskId
-	(*SwitchId)(nil),               // 8: cirrina.SwitchId
-	(*VmNicId)(nil),                // 9: cirrina.VmNicId
-	(*SetISOReq)(nil),              // 10: cirrina.SetISOReq
-	(*SetDiskReq)(nil),             // 11: cirrina.SetDiskReq
-	(*SetNicReq)(nil),              // 12: cirrina.SetNicReq
-	(*SetVmNicSwitchReq)(nil),      // 13: cirrina.SetVmNicSwitchReq
-	(*SwitchUplinkReq)(nil),        // 14: cirrina.SwitchUplinkReq
-	(*KbdLayout)(nil),              // 15: cirrina.KbdLayout
-	(*DiskInfo)(nil),               // 16: cirrina.DiskInfo
-	(*DiskSizeUsage)(nil),          // 17: cirrina.DiskSizeUsage
-	(*DiskInfoUpdate)(nil),         // 18: cirrina.DiskInfoUpdate
-	(*NetInterfacesReq)(nil),       // 19: cirrina.NetInterfacesReq
-	(*NetIf)(nil),                  // 20: cirrina.NetIf
-	(*SwitchInfo)(nil),             // 21: cirrina.SwitchInfo
-	(*SwitchInfoUpdate)(nil),       // 22: cirrina.SwitchInfoUpdate
-	(*VmNicInfo)(nil),              // 23: cirrina.VmNicInfo
-	(*VmNicInfoUpdate)(nil),        // 24: cirrina.VmNicInfoUpdate
-	(*VMConfig)(nil),               // 25: cirrina.VMConfig
-	(*VMsQuery)(nil),               // 26: cirrina.VMsQuery
-	(*ISOsQuery)(nil),              // 27: cirrina.ISOsQuery
-	(*KbdQuery)(nil),               // 28: cirrina.KbdQuery
-	(*DisksQuery)(nil),             // 29: cirrina.DisksQuery
-	(*SwitchesQuery)(nil),          // 30: cirrina.SwitchesQuery
-	(*VmNicsQuery)(nil),            // 31: cirrina.VmNicsQuery
-	(*VmNicCloneReq)(nil),          // 32: cirrina.VmNicCloneReq
-	(*RequestID)(nil),              // 33: cirrina.RequestID
-	(*ReqStatus)(nil),              // 34: cirrina.ReqStatus
-	(*VMState)(nil),                // 35: cirrina.VMState
-	(*ReqBool)(nil),                // 36: cirrina.ReqBool
-	(*ISOID)(nil),                  // 37: cirrina.ISOID
-	(*ISOInfo)(nil),                // 38: cirrina.ISOInfo
-	(*ISOUploadInfo)(nil),          // 39: cirrina.ISOUploadInfo
-	(*ISOImageRequest)(nil),        // 40: cirrina.ISOImageRequest
-	(*DiskUploadInfo)(nil),         // 41: cirrina.DiskUploadInfo
-	(*DiskImageRequest)(nil),       // 42: cirrina.DiskImageRequest
-	(*ComDataRequest)(nil),         // 43: cirrina.ComDataRequest
-	(*ComDataResponse)(nil),        // 44: cirrina.ComDataResponse
-	(*wrapperspb.StringValue)(nil), // 45: google.protobuf.StringValue
-	(*emptypb.Empty)(nil),          // 46: google.protobuf.Empty
+	(StorageType)(0),               // 3: cirrina.StorageType
+	(SwitchType)(0),                // 4: cirrina.SwitchType
+	(NetDevType)(0),                // 5: cirrina.NetDevType
+	(VmStatus)(0),                  // 6: cirrina.vmStatus
+	(*VMID)(nil),                   // 7: cirrina.VMID
+	(*DiskId)(nil),                 // 8: cirrina.DiskId
+	(*SwitchId)(nil),               // 9: cirrina.SwitchId
+	(*VmNicId)(nil),                // 10: cirrina.VmNicId
+	(*SetISOReq)(nil),              // 11: cirrina.SetISOReq
+	(*SetDiskReq)(nil),             // 12: cirrina.SetDiskReq
+	(*SetNicReq)(nil),              // 13: cirrina.SetNicReq
+	(*SetVmNicSwitchReq)(nil),      // 14: cirrina.SetVmNicSwitchReq
+	(*SwitchUplinkReq)(nil),        // 15: cirrina.SwitchUplinkReq
+	(*KbdLayout)(nil),              // 16: cirrina.KbdLayout
+	(*DiskInfo)(nil),               // 17: cirrina.DiskInfo
+	(*DiskSizeUsage)(nil),          // 18: cirrina.DiskSizeUsage
+	(*DiskInfoUpdate)(nil),         // 19: cirrina.DiskInfoUpdate
+	(*DiskRemoveReq)(nil),          // 20: cirrina.DiskRemoveReq
+	(*DiskResizeReq)(nil),          // 21: cirrina.DiskResizeReq
+	(*DiskSnapshotId)(nil),         // 22: cirrina.DiskSnapshotId
+	(*DiskSnapshotInfo)(nil),       // 23: cirrina.DiskSnapshotInfo
+	(*DiskSnapshotReq)(nil),        // 24: cirrina.DiskSnapshotReq
+	(*VMSnapshotId)(nil),           // 25: cirrina.VMSnapshotId
+	(*VMSnapshotInfo)(nil),         // 26: cirrina.VMSnapshotInfo
+	(*VMRestartRecord)(nil),        // 27: cirrina.VMRestartRecord
+	(*VMDevice)(nil),               // 28: cirrina.VMDevice
+	(*VMControllerInfo)(nil),       // 29: cirrina.VMControllerInfo
+	(*VMControllerReq)(nil),        // 30: cirrina.VMControllerReq
+	(*VMAttachmentInfo)(nil),       // 31: cirrina.VMAttachmentInfo
+	(*VMAttachmentReq)(nil),        // 32: cirrina.VMAttachmentReq
+	(*VMPassthruReq)(nil),          // 33: cirrina.VMPassthruReq
+	(*VMShareInfo)(nil),            // 34: cirrina.VMShareInfo
+	(*VMShareReq)(nil),             // 35: cirrina.VMShareReq
+	(*ConsolePortReq)(nil),         // 36: cirrina.ConsolePortReq
+	(*ConsolePortDataRequest)(nil), // 37: cirrina.ConsolePortDataRequest
+	(*GuestIPAddress)(nil),         // 38: cirrina.GuestIPAddress
+	(*GuestInterface)(nil),         // 39: cirrina.GuestInterface
+	(*GuestFSFreezeReply)(nil),     // 40: cirrina.GuestFSFreezeReply
+	(*HostPCIDevice)(nil),          // 41: cirrina.HostPCIDevice
+	(*FirmwareInfo)(nil),           // 42: cirrina.FirmwareInfo
+	(*VMUEFIBootEntry)(nil),        // 43: cirrina.VMUEFIBootEntry
+	(*VMUEFIBootOrder)(nil),        // 44: cirrina.VMUEFIBootOrder
+	(*VMUEFIBootOrderReq)(nil),     // 45: cirrina.VMUEFIBootOrderReq
+	(*VMUEFIBootNextReq)(nil),      // 46: cirrina.VMUEFIBootNextReq
+	(*VMUEFIBootISOReq)(nil),       // 47: cirrina.VMUEFIBootISOReq
+	(*VMUEFIVars)(nil),             // 48: cirrina.VMUEFIVars
+	(*VMSnapshotReq)(nil),          // 49: cirrina.VMSnapshotReq
+	(*ScheduleId)(nil),             // 50: cirrina.ScheduleId
+	(*ScheduleInfo)(nil),           // 51: cirrina.ScheduleInfo
+	(*WebhookId)(nil),              // 52: cirrina.WebhookId
+	(*WebhookInfo)(nil),            // 53: cirrina.WebhookInfo
+	(*WebhookDelivery)(nil),        // 54: cirrina.WebhookDelivery
+	(*OrphanedStorage)(nil),        // 55: cirrina.OrphanedStorage
+	(*AdoptStorageReq)(nil),        // 56: cirrina.AdoptStorageReq
+	(*PurgeStorageReq)(nil),        // 57: cirrina.PurgeStorageReq
+	(*NetInterfacesReq)(nil),       // 58: cirrina.NetInterfacesReq
+	(*NetIf)(nil),                  // 59: cirrina.NetIf
+	(*SwitchInfo)(nil),             // 60: cirrina.SwitchInfo
+	(*SwitchInfoUpdate)(nil),       // 61: cirrina.SwitchInfoUpdate
+	(*VmNicInfo)(nil),              // 62: cirrina.VmNicInfo
+	(*VmNicAddress)(nil),           // 63: cirrina.VmNicAddress
+	(*VmNicInfoUpdate)(nil),        // 64: cirrina.VmNicInfoUpdate
+	(*VMConfig)(nil),               // 65: cirrina.VMConfig
+	(*VMsQuery)(nil),               // 66: cirrina.VMsQuery
+	(*ISOsQuery)(nil),              // 67: cirrina.ISOsQuery
+	(*KbdQuery)(nil),               // 68: cirrina.KbdQuery
+	(*DisksQuery)(nil),             // 69: cirrina.DisksQuery
+	(*SwitchesQuery)(nil),          // 70: cirrina.SwitchesQuery
+	(*VmNicsQuery)(nil),            // 71: cirrina.VmNicsQuery
+	(*VmNicCloneReq)(nil),          // 72: cirrina.VmNicCloneReq
+	(*RequestID)(nil),              // 73: cirrina.RequestID
+	(*ReqStatus)(nil),              // 74: cirrina.ReqStatus
+	(*VMState)(nil),                // 75: cirrina.VMState
+	(*ReqBool)(nil),                // 76: cirrina.ReqBool
+	(*ISOID)(nil),                  // 77: cirrina.ISOID
+	(*ISORemoveReq)(nil),           // 78: cirrina.ISORemoveReq
+	(*ISOInfo)(nil),                // 79: cirrina.ISOInfo
+	(*ISOUploadInfo)(nil),          // 80: cirrina.ISOUploadInfo
+	(*ISOImageRequest)(nil),        // 81: cirrina.ISOImageRequest
+	(*DiskUploadInfo)(nil),         // 82: cirrina.DiskUploadInfo
+	(*DiskImageRequest)(nil),       // 83: cirrina.DiskImageRequest
+	(*ComDataRequest)(nil),         // 84: cirrina.ComDataRequest
+	(*ComDataResponse)(nil),        // 85: cirrina.ComDataResponse
+	(*timestamppb.Timestamp)(nil),  // 86: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil), // 87: google.protobuf.StringValue
+	(*emptypb.Empty)(nil),          // 88: google.protobuf.Empty
+	(*wrapperspb.BoolValue)(nil),   // 89: google.protobuf.BoolValue
 }
 var file_cirrina_proto_depIdxs = []int32{
-	9,  // 0: cirrina.SetVmNicSwitchReq.vmnicid:type_name -> cirrina.VmNicId
-	8,  // 1: cirrina.SetVmNicSwitchReq.switchid:type_name -> cirrina.SwitchId
-	8,  // 2: cirrina.SwitchUplinkReq.switchid:type_name -> cirrina.SwitchId
-	1,  // 3: cirrina.DiskInfo.disk_type:type_name -> cirrina.DiskType
-	2,  // 4: cirrina.DiskInfo.disk_dev_type:type_name -> cirrina.DiskDevType
-	1,  // 5: cirrina.DiskInfoUpdate.disk_type:type_name -> cirrina.DiskType
-	2,  // 6: cirrina.DiskInfoUpdate.disk_dev_type:type_name -> cirrina.DiskDevType
-	3,  // 7: cirrina.SwitchInfo.switch_type:type_name -> cirrina.SwitchType
-	3,  // 8: cirrina.SwitchInfoUpdate.switch_type:type_name -> cirrina.SwitchType
-	4,  // 9: cirrina.VmNicInfo.netdevtype:type_name -> cirrina.NetDevType
-	0,  // 10: cirrina.VmNicInfo.nettype:type_name -> cirrina.NetType
-	9,  // 11: cirrina.VmNicInfoUpdate.vmnicid:type_name -> cirrina.VmNicId
-	4,  // 12: cirrina.VmNicInfoUpdate.netdevtype:type_name -> cirrina.NetDevType
-	0,  // 13: cirrina.VmNicInfoUpdate.nettype:type_name -> cirrina.NetType
-	9,  // 14: cirrina.VmNicCloneReq.vmnicid:type_name -> cirrina.VmNicId
-	45, // 15: cirrina.VmNicCloneReq.NewVmNicName:type_name -> google.protobuf.StringValue
-	5,  // 16: cirrina.VMState.status:type_name -> cirrina.vmStatus
-	37, // 17: cirrina.ISOUploadInfo.isoid:type_name -> cirrina.ISOID
-	39, // 18: cirrina.ISOImageRequest.isouploadinfo:type_name -> cirrina.ISOUploadInfo
-	7,  // 19: cirrina.DiskUploadInfo.diskid:type_name -> cirrina.DiskId
-	41, // 20: cirrina.DiskImageRequest.diskuploadinfo:type_name -> cirrina.DiskUploadInfo
-	6,  // 21: cirrina.ComDataRequest.vm_id:type_name -> cirrina.VMID
-	25, // 22: cirrina.VMInfo.AddVM:input_type -> cirrina.VMConfig
-	26, // 23: cirrina.VMInfo.GetVMs:input_type -> cirrina.VMsQuery
-	6,  // 24: cirrina.VMInfo.GetVMConfig:input_type -> cirrina.VMID
-	6,  // 25: cirrina.VMInfo.GetVMName:input_type -> cirrina.VMID
-	45, // 26: cirrina.VMInfo.GetVMID:input_type -> google.protobuf.StringValue
-	6,  // 27: cirrina.VMInfo.GetVMState:input_type -> cirrina.VMID
-	25, // 28: cirrina.VMInfo.UpdateVM:input_type -> cirrina.VMConfig
-	6,  // 29: cirrina.VMInfo.StartVM:input_type -> cirrina.VMID
-	6,  // 30: cirrina.VMInfo.StopVM:input_type -> cirrina.VMID
-	6,  // 31: cirrina.VMInfo.DeleteVM:input_type -> cirrina.VMID
-	6,  // 32: cirrina.VMInfo.ClearUEFIState:input_type -> cirrina.VMID
-	46, // 33: cirrina.VMInfo.GetVersion:input_type -> google.protobuf.Empty
-	19, // 34: cirrina.VMInfo.GetNetInterfaces:input_type -> cirrina.NetInterfacesReq
-	33, // 35: cirrina.VMInfo.RequestStatus:input_type -> cirrina.RequestID
-	28, // 36: cirrina.VMInfo.GetKeyboardLayouts:input_type -> cirrina.KbdQuery
-	27, // 37: cirrina.VMInfo.GetISOs:input_type -> cirrina.ISOsQuery
-	37, // 38: cirrina.VMInfo.GetISOInfo:input_type -> cirrina.ISOID
-	38, // 39: cirrina.VMInfo.AddISO:input_type -> cirrina.ISOInfo
-	37, // 40: cirrina.VMInfo.RemoveISO:input_type -> cirrina.ISOID
-	10, // 41: cirrina.VMInfo.SetVMISOs:input_type -> cirrina.SetISOReq
-	6,  // 42: cirrina.VMInfo.GetVMISOs:input_type -> cirrina.VMID
-	37, // 43: cirrina.VMInfo.GetISOVMs:input_type -> cirrina.ISOID
-	40, // 44: cirrina.VMInfo.UploadIso:input_type -> cirrina.ISOImageRequest
-	29, // 45: cirrina.VMInfo.GetDisks:input_type -> cirrina.DisksQuery
-	7,  // 46: cirrina.VMInfo.GetDiskInfo:input_type -> cirrina.DiskId
-	18, // 47: cirrina.VMInfo.SetDiskInfo:input_type -> cirrina.DiskInfoUpdate
-	16, // 48: cirrina.VMInfo.AddDisk:input_type -> cirrina.DiskInfo
-	7,  // 49: cirrina.VMInfo.RemoveDisk:input_type -> cirrina.DiskId
-	11, // 50: cirrina.VMInfo.SetVMDisks:input_type -> cirrina.SetDiskReq
-	6,  // 51: cirrina.VMInfo.GetVMDisks:input_type -> cirrina.VMID
-	7,  // 52: cirrina.VMInfo.GetDiskVM:input_type -> cirrina.DiskId
-	42, // 53: cirrina.VMInfo.UploadDisk:input_type -> cirrina.DiskImageRequest
-	7,  // 54: cirrina.VMInfo.WipeDisk:input_type -> cirrina.DiskId
-	7,  // 55: cirrina.VMInfo.GetDiskSizeUsage:input_type -> cirrina.DiskId
-	30, // 56: cirrina.VMInfo.GetSwitches:input_type -> cirrina.SwitchesQuery
-	8,  // 57: cirrina.VMInfo.GetSwitchInfo:input_type -> cirrina.SwitchId
-	21, // 58: cirrina.VMInfo.AddSwitch:input_type -> cirrina.SwitchInfo
-	22, // 59: cirrina.VMInfo.SetSwitchInfo:input_type -> cirrina.SwitchInfoUpdate
-	8,  // 60: cirrina.VMInfo.RemoveSwitch:input_type -> cirrina.SwitchId
-	14, // 61: cirrina.VMInfo.SetSwitchUplink:input_type -> cirrina.SwitchUplinkReq
-	31, // 62: cirrina.VMInfo.GetVMNicsAll:input_type -> cirrina.VmNicsQuery
-	9,  // 63: cirrina.VMInfo.GetVMNicName:input_type -> cirrina.VmNicId
-	45, // 64: cirrina.VMInfo.GetVMNicID:input_type -> google.protobuf.StringValue
-	9,  // 65: cirrina.VMInfo.GetVMNicInfo:input_type -> cirrina.VmNicId
-	23, // 66: cirrina.VMInfo.AddVMNic:input_type -> cirrina.VmNicInfo
-	24, // 67: cirrina.VMInfo.UpdateVMNic:input_type -> cirrina.VmNicInfoUpdate
-	9,  // 68: cirrina.VMInfo.RemoveVMNic:input_type -> cirrina.VmNicId
-	13, // 69: cirrina.VMInfo.SetVMNicSwitch:input_type -> cirrina.SetVmNicSwitchReq
-	9,  // 70: cirrina.VMInfo.GetVMNicVM:input_type -> cirrina.VmNicId
-	32, // 71: cirrina.VMInfo.CloneVMNic:input_type -> cirrina.VmNicCloneReq
-	12, // 72: cirrina.VMInfo.SetVMNics:input_type -> cirrina.SetNicReq
-	6,  // 73: cirrina.VMInfo.GetVMNics:input_type -> cirrina.VMID
-	43, // 74: cirrina.VMInfo.Com1Interactive:input_type -> cirrina.ComDataRequest
-	43, // 75: cirrina.VMInfo.Com2Interactive:input_type -> cirrina.ComDataRequest
-	43, // 76: cirrina.VMInfo.Com3Interactive:input_type -> cirrina.ComDataRequest
-	43, // 77: cirrina.VMInfo.Com4Interactive:input_type -> cirrina.ComDataRequest
-	6,  // 78: cirrina.VMInfo.AddVM:output_type -> cirrina.VMID
-	6,  // 79: cirrina.VMInfo.GetVMs:output_type -> cirrina.VMID
-	25, // 80: cirrina.VMInfo.GetVMConfig:output_type -> cirrina.VMConfig
-	45, // 81: cirrina.VMInfo.GetVMName:output_type -> google.protobuf.StringValue
-	6,  // 82: cirrina.VMInfo.GetVMID:output_type -> cirrina.VMID
-	35, // 83: cirrina.VMInfo.GetVMState:output_type -> cirrina.VMState
-	36, // 84: cirrina.VMInfo.UpdateVM:output_type -> cirrina.ReqBool
-	33, // 85: cirrina.VMInfo.StartVM:output_type -> cirrina.RequestID
-	33, // 86: cirrina.VMInfo.StopVM:output_type -> cirrina.RequestID
-	33, // 87: cirrina.VMInfo.DeleteVM:output_type -> cirrina.RequestID
-	36, // 88: cirrina.VMInfo.ClearUEFIState:output_type -> cirrina.ReqBool
-	45, // 89: cirrina.VMInfo.GetVersion:output_type -> google.protobuf.StringValue
-	20, // 90: cirrina.VMInfo.GetNetInterfaces:output_type -> cirrina.NetIf
-	34, // 91: cirrina.VMInfo.RequestStatus:output_type -> cirrina.ReqStatus
-	15, // 92: cirrina.VMInfo.GetKeyboardLayouts:output_type -> cirrina.KbdLayout
-	37, // 93: cirrina.VMInfo.GetISOs:output_type -> cirrina.ISOID
-	38, // 94: cirrina.VMInfo.GetISOInfo:output_type -> cirrina.ISOInfo
-	37, // 95: cirrina.VMInfo.AddISO:output_type -> cirrina.ISOID
-	36, // 96: cirrina.VMInfo.RemoveISO:output_type -> cirrina.ReqBool
-	36, // 97: cirrina.VMInfo.SetVMISOs:output_type -> cirrina.ReqBool
-	37, // 98: cirrina.VMInfo.GetVMISOs:output_type -> cirrina.ISOID
-	6,  // 99: cirrina.VMInfo.GetISOVMs:output_type -> cirrina.VMID
-	36, // 100: cirrina.VMInfo.UploadIso:output_type -> cirrina.ReqBool
-	7,  // 101: cirrina.VMInfo.GetDisks:output_type -> cirrina.DiskId
-	16, // 102: cirrina.VMInfo.GetDiskInfo:output_type -> cirrina.DiskInfo
-	36, // 103: cirrina.VMInfo.SetDiskInfo:output_type -> cirrina.ReqBool
-	7,  // 104: cirrina.VMInfo.AddDisk:output_type -> cirrina.DiskId
-	36, // 105: cirrina.VMInfo.RemoveDisk:output_type -> cirrina.ReqBool
-	36, // 106: cirrina.VMInfo.SetVMDisks:output_type -> cirrina.ReqBool
-	7,  // 107: cirrina.VMInfo.GetVMDisks:output_type -> cirrina.DiskId
-	6,  // 108: cirrina.VMInfo.GetDiskVM:output_type -> cirrina.VMID
-	36, // 109: cirrina.VMInfo.UploadDisk:output_type -> cirrina.ReqBool
-	33, // 110: cirrina.VMInfo.WipeDisk:output_type -> cirrina.RequestID
-	17, // 111: cirrina.VMInfo.GetDiskSizeUsage:output_type -> cirrina.DiskSizeUsage
-	8,  // 112: cirrina.VMInfo.GetSwitches:output_type -> cirrina.SwitchId
-	21, // 113: cirrina.VMInfo.GetSwitchInfo:output_type -> cirrina.SwitchInfo
-	8,  // 114: cirrina.VMInfo.AddSwitch:output_type -> cirrina.SwitchId
-	36, // 115: cirrina.VMInfo.SetSwitchInfo:output_type -> cirrina.ReqBool
-	36, // 116: cirrina.VMInfo.RemoveSwitch:output_type -> cirrina.ReqBool
-	36, // 117: cirrina.VMInfo.SetSwitchUplink:output_type -> cirrina.ReqBool
-	9,  // 118: cirrina.VMInfo.GetVMNicsAll:output_type -> cirrina.VmNicId
-	45, // 119: cirrina.VMInfo.GetVMNicName:output_type -> google.protobuf.StringValue
-	9,  // 120: cirrina.VMInfo.GetVMNicID:output_type -> cirrina.VmNicId
-	23, // 121: cirrina.VMInfo.GetVMNicInfo:output_type -> cirrina.VmNicInfo
-	9,  // 122: cirrina.VMInfo.AddVMNic:output_type -> cirrina.VmNicId
-	36, // 123: cirrina.VMInfo.UpdateVMNic:output_type -> cirrina.ReqBool
-	36, // 124: cirrina.VMInfo.RemoveVMNic:output_type -> cirrina.ReqBool
-	36, // 125: cirrina.VMInfo.SetVMNicSwitch:output_type -> cirrina.ReqBool
-	6,  // 126: cirrina.VMInfo.GetVMNicVM:output_type -> cirrina.VMID
-	33, // 127: cirrina.VMInfo.CloneVMNic:output_type -> cirrina.RequestID
-	36, // 128: cirrina.VMInfo.SetVMNics:output_type -> cirrina.ReqBool
-	9,  // 129: cirrina.VMInfo.GetVMNics:output_type -> cirrina.VmNicId
-	44, // 130: cirrina.VMInfo.Com1Interactive:output_type -> cirrina.ComDataResponse
-	44, // 131: cirrina.VMInfo.Com2Interactive:output_type -> cirrina.ComDataResponse
-	44, // 132: cirrina.VMInfo.Com3Interactive:output_type -> cirrina.ComDataResponse
-	44, // 133: cirrina.VMInfo.Com4Interactive:output_type -> cirrina.ComDataResponse
-	78, // [78:134] is the sub-list for method output_type
-	22, // [22:78] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	10,  // 0: cirrina.SetVmNicSwitchReq.vmnicid:type_name -> cirrina.VmNicId
+	9,   // 1: cirrina.SetVmNicSwitchReq.switchid:type_name -> cirrina.SwitchId
+	9,   // 2: cirrina.SwitchUplinkReq.switchid:type_name -> cirrina.SwitchId
+	1,   // 3: cirrina.DiskInfo.disk_type:type_name -> cirrina.DiskType
+	2,   // 4: cirrina.DiskInfo.disk_dev_type:type_name -> cirrina.DiskDevType
+	1,   // 5: cirrina.DiskInfoUpdate.disk_type:type_name -> cirrina.DiskType
+	2,   // 6: cirrina.DiskInfoUpdate.disk_dev_type:type_name -> cirrina.DiskDevType
+	8,   // 7: cirrina.DiskRemoveReq.diskid:type_name -> cirrina.DiskId
+	8,   // 8: cirrina.DiskResizeReq.diskid:type_name -> cirrina.DiskId
+	86,  // 9: cirrina.DiskSnapshotInfo.created:type_name -> google.protobuf.Timestamp
+	8,   // 10: cirrina.DiskSnapshotReq.diskid:type_name -> cirrina.DiskId
+	22,  // 11: cirrina.DiskSnapshotReq.snapshotid:type_name -> cirrina.DiskSnapshotId
+	86,  // 12: cirrina.VMSnapshotInfo.created:type_name -> google.protobuf.Timestamp
+	86,  // 13: cirrina.VMRestartRecord.time:type_name -> google.protobuf.Timestamp
+	7,   // 14: cirrina.VMControllerInfo.vmid:type_name -> cirrina.VMID
+	7,   // 15: cirrina.VMControllerReq.vmid:type_name -> cirrina.VMID
+	7,   // 16: cirrina.VMAttachmentInfo.vmid:type_name -> cirrina.VMID
+	7,   // 17: cirrina.VMAttachmentReq.vmid:type_name -> cirrina.VMID
+	7,   // 18: cirrina.VMPassthruReq.vmid:type_name -> cirrina.VMID
+	7,   // 19: cirrina.VMShareInfo.vmid:type_name -> cirrina.VMID
+	7,   // 20: cirrina.VMShareReq.vmid:type_name -> cirrina.VMID
+	7,   // 21: cirrina.ConsolePortReq.vmid:type_name -> cirrina.VMID
+	36,  // 22: cirrina.ConsolePortDataRequest.port:type_name -> cirrina.ConsolePortReq
+	38,  // 23: cirrina.GuestInterface.addresses:type_name -> cirrina.GuestIPAddress
+	7,   // 24: cirrina.VMUEFIBootOrderReq.vmid:type_name -> cirrina.VMID
+	7,   // 25: cirrina.VMUEFIBootNextReq.vmid:type_name -> cirrina.VMID
+	7,   // 26: cirrina.VMUEFIBootISOReq.vmid:type_name -> cirrina.VMID
+	77,  // 27: cirrina.VMUEFIBootISOReq.iso_id:type_name -> cirrina.ISOID
+	7,   // 28: cirrina.VMUEFIVars.vmid:type_name -> cirrina.VMID
+	7,   // 29: cirrina.VMSnapshotReq.vmid:type_name -> cirrina.VMID
+	25,  // 30: cirrina.VMSnapshotReq.snapshotid:type_name -> cirrina.VMSnapshotId
+	86,  // 31: cirrina.ScheduleInfo.last_run:type_name -> google.protobuf.Timestamp
+	86,  // 32: cirrina.ScheduleInfo.next_run:type_name -> google.protobuf.Timestamp
+	86,  // 33: cirrina.WebhookDelivery.time:type_name -> google.protobuf.Timestamp
+	3,   // 34: cirrina.OrphanedStorage.type:type_name -> cirrina.StorageType
+	3,   // 35: cirrina.AdoptStorageReq.type:type_name -> cirrina.StorageType
+	1,   // 36: cirrina.AdoptStorageReq.disk_type:type_name -> cirrina.DiskType
+	3,   // 37: cirrina.PurgeStorageReq.type:type_name -> cirrina.StorageType
+	4,   // 38: cirrina.SwitchInfo.switch_type:type_name -> cirrina.SwitchType
+	4,   // 39: cirrina.SwitchInfoUpdate.switch_type:type_name -> cirrina.SwitchType
+	5,   // 40: cirrina.VmNicInfo.netdevtype:type_name -> cirrina.NetDevType
+	0,   // 41: cirrina.VmNicInfo.nettype:type_name -> cirrina.NetType
+	86,  // 42: cirrina.VmNicAddress.last_seen:type_name -> google.protobuf.Timestamp
+	10,  // 43: cirrina.VmNicInfoUpdate.vmnicid:type_name -> cirrina.VmNicId
+	5,   // 44: cirrina.VmNicInfoUpdate.netdevtype:type_name -> cirrina.NetDevType
+	0,   // 45: cirrina.VmNicInfoUpdate.nettype:type_name -> cirrina.NetType
+	10,  // 46: cirrina.VmNicCloneReq.vmnicid:type_name -> cirrina.VmNicId
+	87,  // 47: cirrina.VmNicCloneReq.NewVmNicName:type_name -> google.protobuf.StringValue
+	6,   // 48: cirrina.VMState.status:type_name -> cirrina.vmStatus
+	77,  // 49: cirrina.ISORemoveReq.isoid:type_name -> cirrina.ISOID
+	77,  // 50: cirrina.ISOUploadInfo.isoid:type_name -> cirrina.ISOID
+	80,  // 51: cirrina.ISOImageRequest.isouploadinfo:type_name -> cirrina.ISOUploadInfo
+	8,   // 52: cirrina.DiskUploadInfo.diskid:type_name -> cirrina.DiskId
+	82,  // 53: cirrina.DiskImageRequest.diskuploadinfo:type_name -> cirrina.DiskUploadInfo
+	7,   // 54: cirrina.ComDataRequest.vm_id:type_name -> cirrina.VMID
+	65,  // 55: cirrina.VMInfo.AddVM:input_type -> cirrina.VMConfig
+	66,  // 56: cirrina.VMInfo.GetVMs:input_type -> cirrina.VMsQuery
+	7,   // 57: cirrina.VMInfo.GetVMConfig:input_type -> cirrina.VMID
+	7,   // 58: cirrina.VMInfo.GetVMName:input_type -> cirrina.VMID
+	87,  // 59: cirrina.VMInfo.GetVMID:input_type -> google.protobuf.StringValue
+	7,   // 60: cirrina.VMInfo.GetVMState:input_type -> cirrina.VMID
+	65,  // 61: cirrina.VMInfo.UpdateVM:input_type -> cirrina.VMConfig
+	7,   // 62: cirrina.VMInfo.StartVM:input_type -> cirrina.VMID
+	7,   // 63: cirrina.VMInfo.StopVM:input_type -> cirrina.VMID
+	7,   // 64: cirrina.VMInfo.PowerOffVM:input_type -> cirrina.VMID
+	7,   // 65: cirrina.VMInfo.ResetVM:input_type -> cirrina.VMID
+	7,   // 66: cirrina.VMInfo.RebootVM:input_type -> cirrina.VMID
+	7,   // 67: cirrina.VMInfo.DeleteVM:input_type -> cirrina.VMID
+	7,   // 68: cirrina.VMInfo.GetVMRestartHistory:input_type -> cirrina.VMID
+	7,   // 69: cirrina.VMInfo.GetVMDeviceMap:input_type -> cirrina.VMID
+	29,  // 70: cirrina.VMInfo.AddVMController:input_type -> cirrina.VMControllerInfo
+	7,   // 71: cirrina.VMInfo.ListVMControllers:input_type -> cirrina.VMID
+	30,  // 72: cirrina.VMInfo.RemoveVMController:input_type -> cirrina.VMControllerReq
+	31,  // 73: cirrina.VMInfo.SetVMAttachment:input_type -> cirrina.VMAttachmentInfo
+	7,   // 74: cirrina.VMInfo.ListVMAttachments:input_type -> cirrina.VMID
+	32,  // 75: cirrina.VMInfo.RemoveVMAttachment:input_type -> cirrina.VMAttachmentReq
+	33,  // 76: cirrina.VMInfo.AddVMPassthru:input_type -> cirrina.VMPassthruReq
+	7,   // 77: cirrina.VMInfo.ListVMPassthrus:input_type -> cirrina.VMID
+	33,  // 78: cirrina.VMInfo.RemoveVMPassthru:input_type -> cirrina.VMPassthruReq
+	34,  // 79: cirrina.VMInfo.AddVMShare:input_type -> cirrina.VMShareInfo
+	7,   // 80: cirrina.VMInfo.ListVMShares:input_type -> cirrina.VMID
+	35,  // 81: cirrina.VMInfo.RemoveVMShare:input_type -> cirrina.VMShareReq
+	37,  // 82: cirrina.VMInfo.ConsolePortInteractive:input_type -> cirrina.ConsolePortDataRequest
+	7,   // 83: cirrina.VMInfo.GuestAgentPing:input_type -> cirrina.VMID
+	7,   // 84: cirrina.VMInfo.GuestAgentShutdown:input_type -> cirrina.VMID
+	7,   // 85: cirrina.VMInfo.GuestAgentInterfaces:input_type -> cirrina.VMID
+	7,   // 86: cirrina.VMInfo.GuestAgentFSFreeze:input_type -> cirrina.VMID
+	7,   // 87: cirrina.VMInfo.GuestAgentFSThaw:input_type -> cirrina.VMID
+	7,   // 88: cirrina.VMInfo.GuestAgentFSStatus:input_type -> cirrina.VMID
+	7,   // 89: cirrina.VMInfo.GuestAgentSyncTime:input_type -> cirrina.VMID
+	26,  // 90: cirrina.VMInfo.CreateVMSnapshot:input_type -> cirrina.VMSnapshotInfo
+	7,   // 91: cirrina.VMInfo.ListVMSnapshots:input_type -> cirrina.VMID
+	49,  // 92: cirrina.VMInfo.RestoreVMSnapshot:input_type -> cirrina.VMSnapshotReq
+	49,  // 93: cirrina.VMInfo.DeleteVMSnapshot:input_type -> cirrina.VMSnapshotReq
+	51,  // 94: cirrina.VMInfo.AddSchedule:input_type -> cirrina.ScheduleInfo
+	88,  // 95: cirrina.VMInfo.ListSchedules:input_type -> google.protobuf.Empty
+	50,  // 96: cirrina.VMInfo.RemoveSchedule:input_type -> cirrina.ScheduleId
+	53,  // 97: cirrina.VMInfo.AddWebhook:input_type -> cirrina.WebhookInfo
+	88,  // 98: cirrina.VMInfo.ListWebhooks:input_type -> google.protobuf.Empty
+	52,  // 99: cirrina.VMInfo.RemoveWebhook:input_type -> cirrina.WebhookId
+	52,  // 100: cirrina.VMInfo.TestWebhook:input_type -> cirrina.WebhookId
+	52,  // 101: cirrina.VMInfo.GetWebhookDeliveries:input_type -> cirrina.WebhookId
+	7,   // 102: cirrina.VMInfo.ClearUEFIState:input_type -> cirrina.VMID
+	7,   // 103: cirrina.VMInfo.ListVMUEFIBootEntries:input_type -> cirrina.VMID
+	7,   // 104: cirrina.VMInfo.GetVMUEFIBootOrder:input_type -> cirrina.VMID
+	45,  // 105: cirrina.VMInfo.SetVMUEFIBootOrder:input_type -> cirrina.VMUEFIBootOrderReq
+	46,  // 106: cirrina.VMInfo.SetVMUEFIBootNext:input_type -> cirrina.VMUEFIBootNextReq
+	47,  // 107: cirrina.VMInfo.BootVMFromISOOnce:input_type -> cirrina.VMUEFIBootISOReq
+	7,   // 108: cirrina.VMInfo.GetVMUEFIVars:input_type -> cirrina.VMID
+	48,  // 109: cirrina.VMInfo.SetVMUEFIVars:input_type -> cirrina.VMUEFIVars
+	88,  // 110: cirrina.VMInfo.GetVersion:input_type -> google.protobuf.Empty
+	58,  // 111: cirrina.VMInfo.GetNetInterfaces:input_type -> cirrina.NetInterfacesReq
+	88,  // 112: cirrina.VMInfo.GetHostPCIDevices:input_type -> google.protobuf.Empty
+	88,  // 113: cirrina.VMInfo.GetFirmwares:input_type -> google.protobuf.Empty
+	88,  // 114: cirrina.VMInfo.GetPurgeDefault:input_type -> google.protobuf.Empty
+	73,  // 115: cirrina.VMInfo.RequestStatus:input_type -> cirrina.RequestID
+	68,  // 116: cirrina.VMInfo.GetKeyboardLayouts:input_type -> cirrina.KbdQuery
+	67,  // 117: cirrina.VMInfo.GetISOs:input_type -> cirrina.ISOsQuery
+	77,  // 118: cirrina.VMInfo.GetISOInfo:input_type -> cirrina.ISOID
+	79,  // 119: cirrina.VMInfo.AddISO:input_type -> cirrina.ISOInfo
+	77,  // 120: cirrina.VMInfo.RemoveISO:input_type -> cirrina.ISOID
+	78,  // 121: cirrina.VMInfo.RemoveISOWithOptions:input_type -> cirrina.ISORemoveReq
+	11,  // 122: cirrina.VMInfo.SetVMISOs:input_type -> cirrina.SetISOReq
+	7,   // 123: cirrina.VMInfo.GetVMISOs:input_type -> cirrina.VMID
+	77,  // 124: cirrina.VMInfo.GetISOVMs:input_type -> cirrina.ISOID
+	81,  // 125: cirrina.VMInfo.UploadIso:input_type -> cirrina.ISOImageRequest
+	69,  // 126: cirrina.VMInfo.GetDisks:input_type -> cirrina.DisksQuery
+	8,   // 127: cirrina.VMInfo.GetDiskInfo:input_type -> cirrina.DiskId
+	19,  // 128: cirrina.VMInfo.SetDiskInfo:input_type -> cirrina.DiskInfoUpdate
+	17,  // 129: cirrina.VMInfo.AddDisk:input_type -> cirrina.DiskInfo
+	8,   // 130: cirrina.VMInfo.RemoveDisk:input_type -> cirrina.DiskId
+	20,  // 131: cirrina.VMInfo.RemoveDiskWithOptions:input_type -> cirrina.DiskRemoveReq
+	12,  // 132: cirrina.VMInfo.SetVMDisks:input_type -> cirrina.SetDiskReq
+	7,   // 133: cirrina.VMInfo.GetVMDisks:input_type -> cirrina.VMID
+	8,   // 134: cirrina.VMInfo.GetDiskVM:input_type -> cirrina.DiskId
+	83,  // 135: cirrina.VMInfo.UploadDisk:input_type -> cirrina.DiskImageRequest
+	8,   // 136: cirrina.VMInfo.WipeDisk:input_type -> cirrina.DiskId
+	8,   // 137: cirrina.VMInfo.GetDiskSizeUsage:input_type -> cirrina.DiskId
+	21,  // 138: cirrina.VMInfo.ResizeDisk:input_type -> cirrina.DiskResizeReq
+	23,  // 139: cirrina.VMInfo.CreateDiskSnapshot:input_type -> cirrina.DiskSnapshotInfo
+	8,   // 140: cirrina.VMInfo.ListDiskSnapshots:input_type -> cirrina.DiskId
+	24,  // 141: cirrina.VMInfo.RollbackDiskSnapshot:input_type -> cirrina.DiskSnapshotReq
+	24,  // 142: cirrina.VMInfo.DeleteDiskSnapshot:input_type -> cirrina.DiskSnapshotReq
+	88,  // 143: cirrina.VMInfo.ListOrphanedStorage:input_type -> google.protobuf.Empty
+	56,  // 144: cirrina.VMInfo.AdoptStorage:input_type -> cirrina.AdoptStorageReq
+	57,  // 145: cirrina.VMInfo.PurgeStorage:input_type -> cirrina.PurgeStorageReq
+	70,  // 146: cirrina.VMInfo.GetSwitches:input_type -> cirrina.SwitchesQuery
+	9,   // 147: cirrina.VMInfo.GetSwitchInfo:input_type -> cirrina.SwitchId
+	60,  // 148: cirrina.VMInfo.AddSwitch:input_type -> cirrina.SwitchInfo
+	61,  // 149: cirrina.VMInfo.SetSwitchInfo:input_type -> cirrina.SwitchInfoUpdate
+	9,   // 150: cirrina.VMInfo.RemoveSwitch:input_type -> cirrina.SwitchId
+	15,  // 151: cirrina.VMInfo.SetSwitchUplink:input_type -> cirrina.SwitchUplinkReq
+	71,  // 152: cirrina.VMInfo.GetVMNicsAll:input_type -> cirrina.VmNicsQuery
+	10,  // 153: cirrina.VMInfo.GetVMNicName:input_type -> cirrina.VmNicId
+	87,  // 154: cirrina.VMInfo.GetVMNicID:input_type -> google.protobuf.StringValue
+	10,  // 155: cirrina.VMInfo.GetVMNicInfo:input_type -> cirrina.VmNicId
+	62,  // 156: cirrina.VMInfo.AddVMNic:input_type -> cirrina.VmNicInfo
+	64,  // 157: cirrina.VMInfo.UpdateVMNic:input_type -> cirrina.VmNicInfoUpdate
+	10,  // 158: cirrina.VMInfo.RemoveVMNic:input_type -> cirrina.VmNicId
+	14,  // 159: cirrina.VMInfo.SetVMNicSwitch:input_type -> cirrina.SetVmNicSwitchReq
+	10,  // 160: cirrina.VMInfo.GetVMNicVM:input_type -> cirrina.VmNicId
+	10,  // 161: cirrina.VMInfo.GetVMNicAddresses:input_type -> cirrina.VmNicId
+	72,  // 162: cirrina.VMInfo.CloneVMNic:input_type -> cirrina.VmNicCloneReq
+	13,  // 163: cirrina.VMInfo.SetVMNics:input_type -> cirrina.SetNicReq
+	7,   // 164: cirrina.VMInfo.GetVMNics:input_type -> cirrina.VMID
+	7,   // 165: cirrina.VMInfo.GetVMAddresses:input_type -> cirrina.VMID
+	84,  // 166: cirrina.VMInfo.Com1Interactive:input_type -> cirrina.ComDataRequest
+	84,  // 167: cirrina.VMInfo.Com2Interactive:input_type -> cirrina.ComDataRequest
+	84,  // 168: cirrina.VMInfo.Com3Interactive:input_type -> cirrina.ComDataRequest
+	84,  // 169: cirrina.VMInfo.Com4Interactive:input_type -> cirrina.ComDataRequest
+	7,   // 170: cirrina.VMInfo.AddVM:output_type -> cirrina.VMID
+	7,   // 171: cirrina.VMInfo.GetVMs:output_type -> cirrina.VMID
+	65,  // 172: cirrina.VMInfo.GetVMConfig:output_type -> cirrina.VMConfig
+	87,  // 173: cirrina.VMInfo.GetVMName:output_type -> google.protobuf.StringValue
+	7,   // 174: cirrina.VMInfo.GetVMID:output_type -> cirrina.VMID
+	75,  // 175: cirrina.VMInfo.GetVMState:output_type -> cirrina.VMState
+	76,  // 176: cirrina.VMInfo.UpdateVM:output_type -> cirrina.ReqBool
+	73,  // 177: cirrina.VMInfo.StartVM:output_type -> cirrina.RequestID
+	73,  // 178: cirrina.VMInfo.StopVM:output_type -> cirrina.RequestID
+	73,  // 179: cirrina.VMInfo.PowerOffVM:output_type -> cirrina.RequestID
+	73,  // 180: cirrina.VMInfo.ResetVM:output_type -> cirrina.RequestID
+	73,  // 181: cirrina.VMInfo.RebootVM:output_type -> cirrina.RequestID
+	73,  // 182: cirrina.VMInfo.DeleteVM:output_type -> cirrina.RequestID
+	27,  // 183: cirrina.VMInfo.GetVMRestartHistory:output_type -> cirrina.VMRestartRecord
+	28,  // 184: cirrina.VMInfo.GetVMDeviceMap:output_type -> cirrina.VMDevice
+	76,  // 185: cirrina.VMInfo.AddVMController:output_type -> cirrina.ReqBool
+	29,  // 186: cirrina.VMInfo.ListVMControllers:output_type -> cirrina.VMControllerInfo
+	76,  // 187: cirrina.VMInfo.RemoveVMController:output_type -> cirrina.ReqBool
+	76,  // 188: cirrina.VMInfo.SetVMAttachment:output_type -> cirrina.ReqBool
+	31,  // 189: cirrina.VMInfo.ListVMAttachments:output_type -> cirrina.VMAttachmentInfo
+	76,  // 190: cirrina.VMInfo.RemoveVMAttachment:output_type -> cirrina.ReqBool
+	76,  // 191: cirrina.VMInfo.AddVMPassthru:output_type -> cirrina.ReqBool
+	33,  // 192: cirrina.VMInfo.ListVMPassthrus:output_type -> cirrina.VMPassthruReq
+	76,  // 193: cirrina.VMInfo.RemoveVMPassthru:output_type -> cirrina.ReqBool
+	76,  // 194: cirrina.VMInfo.AddVMShare:output_type -> cirrina.ReqBool
+	34,  // 195: cirrina.VMInfo.ListVMShares:output_type -> cirrina.VMShareInfo
+	76,  // 196: cirrina.VMInfo.RemoveVMShare:output_type -> cirrina.ReqBool
+	85,  // 197: cirrina.VMInfo.ConsolePortInteractive:output_type -> cirrina.ComDataResponse
+	76,  // 198: cirrina.VMInfo.GuestAgentPing:output_type -> cirrina.ReqBool
+	76,  // 199: cirrina.VMInfo.GuestAgentShutdown:output_type -> cirrina.ReqBool
+	39,  // 200: cirrina.VMInfo.GuestAgentInterfaces:output_type -> cirrina.GuestInterface
+	40,  // 201: cirrina.VMInfo.GuestAgentFSFreeze:output_type -> cirrina.GuestFSFreezeReply
+	40,  // 202: cirrina.VMInfo.GuestAgentFSThaw:output_type -> cirrina.GuestFSFreezeReply
+	40,  // 203: cirrina.VMInfo.GuestAgentFSStatus:output_type -> cirrina.GuestFSFreezeReply
+	76,  // 204: cirrina.VMInfo.GuestAgentSyncTime:output_type -> cirrina.ReqBool
+	73,  // 205: cirrina.VMInfo.CreateVMSnapshot:output_type -> cirrina.RequestID
+	26,  // 206: cirrina.VMInfo.ListVMSnapshots:output_type -> cirrina.VMSnapshotInfo
+	73,  // 207: cirrina.VMInfo.RestoreVMSnapshot:output_type -> cirrina.RequestID
+	76,  // 208: cirrina.VMInfo.DeleteVMSnapshot:output_type -> cirrina.ReqBool
+	50,  // 209: cirrina.VMInfo.AddSchedule:output_type -> cirrina.ScheduleId
+	51,  // 210: cirrina.VMInfo.ListSchedules:output_type -> cirrina.ScheduleInfo
+	76,  // 211: cirrina.VMInfo.RemoveSchedule:output_type -> cirrina.ReqBool
+	52,  // 212: cirrina.VMInfo.AddWebhook:output_type -> cirrina.WebhookId
+	53,  // 213: cirrina.VMInfo.ListWebhooks:output_type -> cirrina.WebhookInfo
+	76,  // 214: cirrina.VMInfo.RemoveWebhook:output_type -> cirrina.ReqBool
+	76,  // 215: cirrina.VMInfo.TestWebhook:output_type -> cirrina.ReqBool
+	54,  // 216: cirrina.VMInfo.GetWebhookDeliveries:output_type -> cirrina.WebhookDelivery
+	76,  // 217: cirrina.VMInfo.ClearUEFIState:output_type -> cirrina.ReqBool
+	43,  // 218: cirrina.VMInfo.ListVMUEFIBootEntries:output_type -> cirrina.VMUEFIBootEntry
+	44,  // 219: cirrina.VMInfo.GetVMUEFIBootOrder:output_type -> cirrina.VMUEFIBootOrder
+	76,  // 220: cirrina.VMInfo.SetVMUEFIBootOrder:output_type -> cirrina.ReqBool
+	76,  // 221: cirrina.VMInfo.SetVMUEFIBootNext:output_type -> cirrina.ReqBool
+	76,  // 222: cirrina.VMInfo.BootVMFromISOOnce:output_type -> cirrina.ReqBool
+	48,  // 223: cirrina.VMInfo.GetVMUEFIVars:output_type -> cirrina.VMUEFIVars
+	76,  // 224: cirrina.VMInfo.SetVMUEFIVars:output_type -> cirrina.ReqBool
+	87,  // 225: cirrina.VMInfo.GetVersion:output_type -> google.protobuf.StringValue
+	59,  // 226: cirrina.VMInfo.GetNetInterfaces:output_type -> cirrina.NetIf
+	41,  // 227: cirrina.VMInfo.GetHostPCIDevices:output_type -> cirrina.HostPCIDevice
+	42,  // 228: cirrina.VMInfo.GetFirmwares:output_type -> cirrina.FirmwareInfo
+	89,  // 229: cirrina.VMInfo.GetPurgeDefault:output_type -> google.protobuf.BoolValue
+	74,  // 230: cirrina.VMInfo.RequestStatus:output_type -> cirrina.ReqStatus
+	16,  // 231: cirrina.VMInfo.GetKeyboardLayouts:output_type -> cirrina.KbdLayout
+	77,  // 232: cirrina.VMInfo.GetISOs:output_type -> cirrina.ISOID
+	79,  // 233: cirrina.VMInfo.GetISOInfo:output_type -> cirrina.ISOInfo
+	77,  // 234: cirrina.VMInfo.AddISO:output_type -> cirrina.ISOID
+	76,  // 235: cirrina.VMInfo.RemoveISO:output_type -> cirrina.ReqBool
+	76,  // 236: cirrina.VMInfo.RemoveISOWithOptions:output_type -> cirrina.ReqBool
+	76,  // 237: cirrina.VMInfo.SetVMISOs:output_type -> cirrina.ReqBool
+	77,  // 238: cirrina.VMInfo.GetVMISOs:output_type -> cirrina.ISOID
+	7,   // 239: cirrina.VMInfo.GetISOVMs:output_type -> cirrina.VMID
+	76,  // 240: cirrina.VMInfo.UploadIso:output_type -> cirrina.ReqBool
+	8,   // 241: cirrina.VMInfo.GetDisks:output_type -> cirrina.DiskId
+	17,  // 242: cirrina.VMInfo.GetDiskInfo:output_type -> cirrina.DiskInfo
+	76,  // 243: cirrina.VMInfo.SetDiskInfo:output_type -> cirrina.ReqBool
+	8,   // 244: cirrina.VMInfo.AddDisk:output_type -> cirrina.DiskId
+	76,  // 245: cirrina.VMInfo.RemoveDisk:output_type -> cirrina.ReqBool
+	76,  // 246: cirrina.VMInfo.RemoveDiskWithOptions:output_type -> cirrina.ReqBool
+	76,  // 247: cirrina.VMInfo.SetVMDisks:output_type -> cirrina.ReqBool
+	8,   // 248: cirrina.VMInfo.GetVMDisks:output_type -> cirrina.DiskId
+	7,   // 249: cirrina.VMInfo.GetDiskVM:output_type -> cirrina.VMID
+	76,  // 250: cirrina.VMInfo.UploadDisk:output_type -> cirrina.ReqBool
+	73,  // 251: cirrina.VMInfo.WipeDisk:output_type -> cirrina.RequestID
+	18,  // 252: cirrina.VMInfo.GetDiskSizeUsage:output_type -> cirrina.DiskSizeUsage
+	76,  // 253: cirrina.VMInfo.ResizeDisk:output_type -> cirrina.ReqBool
+	73,  // 254: cirrina.VMInfo.CreateDiskSnapshot:output_type -> cirrina.RequestID
+	23,  // 255: cirrina.VMInfo.ListDiskSnapshots:output_type -> cirrina.DiskSnapshotInfo
+	73,  // 256: cirrina.VMInfo.RollbackDiskSnapshot:output_type -> cirrina.RequestID
+	76,  // 257: cirrina.VMInfo.DeleteDiskSnapshot:output_type -> cirrina.ReqBool
+	55,  // 258: cirrina.VMInfo.ListOrphanedStorage:output_type -> cirrina.OrphanedStorage
+	87,  // 259: cirrina.VMInfo.AdoptStorage:output_type -> google.protobuf.StringValue
+	76,  // 260: cirrina.VMInfo.PurgeStorage:output_type -> cirrina.ReqBool
+	9,   // 261: cirrina.VMInfo.GetSwitches:output_type -> cirrina.SwitchId
+	60,  // 262: cirrina.VMInfo.GetSwitchInfo:output_type -> cirrina.SwitchInfo
+	9,   // 263: cirrina.VMInfo.AddSwitch:output_type -> cirrina.SwitchId
+	76,  // 264: cirrina.VMInfo.SetSwitchInfo:output_type -> cirrina.ReqBool
+	76,  // 265: cirrina.VMInfo.RemoveSwitch:output_type -> cirrina.ReqBool
+	76,  // 266: cirrina.VMInfo.SetSwitchUplink:output_type -> cirrina.ReqBool
+	10,  // 267: cirrina.VMInfo.GetVMNicsAll:output_type -> cirrina.VmNicId
+	87,  // 268: cirrina.VMInfo.GetVMNicName:output_type -> google.protobuf.StringValue
+	10,  // 269: cirrina.VMInfo.GetVMNicID:output_type -> cirrina.VmNicId
+	62,  // 270: cirrina.VMInfo.GetVMNicInfo:output_type -> cirrina.VmNicInfo
+	10,  // 271: cirrina.VMInfo.AddVMNic:output_type -> cirrina.VmNicId
+	76,  // 272: cirrina.VMInfo.UpdateVMNic:output_type -> cirrina.ReqBool
+	76,  // 273: cirrina.VMInfo.RemoveVMNic:output_type -> cirrina.ReqBool
+	76,  // 274: cirrina.VMInfo.SetVMNicSwitch:output_type -> cirrina.ReqBool
+	7,   // 275: cirrina.VMInfo.GetVMNicVM:output_type -> cirrina.VMID
+	63,  // 276: cirrina.VMInfo.GetVMNicAddresses:output_type -> cirrina.VmNicAddress
+	73,  // 277: cirrina.VMInfo.CloneVMNic:output_type -> cirrina.RequestID
+	76,  // 278: cirrina.VMInfo.SetVMNics:output_type -> cirrina.ReqBool
+	10,  // 279: cirrina.VMInfo.GetVMNics:output_type -> cirrina.VmNicId
+	63,  // 280: cirrina.VMInfo.GetVMAddresses:output_type -> cirrina.VmNicAddress
+	85,  // 281: cirrina.VMInfo.Com1Interactive:output_type -> cirrina.ComDataResponse
+	85,  // 282: cirrina.VMInfo.Com2Interactive:output_type -> cirrina.ComDataResponse
+	85,  // 283: cirrina.VMInfo.Com3Interactive:output_type -> cirrina.ComDataResponse
+	85,  // 284: cirrina.VMInfo.Com4Interactive:output_type -> cirrina.ComDataResponse
+	170, // [170:285] is the sub-list for method output_type
+	55,  // [55:170] is the sub-list for method input_type
+	55,  // [55:55] is the sub-list for extension type_name
+	55,  // [55:55] is the sub-list for extension extendee
+	0,   // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_cirrina_proto_init() }
@@ -3865,21 +7714,36 @@ func file_cirrina_proto_init() {
 	file_cirrina_proto_msgTypes[10].OneofWrappers = []any{}
 	file_cirrina_proto_msgTypes[11].OneofWrappers = []any{}
 	file_cirrina_proto_msgTypes[12].OneofWrappers = []any{}
-	file_cirrina_proto_msgTypes[15].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[13].OneofWrappers = []any{}
 	file_cirrina_proto_msgTypes[16].OneofWrappers = []any{}
-	file_cirrina_proto_msgTypes[17].OneofWrappers = []any{}
-	file_cirrina_proto_msgTypes[18].OneofWrappers = []any{}
 	file_cirrina_proto_msgTypes[19].OneofWrappers = []any{}
-	file_cirrina_proto_msgTypes[32].OneofWrappers = []any{}
-	file_cirrina_proto_msgTypes[34].OneofWrappers = []any{
+	file_cirrina_proto_msgTypes[21].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[24].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[30].OneofWrappers = []any{
+		(*ConsolePortDataRequest_Port)(nil),
+		(*ConsolePortDataRequest_PortInBytes)(nil),
+	}
+	file_cirrina_proto_msgTypes[37].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[39].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[44].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[46].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[49].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[53].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[54].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[55].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[57].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[58].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[71].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[72].OneofWrappers = []any{}
+	file_cirrina_proto_msgTypes[74].OneofWrappers = []any{
 		(*ISOImageRequest_Isouploadinfo)(nil),
 		(*ISOImageRequest_Image)(nil),
 	}
-	file_cirrina_proto_msgTypes[36].OneofWrappers = []any{
+	file_cirrina_proto_msgTypes[76].OneofWrappers = []any{
 		(*DiskImageRequest_Diskuploadinfo)(nil),
 		(*DiskImageRequest_Image)(nil),
 	}
-	file_cirrina_proto_msgTypes[37].OneofWrappers = []any{
+	file_cirrina_proto_msgTypes[77].OneofWrappers = []any{
 		(*ComDataRequest_VmId)(nil),
 		(*ComDataRequest_ComInBytes)(nil),
 	}
@@ -3888,8 +7752,8 @@ func file_cirrina_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cirrina_proto_rawDesc), len(file_cirrina_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   39,
+			NumEnums:      7,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,62 +21,121 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VMInfo_AddVM_FullMethodName              = "/cirrina.VMInfo/AddVM"
-	VMInfo_GetVMs_FullMethodName             = "/cirrina.VMInfo/GetVMs"
-	VMInfo_GetVMConfig_FullMethodName        = "/cirrina.VMInfo/GetVMConfig"
-	VMInfo_GetVMName_FullMethodName          = "/cirrina.VMInfo/GetVMName"
-	VMInfo_GetVMID_FullMethodName            = "/cirrina.VMInfo/GetVMID"
-	VMInfo_GetVMState_FullMethodName         = "/cirrina.VMInfo/GetVMState"
-	VMInfo_UpdateVM_FullMethodName           = "/cirrina.VMInfo/UpdateVM"
-	VMInfo_StartVM_FullMethodName            = "/cirrina.VMInfo/StartVM"
-	VMInfo_StopVM_FullMethodName             = "/cirrina.VMInfo/StopVM"
-	VMInfo_DeleteVM_FullMethodName           = "/cirrina.VMInfo/DeleteVM"
-	VMInfo_ClearUEFIState_FullMethodName     = "/cirrina.VMInfo/ClearUEFIState"
-	VMInfo_GetVersion_FullMethodName         = "/cirrina.VMInfo/GetVersion"
-	VMInfo_GetNetInterfaces_FullMethodName   = "/cirrina.VMInfo/GetNetInterfaces"
-	VMInfo_RequestStatus_FullMethodName      = "/cirrina.VMInfo/RequestStatus"
-	VMInfo_GetKeyboardLayouts_FullMethodName = "/cirrina.VMInfo/GetKeyboardLayouts"
-	VMInfo_GetISOs_FullMethodName            = "/cirrina.VMInfo/GetISOs"
-	VMInfo_GetISOInfo_FullMethodName         = "/cirrina.VMInfo/GetISOInfo"
-	VMInfo_AddISO_FullMethodName             = "/cirrina.VMInfo/AddISO"
-	VMInfo_RemoveISO_FullMethodName          = "/cirrina.VMInfo/RemoveISO"
-	VMInfo_SetVMISOs_FullMethodName          = "/cirrina.VMInfo/SetVMISOs"
-	VMInfo_GetVMISOs_FullMethodName          = "/cirrina.VMInfo/GetVMISOs"
-	VMInfo_GetISOVMs_FullMethodName          = "/cirrina.VMInfo/GetISOVMs"
-	VMInfo_UploadIso_FullMethodName          = "/cirrina.VMInfo/UploadIso"
-	VMInfo_GetDisks_FullMethodName           = "/cirrina.VMInfo/GetDisks"
-	VMInfo_GetDiskInfo_FullMethodName        = "/cirrina.VMInfo/GetDiskInfo"
-	VMInfo_SetDiskInfo_FullMethodName        = "/cirrina.VMInfo/SetDiskInfo"
-	VMInfo_AddDisk_FullMethodName            = "/cirrina.VMInfo/AddDisk"
-	VMInfo_RemoveDisk_FullMethodName         = "/cirrina.VMInfo/RemoveDisk"
-	VMInfo_SetVMDisks_FullMethodName         = "/cirrina.VMInfo/SetVMDisks"
-	VMInfo_GetVMDisks_FullMethodName         = "/cirrina.VMInfo/GetVMDisks"
-	VMInfo_GetDiskVM_FullMethodName          = "/cirrina.VMInfo/GetDiskVM"
-	VMInfo_UploadDisk_FullMethodName         = "/cirrina.VMInfo/UploadDisk"
-	VMInfo_WipeDisk_FullMethodName           = "/cirrina.VMInfo/WipeDisk"
-	VMInfo_GetDiskSizeUsage_FullMethodName   = "/cirrina.VMInfo/GetDiskSizeUsage"
-	VMInfo_GetSwitches_FullMethodName        = "/cirrina.VMInfo/GetSwitches"
-	VMInfo_GetSwitchInfo_FullMethodName      = "/cirrina.VMInfo/GetSwitchInfo"
-	VMInfo_AddSwitch_FullMethodName          = "/cirrina.VMInfo/AddSwitch"
-	VMInfo_SetSwitchInfo_FullMethodName      = "/cirrina.VMInfo/SetSwitchInfo"
-	VMInfo_RemoveSwitch_FullMethodName       = "/cirrina.VMInfo/RemoveSwitch"
-	VMInfo_SetSwitchUplink_FullMethodName    = "/cirrina.VMInfo/SetSwitchUplink"
-	VMInfo_GetVMNicsAll_FullMethodName       = "/cirrina.VMInfo/GetVMNicsAll"
-	VMInfo_GetVMNicName_FullMethodName       = "/cirrina.VMInfo/GetVMNicName"
-	VMInfo_GetVMNicID_FullMethodName         = "/cirrina.VMInfo/GetVMNicID"
-	VMInfo_GetVMNicInfo_FullMethodName       = "/cirrina.VMInfo/GetVMNicInfo"
-	VMInfo_AddVMNic_FullMethodName           = "/cirrina.VMInfo/AddVMNic"
-	VMInfo_UpdateVMNic_FullMethodName        = "/cirrina.VMInfo/UpdateVMNic"
-	VMInfo_RemoveVMNic_FullMethodName        = "/cirrina.VMInfo/RemoveVMNic"
-	VMInfo_SetVMNicSwitch_FullMethodName     = "/cirrina.VMInfo/SetVMNicSwitch"
-	VMInfo_GetVMNicVM_FullMethodName         = "/cirrina.VMInfo/GetVMNicVM"
-	VMInfo_CloneVMNic_FullMethodName         = "/cirrina.VMInfo/CloneVMNic"
-	VMInfo_SetVMNics_FullMethodName          = "/cirrina.VMInfo/SetVMNics"
-	VMInfo_GetVMNics_FullMethodName          = "/cirrina.VMInfo/GetVMNics"
-	VMInfo_Com1Interactive_FullMethodName    = "/cirrina.VMInfo/Com1Interactive"
-	VMInfo_Com2Interactive_FullMethodName    = "/cirrina.VMInfo/Com2Interactive"
-	VMInfo_Com3Interactive_FullMethodName    = "/cirrina.VMInfo/Com3Interactive"
-	VMInfo_Com4Interactive_FullMethodName    = "/cirrina.VMInfo/Com4Interactive"
+	VMInfo_AddVM_FullMethodName                  = "/cirrina.VMInfo/AddVM"
+	VMInfo_GetVMs_FullMethodName                 = "/cirrina.VMInfo/GetVMs"
+	VMInfo_GetVMConfig_FullMethodName            = "/cirrina.VMInfo/GetVMConfig"
+	VMInfo_GetVMName_FullMethodName              = "/cirrina.VMInfo/GetVMName"
+	VMInfo_GetVMID_FullMethodName                = "/cirrina.VMInfo/GetVMID"
+	VMInfo_GetVMState_FullMethodName             = "/cirrina.VMInfo/GetVMState"
+	VMInfo_UpdateVM_FullMethodName               = "/cirrina.VMInfo/UpdateVM"
+	VMInfo_StartVM_FullMethodName                = "/cirrina.VMInfo/StartVM"
+	VMInfo_StopVM_FullMethodName                 = "/cirrina.VMInfo/StopVM"
+	VMInfo_PowerOffVM_FullMethodName             = "/cirrina.VMInfo/PowerOffVM"
+	VMInfo_ResetVM_FullMethodName                = "/cirrina.VMInfo/ResetVM"
+	VMInfo_RebootVM_FullMethodName               = "/cirrina.VMInfo/RebootVM"
+	VMInfo_DeleteVM_FullMethodName               = "/cirrina.VMInfo/DeleteVM"
+	VMInfo_GetVMRestartHistory_FullMethodName    = "/cirrina.VMInfo/GetVMRestartHistory"
+	VMInfo_GetVMDeviceMap_FullMethodName         = "/cirrina.VMInfo/GetVMDeviceMap"
+	VMInfo_AddVMController_FullMethodName        = "/cirrina.VMInfo/AddVMController"
+	VMInfo_ListVMControllers_FullMethodName      = "/cirrina.VMInfo/ListVMControllers"
+	VMInfo_RemoveVMController_FullMethodName     = "/cirrina.VMInfo/RemoveVMController"
+	VMInfo_SetVMAttachment_FullMethodName        = "/cirrina.VMInfo/SetVMAttachment"
+	VMInfo_ListVMAttachments_FullMethodName      = "/cirrina.VMInfo/ListVMAttachments"
+	VMInfo_RemoveVMAttachment_FullMethodName     = "/cirrina.VMInfo/RemoveVMAttachment"
+	VMInfo_AddVMPassthru_FullMethodName          = "/cirrina.VMInfo/AddVMPassthru"
+	VMInfo_ListVMPassthrus_FullMethodName        = "/cirrina.VMInfo/ListVMPassthrus"
+	VMInfo_RemoveVMPassthru_FullMethodName       = "/cirrina.VMInfo/RemoveVMPassthru"
+	VMInfo_AddVMShare_FullMethodName             = "/cirrina.VMInfo/AddVMShare"
+	VMInfo_ListVMShares_FullMethodName           = "/cirrina.VMInfo/ListVMShares"
+	VMInfo_RemoveVMShare_FullMethodName          = "/cirrina.VMInfo/RemoveVMShare"
+	VMInfo_ConsolePortInteractive_FullMethodName = "/cirrina.VMInfo/ConsolePortInteractive"
+	VMInfo_GuestAgentPing_FullMethodName         = "/cirrina.VMInfo/GuestAgentPing"
+	VMInfo_GuestAgentShutdown_FullMethodName     = "/cirrina.VMInfo/GuestAgentShutdown"
+	VMInfo_GuestAgentInterfaces_FullMethodName   = "/cirrina.VMInfo/GuestAgentInterfaces"
+	VMInfo_GuestAgentFSFreeze_FullMethodName     = "/cirrina.VMInfo/GuestAgentFSFreeze"
+	VMInfo_GuestAgentFSThaw_FullMethodName       = "/cirrina.VMInfo/GuestAgentFSThaw"
+	VMInfo_GuestAgentFSStatus_FullMethodName     = "/cirrina.VMInfo/GuestAgentFSStatus"
+	VMInfo_GuestAgentSyncTime_FullMethodName     = "/cirrina.VMInfo/GuestAgentSyncTime"
+	VMInfo_CreateVMSnapshot_FullMethodName       = "/cirrina.VMInfo/CreateVMSnapshot"
+	VMInfo_ListVMSnapshots_FullMethodName        = "/cirrina.VMInfo/ListVMSnapshots"
+	VMInfo_RestoreVMSnapshot_FullMethodName      = "/cirrina.VMInfo/RestoreVMSnapshot"
+	VMInfo_DeleteVMSnapshot_FullMethodName       = "/cirrina.VMInfo/DeleteVMSnapshot"
+	VMInfo_AddSchedule_FullMethodName            = "/cirrina.VMInfo/AddSchedule"
+	VMInfo_ListSchedules_FullMethodName          = "/cirrina.VMInfo/ListSchedules"
+	VMInfo_RemoveSchedule_FullMethodName         = "/cirrina.VMInfo/RemoveSchedule"
+	VMInfo_AddWebhook_FullMethodName             = "/cirrina.VMInfo/AddWebhook"
+	VMInfo_ListWebhooks_FullMethodName           = "/cirrina.VMInfo/ListWebhooks"
+	VMInfo_RemoveWebhook_FullMethodName          = "/cirrina.VMInfo/RemoveWebhook"
+	VMInfo_TestWebhook_FullMethodName            = "/cirrina.VMInfo/TestWebhook"
+	VMInfo_GetWebhookDeliveries_FullMethodName   = "/cirrina.VMInfo/GetWebhookDeliveries"
+	VMInfo_ClearUEFIState_FullMethodName         = "/cirrina.VMInfo/ClearUEFIState"
+	VMInfo_ListVMUEFIBootEntries_FullMethodName  = "/cirrina.VMInfo/ListVMUEFIBootEntries"
+	VMInfo_GetVMUEFIBootOrder_FullMethodName     = "/cirrina.VMInfo/GetVMUEFIBootOrder"
+	VMInfo_SetVMUEFIBootOrder_FullMethodName     = "/cirrina.VMInfo/SetVMUEFIBootOrder"
+	VMInfo_SetVMUEFIBootNext_FullMethodName      = "/cirrina.VMInfo/SetVMUEFIBootNext"
+	VMInfo_BootVMFromISOOnce_FullMethodName      = "/cirrina.VMInfo/BootVMFromISOOnce"
+	VMInfo_GetVMUEFIVars_FullMethodName          = "/cirrina.VMInfo/GetVMUEFIVars"
+	VMInfo_SetVMUEFIVars_FullMethodName          = "/cirrina.VMInfo/SetVMUEFIVars"
+	VMInfo_GetVersion_FullMethodName             = "/cirrina.VMInfo/GetVersion"
+	VMInfo_GetNetInterfaces_FullMethodName       = "/cirrina.VMInfo/GetNetInterfaces"
+	VMInfo_GetHostPCIDevices_FullMethodName      = "/cirrina.VMInfo/GetHostPCIDevices"
+	VMInfo_GetFirmwares_FullMethodName           = "/cirrina.VMInfo/GetFirmwares"
+	VMInfo_GetPurgeDefault_FullMethodName        = "/cirrina.VMInfo/GetPurgeDefault"
+	VMInfo_RequestStatus_FullMethodName          = "/cirrina.VMInfo/RequestStatus"
+	VMInfo_GetKeyboardLayouts_FullMethodName     = "/cirrina.VMInfo/GetKeyboardLayouts"
+	VMInfo_GetISOs_FullMethodName                = "/cirrina.VMInfo/GetISOs"
+	VMInfo_GetISOInfo_FullMethodName             = "/cirrina.VMInfo/GetISOInfo"
+	VMInfo_AddISO_FullMethodName                 = "/cirrina.VMInfo/AddISO"
+	VMInfo_RemoveISO_FullMethodName              = "/cirrina.VMInfo/RemoveISO"
+	VMInfo_RemoveISOWithOptions_FullMethodName   = "/cirrina.VMInfo/RemoveISOWithOptions"
+	VMInfo_SetVMISOs_FullMethodName              = "/cirrina.VMInfo/SetVMISOs"
+	VMInfo_GetVMISOs_FullMethodName              = "/cirrina.VMInfo/GetVMISOs"
+	VMInfo_GetISOVMs_FullMethodName              = "/cirrina.VMInfo/GetISOVMs"
+	VMInfo_UploadIso_FullMethodName              = "/cirrina.VMInfo/UploadIso"
+	VMInfo_GetDisks_FullMethodName               = "/cirrina.VMInfo/GetDisks"
+	VMInfo_GetDiskInfo_FullMethodName            = "/cirrina.VMInfo/GetDiskInfo"
+	VMInfo_SetDiskInfo_FullMethodName            = "/cirrina.VMInfo/SetDiskInfo"
+	VMInfo_AddDisk_FullMethodName                = "/cirrina.VMInfo/AddDisk"
+	VMInfo_RemoveDisk_FullMethodName             = "/cirrina.VMInfo/RemoveDisk"
+	VMInfo_RemoveDiskWithOptions_FullMethodName  = "/cirrina.VMInfo/RemoveDiskWithOptions"
+	VMInfo_SetVMDisks_FullMethodName             = "/cirrina.VMInfo/SetVMDisks"
+	VMInfo_GetVMDisks_FullMethodName             = "/cirrina.VMInfo/GetVMDisks"
+	VMInfo_GetDiskVM_FullMethodName              = "/cirrina.VMInfo/GetDiskVM"
+	VMInfo_UploadDisk_FullMethodName             = "/cirrina.VMInfo/UploadDisk"
+	VMInfo_WipeDisk_FullMethodName               = "/cirrina.VMInfo/WipeDisk"
+	VMInfo_GetDiskSizeUsage_FullMethodName       = "/cirrina.VMInfo/GetDiskSizeUsage"
+	VMInfo_ResizeDisk_FullMethodName             = "/cirrina.VMInfo/ResizeDisk"
+	VMInfo_CreateDiskSnapshot_FullMethodName     = "/cirrina.VMInfo/CreateDiskSnapshot"
+	VMInfo_ListDiskSnapshots_FullMethodName      = "/cirrina.VMInfo/ListDiskSnapshots"
+	VMInfo_RollbackDiskSnapshot_FullMethodName   = "/cirrina.VMInfo/RollbackDiskSnapshot"
+	VMInfo_DeleteDiskSnapshot_FullMethodName     = "/cirrina.VMInfo/DeleteDiskSnapshot"
+	VMInfo_ListOrphanedStorage_FullMethodName    = "/cirrina.VMInfo/ListOrphanedStorage"
+	VMInfo_AdoptStorage_FullMethodName           = "/cirrina.VMInfo/AdoptStorage"
+	VMInfo_PurgeStorage_FullMethodName           = "/cirrina.VMInfo/PurgeStorage"
+	VMInfo_GetSwitches_FullMethodName            = "/cirrina.VMInfo/GetSwitches"
+	VMInfo_GetSwitchInfo_FullMethodName          = "/cirrina.VMInfo/GetSwitchInfo"
+	VMInfo_AddSwitch_FullMethodName              = "/cirrina.VMInfo/AddSwitch"
+	VMInfo_SetSwitchInfo_FullMethodName          = "/cirrina.VMInfo/SetSwitchInfo"
+	VMInfo_RemoveSwitch_FullMethodName           = "/cirrina.VMInfo/RemoveSwitch"
+	VMInfo_SetSwitchUplink_FullMethodName        = "/cirrina.VMInfo/SetSwitchUplink"
+	VMInfo_GetVMNicsAll_FullMethodName           = "/cirrina.VMInfo/GetVMNicsAll"
+	VMInfo_GetVMNicName_FullMethodName           = "/cirrina.VMInfo/GetVMNicName"
+	VMInfo_GetVMNicID_FullMethodName             = "/cirrina.VMInfo/GetVMNicID"
+	VMInfo_GetVMNicInfo_FullMethodName           = "/cirrina.VMInfo/GetVMNicInfo"
+	VMInfo_AddVMNic_FullMethodName               = "/cirrina.VMInfo/AddVMNic"
+	VMInfo_UpdateVMNic_FullMethodName            = "/cirrina.VMInfo/UpdateVMNic"
+	VMInfo_RemoveVMNic_FullMethodName            = "/cirrina.VMInfo/RemoveVMNic"
+	VMInfo_SetVMNicSwitch_FullMethodName         = "/cirrina.VMInfo/SetVMNicSwitch"
+	VMInfo_GetVMNicVM_FullMethodName             = "/cirrina.VMInfo/GetVMNicVM"
+	VMInfo_GetVMNicAddresses_FullMethodName      = "/cirrina.VMInfo/GetVMNicAddresses"
+	VMInfo_CloneVMNic_FullMethodName             = "/cirrina.VMInfo/CloneVMNic"
+	VMInfo_SetVMNics_FullMethodName              = "/cirrina.VMInfo/SetVMNics"
+	VMInfo_GetVMNics_FullMethodName              = "/cirrina.VMInfo/GetVMNics"
+	VMInfo_GetVMAddresses_FullMethodName         = "/cirrina.VMInfo/GetVMAddresses"
+	VMInfo_Com1Interactive_FullMethodName        = "/cirrina.VMInfo/Com1Interactive"
+	VMInfo_Com2Interactive_FullMethodName        = "/cirrina.VMInfo/Com2Interactive"
+	VMInfo_Com3Interactive_FullMethodName        = "/cirrina.VMInfo/Com3Interactive"
+	VMInfo_Com4Interactive_FullMethodName        = "/cirrina.VMInfo/Com4Interactive"
 )
 
 // VMInfoClient is the client API for VMInfo service.
@@ -92,16 +151,64 @@ type VMInfoClient interface {
 	UpdateVM(ctx context.Context, in *VMConfig, opts ...grpc.CallOption) (*ReqBool, error)
 	StartVM(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*RequestID, error)
 	StopVM(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*RequestID, error)
+	PowerOffVM(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*RequestID, error)
+	ResetVM(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*RequestID, error)
+	RebootVM(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*RequestID, error)
 	DeleteVM(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*RequestID, error)
+	GetVMRestartHistory(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMRestartRecord], error)
+	GetVMDeviceMap(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMDevice], error)
+	AddVMController(ctx context.Context, in *VMControllerInfo, opts ...grpc.CallOption) (*ReqBool, error)
+	ListVMControllers(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMControllerInfo], error)
+	RemoveVMController(ctx context.Context, in *VMControllerReq, opts ...grpc.CallOption) (*ReqBool, error)
+	SetVMAttachment(ctx context.Context, in *VMAttachmentInfo, opts ...grpc.CallOption) (*ReqBool, error)
+	ListVMAttachments(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMAttachmentInfo], error)
+	RemoveVMAttachment(ctx context.Context, in *VMAttachmentReq, opts ...grpc.CallOption) (*ReqBool, error)
+	AddVMPassthru(ctx context.Context, in *VMPassthruReq, opts ...grpc.CallOption) (*ReqBool, error)
+	ListVMPassthrus(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMPassthruReq], error)
+	RemoveVMPassthru(ctx context.Context, in *VMPassthruReq, opts ...grpc.CallOption) (*ReqBool, error)
+	AddVMShare(ctx context.Context, in *VMShareInfo, opts ...grpc.CallOption) (*ReqBool, error)
+	ListVMShares(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMShareInfo], error)
+	RemoveVMShare(ctx context.Context, in *VMShareReq, opts ...grpc.CallOption) (*ReqBool, error)
+	ConsolePortInteractive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConsolePortDataRequest, ComDataResponse], error)
+	GuestAgentPing(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*ReqBool, error)
+	GuestAgentShutdown(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*ReqBool, error)
+	GuestAgentInterfaces(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GuestInterface], error)
+	GuestAgentFSFreeze(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*GuestFSFreezeReply, error)
+	GuestAgentFSThaw(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*GuestFSFreezeReply, error)
+	GuestAgentFSStatus(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*GuestFSFreezeReply, error)
+	GuestAgentSyncTime(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*ReqBool, error)
+	CreateVMSnapshot(ctx context.Context, in *VMSnapshotInfo, opts ...grpc.CallOption) (*RequestID, error)
+	ListVMSnapshots(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMSnapshotInfo], error)
+	RestoreVMSnapshot(ctx context.Context, in *VMSnapshotReq, opts ...grpc.CallOption) (*RequestID, error)
+	DeleteVMSnapshot(ctx context.Context, in *VMSnapshotReq, opts ...grpc.CallOption) (*ReqBool, error)
+	AddSchedule(ctx context.Context, in *ScheduleInfo, opts ...grpc.CallOption) (*ScheduleId, error)
+	ListSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScheduleInfo], error)
+	RemoveSchedule(ctx context.Context, in *ScheduleId, opts ...grpc.CallOption) (*ReqBool, error)
+	AddWebhook(ctx context.Context, in *WebhookInfo, opts ...grpc.CallOption) (*WebhookId, error)
+	ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WebhookInfo], error)
+	RemoveWebhook(ctx context.Context, in *WebhookId, opts ...grpc.CallOption) (*ReqBool, error)
+	TestWebhook(ctx context.Context, in *WebhookId, opts ...grpc.CallOption) (*ReqBool, error)
+	GetWebhookDeliveries(ctx context.Context, in *WebhookId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WebhookDelivery], error)
 	ClearUEFIState(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*ReqBool, error)
+	ListVMUEFIBootEntries(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMUEFIBootEntry], error)
+	GetVMUEFIBootOrder(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*VMUEFIBootOrder, error)
+	SetVMUEFIBootOrder(ctx context.Context, in *VMUEFIBootOrderReq, opts ...grpc.CallOption) (*ReqBool, error)
+	SetVMUEFIBootNext(ctx context.Context, in *VMUEFIBootNextReq, opts ...grpc.CallOption) (*ReqBool, error)
+	BootVMFromISOOnce(ctx context.Context, in *VMUEFIBootISOReq, opts ...grpc.CallOption) (*ReqBool, error)
+	GetVMUEFIVars(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*VMUEFIVars, error)
+	SetVMUEFIVars(ctx context.Context, in *VMUEFIVars, opts ...grpc.CallOption) (*ReqBool, error)
 	GetVersion(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
 	GetNetInterfaces(ctx context.Context, in *NetInterfacesReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NetIf], error)
+	GetHostPCIDevices(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HostPCIDevice], error)
+	GetFirmwares(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FirmwareInfo], error)
+	GetPurgeDefault(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
 	RequestStatus(ctx context.Context, in *RequestID, opts ...grpc.CallOption) (*ReqStatus, error)
 	GetKeyboardLayouts(ctx context.Context, in *KbdQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KbdLayout], error)
 	GetISOs(ctx context.Context, in *ISOsQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ISOID], error)
 	GetISOInfo(ctx context.Context, in *ISOID, opts ...grpc.CallOption) (*ISOInfo, error)
 	AddISO(ctx context.Context, in *ISOInfo, opts ...grpc.CallOption) (*ISOID, error)
 	RemoveISO(ctx context.Context, in *ISOID, opts ...grpc.CallOption) (*ReqBool, error)
+	RemoveISOWithOptions(ctx context.Context, in *ISORemoveReq, opts ...grpc.CallOption) (*ReqBool, error)
 	SetVMISOs(ctx context.Context, in *SetISOReq, opts ...grpc.CallOption) (*ReqBool, error)
 	GetVMISOs(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ISOID], error)
 	GetISOVMs(ctx context.Context, in *ISOID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMID], error)
@@ -111,12 +218,21 @@ type VMInfoClient interface {
 	SetDiskInfo(ctx context.Context, in *DiskInfoUpdate, opts ...grpc.CallOption) (*ReqBool, error)
 	AddDisk(ctx context.Context, in *DiskInfo, opts ...grpc.CallOption) (*DiskId, error)
 	RemoveDisk(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*ReqBool, error)
+	RemoveDiskWithOptions(ctx context.Context, in *DiskRemoveReq, opts ...grpc.CallOption) (*ReqBool, error)
 	SetVMDisks(ctx context.Context, in *SetDiskReq, opts ...grpc.CallOption) (*ReqBool, error)
 	GetVMDisks(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiskId], error)
 	GetDiskVM(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*VMID, error)
 	UploadDisk(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[DiskImageRequest, ReqBool], error)
 	WipeDisk(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*RequestID, error)
 	GetDiskSizeUsage(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*DiskSizeUsage, error)
+	ResizeDisk(ctx context.Context, in *DiskResizeReq, opts ...grpc.CallOption) (*ReqBool, error)
+	CreateDiskSnapshot(ctx context.Context, in *DiskSnapshotInfo, opts ...grpc.CallOption) (*RequestID, error)
+	ListDiskSnapshots(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiskSnapshotInfo], error)
+	RollbackDiskSnapshot(ctx context.Context, in *DiskSnapshotReq, opts ...grpc.CallOption) (*RequestID, error)
+	DeleteDiskSnapshot(ctx context.Context, in *DiskSnapshotReq, opts ...grpc.CallOption) (*ReqBool, error)
+	ListOrphanedStorage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrphanedStorage], error)
+	AdoptStorage(ctx context.Context, in *AdoptStorageReq, opts ...grpc.CallOption) (*wrapperspb.StringValue, error)
+	PurgeStorage(ctx context.Context, in *PurgeStorageReq, opts ...grpc.CallOption) (*ReqBool, error)
 	GetSwitches(ctx context.Context, in *SwitchesQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SwitchId], error)
 	GetSwitchInfo(ctx context.Context, in *SwitchId, opts ...grpc.CallOption) (*SwitchInfo, error)
 	AddSwitch(ctx context.Context, in *SwitchInfo, opts ...grpc.CallOption) (*SwitchId, error)
@@ -132,9 +248,11 @@ type VMInfoClient interface {
 	RemoveVMNic(ctx context.Context, in *VmNicId, opts ...grpc.CallOption) (*ReqBool, error)
 	SetVMNicSwitch(ctx context.Context, in *SetVmNicSwitchReq, opts ...grpc.CallOption) (*ReqBool, error)
 	GetVMNicVM(ctx context.Context, in *VmNicId, opts ...grpc.CallOption) (*VMID, error)
+	GetVMNicAddresses(ctx context.Context, in *VmNicId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VmNicAddress], error)
 	CloneVMNic(ctx context.Context, in *VmNicCloneReq, opts ...grpc.CallOption) (*RequestID, error)
 	SetVMNics(ctx context.Context, in *SetNicReq, opts ...grpc.CallOption) (*ReqBool, error)
 	GetVMNics(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VmNicId], error)
+	GetVMAddresses(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VmNicAddress], error)
 	Com1Interactive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComDataRequest, ComDataResponse], error)
 	Com2Interactive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComDataRequest, ComDataResponse], error)
 	Com3Interactive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComDataRequest, ComDataResponse], error)
@@ -248,43 +366,53 @@ func (c *vMInfoClient) StopVM(ctx context.Context, in *VMID, opts ...grpc.CallOp
 	return out, nil
 }
 
-func (c *vMInfoClient) DeleteVM(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*RequestID, error) {
+func (c *vMInfoClient) PowerOffVM(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*RequestID, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RequestID)
-	err := c.cc.Invoke(ctx, VMInfo_DeleteVM_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, VMInfo_PowerOffVM_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) ClearUEFIState(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*ReqBool, error) {
+func (c *vMInfoClient) ResetVM(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*RequestID, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_ClearUEFIState_FullMethodName, in, out, cOpts...)
+	out := new(RequestID)
+	err := c.cc.Invoke(ctx, VMInfo_ResetVM_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) GetVersion(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+func (c *vMInfoClient) RebootVM(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*RequestID, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(wrapperspb.StringValue)
-	err := c.cc.Invoke(ctx, VMInfo_GetVersion_FullMethodName, in, out, cOpts...)
+	out := new(RequestID)
+	err := c.cc.Invoke(ctx, VMInfo_RebootVM_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) GetNetInterfaces(ctx context.Context, in *NetInterfacesReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NetIf], error) {
+func (c *vMInfoClient) DeleteVM(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*RequestID, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[1], VMInfo_GetNetInterfaces_FullMethodName, cOpts...)
+	out := new(RequestID)
+	err := c.cc.Invoke(ctx, VMInfo_DeleteVM_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[NetInterfacesReq, NetIf]{ClientStream: stream}
+	return out, nil
+}
+
+func (c *vMInfoClient) GetVMRestartHistory(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMRestartRecord], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[1], VMInfo_GetVMRestartHistory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VMID, VMRestartRecord]{ClientStream: stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -295,25 +423,44 @@ func (c *vMInfoClient) GetNetInterfaces(ctx context.Context, in *NetInterfacesRe
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetNetInterfacesClient = grpc.ServerStreamingClient[NetIf]
+type VMInfo_GetVMRestartHistoryClient = grpc.ServerStreamingClient[VMRestartRecord]
 
-func (c *vMInfoClient) RequestStatus(ctx context.Context, in *RequestID, opts ...grpc.CallOption) (*ReqStatus, error) {
+func (c *vMInfoClient) GetVMDeviceMap(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMDevice], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ReqStatus)
-	err := c.cc.Invoke(ctx, VMInfo_RequestStatus_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[2], VMInfo_GetVMDeviceMap_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VMID, VMDevice]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetVMDeviceMapClient = grpc.ServerStreamingClient[VMDevice]
+
+func (c *vMInfoClient) AddVMController(ctx context.Context, in *VMControllerInfo, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_AddVMController_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) GetKeyboardLayouts(ctx context.Context, in *KbdQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KbdLayout], error) {
+func (c *vMInfoClient) ListVMControllers(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMControllerInfo], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[2], VMInfo_GetKeyboardLayouts_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[3], VMInfo_ListVMControllers_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[KbdQuery, KbdLayout]{ClientStream: stream}
+	x := &grpc.GenericClientStream[VMID, VMControllerInfo]{ClientStream: stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -324,15 +471,35 @@ func (c *vMInfoClient) GetKeyboardLayouts(ctx context.Context, in *KbdQuery, opt
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetKeyboardLayoutsClient = grpc.ServerStreamingClient[KbdLayout]
+type VMInfo_ListVMControllersClient = grpc.ServerStreamingClient[VMControllerInfo]
 
-func (c *vMInfoClient) GetISOs(ctx context.Context, in *ISOsQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ISOID], error) {
+func (c *vMInfoClient) RemoveVMController(ctx context.Context, in *VMControllerReq, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[3], VMInfo_GetISOs_FullMethodName, cOpts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_RemoveVMController_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[ISOsQuery, ISOID]{ClientStream: stream}
+	return out, nil
+}
+
+func (c *vMInfoClient) SetVMAttachment(ctx context.Context, in *VMAttachmentInfo, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_SetVMAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) ListVMAttachments(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMAttachmentInfo], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[4], VMInfo_ListVMAttachments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VMID, VMAttachmentInfo]{ClientStream: stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -343,55 +510,74 @@ func (c *vMInfoClient) GetISOs(ctx context.Context, in *ISOsQuery, opts ...grpc.
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetISOsClient = grpc.ServerStreamingClient[ISOID]
+type VMInfo_ListVMAttachmentsClient = grpc.ServerStreamingClient[VMAttachmentInfo]
 
-func (c *vMInfoClient) GetISOInfo(ctx context.Context, in *ISOID, opts ...grpc.CallOption) (*ISOInfo, error) {
+func (c *vMInfoClient) RemoveVMAttachment(ctx context.Context, in *VMAttachmentReq, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ISOInfo)
-	err := c.cc.Invoke(ctx, VMInfo_GetISOInfo_FullMethodName, in, out, cOpts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_RemoveVMAttachment_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) AddISO(ctx context.Context, in *ISOInfo, opts ...grpc.CallOption) (*ISOID, error) {
+func (c *vMInfoClient) AddVMPassthru(ctx context.Context, in *VMPassthruReq, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ISOID)
-	err := c.cc.Invoke(ctx, VMInfo_AddISO_FullMethodName, in, out, cOpts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_AddVMPassthru_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) RemoveISO(ctx context.Context, in *ISOID, opts ...grpc.CallOption) (*ReqBool, error) {
+func (c *vMInfoClient) ListVMPassthrus(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMPassthruReq], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[5], VMInfo_ListVMPassthrus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VMID, VMPassthruReq]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ListVMPassthrusClient = grpc.ServerStreamingClient[VMPassthruReq]
+
+func (c *vMInfoClient) RemoveVMPassthru(ctx context.Context, in *VMPassthruReq, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_RemoveISO_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, VMInfo_RemoveVMPassthru_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) SetVMISOs(ctx context.Context, in *SetISOReq, opts ...grpc.CallOption) (*ReqBool, error) {
+func (c *vMInfoClient) AddVMShare(ctx context.Context, in *VMShareInfo, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_SetVMISOs_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, VMInfo_AddVMShare_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) GetVMISOs(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ISOID], error) {
+func (c *vMInfoClient) ListVMShares(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMShareInfo], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[4], VMInfo_GetVMISOs_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[6], VMInfo_ListVMShares_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[VMID, ISOID]{ClientStream: stream}
+	x := &grpc.GenericClientStream[VMID, VMShareInfo]{ClientStream: stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -402,47 +588,58 @@ func (c *vMInfoClient) GetVMISOs(ctx context.Context, in *VMID, opts ...grpc.Cal
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetVMISOsClient = grpc.ServerStreamingClient[ISOID]
+type VMInfo_ListVMSharesClient = grpc.ServerStreamingClient[VMShareInfo]
 
-func (c *vMInfoClient) GetISOVMs(ctx context.Context, in *ISOID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMID], error) {
+func (c *vMInfoClient) RemoveVMShare(ctx context.Context, in *VMShareReq, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[5], VMInfo_GetISOVMs_FullMethodName, cOpts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_RemoveVMShare_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[ISOID, VMID]{ClientStream: stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *vMInfoClient) ConsolePortInteractive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConsolePortDataRequest, ComDataResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[7], VMInfo_ConsolePortInteractive_FullMethodName, cOpts...)
+	if err != nil {
 		return nil, err
 	}
+	x := &grpc.GenericClientStream[ConsolePortDataRequest, ComDataResponse]{ClientStream: stream}
 	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetISOVMsClient = grpc.ServerStreamingClient[VMID]
+type VMInfo_ConsolePortInteractiveClient = grpc.BidiStreamingClient[ConsolePortDataRequest, ComDataResponse]
 
-func (c *vMInfoClient) UploadIso(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ISOImageRequest, ReqBool], error) {
+func (c *vMInfoClient) GuestAgentPing(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[6], VMInfo_UploadIso_FullMethodName, cOpts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_GuestAgentPing_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[ISOImageRequest, ReqBool]{ClientStream: stream}
-	return x, nil
+	return out, nil
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_UploadIsoClient = grpc.ClientStreamingClient[ISOImageRequest, ReqBool]
+func (c *vMInfoClient) GuestAgentShutdown(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_GuestAgentShutdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (c *vMInfoClient) GetDisks(ctx context.Context, in *DisksQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiskId], error) {
+func (c *vMInfoClient) GuestAgentInterfaces(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GuestInterface], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[7], VMInfo_GetDisks_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[8], VMInfo_GuestAgentInterfaces_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[DisksQuery, DiskId]{ClientStream: stream}
+	x := &grpc.GenericClientStream[VMID, GuestInterface]{ClientStream: stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -453,65 +650,65 @@ func (c *vMInfoClient) GetDisks(ctx context.Context, in *DisksQuery, opts ...grp
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetDisksClient = grpc.ServerStreamingClient[DiskId]
+type VMInfo_GuestAgentInterfacesClient = grpc.ServerStreamingClient[GuestInterface]
 
-func (c *vMInfoClient) GetDiskInfo(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*DiskInfo, error) {
+func (c *vMInfoClient) GuestAgentFSFreeze(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*GuestFSFreezeReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DiskInfo)
-	err := c.cc.Invoke(ctx, VMInfo_GetDiskInfo_FullMethodName, in, out, cOpts...)
+	out := new(GuestFSFreezeReply)
+	err := c.cc.Invoke(ctx, VMInfo_GuestAgentFSFreeze_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) SetDiskInfo(ctx context.Context, in *DiskInfoUpdate, opts ...grpc.CallOption) (*ReqBool, error) {
+func (c *vMInfoClient) GuestAgentFSThaw(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*GuestFSFreezeReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_SetDiskInfo_FullMethodName, in, out, cOpts...)
+	out := new(GuestFSFreezeReply)
+	err := c.cc.Invoke(ctx, VMInfo_GuestAgentFSThaw_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) AddDisk(ctx context.Context, in *DiskInfo, opts ...grpc.CallOption) (*DiskId, error) {
+func (c *vMInfoClient) GuestAgentFSStatus(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*GuestFSFreezeReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DiskId)
-	err := c.cc.Invoke(ctx, VMInfo_AddDisk_FullMethodName, in, out, cOpts...)
+	out := new(GuestFSFreezeReply)
+	err := c.cc.Invoke(ctx, VMInfo_GuestAgentFSStatus_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) RemoveDisk(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*ReqBool, error) {
+func (c *vMInfoClient) GuestAgentSyncTime(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_RemoveDisk_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, VMInfo_GuestAgentSyncTime_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) SetVMDisks(ctx context.Context, in *SetDiskReq, opts ...grpc.CallOption) (*ReqBool, error) {
+func (c *vMInfoClient) CreateVMSnapshot(ctx context.Context, in *VMSnapshotInfo, opts ...grpc.CallOption) (*RequestID, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_SetVMDisks_FullMethodName, in, out, cOpts...)
+	out := new(RequestID)
+	err := c.cc.Invoke(ctx, VMInfo_CreateVMSnapshot_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) GetVMDisks(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiskId], error) {
+func (c *vMInfoClient) ListVMSnapshots(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMSnapshotInfo], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[8], VMInfo_GetVMDisks_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[9], VMInfo_ListVMSnapshots_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[VMID, DiskId]{ClientStream: stream}
+	x := &grpc.GenericClientStream[VMID, VMSnapshotInfo]{ClientStream: stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -522,58 +719,45 @@ func (c *vMInfoClient) GetVMDisks(ctx context.Context, in *VMID, opts ...grpc.Ca
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetVMDisksClient = grpc.ServerStreamingClient[DiskId]
+type VMInfo_ListVMSnapshotsClient = grpc.ServerStreamingClient[VMSnapshotInfo]
 
-func (c *vMInfoClient) GetDiskVM(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*VMID, error) {
+func (c *vMInfoClient) RestoreVMSnapshot(ctx context.Context, in *VMSnapshotReq, opts ...grpc.CallOption) (*RequestID, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(VMID)
-	err := c.cc.Invoke(ctx, VMInfo_GetDiskVM_FullMethodName, in, out, cOpts...)
+	out := new(RequestID)
+	err := c.cc.Invoke(ctx, VMInfo_RestoreVMSnapshot_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) UploadDisk(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[DiskImageRequest, ReqBool], error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[9], VMInfo_UploadDisk_FullMethodName, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &grpc.GenericClientStream[DiskImageRequest, ReqBool]{ClientStream: stream}
-	return x, nil
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_UploadDiskClient = grpc.ClientStreamingClient[DiskImageRequest, ReqBool]
-
-func (c *vMInfoClient) WipeDisk(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*RequestID, error) {
+func (c *vMInfoClient) DeleteVMSnapshot(ctx context.Context, in *VMSnapshotReq, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RequestID)
-	err := c.cc.Invoke(ctx, VMInfo_WipeDisk_FullMethodName, in, out, cOpts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_DeleteVMSnapshot_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) GetDiskSizeUsage(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*DiskSizeUsage, error) {
+func (c *vMInfoClient) AddSchedule(ctx context.Context, in *ScheduleInfo, opts ...grpc.CallOption) (*ScheduleId, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DiskSizeUsage)
-	err := c.cc.Invoke(ctx, VMInfo_GetDiskSizeUsage_FullMethodName, in, out, cOpts...)
+	out := new(ScheduleId)
+	err := c.cc.Invoke(ctx, VMInfo_AddSchedule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) GetSwitches(ctx context.Context, in *SwitchesQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SwitchId], error) {
+func (c *vMInfoClient) ListSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScheduleInfo], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[10], VMInfo_GetSwitches_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[10], VMInfo_ListSchedules_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[SwitchesQuery, SwitchId]{ClientStream: stream}
+	x := &grpc.GenericClientStream[emptypb.Empty, ScheduleInfo]{ClientStream: stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -584,65 +768,74 @@ func (c *vMInfoClient) GetSwitches(ctx context.Context, in *SwitchesQuery, opts
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetSwitchesClient = grpc.ServerStreamingClient[SwitchId]
+type VMInfo_ListSchedulesClient = grpc.ServerStreamingClient[ScheduleInfo]
 
-func (c *vMInfoClient) GetSwitchInfo(ctx context.Context, in *SwitchId, opts ...grpc.CallOption) (*SwitchInfo, error) {
+func (c *vMInfoClient) RemoveSchedule(ctx context.Context, in *ScheduleId, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SwitchInfo)
-	err := c.cc.Invoke(ctx, VMInfo_GetSwitchInfo_FullMethodName, in, out, cOpts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_RemoveSchedule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) AddSwitch(ctx context.Context, in *SwitchInfo, opts ...grpc.CallOption) (*SwitchId, error) {
+func (c *vMInfoClient) AddWebhook(ctx context.Context, in *WebhookInfo, opts ...grpc.CallOption) (*WebhookId, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SwitchId)
-	err := c.cc.Invoke(ctx, VMInfo_AddSwitch_FullMethodName, in, out, cOpts...)
+	out := new(WebhookId)
+	err := c.cc.Invoke(ctx, VMInfo_AddWebhook_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) SetSwitchInfo(ctx context.Context, in *SwitchInfoUpdate, opts ...grpc.CallOption) (*ReqBool, error) {
+func (c *vMInfoClient) ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WebhookInfo], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_SetSwitchInfo_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[11], VMInfo_ListWebhooks_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[emptypb.Empty, WebhookInfo]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *vMInfoClient) RemoveSwitch(ctx context.Context, in *SwitchId, opts ...grpc.CallOption) (*ReqBool, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ListWebhooksClient = grpc.ServerStreamingClient[WebhookInfo]
+
+func (c *vMInfoClient) RemoveWebhook(ctx context.Context, in *WebhookId, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_RemoveSwitch_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, VMInfo_RemoveWebhook_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) SetSwitchUplink(ctx context.Context, in *SwitchUplinkReq, opts ...grpc.CallOption) (*ReqBool, error) {
+func (c *vMInfoClient) TestWebhook(ctx context.Context, in *WebhookId, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_SetSwitchUplink_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, VMInfo_TestWebhook_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) GetVMNicsAll(ctx context.Context, in *VmNicsQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VmNicId], error) {
+func (c *vMInfoClient) GetWebhookDeliveries(ctx context.Context, in *WebhookId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WebhookDelivery], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[11], VMInfo_GetVMNicsAll_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[12], VMInfo_GetWebhookDeliveries_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[VmNicsQuery, VmNicId]{ClientStream: stream}
+	x := &grpc.GenericClientStream[WebhookId, WebhookDelivery]{ClientStream: stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -653,115 +846,114 @@ func (c *vMInfoClient) GetVMNicsAll(ctx context.Context, in *VmNicsQuery, opts .
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetVMNicsAllClient = grpc.ServerStreamingClient[VmNicId]
+type VMInfo_GetWebhookDeliveriesClient = grpc.ServerStreamingClient[WebhookDelivery]
 
-func (c *vMInfoClient) GetVMNicName(ctx context.Context, in *VmNicId, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+func (c *vMInfoClient) ClearUEFIState(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(wrapperspb.StringValue)
-	err := c.cc.Invoke(ctx, VMInfo_GetVMNicName_FullMethodName, in, out, cOpts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_ClearUEFIState_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) GetVMNicID(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*VmNicId, error) {
+func (c *vMInfoClient) ListVMUEFIBootEntries(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMUEFIBootEntry], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(VmNicId)
-	err := c.cc.Invoke(ctx, VMInfo_GetVMNicID_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[13], VMInfo_ListVMUEFIBootEntries_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *vMInfoClient) GetVMNicInfo(ctx context.Context, in *VmNicId, opts ...grpc.CallOption) (*VmNicInfo, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(VmNicInfo)
-	err := c.cc.Invoke(ctx, VMInfo_GetVMNicInfo_FullMethodName, in, out, cOpts...)
-	if err != nil {
+	x := &grpc.GenericClientStream[VMID, VMUEFIBootEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *vMInfoClient) AddVMNic(ctx context.Context, in *VmNicInfo, opts ...grpc.CallOption) (*VmNicId, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ListVMUEFIBootEntriesClient = grpc.ServerStreamingClient[VMUEFIBootEntry]
+
+func (c *vMInfoClient) GetVMUEFIBootOrder(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*VMUEFIBootOrder, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(VmNicId)
-	err := c.cc.Invoke(ctx, VMInfo_AddVMNic_FullMethodName, in, out, cOpts...)
+	out := new(VMUEFIBootOrder)
+	err := c.cc.Invoke(ctx, VMInfo_GetVMUEFIBootOrder_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) UpdateVMNic(ctx context.Context, in *VmNicInfoUpdate, opts ...grpc.CallOption) (*ReqBool, error) {
+func (c *vMInfoClient) SetVMUEFIBootOrder(ctx context.Context, in *VMUEFIBootOrderReq, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_UpdateVMNic_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, VMInfo_SetVMUEFIBootOrder_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) RemoveVMNic(ctx context.Context, in *VmNicId, opts ...grpc.CallOption) (*ReqBool, error) {
+func (c *vMInfoClient) SetVMUEFIBootNext(ctx context.Context, in *VMUEFIBootNextReq, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_RemoveVMNic_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, VMInfo_SetVMUEFIBootNext_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) SetVMNicSwitch(ctx context.Context, in *SetVmNicSwitchReq, opts ...grpc.CallOption) (*ReqBool, error) {
+func (c *vMInfoClient) BootVMFromISOOnce(ctx context.Context, in *VMUEFIBootISOReq, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_SetVMNicSwitch_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, VMInfo_BootVMFromISOOnce_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) GetVMNicVM(ctx context.Context, in *VmNicId, opts ...grpc.CallOption) (*VMID, error) {
+func (c *vMInfoClient) GetVMUEFIVars(ctx context.Context, in *VMID, opts ...grpc.CallOption) (*VMUEFIVars, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(VMID)
-	err := c.cc.Invoke(ctx, VMInfo_GetVMNicVM_FullMethodName, in, out, cOpts...)
+	out := new(VMUEFIVars)
+	err := c.cc.Invoke(ctx, VMInfo_GetVMUEFIVars_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) CloneVMNic(ctx context.Context, in *VmNicCloneReq, opts ...grpc.CallOption) (*RequestID, error) {
+func (c *vMInfoClient) SetVMUEFIVars(ctx context.Context, in *VMUEFIVars, opts ...grpc.CallOption) (*ReqBool, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RequestID)
-	err := c.cc.Invoke(ctx, VMInfo_CloneVMNic_FullMethodName, in, out, cOpts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_SetVMUEFIVars_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) SetVMNics(ctx context.Context, in *SetNicReq, opts ...grpc.CallOption) (*ReqBool, error) {
+func (c *vMInfoClient) GetVersion(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ReqBool)
-	err := c.cc.Invoke(ctx, VMInfo_SetVMNics_FullMethodName, in, out, cOpts...)
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, VMInfo_GetVersion_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *vMInfoClient) GetVMNics(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VmNicId], error) {
+func (c *vMInfoClient) GetNetInterfaces(ctx context.Context, in *NetInterfacesReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NetIf], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[12], VMInfo_GetVMNics_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[14], VMInfo_GetNetInterfaces_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[VMID, VmNicId]{ClientStream: stream}
+	x := &grpc.GenericClientStream[NetInterfacesReq, NetIf]{ClientStream: stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -772,506 +964,2120 @@ func (c *vMInfoClient) GetVMNics(ctx context.Context, in *VMID, opts ...grpc.Cal
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetVMNicsClient = grpc.ServerStreamingClient[VmNicId]
+type VMInfo_GetNetInterfacesClient = grpc.ServerStreamingClient[NetIf]
 
-func (c *vMInfoClient) Com1Interactive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComDataRequest, ComDataResponse], error) {
+func (c *vMInfoClient) GetHostPCIDevices(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HostPCIDevice], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[13], VMInfo_Com1Interactive_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[15], VMInfo_GetHostPCIDevices_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[ComDataRequest, ComDataResponse]{ClientStream: stream}
+	x := &grpc.GenericClientStream[emptypb.Empty, HostPCIDevice]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
 	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_Com1InteractiveClient = grpc.BidiStreamingClient[ComDataRequest, ComDataResponse]
+type VMInfo_GetHostPCIDevicesClient = grpc.ServerStreamingClient[HostPCIDevice]
 
-func (c *vMInfoClient) Com2Interactive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComDataRequest, ComDataResponse], error) {
+func (c *vMInfoClient) GetFirmwares(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FirmwareInfo], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[14], VMInfo_Com2Interactive_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[16], VMInfo_GetFirmwares_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[ComDataRequest, ComDataResponse]{ClientStream: stream}
+	x := &grpc.GenericClientStream[emptypb.Empty, FirmwareInfo]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
 	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_Com2InteractiveClient = grpc.BidiStreamingClient[ComDataRequest, ComDataResponse]
+type VMInfo_GetFirmwaresClient = grpc.ServerStreamingClient[FirmwareInfo]
 
-func (c *vMInfoClient) Com3Interactive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComDataRequest, ComDataResponse], error) {
+func (c *vMInfoClient) GetPurgeDefault(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[15], VMInfo_Com3Interactive_FullMethodName, cOpts...)
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, VMInfo_GetPurgeDefault_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[ComDataRequest, ComDataResponse]{ClientStream: stream}
-	return x, nil
+	return out, nil
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_Com3InteractiveClient = grpc.BidiStreamingClient[ComDataRequest, ComDataResponse]
+func (c *vMInfoClient) RequestStatus(ctx context.Context, in *RequestID, opts ...grpc.CallOption) (*ReqStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqStatus)
+	err := c.cc.Invoke(ctx, VMInfo_RequestStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (c *vMInfoClient) Com4Interactive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComDataRequest, ComDataResponse], error) {
+func (c *vMInfoClient) GetKeyboardLayouts(ctx context.Context, in *KbdQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[KbdLayout], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[16], VMInfo_Com4Interactive_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[17], VMInfo_GetKeyboardLayouts_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[ComDataRequest, ComDataResponse]{ClientStream: stream}
+	x := &grpc.GenericClientStream[KbdQuery, KbdLayout]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
 	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_Com4InteractiveClient = grpc.BidiStreamingClient[ComDataRequest, ComDataResponse]
+type VMInfo_GetKeyboardLayoutsClient = grpc.ServerStreamingClient[KbdLayout]
 
-// VMInfoServer is the server API for VMInfo service.
-// All implementations must embed UnimplementedVMInfoServer
-// for forward compatibility.
-type VMInfoServer interface {
-	AddVM(context.Context, *VMConfig) (*VMID, error)
-	GetVMs(*VMsQuery, grpc.ServerStreamingServer[VMID]) error
-	GetVMConfig(context.Context, *VMID) (*VMConfig, error)
-	GetVMName(context.Context, *VMID) (*wrapperspb.StringValue, error)
-	GetVMID(context.Context, *wrapperspb.StringValue) (*VMID, error)
-	GetVMState(context.Context, *VMID) (*VMState, error)
-	UpdateVM(context.Context, *VMConfig) (*ReqBool, error)
-	StartVM(context.Context, *VMID) (*RequestID, error)
-	StopVM(context.Context, *VMID) (*RequestID, error)
-	DeleteVM(context.Context, *VMID) (*RequestID, error)
-	ClearUEFIState(context.Context, *VMID) (*ReqBool, error)
-	GetVersion(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
-	GetNetInterfaces(*NetInterfacesReq, grpc.ServerStreamingServer[NetIf]) error
-	RequestStatus(context.Context, *RequestID) (*ReqStatus, error)
-	GetKeyboardLayouts(*KbdQuery, grpc.ServerStreamingServer[KbdLayout]) error
-	GetISOs(*ISOsQuery, grpc.ServerStreamingServer[ISOID]) error
-	GetISOInfo(context.Context, *ISOID) (*ISOInfo, error)
-	AddISO(context.Context, *ISOInfo) (*ISOID, error)
-	RemoveISO(context.Context, *ISOID) (*ReqBool, error)
-	SetVMISOs(context.Context, *SetISOReq) (*ReqBool, error)
-	GetVMISOs(*VMID, grpc.ServerStreamingServer[ISOID]) error
-	GetISOVMs(*ISOID, grpc.ServerStreamingServer[VMID]) error
-	UploadIso(grpc.ClientStreamingServer[ISOImageRequest, ReqBool]) error
-	GetDisks(*DisksQuery, grpc.ServerStreamingServer[DiskId]) error
-	GetDiskInfo(context.Context, *DiskId) (*DiskInfo, error)
-	SetDiskInfo(context.Context, *DiskInfoUpdate) (*ReqBool, error)
-	AddDisk(context.Context, *DiskInfo) (*DiskId, error)
-	RemoveDisk(context.Context, *DiskId) (*ReqBool, error)
-	SetVMDisks(context.Context, *SetDiskReq) (*ReqBool, error)
-	GetVMDisks(*VMID, grpc.ServerStreamingServer[DiskId]) error
-	GetDiskVM(context.Context, *DiskId) (*VMID, error)
-	UploadDisk(grpc.ClientStreamingServer[DiskImageRequest, ReqBool]) error
-	WipeDisk(context.Context, *DiskId) (*RequestID, error)
-	GetDiskSizeUsage(context.Context, *DiskId) (*DiskSizeUsage, error)
-	GetSwitches(*SwitchesQuery, grpc.ServerStreamingServer[SwitchId]) error
-	GetSwitchInfo(context.Context, *SwitchId) (*SwitchInfo, error)
-	AddSwitch(context.Context, *SwitchInfo) (*SwitchId, error)
-	SetSwitchInfo(context.Context, *SwitchInfoUpdate) (*ReqBool, error)
-	RemoveSwitch(context.Context, *SwitchId) (*ReqBool, error)
-	SetSwitchUplink(context.Context, *SwitchUplinkReq) (*ReqBool, error)
-	GetVMNicsAll(*VmNicsQuery, grpc.ServerStreamingServer[VmNicId]) error
-	GetVMNicName(context.Context, *VmNicId) (*wrapperspb.StringValue, error)
-	GetVMNicID(context.Context, *wrapperspb.StringValue) (*VmNicId, error)
-	GetVMNicInfo(context.Context, *VmNicId) (*VmNicInfo, error)
-	AddVMNic(context.Context, *VmNicInfo) (*VmNicId, error)
-	UpdateVMNic(context.Context, *VmNicInfoUpdate) (*ReqBool, error)
-	RemoveVMNic(context.Context, *VmNicId) (*ReqBool, error)
-	SetVMNicSwitch(context.Context, *SetVmNicSwitchReq) (*ReqBool, error)
-	GetVMNicVM(context.Context, *VmNicId) (*VMID, error)
-	CloneVMNic(context.Context, *VmNicCloneReq) (*RequestID, error)
-	SetVMNics(context.Context, *SetNicReq) (*ReqBool, error)
-	GetVMNics(*VMID, grpc.ServerStreamingServer[VmNicId]) error
-	Com1Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error
-	Com2Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error
-	Com3Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error
-	Com4Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error
-	mustEmbedUnimplementedVMInfoServer()
+func (c *vMInfoClient) GetISOs(ctx context.Context, in *ISOsQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ISOID], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[18], VMInfo_GetISOs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ISOsQuery, ISOID]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// UnimplementedVMInfoServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedVMInfoServer struct{}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetISOsClient = grpc.ServerStreamingClient[ISOID]
 
-func (UnimplementedVMInfoServer) AddVM(context.Context, *VMConfig) (*VMID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddVM not implemented")
-}
-func (UnimplementedVMInfoServer) GetVMs(*VMsQuery, grpc.ServerStreamingServer[VMID]) error {
-	return status.Errorf(codes.Unimplemented, "method GetVMs not implemented")
+func (c *vMInfoClient) GetISOInfo(ctx context.Context, in *ISOID, opts ...grpc.CallOption) (*ISOInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ISOInfo)
+	err := c.cc.Invoke(ctx, VMInfo_GetISOInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetVMConfig(context.Context, *VMID) (*VMConfig, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVMConfig not implemented")
+
+func (c *vMInfoClient) AddISO(ctx context.Context, in *ISOInfo, opts ...grpc.CallOption) (*ISOID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ISOID)
+	err := c.cc.Invoke(ctx, VMInfo_AddISO_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetVMName(context.Context, *VMID) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVMName not implemented")
+
+func (c *vMInfoClient) RemoveISO(ctx context.Context, in *ISOID, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_RemoveISO_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetVMID(context.Context, *wrapperspb.StringValue) (*VMID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVMID not implemented")
+
+func (c *vMInfoClient) RemoveISOWithOptions(ctx context.Context, in *ISORemoveReq, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_RemoveISOWithOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetVMState(context.Context, *VMID) (*VMState, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVMState not implemented")
+
+func (c *vMInfoClient) SetVMISOs(ctx context.Context, in *SetISOReq, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_SetVMISOs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) UpdateVM(context.Context, *VMConfig) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVM not implemented")
+
+func (c *vMInfoClient) GetVMISOs(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ISOID], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[19], VMInfo_GetVMISOs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VMID, ISOID]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedVMInfoServer) StartVM(context.Context, *VMID) (*RequestID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartVM not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetVMISOsClient = grpc.ServerStreamingClient[ISOID]
+
+func (c *vMInfoClient) GetISOVMs(ctx context.Context, in *ISOID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VMID], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[20], VMInfo_GetISOVMs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ISOID, VMID]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedVMInfoServer) StopVM(context.Context, *VMID) (*RequestID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopVM not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetISOVMsClient = grpc.ServerStreamingClient[VMID]
+
+func (c *vMInfoClient) UploadIso(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ISOImageRequest, ReqBool], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[21], VMInfo_UploadIso_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ISOImageRequest, ReqBool]{ClientStream: stream}
+	return x, nil
 }
-func (UnimplementedVMInfoServer) DeleteVM(context.Context, *VMID) (*RequestID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVM not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_UploadIsoClient = grpc.ClientStreamingClient[ISOImageRequest, ReqBool]
+
+func (c *vMInfoClient) GetDisks(ctx context.Context, in *DisksQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiskId], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[22], VMInfo_GetDisks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DisksQuery, DiskId]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedVMInfoServer) ClearUEFIState(context.Context, *VMID) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearUEFIState not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetDisksClient = grpc.ServerStreamingClient[DiskId]
+
+func (c *vMInfoClient) GetDiskInfo(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*DiskInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiskInfo)
+	err := c.cc.Invoke(ctx, VMInfo_GetDiskInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetVersion(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+
+func (c *vMInfoClient) SetDiskInfo(ctx context.Context, in *DiskInfoUpdate, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_SetDiskInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetNetInterfaces(*NetInterfacesReq, grpc.ServerStreamingServer[NetIf]) error {
-	return status.Errorf(codes.Unimplemented, "method GetNetInterfaces not implemented")
+
+func (c *vMInfoClient) AddDisk(ctx context.Context, in *DiskInfo, opts ...grpc.CallOption) (*DiskId, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiskId)
+	err := c.cc.Invoke(ctx, VMInfo_AddDisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) RequestStatus(context.Context, *RequestID) (*ReqStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestStatus not implemented")
+
+func (c *vMInfoClient) RemoveDisk(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_RemoveDisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetKeyboardLayouts(*KbdQuery, grpc.ServerStreamingServer[KbdLayout]) error {
-	return status.Errorf(codes.Unimplemented, "method GetKeyboardLayouts not implemented")
+
+func (c *vMInfoClient) RemoveDiskWithOptions(ctx context.Context, in *DiskRemoveReq, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_RemoveDiskWithOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetISOs(*ISOsQuery, grpc.ServerStreamingServer[ISOID]) error {
-	return status.Errorf(codes.Unimplemented, "method GetISOs not implemented")
+
+func (c *vMInfoClient) SetVMDisks(ctx context.Context, in *SetDiskReq, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_SetVMDisks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetISOInfo(context.Context, *ISOID) (*ISOInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetISOInfo not implemented")
+
+func (c *vMInfoClient) GetVMDisks(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiskId], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[23], VMInfo_GetVMDisks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VMID, DiskId]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedVMInfoServer) AddISO(context.Context, *ISOInfo) (*ISOID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddISO not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetVMDisksClient = grpc.ServerStreamingClient[DiskId]
+
+func (c *vMInfoClient) GetDiskVM(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*VMID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VMID)
+	err := c.cc.Invoke(ctx, VMInfo_GetDiskVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) RemoveISO(context.Context, *ISOID) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveISO not implemented")
+
+func (c *vMInfoClient) UploadDisk(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[DiskImageRequest, ReqBool], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[24], VMInfo_UploadDisk_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DiskImageRequest, ReqBool]{ClientStream: stream}
+	return x, nil
 }
-func (UnimplementedVMInfoServer) SetVMISOs(context.Context, *SetISOReq) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetVMISOs not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_UploadDiskClient = grpc.ClientStreamingClient[DiskImageRequest, ReqBool]
+
+func (c *vMInfoClient) WipeDisk(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*RequestID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestID)
+	err := c.cc.Invoke(ctx, VMInfo_WipeDisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetVMISOs(*VMID, grpc.ServerStreamingServer[ISOID]) error {
-	return status.Errorf(codes.Unimplemented, "method GetVMISOs not implemented")
+
+func (c *vMInfoClient) GetDiskSizeUsage(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (*DiskSizeUsage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiskSizeUsage)
+	err := c.cc.Invoke(ctx, VMInfo_GetDiskSizeUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetISOVMs(*ISOID, grpc.ServerStreamingServer[VMID]) error {
-	return status.Errorf(codes.Unimplemented, "method GetISOVMs not implemented")
+
+func (c *vMInfoClient) ResizeDisk(ctx context.Context, in *DiskResizeReq, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_ResizeDisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) UploadIso(grpc.ClientStreamingServer[ISOImageRequest, ReqBool]) error {
-	return status.Errorf(codes.Unimplemented, "method UploadIso not implemented")
+
+func (c *vMInfoClient) CreateDiskSnapshot(ctx context.Context, in *DiskSnapshotInfo, opts ...grpc.CallOption) (*RequestID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestID)
+	err := c.cc.Invoke(ctx, VMInfo_CreateDiskSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetDisks(*DisksQuery, grpc.ServerStreamingServer[DiskId]) error {
-	return status.Errorf(codes.Unimplemented, "method GetDisks not implemented")
+
+func (c *vMInfoClient) ListDiskSnapshots(ctx context.Context, in *DiskId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DiskSnapshotInfo], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[25], VMInfo_ListDiskSnapshots_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DiskId, DiskSnapshotInfo]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedVMInfoServer) GetDiskInfo(context.Context, *DiskId) (*DiskInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDiskInfo not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ListDiskSnapshotsClient = grpc.ServerStreamingClient[DiskSnapshotInfo]
+
+func (c *vMInfoClient) RollbackDiskSnapshot(ctx context.Context, in *DiskSnapshotReq, opts ...grpc.CallOption) (*RequestID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestID)
+	err := c.cc.Invoke(ctx, VMInfo_RollbackDiskSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) SetDiskInfo(context.Context, *DiskInfoUpdate) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDiskInfo not implemented")
+
+func (c *vMInfoClient) DeleteDiskSnapshot(ctx context.Context, in *DiskSnapshotReq, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_DeleteDiskSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) AddDisk(context.Context, *DiskInfo) (*DiskId, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddDisk not implemented")
+
+func (c *vMInfoClient) ListOrphanedStorage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrphanedStorage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[26], VMInfo_ListOrphanedStorage_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, OrphanedStorage]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedVMInfoServer) RemoveDisk(context.Context, *DiskId) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveDisk not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ListOrphanedStorageClient = grpc.ServerStreamingClient[OrphanedStorage]
+
+func (c *vMInfoClient) AdoptStorage(ctx context.Context, in *AdoptStorageReq, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, VMInfo_AdoptStorage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) SetVMDisks(context.Context, *SetDiskReq) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetVMDisks not implemented")
+
+func (c *vMInfoClient) PurgeStorage(ctx context.Context, in *PurgeStorageReq, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_PurgeStorage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetVMDisks(*VMID, grpc.ServerStreamingServer[DiskId]) error {
-	return status.Errorf(codes.Unimplemented, "method GetVMDisks not implemented")
+
+func (c *vMInfoClient) GetSwitches(ctx context.Context, in *SwitchesQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SwitchId], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[27], VMInfo_GetSwitches_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SwitchesQuery, SwitchId]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedVMInfoServer) GetDiskVM(context.Context, *DiskId) (*VMID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDiskVM not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetSwitchesClient = grpc.ServerStreamingClient[SwitchId]
+
+func (c *vMInfoClient) GetSwitchInfo(ctx context.Context, in *SwitchId, opts ...grpc.CallOption) (*SwitchInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwitchInfo)
+	err := c.cc.Invoke(ctx, VMInfo_GetSwitchInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) UploadDisk(grpc.ClientStreamingServer[DiskImageRequest, ReqBool]) error {
-	return status.Errorf(codes.Unimplemented, "method UploadDisk not implemented")
+
+func (c *vMInfoClient) AddSwitch(ctx context.Context, in *SwitchInfo, opts ...grpc.CallOption) (*SwitchId, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwitchId)
+	err := c.cc.Invoke(ctx, VMInfo_AddSwitch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) WipeDisk(context.Context, *DiskId) (*RequestID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WipeDisk not implemented")
+
+func (c *vMInfoClient) SetSwitchInfo(ctx context.Context, in *SwitchInfoUpdate, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_SetSwitchInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedVMInfoServer) GetDiskSizeUsage(context.Context, *DiskId) (*DiskSizeUsage, error) {
+
+func (c *vMInfoClient) RemoveSwitch(ctx context.Context, in *SwitchId, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_RemoveSwitch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) SetSwitchUplink(ctx context.Context, in *SwitchUplinkReq, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_SetSwitchUplink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) GetVMNicsAll(ctx context.Context, in *VmNicsQuery, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VmNicId], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[28], VMInfo_GetVMNicsAll_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VmNicsQuery, VmNicId]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetVMNicsAllClient = grpc.ServerStreamingClient[VmNicId]
+
+func (c *vMInfoClient) GetVMNicName(ctx context.Context, in *VmNicId, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(wrapperspb.StringValue)
+	err := c.cc.Invoke(ctx, VMInfo_GetVMNicName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) GetVMNicID(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*VmNicId, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VmNicId)
+	err := c.cc.Invoke(ctx, VMInfo_GetVMNicID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) GetVMNicInfo(ctx context.Context, in *VmNicId, opts ...grpc.CallOption) (*VmNicInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VmNicInfo)
+	err := c.cc.Invoke(ctx, VMInfo_GetVMNicInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) AddVMNic(ctx context.Context, in *VmNicInfo, opts ...grpc.CallOption) (*VmNicId, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VmNicId)
+	err := c.cc.Invoke(ctx, VMInfo_AddVMNic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) UpdateVMNic(ctx context.Context, in *VmNicInfoUpdate, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_UpdateVMNic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) RemoveVMNic(ctx context.Context, in *VmNicId, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_RemoveVMNic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) SetVMNicSwitch(ctx context.Context, in *SetVmNicSwitchReq, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_SetVMNicSwitch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) GetVMNicVM(ctx context.Context, in *VmNicId, opts ...grpc.CallOption) (*VMID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VMID)
+	err := c.cc.Invoke(ctx, VMInfo_GetVMNicVM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) GetVMNicAddresses(ctx context.Context, in *VmNicId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VmNicAddress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[29], VMInfo_GetVMNicAddresses_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VmNicId, VmNicAddress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetVMNicAddressesClient = grpc.ServerStreamingClient[VmNicAddress]
+
+func (c *vMInfoClient) CloneVMNic(ctx context.Context, in *VmNicCloneReq, opts ...grpc.CallOption) (*RequestID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestID)
+	err := c.cc.Invoke(ctx, VMInfo_CloneVMNic_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) SetVMNics(ctx context.Context, in *SetNicReq, opts ...grpc.CallOption) (*ReqBool, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReqBool)
+	err := c.cc.Invoke(ctx, VMInfo_SetVMNics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMInfoClient) GetVMNics(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VmNicId], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[30], VMInfo_GetVMNics_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VMID, VmNicId]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetVMNicsClient = grpc.ServerStreamingClient[VmNicId]
+
+func (c *vMInfoClient) GetVMAddresses(ctx context.Context, in *VMID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VmNicAddress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[31], VMInfo_GetVMAddresses_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VMID, VmNicAddress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetVMAddressesClient = grpc.ServerStreamingClient[VmNicAddress]
+
+func (c *vMInfoClient) Com1Interactive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComDataRequest, ComDataResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[32], VMInfo_Com1Interactive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ComDataRequest, ComDataResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_Com1InteractiveClient = grpc.BidiStreamingClient[ComDataRequest, ComDataResponse]
+
+func (c *vMInfoClient) Com2Interactive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComDataRequest, ComDataResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[33], VMInfo_Com2Interactive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ComDataRequest, ComDataResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_Com2InteractiveClient = grpc.BidiStreamingClient[ComDataRequest, ComDataResponse]
+
+func (c *vMInfoClient) Com3Interactive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComDataRequest, ComDataResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[34], VMInfo_Com3Interactive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ComDataRequest, ComDataResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_Com3InteractiveClient = grpc.BidiStreamingClient[ComDataRequest, ComDataResponse]
+
+func (c *vMInfoClient) Com4Interactive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ComDataRequest, ComDataResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VMInfo_ServiceDesc.Streams[35], VMInfo_Com4Interactive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ComDataRequest, ComDataResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_Com4InteractiveClient = grpc.BidiStreamingClient[ComDataRequest, ComDataResponse]
+
+// VMInfoServer is the server API for VMInfo service.
+// All implementations must embed UnimplementedVMInfoServer
+// for forward compatibility.
+type VMInfoServer interface {
+	AddVM(context.Context, *VMConfig) (*VMID, error)
+	GetVMs(*VMsQuery, grpc.ServerStreamingServer[VMID]) error
+	GetVMConfig(context.Context, *VMID) (*VMConfig, error)
+	GetVMName(context.Context, *VMID) (*wrapperspb.StringValue, error)
+	GetVMID(context.Context, *wrapperspb.StringValue) (*VMID, error)
+	GetVMState(context.Context, *VMID) (*VMState, error)
+	UpdateVM(context.Context, *VMConfig) (*ReqBool, error)
+	StartVM(context.Context, *VMID) (*RequestID, error)
+	StopVM(context.Context, *VMID) (*RequestID, error)
+	PowerOffVM(context.Context, *VMID) (*RequestID, error)
+	ResetVM(context.Context, *VMID) (*RequestID, error)
+	RebootVM(context.Context, *VMID) (*RequestID, error)
+	DeleteVM(context.Context, *VMID) (*RequestID, error)
+	GetVMRestartHistory(*VMID, grpc.ServerStreamingServer[VMRestartRecord]) error
+	GetVMDeviceMap(*VMID, grpc.ServerStreamingServer[VMDevice]) error
+	AddVMController(context.Context, *VMControllerInfo) (*ReqBool, error)
+	ListVMControllers(*VMID, grpc.ServerStreamingServer[VMControllerInfo]) error
+	RemoveVMController(context.Context, *VMControllerReq) (*ReqBool, error)
+	SetVMAttachment(context.Context, *VMAttachmentInfo) (*ReqBool, error)
+	ListVMAttachments(*VMID, grpc.ServerStreamingServer[VMAttachmentInfo]) error
+	RemoveVMAttachment(context.Context, *VMAttachmentReq) (*ReqBool, error)
+	AddVMPassthru(context.Context, *VMPassthruReq) (*ReqBool, error)
+	ListVMPassthrus(*VMID, grpc.ServerStreamingServer[VMPassthruReq]) error
+	RemoveVMPassthru(context.Context, *VMPassthruReq) (*ReqBool, error)
+	AddVMShare(context.Context, *VMShareInfo) (*ReqBool, error)
+	ListVMShares(*VMID, grpc.ServerStreamingServer[VMShareInfo]) error
+	RemoveVMShare(context.Context, *VMShareReq) (*ReqBool, error)
+	ConsolePortInteractive(grpc.BidiStreamingServer[ConsolePortDataRequest, ComDataResponse]) error
+	GuestAgentPing(context.Context, *VMID) (*ReqBool, error)
+	GuestAgentShutdown(context.Context, *VMID) (*ReqBool, error)
+	GuestAgentInterfaces(*VMID, grpc.ServerStreamingServer[GuestInterface]) error
+	GuestAgentFSFreeze(context.Context, *VMID) (*GuestFSFreezeReply, error)
+	GuestAgentFSThaw(context.Context, *VMID) (*GuestFSFreezeReply, error)
+	GuestAgentFSStatus(context.Context, *VMID) (*GuestFSFreezeReply, error)
+	GuestAgentSyncTime(context.Context, *VMID) (*ReqBool, error)
+	CreateVMSnapshot(context.Context, *VMSnapshotInfo) (*RequestID, error)
+	ListVMSnapshots(*VMID, grpc.ServerStreamingServer[VMSnapshotInfo]) error
+	RestoreVMSnapshot(context.Context, *VMSnapshotReq) (*RequestID, error)
+	DeleteVMSnapshot(context.Context, *VMSnapshotReq) (*ReqBool, error)
+	AddSchedule(context.Context, *ScheduleInfo) (*ScheduleId, error)
+	ListSchedules(*emptypb.Empty, grpc.ServerStreamingServer[ScheduleInfo]) error
+	RemoveSchedule(context.Context, *ScheduleId) (*ReqBool, error)
+	AddWebhook(context.Context, *WebhookInfo) (*WebhookId, error)
+	ListWebhooks(*emptypb.Empty, grpc.ServerStreamingServer[WebhookInfo]) error
+	RemoveWebhook(context.Context, *WebhookId) (*ReqBool, error)
+	TestWebhook(context.Context, *WebhookId) (*ReqBool, error)
+	GetWebhookDeliveries(*WebhookId, grpc.ServerStreamingServer[WebhookDelivery]) error
+	ClearUEFIState(context.Context, *VMID) (*ReqBool, error)
+	ListVMUEFIBootEntries(*VMID, grpc.ServerStreamingServer[VMUEFIBootEntry]) error
+	GetVMUEFIBootOrder(context.Context, *VMID) (*VMUEFIBootOrder, error)
+	SetVMUEFIBootOrder(context.Context, *VMUEFIBootOrderReq) (*ReqBool, error)
+	SetVMUEFIBootNext(context.Context, *VMUEFIBootNextReq) (*ReqBool, error)
+	BootVMFromISOOnce(context.Context, *VMUEFIBootISOReq) (*ReqBool, error)
+	GetVMUEFIVars(context.Context, *VMID) (*VMUEFIVars, error)
+	SetVMUEFIVars(context.Context, *VMUEFIVars) (*ReqBool, error)
+	GetVersion(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error)
+	GetNetInterfaces(*NetInterfacesReq, grpc.ServerStreamingServer[NetIf]) error
+	GetHostPCIDevices(*emptypb.Empty, grpc.ServerStreamingServer[HostPCIDevice]) error
+	GetFirmwares(*emptypb.Empty, grpc.ServerStreamingServer[FirmwareInfo]) error
+	GetPurgeDefault(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error)
+	RequestStatus(context.Context, *RequestID) (*ReqStatus, error)
+	GetKeyboardLayouts(*KbdQuery, grpc.ServerStreamingServer[KbdLayout]) error
+	GetISOs(*ISOsQuery, grpc.ServerStreamingServer[ISOID]) error
+	GetISOInfo(context.Context, *ISOID) (*ISOInfo, error)
+	AddISO(context.Context, *ISOInfo) (*ISOID, error)
+	RemoveISO(context.Context, *ISOID) (*ReqBool, error)
+	RemoveISOWithOptions(context.Context, *ISORemoveReq) (*ReqBool, error)
+	SetVMISOs(context.Context, *SetISOReq) (*ReqBool, error)
+	GetVMISOs(*VMID, grpc.ServerStreamingServer[ISOID]) error
+	GetISOVMs(*ISOID, grpc.ServerStreamingServer[VMID]) error
+	UploadIso(grpc.ClientStreamingServer[ISOImageRequest, ReqBool]) error
+	GetDisks(*DisksQuery, grpc.ServerStreamingServer[DiskId]) error
+	GetDiskInfo(context.Context, *DiskId) (*DiskInfo, error)
+	SetDiskInfo(context.Context, *DiskInfoUpdate) (*ReqBool, error)
+	AddDisk(context.Context, *DiskInfo) (*DiskId, error)
+	RemoveDisk(context.Context, *DiskId) (*ReqBool, error)
+	RemoveDiskWithOptions(context.Context, *DiskRemoveReq) (*ReqBool, error)
+	SetVMDisks(context.Context, *SetDiskReq) (*ReqBool, error)
+	GetVMDisks(*VMID, grpc.ServerStreamingServer[DiskId]) error
+	GetDiskVM(context.Context, *DiskId) (*VMID, error)
+	UploadDisk(grpc.ClientStreamingServer[DiskImageRequest, ReqBool]) error
+	WipeDisk(context.Context, *DiskId) (*RequestID, error)
+	GetDiskSizeUsage(context.Context, *DiskId) (*DiskSizeUsage, error)
+	ResizeDisk(context.Context, *DiskResizeReq) (*ReqBool, error)
+	CreateDiskSnapshot(context.Context, *DiskSnapshotInfo) (*RequestID, error)
+	ListDiskSnapshots(*DiskId, grpc.ServerStreamingServer[DiskSnapshotInfo]) error
+	RollbackDiskSnapshot(context.Context, *DiskSnapshotReq) (*RequestID, error)
+	DeleteDiskSnapshot(context.Context, *DiskSnapshotReq) (*ReqBool, error)
+	ListOrphanedStorage(*emptypb.Empty, grpc.ServerStreamingServer[OrphanedStorage]) error
+	AdoptStorage(context.Context, *AdoptStorageReq) (*wrapperspb.StringValue, error)
+	PurgeStorage(context.Context, *PurgeStorageReq) (*ReqBool, error)
+	GetSwitches(*SwitchesQuery, grpc.ServerStreamingServer[SwitchId]) error
+	GetSwitchInfo(context.Context, *SwitchId) (*SwitchInfo, error)
+	AddSwitch(context.Context, *SwitchInfo) (*SwitchId, error)
+	SetSwitchInfo(context.Context, *SwitchInfoUpdate) (*ReqBool, error)
+	RemoveSwitch(context.Context, *SwitchId) (*ReqBool, error)
+	SetSwitchUplink(context.Context, *SwitchUplinkReq) (*ReqBool, error)
+	GetVMNicsAll(*VmNicsQuery, grpc.ServerStreamingServer[VmNicId]) error
+	GetVMNicName(context.Context, *VmNicId) (*wrapperspb.StringValue, error)
+	GetVMNicID(context.Context, *wrapperspb.StringValue) (*VmNicId, error)
+	GetVMNicInfo(context.Context, *VmNicId) (*VmNicInfo, error)
+	AddVMNic(context.Context, *VmNicInfo) (*VmNicId, error)
+	UpdateVMNic(context.Context, *VmNicInfoUpdate) (*ReqBool, error)
+	RemoveVMNic(context.Context, *VmNicId) (*ReqBool, error)
+	SetVMNicSwitch(context.Context, *SetVmNicSwitchReq) (*ReqBool, error)
+	GetVMNicVM(context.Context, *VmNicId) (*VMID, error)
+	GetVMNicAddresses(*VmNicId, grpc.ServerStreamingServer[VmNicAddress]) error
+	CloneVMNic(context.Context, *VmNicCloneReq) (*RequestID, error)
+	SetVMNics(context.Context, *SetNicReq) (*ReqBool, error)
+	GetVMNics(*VMID, grpc.ServerStreamingServer[VmNicId]) error
+	GetVMAddresses(*VMID, grpc.ServerStreamingServer[VmNicAddress]) error
+	Com1Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error
+	Com2Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error
+	Com3Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error
+	Com4Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error
+	mustEmbedUnimplementedVMInfoServer()
+}
+
+// UnimplementedVMInfoServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVMInfoServer struct{}
+
+func (UnimplementedVMInfoServer) AddVM(context.Context, *VMConfig) (*VMID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVM not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMs(*VMsQuery, grpc.ServerStreamingServer[VMID]) error {
+	return status.Errorf(codes.Unimplemented, "method GetVMs not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMConfig(context.Context, *VMID) (*VMConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVMConfig not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMName(context.Context, *VMID) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVMName not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMID(context.Context, *wrapperspb.StringValue) (*VMID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVMID not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMState(context.Context, *VMID) (*VMState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVMState not implemented")
+}
+func (UnimplementedVMInfoServer) UpdateVM(context.Context, *VMConfig) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVM not implemented")
+}
+func (UnimplementedVMInfoServer) StartVM(context.Context, *VMID) (*RequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartVM not implemented")
+}
+func (UnimplementedVMInfoServer) StopVM(context.Context, *VMID) (*RequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopVM not implemented")
+}
+func (UnimplementedVMInfoServer) PowerOffVM(context.Context, *VMID) (*RequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PowerOffVM not implemented")
+}
+func (UnimplementedVMInfoServer) ResetVM(context.Context, *VMID) (*RequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetVM not implemented")
+}
+func (UnimplementedVMInfoServer) RebootVM(context.Context, *VMID) (*RequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebootVM not implemented")
+}
+func (UnimplementedVMInfoServer) DeleteVM(context.Context, *VMID) (*RequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVM not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMRestartHistory(*VMID, grpc.ServerStreamingServer[VMRestartRecord]) error {
+	return status.Errorf(codes.Unimplemented, "method GetVMRestartHistory not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMDeviceMap(*VMID, grpc.ServerStreamingServer[VMDevice]) error {
+	return status.Errorf(codes.Unimplemented, "method GetVMDeviceMap not implemented")
+}
+func (UnimplementedVMInfoServer) AddVMController(context.Context, *VMControllerInfo) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVMController not implemented")
+}
+func (UnimplementedVMInfoServer) ListVMControllers(*VMID, grpc.ServerStreamingServer[VMControllerInfo]) error {
+	return status.Errorf(codes.Unimplemented, "method ListVMControllers not implemented")
+}
+func (UnimplementedVMInfoServer) RemoveVMController(context.Context, *VMControllerReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVMController not implemented")
+}
+func (UnimplementedVMInfoServer) SetVMAttachment(context.Context, *VMAttachmentInfo) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVMAttachment not implemented")
+}
+func (UnimplementedVMInfoServer) ListVMAttachments(*VMID, grpc.ServerStreamingServer[VMAttachmentInfo]) error {
+	return status.Errorf(codes.Unimplemented, "method ListVMAttachments not implemented")
+}
+func (UnimplementedVMInfoServer) RemoveVMAttachment(context.Context, *VMAttachmentReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVMAttachment not implemented")
+}
+func (UnimplementedVMInfoServer) AddVMPassthru(context.Context, *VMPassthruReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVMPassthru not implemented")
+}
+func (UnimplementedVMInfoServer) ListVMPassthrus(*VMID, grpc.ServerStreamingServer[VMPassthruReq]) error {
+	return status.Errorf(codes.Unimplemented, "method ListVMPassthrus not implemented")
+}
+func (UnimplementedVMInfoServer) RemoveVMPassthru(context.Context, *VMPassthruReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVMPassthru not implemented")
+}
+func (UnimplementedVMInfoServer) AddVMShare(context.Context, *VMShareInfo) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVMShare not implemented")
+}
+func (UnimplementedVMInfoServer) ListVMShares(*VMID, grpc.ServerStreamingServer[VMShareInfo]) error {
+	return status.Errorf(codes.Unimplemented, "method ListVMShares not implemented")
+}
+func (UnimplementedVMInfoServer) RemoveVMShare(context.Context, *VMShareReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVMShare not implemented")
+}
+func (UnimplementedVMInfoServer) ConsolePortInteractive(grpc.BidiStreamingServer[ConsolePortDataRequest, ComDataResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ConsolePortInteractive not implemented")
+}
+func (UnimplementedVMInfoServer) GuestAgentPing(context.Context, *VMID) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuestAgentPing not implemented")
+}
+func (UnimplementedVMInfoServer) GuestAgentShutdown(context.Context, *VMID) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuestAgentShutdown not implemented")
+}
+func (UnimplementedVMInfoServer) GuestAgentInterfaces(*VMID, grpc.ServerStreamingServer[GuestInterface]) error {
+	return status.Errorf(codes.Unimplemented, "method GuestAgentInterfaces not implemented")
+}
+func (UnimplementedVMInfoServer) GuestAgentFSFreeze(context.Context, *VMID) (*GuestFSFreezeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuestAgentFSFreeze not implemented")
+}
+func (UnimplementedVMInfoServer) GuestAgentFSThaw(context.Context, *VMID) (*GuestFSFreezeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuestAgentFSThaw not implemented")
+}
+func (UnimplementedVMInfoServer) GuestAgentFSStatus(context.Context, *VMID) (*GuestFSFreezeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuestAgentFSStatus not implemented")
+}
+func (UnimplementedVMInfoServer) GuestAgentSyncTime(context.Context, *VMID) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuestAgentSyncTime not implemented")
+}
+func (UnimplementedVMInfoServer) CreateVMSnapshot(context.Context, *VMSnapshotInfo) (*RequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVMSnapshot not implemented")
+}
+func (UnimplementedVMInfoServer) ListVMSnapshots(*VMID, grpc.ServerStreamingServer[VMSnapshotInfo]) error {
+	return status.Errorf(codes.Unimplemented, "method ListVMSnapshots not implemented")
+}
+func (UnimplementedVMInfoServer) RestoreVMSnapshot(context.Context, *VMSnapshotReq) (*RequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVMSnapshot not implemented")
+}
+func (UnimplementedVMInfoServer) DeleteVMSnapshot(context.Context, *VMSnapshotReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVMSnapshot not implemented")
+}
+func (UnimplementedVMInfoServer) AddSchedule(context.Context, *ScheduleInfo) (*ScheduleId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSchedule not implemented")
+}
+func (UnimplementedVMInfoServer) ListSchedules(*emptypb.Empty, grpc.ServerStreamingServer[ScheduleInfo]) error {
+	return status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedVMInfoServer) RemoveSchedule(context.Context, *ScheduleId) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSchedule not implemented")
+}
+func (UnimplementedVMInfoServer) AddWebhook(context.Context, *WebhookInfo) (*WebhookId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWebhook not implemented")
+}
+func (UnimplementedVMInfoServer) ListWebhooks(*emptypb.Empty, grpc.ServerStreamingServer[WebhookInfo]) error {
+	return status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedVMInfoServer) RemoveWebhook(context.Context, *WebhookId) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebhook not implemented")
+}
+func (UnimplementedVMInfoServer) TestWebhook(context.Context, *WebhookId) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestWebhook not implemented")
+}
+func (UnimplementedVMInfoServer) GetWebhookDeliveries(*WebhookId, grpc.ServerStreamingServer[WebhookDelivery]) error {
+	return status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedVMInfoServer) ClearUEFIState(context.Context, *VMID) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearUEFIState not implemented")
+}
+func (UnimplementedVMInfoServer) ListVMUEFIBootEntries(*VMID, grpc.ServerStreamingServer[VMUEFIBootEntry]) error {
+	return status.Errorf(codes.Unimplemented, "method ListVMUEFIBootEntries not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMUEFIBootOrder(context.Context, *VMID) (*VMUEFIBootOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVMUEFIBootOrder not implemented")
+}
+func (UnimplementedVMInfoServer) SetVMUEFIBootOrder(context.Context, *VMUEFIBootOrderReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVMUEFIBootOrder not implemented")
+}
+func (UnimplementedVMInfoServer) SetVMUEFIBootNext(context.Context, *VMUEFIBootNextReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVMUEFIBootNext not implemented")
+}
+func (UnimplementedVMInfoServer) BootVMFromISOOnce(context.Context, *VMUEFIBootISOReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BootVMFromISOOnce not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMUEFIVars(context.Context, *VMID) (*VMUEFIVars, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVMUEFIVars not implemented")
+}
+func (UnimplementedVMInfoServer) SetVMUEFIVars(context.Context, *VMUEFIVars) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVMUEFIVars not implemented")
+}
+func (UnimplementedVMInfoServer) GetVersion(context.Context, *emptypb.Empty) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
+func (UnimplementedVMInfoServer) GetNetInterfaces(*NetInterfacesReq, grpc.ServerStreamingServer[NetIf]) error {
+	return status.Errorf(codes.Unimplemented, "method GetNetInterfaces not implemented")
+}
+func (UnimplementedVMInfoServer) GetHostPCIDevices(*emptypb.Empty, grpc.ServerStreamingServer[HostPCIDevice]) error {
+	return status.Errorf(codes.Unimplemented, "method GetHostPCIDevices not implemented")
+}
+func (UnimplementedVMInfoServer) GetFirmwares(*emptypb.Empty, grpc.ServerStreamingServer[FirmwareInfo]) error {
+	return status.Errorf(codes.Unimplemented, "method GetFirmwares not implemented")
+}
+func (UnimplementedVMInfoServer) GetPurgeDefault(context.Context, *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurgeDefault not implemented")
+}
+func (UnimplementedVMInfoServer) RequestStatus(context.Context, *RequestID) (*ReqStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestStatus not implemented")
+}
+func (UnimplementedVMInfoServer) GetKeyboardLayouts(*KbdQuery, grpc.ServerStreamingServer[KbdLayout]) error {
+	return status.Errorf(codes.Unimplemented, "method GetKeyboardLayouts not implemented")
+}
+func (UnimplementedVMInfoServer) GetISOs(*ISOsQuery, grpc.ServerStreamingServer[ISOID]) error {
+	return status.Errorf(codes.Unimplemented, "method GetISOs not implemented")
+}
+func (UnimplementedVMInfoServer) GetISOInfo(context.Context, *ISOID) (*ISOInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetISOInfo not implemented")
+}
+func (UnimplementedVMInfoServer) AddISO(context.Context, *ISOInfo) (*ISOID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddISO not implemented")
+}
+func (UnimplementedVMInfoServer) RemoveISO(context.Context, *ISOID) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveISO not implemented")
+}
+func (UnimplementedVMInfoServer) RemoveISOWithOptions(context.Context, *ISORemoveReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveISOWithOptions not implemented")
+}
+func (UnimplementedVMInfoServer) SetVMISOs(context.Context, *SetISOReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVMISOs not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMISOs(*VMID, grpc.ServerStreamingServer[ISOID]) error {
+	return status.Errorf(codes.Unimplemented, "method GetVMISOs not implemented")
+}
+func (UnimplementedVMInfoServer) GetISOVMs(*ISOID, grpc.ServerStreamingServer[VMID]) error {
+	return status.Errorf(codes.Unimplemented, "method GetISOVMs not implemented")
+}
+func (UnimplementedVMInfoServer) UploadIso(grpc.ClientStreamingServer[ISOImageRequest, ReqBool]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadIso not implemented")
+}
+func (UnimplementedVMInfoServer) GetDisks(*DisksQuery, grpc.ServerStreamingServer[DiskId]) error {
+	return status.Errorf(codes.Unimplemented, "method GetDisks not implemented")
+}
+func (UnimplementedVMInfoServer) GetDiskInfo(context.Context, *DiskId) (*DiskInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskInfo not implemented")
+}
+func (UnimplementedVMInfoServer) SetDiskInfo(context.Context, *DiskInfoUpdate) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiskInfo not implemented")
+}
+func (UnimplementedVMInfoServer) AddDisk(context.Context, *DiskInfo) (*DiskId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDisk not implemented")
+}
+func (UnimplementedVMInfoServer) RemoveDisk(context.Context, *DiskId) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDisk not implemented")
+}
+func (UnimplementedVMInfoServer) RemoveDiskWithOptions(context.Context, *DiskRemoveReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDiskWithOptions not implemented")
+}
+func (UnimplementedVMInfoServer) SetVMDisks(context.Context, *SetDiskReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVMDisks not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMDisks(*VMID, grpc.ServerStreamingServer[DiskId]) error {
+	return status.Errorf(codes.Unimplemented, "method GetVMDisks not implemented")
+}
+func (UnimplementedVMInfoServer) GetDiskVM(context.Context, *DiskId) (*VMID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskVM not implemented")
+}
+func (UnimplementedVMInfoServer) UploadDisk(grpc.ClientStreamingServer[DiskImageRequest, ReqBool]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadDisk not implemented")
+}
+func (UnimplementedVMInfoServer) WipeDisk(context.Context, *DiskId) (*RequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WipeDisk not implemented")
+}
+func (UnimplementedVMInfoServer) GetDiskSizeUsage(context.Context, *DiskId) (*DiskSizeUsage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDiskSizeUsage not implemented")
 }
-func (UnimplementedVMInfoServer) GetSwitches(*SwitchesQuery, grpc.ServerStreamingServer[SwitchId]) error {
-	return status.Errorf(codes.Unimplemented, "method GetSwitches not implemented")
+func (UnimplementedVMInfoServer) ResizeDisk(context.Context, *DiskResizeReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeDisk not implemented")
+}
+func (UnimplementedVMInfoServer) CreateDiskSnapshot(context.Context, *DiskSnapshotInfo) (*RequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDiskSnapshot not implemented")
+}
+func (UnimplementedVMInfoServer) ListDiskSnapshots(*DiskId, grpc.ServerStreamingServer[DiskSnapshotInfo]) error {
+	return status.Errorf(codes.Unimplemented, "method ListDiskSnapshots not implemented")
+}
+func (UnimplementedVMInfoServer) RollbackDiskSnapshot(context.Context, *DiskSnapshotReq) (*RequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackDiskSnapshot not implemented")
+}
+func (UnimplementedVMInfoServer) DeleteDiskSnapshot(context.Context, *DiskSnapshotReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDiskSnapshot not implemented")
+}
+func (UnimplementedVMInfoServer) ListOrphanedStorage(*emptypb.Empty, grpc.ServerStreamingServer[OrphanedStorage]) error {
+	return status.Errorf(codes.Unimplemented, "method ListOrphanedStorage not implemented")
+}
+func (UnimplementedVMInfoServer) AdoptStorage(context.Context, *AdoptStorageReq) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdoptStorage not implemented")
+}
+func (UnimplementedVMInfoServer) PurgeStorage(context.Context, *PurgeStorageReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeStorage not implemented")
+}
+func (UnimplementedVMInfoServer) GetSwitches(*SwitchesQuery, grpc.ServerStreamingServer[SwitchId]) error {
+	return status.Errorf(codes.Unimplemented, "method GetSwitches not implemented")
+}
+func (UnimplementedVMInfoServer) GetSwitchInfo(context.Context, *SwitchId) (*SwitchInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwitchInfo not implemented")
+}
+func (UnimplementedVMInfoServer) AddSwitch(context.Context, *SwitchInfo) (*SwitchId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSwitch not implemented")
+}
+func (UnimplementedVMInfoServer) SetSwitchInfo(context.Context, *SwitchInfoUpdate) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSwitchInfo not implemented")
+}
+func (UnimplementedVMInfoServer) RemoveSwitch(context.Context, *SwitchId) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSwitch not implemented")
+}
+func (UnimplementedVMInfoServer) SetSwitchUplink(context.Context, *SwitchUplinkReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSwitchUplink not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMNicsAll(*VmNicsQuery, grpc.ServerStreamingServer[VmNicId]) error {
+	return status.Errorf(codes.Unimplemented, "method GetVMNicsAll not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMNicName(context.Context, *VmNicId) (*wrapperspb.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVMNicName not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMNicID(context.Context, *wrapperspb.StringValue) (*VmNicId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVMNicID not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMNicInfo(context.Context, *VmNicId) (*VmNicInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVMNicInfo not implemented")
+}
+func (UnimplementedVMInfoServer) AddVMNic(context.Context, *VmNicInfo) (*VmNicId, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVMNic not implemented")
+}
+func (UnimplementedVMInfoServer) UpdateVMNic(context.Context, *VmNicInfoUpdate) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVMNic not implemented")
+}
+func (UnimplementedVMInfoServer) RemoveVMNic(context.Context, *VmNicId) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVMNic not implemented")
+}
+func (UnimplementedVMInfoServer) SetVMNicSwitch(context.Context, *SetVmNicSwitchReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVMNicSwitch not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMNicVM(context.Context, *VmNicId) (*VMID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVMNicVM not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMNicAddresses(*VmNicId, grpc.ServerStreamingServer[VmNicAddress]) error {
+	return status.Errorf(codes.Unimplemented, "method GetVMNicAddresses not implemented")
+}
+func (UnimplementedVMInfoServer) CloneVMNic(context.Context, *VmNicCloneReq) (*RequestID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneVMNic not implemented")
+}
+func (UnimplementedVMInfoServer) SetVMNics(context.Context, *SetNicReq) (*ReqBool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVMNics not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMNics(*VMID, grpc.ServerStreamingServer[VmNicId]) error {
+	return status.Errorf(codes.Unimplemented, "method GetVMNics not implemented")
+}
+func (UnimplementedVMInfoServer) GetVMAddresses(*VMID, grpc.ServerStreamingServer[VmNicAddress]) error {
+	return status.Errorf(codes.Unimplemented, "method GetVMAddresses not implemented")
+}
+func (UnimplementedVMInfoServer) Com1Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Com1Interactive not implemented")
+}
+func (UnimplementedVMInfoServer) Com2Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Com2Interactive not implemented")
+}
+func (UnimplementedVMInfoServer) Com3Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Com3Interactive not implemented")
+}
+func (UnimplementedVMInfoServer) Com4Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Com4Interactive not implemented")
+}
+func (UnimplementedVMInfoServer) mustEmbedUnimplementedVMInfoServer() {}
+func (UnimplementedVMInfoServer) testEmbeddedByValue()                {}
+
+// UnsafeVMInfoServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VMInfoServer will
+// result in compilation errors.
+type UnsafeVMInfoServer interface {
+	mustEmbedUnimplementedVMInfoServer()
+}
+
+func RegisterVMInfoServer(s grpc.ServiceRegistrar, srv VMInfoServer) {
+	// If the following call pancis, it indicates UnimplementedVMInfoServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VMInfo_ServiceDesc, srv)
+}
+
+func _VMInfo_AddVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).AddVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_AddVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).AddVM(ctx, req.(*VMConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_GetVMs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VMsQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).GetVMs(m, &grpc.GenericServerStream[VMsQuery, VMID]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetVMsServer = grpc.ServerStreamingServer[VMID]
+
+func _VMInfo_GetVMConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).GetVMConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_GetVMConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).GetVMConfig(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_GetVMName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).GetVMName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_GetVMName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).GetVMName(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) GetSwitchInfo(context.Context, *SwitchId) (*SwitchInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSwitchInfo not implemented")
+
+func _VMInfo_GetVMID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).GetVMID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_GetVMID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).GetVMID(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) AddSwitch(context.Context, *SwitchInfo) (*SwitchId, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSwitch not implemented")
+
+func _VMInfo_GetVMState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).GetVMState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_GetVMState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).GetVMState(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_UpdateVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).UpdateVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_UpdateVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).UpdateVM(ctx, req.(*VMConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_StartVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).StartVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_StartVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).StartVM(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_StopVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).StopVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_StopVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).StopVM(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_PowerOffVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).PowerOffVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_PowerOffVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).PowerOffVM(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_ResetVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).ResetVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_ResetVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).ResetVM(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_RebootVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).RebootVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_RebootVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).RebootVM(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_DeleteVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).DeleteVM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_DeleteVM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).DeleteVM(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_GetVMRestartHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VMID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).GetVMRestartHistory(m, &grpc.GenericServerStream[VMID, VMRestartRecord]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetVMRestartHistoryServer = grpc.ServerStreamingServer[VMRestartRecord]
+
+func _VMInfo_GetVMDeviceMap_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VMID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).GetVMDeviceMap(m, &grpc.GenericServerStream[VMID, VMDevice]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetVMDeviceMapServer = grpc.ServerStreamingServer[VMDevice]
+
+func _VMInfo_AddVMController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMControllerInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).AddVMController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_AddVMController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).AddVMController(ctx, req.(*VMControllerInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_ListVMControllers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VMID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).ListVMControllers(m, &grpc.GenericServerStream[VMID, VMControllerInfo]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ListVMControllersServer = grpc.ServerStreamingServer[VMControllerInfo]
+
+func _VMInfo_RemoveVMController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMControllerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).RemoveVMController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_RemoveVMController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).RemoveVMController(ctx, req.(*VMControllerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_SetVMAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMAttachmentInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).SetVMAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_SetVMAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).SetVMAttachment(ctx, req.(*VMAttachmentInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_ListVMAttachments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VMID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).ListVMAttachments(m, &grpc.GenericServerStream[VMID, VMAttachmentInfo]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ListVMAttachmentsServer = grpc.ServerStreamingServer[VMAttachmentInfo]
+
+func _VMInfo_RemoveVMAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMAttachmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).RemoveVMAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_RemoveVMAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).RemoveVMAttachment(ctx, req.(*VMAttachmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) SetSwitchInfo(context.Context, *SwitchInfoUpdate) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetSwitchInfo not implemented")
+
+func _VMInfo_AddVMPassthru_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMPassthruReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).AddVMPassthru(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_AddVMPassthru_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).AddVMPassthru(ctx, req.(*VMPassthruReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) RemoveSwitch(context.Context, *SwitchId) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveSwitch not implemented")
+
+func _VMInfo_ListVMPassthrus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VMID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).ListVMPassthrus(m, &grpc.GenericServerStream[VMID, VMPassthruReq]{ServerStream: stream})
 }
-func (UnimplementedVMInfoServer) SetSwitchUplink(context.Context, *SwitchUplinkReq) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetSwitchUplink not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ListVMPassthrusServer = grpc.ServerStreamingServer[VMPassthruReq]
+
+func _VMInfo_RemoveVMPassthru_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMPassthruReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).RemoveVMPassthru(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_RemoveVMPassthru_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).RemoveVMPassthru(ctx, req.(*VMPassthruReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) GetVMNicsAll(*VmNicsQuery, grpc.ServerStreamingServer[VmNicId]) error {
-	return status.Errorf(codes.Unimplemented, "method GetVMNicsAll not implemented")
+
+func _VMInfo_AddVMShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMShareInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).AddVMShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_AddVMShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).AddVMShare(ctx, req.(*VMShareInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) GetVMNicName(context.Context, *VmNicId) (*wrapperspb.StringValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVMNicName not implemented")
+
+func _VMInfo_ListVMShares_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VMID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).ListVMShares(m, &grpc.GenericServerStream[VMID, VMShareInfo]{ServerStream: stream})
 }
-func (UnimplementedVMInfoServer) GetVMNicID(context.Context, *wrapperspb.StringValue) (*VmNicId, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVMNicID not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ListVMSharesServer = grpc.ServerStreamingServer[VMShareInfo]
+
+func _VMInfo_RemoveVMShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).RemoveVMShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_RemoveVMShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).RemoveVMShare(ctx, req.(*VMShareReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) GetVMNicInfo(context.Context, *VmNicId) (*VmNicInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVMNicInfo not implemented")
+
+func _VMInfo_ConsolePortInteractive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VMInfoServer).ConsolePortInteractive(&grpc.GenericServerStream[ConsolePortDataRequest, ComDataResponse]{ServerStream: stream})
 }
-func (UnimplementedVMInfoServer) AddVMNic(context.Context, *VmNicInfo) (*VmNicId, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddVMNic not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ConsolePortInteractiveServer = grpc.BidiStreamingServer[ConsolePortDataRequest, ComDataResponse]
+
+func _VMInfo_GuestAgentPing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).GuestAgentPing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_GuestAgentPing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).GuestAgentPing(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) UpdateVMNic(context.Context, *VmNicInfoUpdate) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVMNic not implemented")
+
+func _VMInfo_GuestAgentShutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).GuestAgentShutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_GuestAgentShutdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).GuestAgentShutdown(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) RemoveVMNic(context.Context, *VmNicId) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveVMNic not implemented")
+
+func _VMInfo_GuestAgentInterfaces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VMID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).GuestAgentInterfaces(m, &grpc.GenericServerStream[VMID, GuestInterface]{ServerStream: stream})
 }
-func (UnimplementedVMInfoServer) SetVMNicSwitch(context.Context, *SetVmNicSwitchReq) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetVMNicSwitch not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GuestAgentInterfacesServer = grpc.ServerStreamingServer[GuestInterface]
+
+func _VMInfo_GuestAgentFSFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).GuestAgentFSFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_GuestAgentFSFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).GuestAgentFSFreeze(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) GetVMNicVM(context.Context, *VmNicId) (*VMID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVMNicVM not implemented")
+
+func _VMInfo_GuestAgentFSThaw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).GuestAgentFSThaw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_GuestAgentFSThaw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).GuestAgentFSThaw(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) CloneVMNic(context.Context, *VmNicCloneReq) (*RequestID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloneVMNic not implemented")
+
+func _VMInfo_GuestAgentFSStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).GuestAgentFSStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_GuestAgentFSStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).GuestAgentFSStatus(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) SetVMNics(context.Context, *SetNicReq) (*ReqBool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetVMNics not implemented")
+
+func _VMInfo_GuestAgentSyncTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).GuestAgentSyncTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_GuestAgentSyncTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).GuestAgentSyncTime(ctx, req.(*VMID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) GetVMNics(*VMID, grpc.ServerStreamingServer[VmNicId]) error {
-	return status.Errorf(codes.Unimplemented, "method GetVMNics not implemented")
+
+func _VMInfo_CreateVMSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMSnapshotInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).CreateVMSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_CreateVMSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).CreateVMSnapshot(ctx, req.(*VMSnapshotInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) Com1Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method Com1Interactive not implemented")
+
+func _VMInfo_ListVMSnapshots_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VMID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).ListVMSnapshots(m, &grpc.GenericServerStream[VMID, VMSnapshotInfo]{ServerStream: stream})
 }
-func (UnimplementedVMInfoServer) Com2Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method Com2Interactive not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ListVMSnapshotsServer = grpc.ServerStreamingServer[VMSnapshotInfo]
+
+func _VMInfo_RestoreVMSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMSnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).RestoreVMSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_RestoreVMSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).RestoreVMSnapshot(ctx, req.(*VMSnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) Com3Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method Com3Interactive not implemented")
+
+func _VMInfo_DeleteVMSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMSnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).DeleteVMSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_DeleteVMSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).DeleteVMSnapshot(ctx, req.(*VMSnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) Com4Interactive(grpc.BidiStreamingServer[ComDataRequest, ComDataResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method Com4Interactive not implemented")
+
+func _VMInfo_AddSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).AddSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_AddSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).AddSchedule(ctx, req.(*ScheduleInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedVMInfoServer) mustEmbedUnimplementedVMInfoServer() {}
-func (UnimplementedVMInfoServer) testEmbeddedByValue()                {}
 
-// UnsafeVMInfoServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to VMInfoServer will
-// result in compilation errors.
-type UnsafeVMInfoServer interface {
-	mustEmbedUnimplementedVMInfoServer()
+func _VMInfo_ListSchedules_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).ListSchedules(m, &grpc.GenericServerStream[emptypb.Empty, ScheduleInfo]{ServerStream: stream})
 }
 
-func RegisterVMInfoServer(s grpc.ServiceRegistrar, srv VMInfoServer) {
-	// If the following call pancis, it indicates UnimplementedVMInfoServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ListSchedulesServer = grpc.ServerStreamingServer[ScheduleInfo]
+
+func _VMInfo_RemoveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleId)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&VMInfo_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(VMInfoServer).RemoveSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_RemoveSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).RemoveSchedule(ctx, req.(*ScheduleId))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_AddVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VMConfig)
+func _VMInfo_AddWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).AddVM(ctx, in)
+		return srv.(VMInfoServer).AddWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_AddVM_FullMethodName,
+		FullMethod: VMInfo_AddWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).AddVM(ctx, req.(*VMConfig))
+		return srv.(VMInfoServer).AddWebhook(ctx, req.(*WebhookInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_GetVMs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(VMsQuery)
+func _VMInfo_ListWebhooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(VMInfoServer).GetVMs(m, &grpc.GenericServerStream[VMsQuery, VMID]{ServerStream: stream})
+	return srv.(VMInfoServer).ListWebhooks(m, &grpc.GenericServerStream[emptypb.Empty, WebhookInfo]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetVMsServer = grpc.ServerStreamingServer[VMID]
+type VMInfo_ListWebhooksServer = grpc.ServerStreamingServer[WebhookInfo]
 
-func _VMInfo_GetVMConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VMID)
+func _VMInfo_RemoveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).GetVMConfig(ctx, in)
+		return srv.(VMInfoServer).RemoveWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_GetVMConfig_FullMethodName,
+		FullMethod: VMInfo_RemoveWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).GetVMConfig(ctx, req.(*VMID))
+		return srv.(VMInfoServer).RemoveWebhook(ctx, req.(*WebhookId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_GetVMName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VMID)
+func _VMInfo_TestWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).GetVMName(ctx, in)
+		return srv.(VMInfoServer).TestWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_GetVMName_FullMethodName,
+		FullMethod: VMInfo_TestWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).GetVMName(ctx, req.(*VMID))
+		return srv.(VMInfoServer).TestWebhook(ctx, req.(*WebhookId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_GetVMID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.StringValue)
+func _VMInfo_GetWebhookDeliveries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WebhookId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).GetWebhookDeliveries(m, &grpc.GenericServerStream[WebhookId, WebhookDelivery]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetWebhookDeliveriesServer = grpc.ServerStreamingServer[WebhookDelivery]
+
+func _VMInfo_ClearUEFIState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).GetVMID(ctx, in)
+		return srv.(VMInfoServer).ClearUEFIState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_GetVMID_FullMethodName,
+		FullMethod: VMInfo_ClearUEFIState_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).GetVMID(ctx, req.(*wrapperspb.StringValue))
+		return srv.(VMInfoServer).ClearUEFIState(ctx, req.(*VMID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_GetVMState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _VMInfo_ListVMUEFIBootEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VMID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).ListVMUEFIBootEntries(m, &grpc.GenericServerStream[VMID, VMUEFIBootEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_ListVMUEFIBootEntriesServer = grpc.ServerStreamingServer[VMUEFIBootEntry]
+
+func _VMInfo_GetVMUEFIBootOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VMID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).GetVMState(ctx, in)
+		return srv.(VMInfoServer).GetVMUEFIBootOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_GetVMState_FullMethodName,
+		FullMethod: VMInfo_GetVMUEFIBootOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).GetVMState(ctx, req.(*VMID))
+		return srv.(VMInfoServer).GetVMUEFIBootOrder(ctx, req.(*VMID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_UpdateVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VMConfig)
+func _VMInfo_SetVMUEFIBootOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMUEFIBootOrderReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).UpdateVM(ctx, in)
+		return srv.(VMInfoServer).SetVMUEFIBootOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_UpdateVM_FullMethodName,
+		FullMethod: VMInfo_SetVMUEFIBootOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).UpdateVM(ctx, req.(*VMConfig))
+		return srv.(VMInfoServer).SetVMUEFIBootOrder(ctx, req.(*VMUEFIBootOrderReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_StartVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VMID)
+func _VMInfo_SetVMUEFIBootNext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMUEFIBootNextReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).StartVM(ctx, in)
+		return srv.(VMInfoServer).SetVMUEFIBootNext(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_StartVM_FullMethodName,
+		FullMethod: VMInfo_SetVMUEFIBootNext_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).StartVM(ctx, req.(*VMID))
+		return srv.(VMInfoServer).SetVMUEFIBootNext(ctx, req.(*VMUEFIBootNextReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_StopVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VMID)
+func _VMInfo_BootVMFromISOOnce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMUEFIBootISOReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).StopVM(ctx, in)
+		return srv.(VMInfoServer).BootVMFromISOOnce(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_StopVM_FullMethodName,
+		FullMethod: VMInfo_BootVMFromISOOnce_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).StopVM(ctx, req.(*VMID))
+		return srv.(VMInfoServer).BootVMFromISOOnce(ctx, req.(*VMUEFIBootISOReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_DeleteVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _VMInfo_GetVMUEFIVars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VMID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).DeleteVM(ctx, in)
+		return srv.(VMInfoServer).GetVMUEFIVars(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_DeleteVM_FullMethodName,
+		FullMethod: VMInfo_GetVMUEFIVars_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).DeleteVM(ctx, req.(*VMID))
+		return srv.(VMInfoServer).GetVMUEFIVars(ctx, req.(*VMID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_ClearUEFIState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VMID)
+func _VMInfo_SetVMUEFIVars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMUEFIVars)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).ClearUEFIState(ctx, in)
+		return srv.(VMInfoServer).SetVMUEFIVars(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_ClearUEFIState_FullMethodName,
+		FullMethod: VMInfo_SetVMUEFIVars_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).ClearUEFIState(ctx, req.(*VMID))
+		return srv.(VMInfoServer).SetVMUEFIVars(ctx, req.(*VMUEFIVars))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1305,6 +3111,46 @@ func _VMInfo_GetNetInterfaces_Handler(srv interface{}, stream grpc.ServerStream)
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type VMInfo_GetNetInterfacesServer = grpc.ServerStreamingServer[NetIf]
 
+func _VMInfo_GetHostPCIDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).GetHostPCIDevices(m, &grpc.GenericServerStream[emptypb.Empty, HostPCIDevice]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetHostPCIDevicesServer = grpc.ServerStreamingServer[HostPCIDevice]
+
+func _VMInfo_GetFirmwares_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).GetFirmwares(m, &grpc.GenericServerStream[emptypb.Empty, FirmwareInfo]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetFirmwaresServer = grpc.ServerStreamingServer[FirmwareInfo]
+
+func _VMInfo_GetPurgeDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).GetPurgeDefault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_GetPurgeDefault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).GetPurgeDefault(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VMInfo_RequestStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RequestID)
 	if err := dec(in); err != nil {
@@ -1399,6 +3245,24 @@ func _VMInfo_RemoveISO_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VMInfo_RemoveISOWithOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ISORemoveReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).RemoveISOWithOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_RemoveISOWithOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).RemoveISOWithOptions(ctx, req.(*ISORemoveReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VMInfo_SetVMISOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetISOReq)
 	if err := dec(in); err != nil {
@@ -1433,188 +3297,336 @@ func _VMInfo_GetISOVMs_Handler(srv interface{}, stream grpc.ServerStream) error
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(VMInfoServer).GetISOVMs(m, &grpc.GenericServerStream[ISOID, VMID]{ServerStream: stream})
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetISOVMsServer = grpc.ServerStreamingServer[VMID]
-
-func _VMInfo_UploadIso_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(VMInfoServer).UploadIso(&grpc.GenericServerStream[ISOImageRequest, ReqBool]{ServerStream: stream})
+	return srv.(VMInfoServer).GetISOVMs(m, &grpc.GenericServerStream[ISOID, VMID]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetISOVMsServer = grpc.ServerStreamingServer[VMID]
+
+func _VMInfo_UploadIso_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VMInfoServer).UploadIso(&grpc.GenericServerStream[ISOImageRequest, ReqBool]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_UploadIsoServer = grpc.ClientStreamingServer[ISOImageRequest, ReqBool]
+
+func _VMInfo_GetDisks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DisksQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).GetDisks(m, &grpc.GenericServerStream[DisksQuery, DiskId]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetDisksServer = grpc.ServerStreamingServer[DiskId]
+
+func _VMInfo_GetDiskInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).GetDiskInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_GetDiskInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).GetDiskInfo(ctx, req.(*DiskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_SetDiskInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskInfoUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).SetDiskInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_SetDiskInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).SetDiskInfo(ctx, req.(*DiskInfoUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_AddDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).AddDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_AddDisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).AddDisk(ctx, req.(*DiskInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_RemoveDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).RemoveDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_RemoveDisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).RemoveDisk(ctx, req.(*DiskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_RemoveDiskWithOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskRemoveReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).RemoveDiskWithOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_RemoveDiskWithOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).RemoveDiskWithOptions(ctx, req.(*DiskRemoveReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_SetVMDisks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDiskReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).SetVMDisks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_SetVMDisks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).SetVMDisks(ctx, req.(*SetDiskReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_UploadIsoServer = grpc.ClientStreamingServer[ISOImageRequest, ReqBool]
-
-func _VMInfo_GetDisks_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(DisksQuery)
+func _VMInfo_GetVMDisks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VMID)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(VMInfoServer).GetDisks(m, &grpc.GenericServerStream[DisksQuery, DiskId]{ServerStream: stream})
+	return srv.(VMInfoServer).GetVMDisks(m, &grpc.GenericServerStream[VMID, DiskId]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetDisksServer = grpc.ServerStreamingServer[DiskId]
+type VMInfo_GetVMDisksServer = grpc.ServerStreamingServer[DiskId]
 
-func _VMInfo_GetDiskInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _VMInfo_GetDiskVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DiskId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).GetDiskInfo(ctx, in)
+		return srv.(VMInfoServer).GetDiskVM(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_GetDiskInfo_FullMethodName,
+		FullMethod: VMInfo_GetDiskVM_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).GetDiskInfo(ctx, req.(*DiskId))
+		return srv.(VMInfoServer).GetDiskVM(ctx, req.(*DiskId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_SetDiskInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiskInfoUpdate)
+func _VMInfo_UploadDisk_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VMInfoServer).UploadDisk(&grpc.GenericServerStream[DiskImageRequest, ReqBool]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_UploadDiskServer = grpc.ClientStreamingServer[DiskImageRequest, ReqBool]
+
+func _VMInfo_WipeDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).SetDiskInfo(ctx, in)
+		return srv.(VMInfoServer).WipeDisk(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_SetDiskInfo_FullMethodName,
+		FullMethod: VMInfo_WipeDisk_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).SetDiskInfo(ctx, req.(*DiskInfoUpdate))
+		return srv.(VMInfoServer).WipeDisk(ctx, req.(*DiskId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_AddDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiskInfo)
+func _VMInfo_GetDiskSizeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).AddDisk(ctx, in)
+		return srv.(VMInfoServer).GetDiskSizeUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_AddDisk_FullMethodName,
+		FullMethod: VMInfo_GetDiskSizeUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).AddDisk(ctx, req.(*DiskInfo))
+		return srv.(VMInfoServer).GetDiskSizeUsage(ctx, req.(*DiskId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_RemoveDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiskId)
+func _VMInfo_ResizeDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskResizeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).RemoveDisk(ctx, in)
+		return srv.(VMInfoServer).ResizeDisk(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_RemoveDisk_FullMethodName,
+		FullMethod: VMInfo_ResizeDisk_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).RemoveDisk(ctx, req.(*DiskId))
+		return srv.(VMInfoServer).ResizeDisk(ctx, req.(*DiskResizeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_SetVMDisks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetDiskReq)
+func _VMInfo_CreateDiskSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskSnapshotInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).SetVMDisks(ctx, in)
+		return srv.(VMInfoServer).CreateDiskSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_SetVMDisks_FullMethodName,
+		FullMethod: VMInfo_CreateDiskSnapshot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).SetVMDisks(ctx, req.(*SetDiskReq))
+		return srv.(VMInfoServer).CreateDiskSnapshot(ctx, req.(*DiskSnapshotInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_GetVMDisks_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(VMID)
+func _VMInfo_ListDiskSnapshots_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DiskId)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(VMInfoServer).GetVMDisks(m, &grpc.GenericServerStream[VMID, DiskId]{ServerStream: stream})
+	return srv.(VMInfoServer).ListDiskSnapshots(m, &grpc.GenericServerStream[DiskId, DiskSnapshotInfo]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_GetVMDisksServer = grpc.ServerStreamingServer[DiskId]
+type VMInfo_ListDiskSnapshotsServer = grpc.ServerStreamingServer[DiskSnapshotInfo]
 
-func _VMInfo_GetDiskVM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiskId)
+func _VMInfo_RollbackDiskSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskSnapshotReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).GetDiskVM(ctx, in)
+		return srv.(VMInfoServer).RollbackDiskSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_GetDiskVM_FullMethodName,
+		FullMethod: VMInfo_RollbackDiskSnapshot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).GetDiskVM(ctx, req.(*DiskId))
+		return srv.(VMInfoServer).RollbackDiskSnapshot(ctx, req.(*DiskSnapshotReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_UploadDisk_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(VMInfoServer).UploadDisk(&grpc.GenericServerStream[DiskImageRequest, ReqBool]{ServerStream: stream})
+func _VMInfo_DeleteDiskSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskSnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMInfoServer).DeleteDiskSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMInfo_DeleteDiskSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMInfoServer).DeleteDiskSnapshot(ctx, req.(*DiskSnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMInfo_ListOrphanedStorage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).ListOrphanedStorage(m, &grpc.GenericServerStream[emptypb.Empty, OrphanedStorage]{ServerStream: stream})
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type VMInfo_UploadDiskServer = grpc.ClientStreamingServer[DiskImageRequest, ReqBool]
+type VMInfo_ListOrphanedStorageServer = grpc.ServerStreamingServer[OrphanedStorage]
 
-func _VMInfo_WipeDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiskId)
+func _VMInfo_AdoptStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdoptStorageReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).WipeDisk(ctx, in)
+		return srv.(VMInfoServer).AdoptStorage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_WipeDisk_FullMethodName,
+		FullMethod: VMInfo_AdoptStorage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).WipeDisk(ctx, req.(*DiskId))
+		return srv.(VMInfoServer).AdoptStorage(ctx, req.(*AdoptStorageReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VMInfo_GetDiskSizeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DiskId)
+func _VMInfo_PurgeStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeStorageReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VMInfoServer).GetDiskSizeUsage(ctx, in)
+		return srv.(VMInfoServer).PurgeStorage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VMInfo_GetDiskSizeUsage_FullMethodName,
+		FullMethod: VMInfo_PurgeStorage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VMInfoServer).GetDiskSizeUsage(ctx, req.(*DiskId))
+		return srv.(VMInfoServer).PurgeStorage(ctx, req.(*PurgeStorageReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1875,6 +3887,17 @@ func _VMInfo_GetVMNicVM_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VMInfo_GetVMNicAddresses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VmNicId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).GetVMNicAddresses(m, &grpc.GenericServerStream[VmNicId, VmNicAddress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetVMNicAddressesServer = grpc.ServerStreamingServer[VmNicAddress]
+
 func _VMInfo_CloneVMNic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VmNicCloneReq)
 	if err := dec(in); err != nil {
@@ -1922,6 +3945,17 @@ func _VMInfo_GetVMNics_Handler(srv interface{}, stream grpc.ServerStream) error
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type VMInfo_GetVMNicsServer = grpc.ServerStreamingServer[VmNicId]
 
+func _VMInfo_GetVMAddresses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VMID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMInfoServer).GetVMAddresses(m, &grpc.GenericServerStream[VMID, VmNicAddress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VMInfo_GetVMAddressesServer = grpc.ServerStreamingServer[VmNicAddress]
+
 func _VMInfo_Com1Interactive_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(VMInfoServer).Com1Interactive(&grpc.GenericServerStream[ComDataRequest, ComDataResponse]{ServerStream: stream})
 }
@@ -1989,18 +4023,146 @@ var VMInfo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopVM",
 			Handler:    _VMInfo_StopVM_Handler,
 		},
+		{
+			MethodName: "PowerOffVM",
+			Handler:    _VMInfo_PowerOffVM_Handler,
+		},
+		{
+			MethodName: "ResetVM",
+			Handler:    _VMInfo_ResetVM_Handler,
+		},
+		{
+			MethodName: "RebootVM",
+			Handler:    _VMInfo_RebootVM_Handler,
+		},
 		{
 			MethodName: "DeleteVM",
 			Handler:    _VMInfo_DeleteVM_Handler,
 		},
+		{
+			MethodName: "AddVMController",
+			Handler:    _VMInfo_AddVMController_Handler,
+		},
+		{
+			MethodName: "RemoveVMController",
+			Handler:    _VMInfo_RemoveVMController_Handler,
+		},
+		{
+			MethodName: "SetVMAttachment",
+			Handler:    _VMInfo_SetVMAttachment_Handler,
+		},
+		{
+			MethodName: "RemoveVMAttachment",
+			Handler:    _VMInfo_RemoveVMAttachment_Handler,
+		},
+		{
+			MethodName: "AddVMPassthru",
+			Handler:    _VMInfo_AddVMPassthru_Handler,
+		},
+		{
+			MethodName: "RemoveVMPassthru",
+			Handler:    _VMInfo_RemoveVMPassthru_Handler,
+		},
+		{
+			MethodName: "AddVMShare",
+			Handler:    _VMInfo_AddVMShare_Handler,
+		},
+		{
+			MethodName: "RemoveVMShare",
+			Handler:    _VMInfo_RemoveVMShare_Handler,
+		},
+		{
+			MethodName: "GuestAgentPing",
+			Handler:    _VMInfo_GuestAgentPing_Handler,
+		},
+		{
+			MethodName: "GuestAgentShutdown",
+			Handler:    _VMInfo_GuestAgentShutdown_Handler,
+		},
+		{
+			MethodName: "GuestAgentFSFreeze",
+			Handler:    _VMInfo_GuestAgentFSFreeze_Handler,
+		},
+		{
+			MethodName: "GuestAgentFSThaw",
+			Handler:    _VMInfo_GuestAgentFSThaw_Handler,
+		},
+		{
+			MethodName: "GuestAgentFSStatus",
+			Handler:    _VMInfo_GuestAgentFSStatus_Handler,
+		},
+		{
+			MethodName: "GuestAgentSyncTime",
+			Handler:    _VMInfo_GuestAgentSyncTime_Handler,
+		},
+		{
+			MethodName: "CreateVMSnapshot",
+			Handler:    _VMInfo_CreateVMSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreVMSnapshot",
+			Handler:    _VMInfo_RestoreVMSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteVMSnapshot",
+			Handler:    _VMInfo_DeleteVMSnapshot_Handler,
+		},
+		{
+			MethodName: "AddSchedule",
+			Handler:    _VMInfo_AddSchedule_Handler,
+		},
+		{
+			MethodName: "RemoveSchedule",
+			Handler:    _VMInfo_RemoveSchedule_Handler,
+		},
+		{
+			MethodName: "AddWebhook",
+			Handler:    _VMInfo_AddWebhook_Handler,
+		},
+		{
+			MethodName: "RemoveWebhook",
+			Handler:    _VMInfo_RemoveWebhook_Handler,
+		},
+		{
+			MethodName: "TestWebhook",
+			Handler:    _VMInfo_TestWebhook_Handler,
+		},
 		{
 			MethodName: "ClearUEFIState",
 			Handler:    _VMInfo_ClearUEFIState_Handler,
 		},
+		{
+			MethodName: "GetVMUEFIBootOrder",
+			Handler:    _VMInfo_GetVMUEFIBootOrder_Handler,
+		},
+		{
+			MethodName: "SetVMUEFIBootOrder",
+			Handler:    _VMInfo_SetVMUEFIBootOrder_Handler,
+		},
+		{
+			MethodName: "SetVMUEFIBootNext",
+			Handler:    _VMInfo_SetVMUEFIBootNext_Handler,
+		},
+		{
+			MethodName: "BootVMFromISOOnce",
+			Handler:    _VMInfo_BootVMFromISOOnce_Handler,
+		},
+		{
+			MethodName: "GetVMUEFIVars",
+			Handler:    _VMInfo_GetVMUEFIVars_Handler,
+		},
+		{
+			MethodName: "SetVMUEFIVars",
+			Handler:    _VMInfo_SetVMUEFIVars_Handler,
+		},
 		{
 			MethodName: "GetVersion",
 			Handler:    _VMInfo_GetVersion_Handler,
 		},
+		{
+			MethodName: "GetPurgeDefault",
+			Handler:    _VMInfo_GetPurgeDefault_Handler,
+		},
 		{
 			MethodName: "RequestStatus",
 			Handler:    _VMInfo_RequestStatus_Handler,
@@ -2017,6 +4179,10 @@ var VMInfo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveISO",
 			Handler:    _VMInfo_RemoveISO_Handler,
 		},
+		{
+			MethodName: "RemoveISOWithOptions",
+			Handler:    _VMInfo_RemoveISOWithOptions_Handler,
+		},
 		{
 			MethodName: "SetVMISOs",
 			Handler:    _VMInfo_SetVMISOs_Handler,
@@ -2037,6 +4203,10 @@ var VMInfo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveDisk",
 			Handler:    _VMInfo_RemoveDisk_Handler,
 		},
+		{
+			MethodName: "RemoveDiskWithOptions",
+			Handler:    _VMInfo_RemoveDiskWithOptions_Handler,
+		},
 		{
 			MethodName: "SetVMDisks",
 			Handler:    _VMInfo_SetVMDisks_Handler,
@@ -2053,6 +4223,30 @@ var VMInfo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDiskSizeUsage",
 			Handler:    _VMInfo_GetDiskSizeUsage_Handler,
 		},
+		{
+			MethodName: "ResizeDisk",
+			Handler:    _VMInfo_ResizeDisk_Handler,
+		},
+		{
+			MethodName: "CreateDiskSnapshot",
+			Handler:    _VMInfo_CreateDiskSnapshot_Handler,
+		},
+		{
+			MethodName: "RollbackDiskSnapshot",
+			Handler:    _VMInfo_RollbackDiskSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteDiskSnapshot",
+			Handler:    _VMInfo_DeleteDiskSnapshot_Handler,
+		},
+		{
+			MethodName: "AdoptStorage",
+			Handler:    _VMInfo_AdoptStorage_Handler,
+		},
+		{
+			MethodName: "PurgeStorage",
+			Handler:    _VMInfo_PurgeStorage_Handler,
+		},
 		{
 			MethodName: "GetSwitchInfo",
 			Handler:    _VMInfo_GetSwitchInfo_Handler,
@@ -2120,11 +4314,87 @@ var VMInfo_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _VMInfo_GetVMs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetVMRestartHistory",
+			Handler:       _VMInfo_GetVMRestartHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetVMDeviceMap",
+			Handler:       _VMInfo_GetVMDeviceMap_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListVMControllers",
+			Handler:       _VMInfo_ListVMControllers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListVMAttachments",
+			Handler:       _VMInfo_ListVMAttachments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListVMPassthrus",
+			Handler:       _VMInfo_ListVMPassthrus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListVMShares",
+			Handler:       _VMInfo_ListVMShares_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ConsolePortInteractive",
+			Handler:       _VMInfo_ConsolePortInteractive_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GuestAgentInterfaces",
+			Handler:       _VMInfo_GuestAgentInterfaces_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListVMSnapshots",
+			Handler:       _VMInfo_ListVMSnapshots_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListSchedules",
+			Handler:       _VMInfo_ListSchedules_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListWebhooks",
+			Handler:       _VMInfo_ListWebhooks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetWebhookDeliveries",
+			Handler:       _VMInfo_GetWebhookDeliveries_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListVMUEFIBootEntries",
+			Handler:       _VMInfo_ListVMUEFIBootEntries_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetNetInterfaces",
 			Handler:       _VMInfo_GetNetInterfaces_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetHostPCIDevices",
+			Handler:       _VMInfo_GetHostPCIDevices_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetFirmwares",
+			Handler:       _VMInfo_GetFirmwares_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetKeyboardLayouts",
 			Handler:       _VMInfo_GetKeyboardLayouts_Handler,
@@ -2165,6 +4435,16 @@ var VMInfo_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _VMInfo_UploadDisk_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ListDiskSnapshots",
+			Handler:       _VMInfo_ListDiskSnapshots_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListOrphanedStorage",
+			Handler:       _VMInfo_ListOrphanedStorage_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetSwitches",
 			Handler:       _VMInfo_GetSwitches_Handler,
@@ -2175,11 +4455,21 @@ var VMInfo_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _VMInfo_GetVMNicsAll_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetVMNicAddresses",
+			Handler:       _VMInfo_GetVMNicAddresses_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetVMNics",
 			Handler:       _VMInfo_GetVMNics_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetVMAddresses",
+			Handler:       _VMInfo_GetVMAddresses_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Com1Interactive",
 			Handler:       _VMInfo_Com1Interactive_Handler,
@@ -36,6 +36,7 @@ var (
 	DiskCache              = true
 	DiskCacheChanged       = false
 	DiskFilePath           string
+	DiskResizeForce        bool
 )
 
 var DiskListCmd = &cobra.Command{
@@ -229,10 +230,18 @@ var DiskCreateCmd = &cobra.Command{
 
 var DiskDeleteCmd = &cobra.Command{
 	Use:          "delete",
+	Aliases:      []string{"rm"},
 	Short:        "delete virtual disk",
+	Long:         "Delete a virtual disk, with --purge the backing file or zvol is permanently destroyed as well",
 	SilenceUsage: true,
-	RunE: func(_ *cobra.Command, _ []string) error {
+	RunE: func(cmd *cobra.Command, _ []string) error {
 		var err error
+
+		purge, err := getPurgeFlag(cmd, "Permanently destroy all data on disk "+DiskName+DiskID+"?")
+		if err != nil {
+			return err
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
 		defer cancel()
 
@@ -245,7 +254,7 @@ var DiskDeleteCmd = &cobra.Command{
 				return errDiskNotFound
 			}
 		}
-		err = rpc.RmDisk(ctx, DiskID)
+		err = rpc.RmDisk(ctx, DiskID, &purge)
 		if err != nil {
 			return fmt.Errorf("failed removing disk: %w", err)
 		}
@@ -315,6 +324,37 @@ var DiskUpdateCmd = &cobra.Command{
 	},
 }
 
+var DiskResizeCmd = &cobra.Command{
+	Use:          "resize",
+	Short:        "resize virtual disk, shrinking permanently destroys data at the end of the virtual disk",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		var err error
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		if DiskID == "" {
+			DiskID, err = rpc.DiskNameToID(ctx, DiskName)
+			if err != nil {
+				return fmt.Errorf("failed getting disk ID: %w", err)
+			}
+			if DiskID == "" {
+				return errDiskNotFound
+			}
+		}
+
+		err = rpc.ResizeDisk(ctx, DiskID, DiskSize, DiskResizeForce)
+		if err != nil {
+			return fmt.Errorf("failed resizing disk: %w", err)
+		}
+
+		fmt.Print("Disk Resized\n")
+
+		return nil
+	},
+}
+
 var DiskWipeCmd = &cobra.Command{
 	Use:          "wipe",
 	Short:        "wipe virtual disk, permanently destroys all data on the virtual disk",
@@ -18,6 +18,11 @@ func init() {
 	setupDiskUpdateCmd()
 	setupDiskWipeCmd()
 
+	err = setupDiskResizeCmd()
+	if err != nil {
+		panic(err)
+	}
+
 	err = setupDiskUploadCmd()
 	if err != nil {
 		panic(err)
@@ -29,6 +34,7 @@ func init() {
 	DiskCmd.AddCommand(DiskUpdateCmd)
 	DiskCmd.AddCommand(DiskUploadCmd)
 	DiskCmd.AddCommand(DiskWipeCmd)
+	DiskCmd.AddCommand(DiskResizeCmd)
 }
 
 func setupDiskUploadCmd() error {
@@ -66,6 +72,10 @@ func setupDiskUpdateCmd() {
 func setupDiskDeleteCmd() {
 	disableFlagSorting(DiskDeleteCmd)
 	addNameOrIDArgs(DiskDeleteCmd, &DiskName, &DiskID, "disk")
+	DiskDeleteCmd.Flags().BoolVar(&PurgeFlag,
+		"purge", PurgeFlag, "Also destroy the backing file or zvol, defaults to the server setting",
+	)
+	DiskDeleteCmd.Flags().BoolVarP(&ConfirmFlag, "yes", "y", ConfirmFlag, "Do not ask for confirmation")
 }
 
 func setupDiskWipeCmd() {
@@ -73,6 +83,25 @@ func setupDiskWipeCmd() {
 	addNameOrIDArgs(DiskWipeCmd, &DiskName, &DiskID, "disk")
 }
 
+func setupDiskResizeCmd() error {
+	disableFlagSorting(DiskResizeCmd)
+	addNameOrIDArgs(DiskResizeCmd, &DiskName, &DiskID, "disk")
+	DiskResizeCmd.Flags().StringVarP(&DiskSize,
+		"size", "s", DiskSize, "new size of disk (bytes, or with k, m, g or t suffix)",
+	)
+
+	err := DiskResizeCmd.MarkFlagRequired("size")
+	if err != nil {
+		return fmt.Errorf("error marking flag required: %w", err)
+	}
+
+	DiskResizeCmd.Flags().BoolVarP(&DiskResizeForce,
+		"force", "f", DiskResizeForce, "Allow shrinking the disk, destroys data at the end of the disk",
+	)
+
+	return nil
+}
+
 func setupDiskCreateCmd() error {
 	var err error
 
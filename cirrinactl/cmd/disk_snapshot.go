@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+var (
+	DiskSnapshotName        string
+	DiskSnapshotID          string
+	DiskSnapshotDescription string
+)
+
+func diskSnapshotLookupIDs(ctx context.Context) error {
+	var err error
+
+	if DiskID == "" {
+		DiskID, err = rpc.DiskNameToID(ctx, DiskName)
+		if err != nil {
+			return fmt.Errorf("failed getting disk ID: %w", err)
+		}
+
+		if DiskID == "" {
+			return errDiskNotFound
+		}
+	}
+
+	if DiskSnapshotID == "" && DiskSnapshotName != "" {
+		DiskSnapshotID, err = rpc.DiskSnapshotNameToID(ctx, DiskID, DiskSnapshotName)
+		if err != nil {
+			return fmt.Errorf("failed getting snapshot ID: %w", err)
+		}
+	}
+
+	return nil
+}
+
+var DiskSnapshotListCmd = &cobra.Command{
+	Use:          "list",
+	Short:        "list snapshots of a virtual disk",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := diskSnapshotLookupIDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		snapshots, err := rpc.GetDiskSnapshots(ctx, DiskID)
+		if err != nil {
+			return fmt.Errorf("failed getting snapshot list: %w", err)
+		}
+
+		snapshotTableWriter := table.NewWriter()
+		snapshotTableWriter.SetOutputMirror(os.Stdout)
+		snapshotTableWriter.SetStyle(myTableStyle)
+
+		if ShowUUID {
+			snapshotTableWriter.AppendHeader(table.Row{"NAME", "UUID", "CREATED", "DESCRIPTION"})
+		} else {
+			snapshotTableWriter.AppendHeader(table.Row{"NAME", "CREATED", "DESCRIPTION"})
+		}
+
+		for _, snapshot := range snapshots {
+			created := snapshot.Created.Local().Format(time.DateTime)
+			if ShowUUID {
+				snapshotTableWriter.AppendRow(table.Row{snapshot.Name, snapshot.ID, created, snapshot.Descr})
+			} else {
+				snapshotTableWriter.AppendRow(table.Row{snapshot.Name, created, snapshot.Descr})
+			}
+		}
+
+		snapshotTableWriter.Render()
+
+		return nil
+	},
+}
+
+var DiskSnapshotCreateCmd = &cobra.Command{
+	Use:          "create",
+	Short:        "create a snapshot of a virtual disk",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := diskSnapshotLookupIDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		reqID, err := rpc.CreateDiskSnapshot(ctx, DiskID, DiskSnapshotName, DiskSnapshotDescription)
+		if err != nil {
+			return fmt.Errorf("failed creating snapshot: %w", err)
+		}
+
+		if !CheckReqStat {
+			fmt.Print("Snapshot requested\n")
+
+			return nil
+		}
+
+		return waitReq(ctx, reqID, "Creating Snapshot")
+	},
+}
+
+var DiskSnapshotRollbackCmd = &cobra.Command{
+	Use:          "rollback",
+	Short:        "roll back a virtual disk to a snapshot, permanently destroys all changes made since the snapshot",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := diskSnapshotLookupIDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		reqID, err := rpc.RollbackDiskSnapshot(ctx, DiskID, DiskSnapshotID)
+		if err != nil {
+			return fmt.Errorf("failed rolling back snapshot: %w", err)
+		}
+
+		if !CheckReqStat {
+			fmt.Print("Rollback requested\n")
+
+			return nil
+		}
+
+		return waitReq(ctx, reqID, "Rolling back")
+	},
+}
+
+var DiskSnapshotDeleteCmd = &cobra.Command{
+	Use:          "delete",
+	Aliases:      []string{"rm"},
+	Short:        "delete a snapshot of a virtual disk",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := diskSnapshotLookupIDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.RmDiskSnapshot(ctx, DiskID, DiskSnapshotID)
+		if err != nil {
+			return fmt.Errorf("failed removing snapshot: %w", err)
+		}
+
+		fmt.Printf("Snapshot deleted\n")
+
+		return nil
+	},
+}
+
+var DiskSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Create, list, roll back and delete virtual disk snapshots",
+}
@@ -0,0 +1,51 @@
+//go:build !test
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+func addSnapshotNameOrIDArgs(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&DiskSnapshotName, "snapshot", "S", DiskSnapshotName, "Name of snapshot")
+	cmd.Flags().StringVar(&DiskSnapshotID, "snapshot-id", DiskSnapshotID, "ID of snapshot")
+	cmd.MarkFlagsOneRequired("snapshot", "snapshot-id")
+	cmd.MarkFlagsMutuallyExclusive("snapshot", "snapshot-id")
+}
+
+func init() {
+	disableFlagSorting(DiskSnapshotCmd)
+
+	disableFlagSorting(DiskSnapshotListCmd)
+	addNameOrIDArgs(DiskSnapshotListCmd, &DiskName, &DiskID, "disk")
+	DiskSnapshotListCmd.Flags().BoolVarP(&ShowUUID,
+		"uuid", "u", ShowUUID, "Show UUIDs",
+	)
+
+	disableFlagSorting(DiskSnapshotCreateCmd)
+	addNameOrIDArgs(DiskSnapshotCreateCmd, &DiskName, &DiskID, "disk")
+	DiskSnapshotCreateCmd.Flags().StringVarP(&DiskSnapshotName, "snapshot", "S", DiskSnapshotName, "Name of snapshot")
+	DiskSnapshotCreateCmd.Flags().StringVarP(&DiskSnapshotDescription,
+		"description", "d", DiskSnapshotDescription, "description of snapshot",
+	)
+	DiskSnapshotCreateCmd.Flags().BoolVarP(&CheckReqStat, "status", "s", CheckReqStat, "Check status")
+
+	err := DiskSnapshotCreateCmd.MarkFlagRequired("snapshot")
+	if err != nil {
+		panic(err)
+	}
+
+	disableFlagSorting(DiskSnapshotRollbackCmd)
+	addNameOrIDArgs(DiskSnapshotRollbackCmd, &DiskName, &DiskID, "disk")
+	addSnapshotNameOrIDArgs(DiskSnapshotRollbackCmd)
+	DiskSnapshotRollbackCmd.Flags().BoolVarP(&CheckReqStat, "status", "s", CheckReqStat, "Check status")
+
+	disableFlagSorting(DiskSnapshotDeleteCmd)
+	addNameOrIDArgs(DiskSnapshotDeleteCmd, &DiskName, &DiskID, "disk")
+	addSnapshotNameOrIDArgs(DiskSnapshotDeleteCmd)
+
+	DiskSnapshotCmd.AddCommand(DiskSnapshotListCmd)
+	DiskSnapshotCmd.AddCommand(DiskSnapshotCreateCmd)
+	DiskSnapshotCmd.AddCommand(DiskSnapshotRollbackCmd)
+	DiskSnapshotCmd.AddCommand(DiskSnapshotDeleteCmd)
+
+	DiskCmd.AddCommand(DiskSnapshotCmd)
+}
@@ -24,6 +24,12 @@ var (
 	errSwitchNotFound  = errors.New("switch not found")
 )
 
+var errUEFIBootNumber = errors.New("invalid UEFI boot entry number, must be up to four hex digits such as 0001")
+
+var errScheduleNotFound = errors.New("schedule not found")
+
+var errWebhookNotFound = errors.New("webhook not found")
+
 var (
 	errVMEmptyName     = errors.New("empty VM name")
 	errVMNotFound      = errors.New("VM not found")
@@ -31,10 +37,13 @@ var (
 	errVMNotRunning    = errors.New("VM not running")
 	errVMNotStopped    = errors.New("VM must be stopped in order to be started")
 	errVMUnknownFormat = errors.New("unknown output format")
+	errVMWaitTimeout   = errors.New("timed out waiting for VM")
 )
 
 var errReqFailed = errors.New("failed")
 
+var errNotConfirmed = errors.New("not confirmed")
+
 var errHostNotAvailable = errors.New("host not available")
 
 var ErrServerError = errors.New("server reported error")
@@ -3,8 +3,10 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
+	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
 
 	"cirrina/cirrinactl/rpc"
@@ -30,6 +32,65 @@ var HostNicsCmd = &cobra.Command{
 	},
 }
 
+var HostPCICmd = &cobra.Command{
+	Use:          "pci",
+	Short:        "Get list of host PCI devices and which can be passed through to VMs",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		res, err := rpc.GetHostPCIDevices(ctx)
+		if err != nil {
+			return fmt.Errorf("failed getting host PCI devices: %w", err)
+		}
+
+		pciTableWriter := table.NewWriter()
+		pciTableWriter.SetOutputMirror(os.Stdout)
+		pciTableWriter.SetStyle(myTableStyle)
+		pciTableWriter.AppendHeader(table.Row{"ADDRESS", "DRIVER", "PPT", "VM", "CLASS", "VENDOR", "DEVICE"})
+
+		for _, hostDevice := range res {
+			pciTableWriter.AppendRow(table.Row{
+				hostDevice.Address, hostDevice.Driver, hostDevice.PPT, hostDevice.HeldBy, hostDevice.Class,
+				hostDevice.Vendor, hostDevice.Device,
+			})
+		}
+
+		pciTableWriter.Render()
+
+		return nil
+	},
+}
+
+var HostFirmwareCmd = &cobra.Command{
+	Use:          "firmware",
+	Short:        "Get list of firmware VMs can boot from",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		res, err := rpc.GetFirmwares(ctx)
+		if err != nil {
+			return fmt.Errorf("failed getting firmware: %w", err)
+		}
+
+		firmwareTableWriter := table.NewWriter()
+		firmwareTableWriter.SetOutputMirror(os.Stdout)
+		firmwareTableWriter.SetStyle(myTableStyle)
+		firmwareTableWriter.AppendHeader(table.Row{"NAME", "PATH", "VARS-TEMPLATE"})
+
+		for _, firmware := range res {
+			firmwareTableWriter.AppendRow(table.Row{firmware.Name, firmware.Path, firmware.VarsTemplate})
+		}
+
+		firmwareTableWriter.Render()
+
+		return nil
+	},
+}
+
 var HostVersionCmd = &cobra.Command{
 	Use:          "version",
 	Short:        "Get host daemon version",
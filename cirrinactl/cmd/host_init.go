@@ -9,6 +9,12 @@ func init() {
 
 	disableFlagSorting(HostNicsCmd)
 
+	disableFlagSorting(HostPCICmd)
+
+	disableFlagSorting(HostFirmwareCmd)
+
 	HostCmd.AddCommand(HostNicsCmd)
+	HostCmd.AddCommand(HostPCICmd)
+	HostCmd.AddCommand(HostFirmwareCmd)
 	HostCmd.AddCommand(HostVersionCmd)
 }
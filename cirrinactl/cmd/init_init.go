@@ -56,6 +56,9 @@ func init() {
 	rootCmd.AddCommand(IsoCmd)
 	rootCmd.AddCommand(NicCmd)
 	rootCmd.AddCommand(SwitchCmd)
+	rootCmd.AddCommand(StorageCmd)
+	rootCmd.AddCommand(ScheduleCmd)
+	rootCmd.AddCommand(WebhookCmd)
 	rootCmd.AddCommand(TuiCmd)
 	rootCmd.AddCommand(HostCmd)
 	rootCmd.AddCommand(ReqStatCmd)
@@ -317,10 +317,18 @@ var IsoUploadCmd = &cobra.Command{
 
 var IsoDeleteCmd = &cobra.Command{
 	Use:          "delete",
+	Aliases:      []string{"rm"},
 	Short:        "Delete an ISO",
+	Long:         "Delete an ISO, with --purge the ISO file is removed as well",
 	SilenceUsage: true,
-	RunE: func(_ *cobra.Command, _ []string) error {
+	RunE: func(cmd *cobra.Command, _ []string) error {
 		var err error
+
+		purge, err := getPurgeFlag(cmd, "Permanently remove the file of ISO "+IsoName+IsoID+"?")
+		if err != nil {
+			return err
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
 		defer cancel()
 
@@ -333,7 +341,7 @@ var IsoDeleteCmd = &cobra.Command{
 				return errIsoNotFound
 			}
 		}
-		err = rpc.RmIso(ctx, IsoID)
+		err = rpc.RmIso(ctx, IsoID, &purge)
 		if err != nil {
 			return fmt.Errorf("error removing iso: %w", err)
 		}
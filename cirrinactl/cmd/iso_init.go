@@ -29,6 +29,10 @@ func init() {
 
 	disableFlagSorting(IsoDeleteCmd)
 	addNameOrIDArgs(IsoDeleteCmd, &IsoName, &IsoID, "ISO")
+	IsoDeleteCmd.Flags().BoolVar(&PurgeFlag,
+		"purge", PurgeFlag, "Also remove the ISO file, defaults to the server setting",
+	)
+	IsoDeleteCmd.Flags().BoolVarP(&ConfirmFlag, "yes", "y", ConfirmFlag, "Do not ask for confirmation")
 
 	disableFlagSorting(IsoUploadCmd)
 	addNameOrIDArgs(IsoUploadCmd, &IsoName, &IsoID, "ISO")
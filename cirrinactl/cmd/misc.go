@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -10,7 +13,11 @@ import (
 	"cirrina/cirrinactl/rpc"
 )
 
-var ReqID string
+var (
+	ReqID       string
+	PurgeFlag   bool
+	ConfirmFlag bool
+)
 
 var ReqStatCmd = &cobra.Command{
 	Use:          "reqstat",
@@ -26,7 +33,89 @@ var ReqStatCmd = &cobra.Command{
 			return fmt.Errorf("error checking request status: %w", err)
 		}
 		fmt.Printf("req status: complete=%v, success=%v\n", res.Complete, res.Success)
+		if res.Result != "" {
+			fmt.Printf("req result: %s\n", res.Result)
+		}
 
 		return nil
 	},
 }
+
+// confirm asks the user a yes/no question on stdin, anything other than "y" or "yes" is treated as no
+func confirm(question string) bool {
+	if ConfirmFlag {
+		return true
+	}
+
+	fmt.Printf("%s [y/N]: ", question)
+
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+
+	return answer == "y" || answer == "yes"
+}
+
+// getPurgeFlag returns the purge flag, or the server default when it was not given, asking the user to confirm
+// whenever the result is to purge
+func getPurgeFlag(cmd *cobra.Command, question string) (bool, error) {
+	purge := PurgeFlag
+
+	if !cmd.Flags().Changed("purge") {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		var err error
+
+		purge, err = rpc.GetPurgeDefault(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed getting purge default: %w", err)
+		}
+	}
+
+	if purge && !confirm(question) {
+		return false, errNotConfirmed
+	}
+
+	return purge, nil
+}
+
+// waitReq prints progress dots until the request completes, returns errReqFailed if it did not succeed
+func waitReq(ctx context.Context, reqID string, msg string) error {
+	var err error
+
+	var reqStat rpc.ReqStatus
+
+	fmt.Printf("%s: ", msg)
+
+	for {
+		reqStat, err = rpc.ReqStat(ctx, reqID)
+		if err != nil {
+			return fmt.Errorf("failed checking request status: %w", err)
+		}
+
+		if reqStat.Complete {
+			break
+		}
+
+		fmt.Printf(".")
+		time.Sleep(time.Second)
+	}
+
+	if !reqStat.Success {
+		fmt.Printf(" failed\n")
+
+		if reqStat.Result != "" {
+			return fmt.Errorf("%w: %s", errReqFailed, reqStat.Result)
+		}
+
+		return errReqFailed
+	}
+
+	fmt.Printf(" done\n")
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+var (
+	ScheduleName        string
+	ScheduleID          string
+	ScheduleDescription string
+	ScheduleCron        string
+	ScheduleAction      string
+	ScheduleVMName      string
+	ScheduleVMID        string
+	ScheduleSelector    string
+)
+
+var ScheduleListCmd = &cobra.Command{
+	Use:          "list",
+	Short:        "list schedules",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		schedules, err := rpc.GetSchedules(ctx)
+		if err != nil {
+			return fmt.Errorf("failed getting schedule list: %w", err)
+		}
+
+		scheduleTableWriter := table.NewWriter()
+		scheduleTableWriter.SetOutputMirror(os.Stdout)
+		scheduleTableWriter.SetStyle(myTableStyle)
+
+		if ShowUUID {
+			scheduleTableWriter.AppendHeader(table.Row{
+				"NAME", "UUID", "CRON", "ACTION", "TARGET", "LAST RUN", "NEXT RUN", "DESCRIPTION",
+			})
+		} else {
+			scheduleTableWriter.AppendHeader(table.Row{
+				"NAME", "CRON", "ACTION", "TARGET", "LAST RUN", "NEXT RUN", "DESCRIPTION",
+			})
+		}
+
+		for _, aSchedule := range schedules {
+			target := "labels " + aSchedule.Selector
+			if aSchedule.VMID != "" {
+				target = "vm " + vmIDsToNames(ctx, aSchedule.VMID)
+			}
+
+			lastRun := ""
+			if !aSchedule.LastRun.IsZero() {
+				lastRun = aSchedule.LastRun.Local().Format(time.DateTime)
+			}
+
+			nextRun := "never"
+			if !aSchedule.NextRun.IsZero() {
+				nextRun = aSchedule.NextRun.Local().Format(time.DateTime)
+			}
+
+			if ShowUUID {
+				scheduleTableWriter.AppendRow(table.Row{
+					aSchedule.Name, aSchedule.ID, aSchedule.Cron, aSchedule.Action, target, lastRun, nextRun,
+					aSchedule.Descr,
+				})
+			} else {
+				scheduleTableWriter.AppendRow(table.Row{
+					aSchedule.Name, aSchedule.Cron, aSchedule.Action, target, lastRun, nextRun, aSchedule.Descr,
+				})
+			}
+		}
+
+		scheduleTableWriter.Render()
+
+		return nil
+	},
+}
+
+var ScheduleAddCmd = &cobra.Command{
+	Use:          "add",
+	Short:        "add a schedule which starts, stops, reboots or snapshots a VM or the VMs matching a label selector",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		var err error
+
+		if ScheduleVMID == "" && ScheduleVMName != "" {
+			ScheduleVMID, err = rpc.VMNameToID(ctx, ScheduleVMName)
+			if err != nil {
+				return fmt.Errorf("failed getting VM ID: %w", err)
+			}
+
+			if ScheduleVMID == "" {
+				return errVMNotFound
+			}
+		}
+
+		scheduleID, err := rpc.AddSchedule(ctx, rpc.ScheduleInfo{
+			Name:     ScheduleName,
+			Descr:    ScheduleDescription,
+			Cron:     ScheduleCron,
+			Action:   ScheduleAction,
+			VMID:     ScheduleVMID,
+			Selector: ScheduleSelector,
+		})
+		if err != nil {
+			return fmt.Errorf("failed adding schedule: %w", err)
+		}
+
+		fmt.Printf("Schedule created. id: %s\n", scheduleID)
+
+		return nil
+	},
+}
+
+var ScheduleRemoveCmd = &cobra.Command{
+	Use:          "rm",
+	Aliases:      []string{"delete"},
+	Short:        "remove a schedule",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		var err error
+
+		if ScheduleID == "" {
+			ScheduleID, err = rpc.ScheduleNameToID(ctx, ScheduleName)
+			if err != nil {
+				if errors.Is(err, rpc.ErrNotFound) {
+					return errScheduleNotFound
+				}
+
+				return fmt.Errorf("failed getting schedule ID: %w", err)
+			}
+		}
+
+		err = rpc.RemoveSchedule(ctx, ScheduleID)
+		if err != nil {
+			return fmt.Errorf("failed removing schedule: %w", err)
+		}
+
+		fmt.Printf("Schedule removed\n")
+
+		return nil
+	},
+}
+
+var ScheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Add, list and remove scheduled VM power actions and snapshots",
+}
@@ -0,0 +1,53 @@
+//go:build !test
+
+package cmd
+
+func init() {
+	disableFlagSorting(ScheduleCmd)
+
+	disableFlagSorting(ScheduleListCmd)
+	ScheduleListCmd.Flags().BoolVarP(&ShowUUID,
+		"uuid", "u", ShowUUID, "Show UUIDs",
+	)
+
+	disableFlagSorting(ScheduleAddCmd)
+	ScheduleAddCmd.Flags().StringVarP(&ScheduleName, "name", "n", ScheduleName, "Name of schedule")
+	ScheduleAddCmd.Flags().StringVarP(&ScheduleDescription,
+		"description", "d", ScheduleDescription, "description of schedule",
+	)
+	ScheduleAddCmd.Flags().StringVarP(&ScheduleCron,
+		"cron", "c", ScheduleCron, "Cron expression of when to run, such as \"0 22 * * mon-fri\" or @daily",
+	)
+	ScheduleAddCmd.Flags().StringVarP(&ScheduleAction,
+		"action", "a", ScheduleAction, "Action to run - start, stop, reboot or snapshot",
+	)
+	ScheduleAddCmd.Flags().StringVar(&ScheduleVMName, "vm", ScheduleVMName, "Name of VM to run the action on")
+	ScheduleAddCmd.Flags().StringVar(&ScheduleVMID, "vm-id", ScheduleVMID, "ID of VM to run the action on")
+	ScheduleAddCmd.Flags().StringVarP(&ScheduleSelector,
+		"selector", "l", ScheduleSelector, "Comma separated key=value labels, the action runs on every VM having all of them",
+	)
+	ScheduleAddCmd.MarkFlagsOneRequired("vm", "vm-id", "selector")
+	ScheduleAddCmd.MarkFlagsMutuallyExclusive("vm", "vm-id", "selector")
+
+	err := ScheduleAddCmd.MarkFlagRequired("name")
+	if err != nil {
+		panic(err)
+	}
+
+	err = ScheduleAddCmd.MarkFlagRequired("cron")
+	if err != nil {
+		panic(err)
+	}
+
+	err = ScheduleAddCmd.MarkFlagRequired("action")
+	if err != nil {
+		panic(err)
+	}
+
+	disableFlagSorting(ScheduleRemoveCmd)
+	addNameOrIDArgs(ScheduleRemoveCmd, &ScheduleName, &ScheduleID, "schedule")
+
+	ScheduleCmd.AddCommand(ScheduleListCmd)
+	ScheduleCmd.AddCommand(ScheduleAddCmd)
+	ScheduleCmd.AddCommand(ScheduleRemoveCmd)
+}
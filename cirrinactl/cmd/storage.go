@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+var (
+	StorageName        string
+	StorageType        = "file"
+	StorageDescription string
+	StorageDiskType    string
+)
+
+var StorageOrphansCmd = &cobra.Command{
+	Use:          "orphans",
+	Short:        "List disk images, zvols and ISO files which are not known to the server",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		orphans, err := rpc.GetOrphanedStorage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed getting orphaned storage: %w", err)
+		}
+
+		orphanTableWriter := table.NewWriter()
+		orphanTableWriter.SetOutputMirror(os.Stdout)
+		orphanTableWriter.AppendHeader(table.Row{"NAME", "TYPE", "SIZE", "PATH"})
+		orphanTableWriter.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "SIZE", Align: text.AlignRight, AlignHeader: text.AlignRight},
+		})
+		orphanTableWriter.SetStyle(myTableStyle)
+
+		for _, orphan := range orphans {
+			var orphanSize string
+
+			if Humanize {
+				orphanSize = humanize.IBytes(orphan.Size)
+			} else {
+				orphanSize = strconv.FormatUint(orphan.Size, 10)
+			}
+
+			orphanTableWriter.AppendRow(table.Row{orphan.Name, orphan.Type, orphanSize, orphan.Path})
+		}
+
+		orphanTableWriter.Render()
+
+		return nil
+	},
+}
+
+var StorageAdoptCmd = &cobra.Command{
+	Use:          "adopt",
+	Short:        "Register orphaned storage as a new disk or ISO",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		newID, err := rpc.AdoptStorage(ctx, StorageName, StorageType, StorageDescription, StorageDiskType)
+		if err != nil {
+			return fmt.Errorf("failed adopting storage: %w", err)
+		}
+
+		fmt.Printf("Storage adopted. ID: %s\n", newID)
+
+		return nil
+	},
+}
+
+var StoragePurgeCmd = &cobra.Command{
+	Use:          "purge",
+	Short:        "Permanently delete orphaned storage",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		if !confirm("Permanently destroy " + StorageType + " " + StorageName + "?") {
+			return errNotConfirmed
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := rpc.PurgeStorage(ctx, StorageName, StorageType)
+		if err != nil {
+			return fmt.Errorf("failed purging storage: %w", err)
+		}
+
+		fmt.Printf("Storage purged\n")
+
+		return nil
+	},
+}
+
+var StorageCmd = &cobra.Command{
+	Use:   "storage",
+	Short: "Find, adopt and purge storage which is not known to the server",
+}
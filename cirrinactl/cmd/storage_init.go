@@ -0,0 +1,41 @@
+//go:build !test
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+func addStorageArgs(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&StorageName, "name", "n", StorageName, "Name of disk or ISO")
+	cmd.Flags().StringVarP(&StorageType, "type", "t", StorageType, "Type of storage - file, zvol or iso")
+
+	err := cmd.MarkFlagRequired("name")
+	if err != nil {
+		panic(err)
+	}
+}
+
+func init() {
+	disableFlagSorting(StorageCmd)
+
+	disableFlagSorting(StorageOrphansCmd)
+	StorageOrphansCmd.Flags().BoolVarP(&Humanize,
+		"human", "H", Humanize, "Print sizes in human readable form",
+	)
+
+	disableFlagSorting(StorageAdoptCmd)
+	addStorageArgs(StorageAdoptCmd)
+	StorageAdoptCmd.Flags().StringVarP(&StorageDescription,
+		"description", "d", StorageDescription, "description of new disk or ISO",
+	)
+	StorageAdoptCmd.Flags().StringVar(&StorageDiskType,
+		"disk-type", StorageDiskType, "type of new disk - nvme, ahci, or virtioblk",
+	)
+
+	disableFlagSorting(StoragePurgeCmd)
+	addStorageArgs(StoragePurgeCmd)
+	StoragePurgeCmd.Flags().BoolVarP(&ConfirmFlag, "yes", "y", ConfirmFlag, "Do not ask for confirmation")
+
+	StorageCmd.AddCommand(StorageOrphansCmd)
+	StorageCmd.AddCommand(StorageAdoptCmd)
+	StorageCmd.AddCommand(StoragePurgeCmd)
+}
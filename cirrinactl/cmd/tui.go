@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
@@ -30,6 +31,7 @@ var TuiCmd = &cobra.Command{
 }
 
 type vmItem struct {
+	id   string
 	name string
 	desc string
 }
@@ -71,6 +73,7 @@ func getVMItems() ([]vmItem, error) {
 		}
 
 		var aItem vmItem
+		aItem.id = vmID
 		aItem.name = res.Name
 		aItem.desc = res.Description
 		theseVMItems = append(theseVMItems, aItem)
@@ -205,12 +208,57 @@ func vmChangedFunc(index int, name string, _ string, _ rune) {
 
 	infoFlex.AddItem(buttonRowFlex, 0, 1, true)
 
+	vmText := "Name: " + name + "\n" +
+		"Description: " + vmItems[index].desc + "\n" +
+		"Addresses:\n"
+
 	nameView := tview.NewTextView()
-	nameView.SetText(
-		"Name: " + name + "\n" +
-			"Description: " + vmItems[index].desc,
-	)
+	nameView.SetText(vmText + "  loading\n")
 	infoFlex.AddItem(nameView, 0, 6, false)
+
+	// the guest agent may take a while to answer, so don't hold up the UI waiting for the addresses
+	go func(vmID string) {
+		addressesText := getVMAddressesText(vmID)
+
+		app.QueueUpdateDraw(func() {
+			nameView.SetText(vmText + addressesText)
+		})
+	}(vmItems[index].id)
+}
+
+// getVMAddressesText returns the addresses the guest has been seen using on each of the VM's NICs
+func getVMAddressesText(vmID string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+	defer cancel()
+
+	nicIDs, err := rpc.GetVMNics(ctx, vmID)
+	if err != nil {
+		return "  unknown\n"
+	}
+
+	vmAddresses, err := rpc.GetVMAddresses(ctx, vmID)
+	if err != nil {
+		return "  unknown\n"
+	}
+
+	var addressesText string
+
+	for _, nicID := range nicIDs {
+		nicName, err := rpc.GetVMNicName(ctx, nicID)
+		if err != nil {
+			continue
+		}
+
+		addressesText += "  " + nicName + ":\n"
+
+		for _, line := range strings.Split(nicAddressesText(vmAddresses[nicID]), "\n") {
+			if line != "" {
+				addressesText += "    " + line + "\n"
+			}
+		}
+	}
+
+	return addressesText
 }
 
 func vmSelectedFunc(_ int, _ string, _ string, _ rune) {
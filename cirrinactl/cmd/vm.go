@@ -7,6 +7,7 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -31,6 +32,74 @@ var (
 	RestartDelayChanged   bool
 	MaxWait               uint32
 	MaxWaitChanged        bool
+	StopPolicy            string
+	StopPolicyChanged     bool
+	RestartPolicy         string
+	RestartPolicyChanged  bool
+	RestartMax            uint32
+	RestartMaxChanged     bool
+	RestartWindow         uint32
+	RestartWindowChanged  bool
+	BootGroup             uint32
+	BootGroupChanged      bool
+	DependsOn             string
+	DependsOnChanged      bool
+	ReadyPattern          string
+	ReadyPatternChanged   bool
+	ReadyTCP              string
+	ReadyTCPChanged       bool
+	ReadyHTTP             string
+	ReadyHTTPChanged      bool
+	ReadyDeadline         uint32
+	ReadyDeadlineChanged  bool
+	ReadyInterval         uint32
+	ReadyIntervalChanged  bool
+	ReadyTimeout          uint32
+	ReadyTimeoutChanged   bool
+	LivePattern           string
+	LivePatternChanged    bool
+	LiveTCP               string
+	LiveTCPChanged        bool
+	LiveHTTP              string
+	LiveHTTPChanged       bool
+	LiveInterval          uint32
+	LiveIntervalChanged   bool
+	LiveTimeout           uint32
+	LiveTimeoutChanged    bool
+	LiveFailures          uint32
+	LiveFailuresChanged   bool
+	LiveAction            string
+	LiveActionChanged     bool
+	Labels                string
+	LabelsChanged         bool
+	HookPreStart          string
+	HookPreStartChanged   bool
+	HookPostStart         string
+	HookPostStartChanged  bool
+	HookPreStop           string
+	HookPreStopChanged    bool
+	HookPostStop          string
+	HookPostStopChanged   bool
+	HookTimeout           uint32
+	HookTimeoutChanged    bool
+	PCISlots              string
+	PCISlotsChanged       bool
+	PCIPack               bool
+	PCIPackChanged        bool
+	Sockets               uint32
+	SocketsChanged        bool
+	Cores                 uint32
+	CoresChanged          bool
+	Threads               uint32
+	ThreadsChanged        bool
+	CPUPins               string
+	CPUPinsChanged        bool
+	ConsolePorts          string
+	ConsolePortsChanged   bool
+	GuestAgent            bool
+	GuestAgentChanged     bool
+	Firmware              string
+	FirmwareChanged       bool
 	Cpus                  uint16
 	CpusChanged           bool
 	VMDescription         string
@@ -251,6 +320,8 @@ var VMListCmd = &cobra.Command{
 				sstatus = color.GreenString("RUNNING")
 			} else if status == "stopping" {
 				sstatus = color.YellowString("STOPPING")
+			} else if status == "crashloop" {
+				sstatus = color.RedString("CRASHLOOP")
 			}
 
 			vmInfos[vmConfig.Name] = vmListInfo{
@@ -346,6 +417,81 @@ var VMDeleteCmd = &cobra.Command{
 	},
 }
 
+// stopPolicyWait returns the longest time the server may take to stop the VM, which is the total of the stop policy
+// stage waits or MaxWait when there is no stop policy
+func stopPolicyWait(vmConfig rpc.VMConfig) uint32 {
+	if vmConfig.StopPolicy == "" {
+		return vmConfig.MaxWait
+	}
+
+	var total uint32
+
+	for _, stage := range strings.Split(vmConfig.StopPolicy, ",") {
+		_, waitStr, hasWait := strings.Cut(stage, ":")
+		if !hasWait {
+			total += uint32(powerOffWait.Seconds())
+
+			continue
+		}
+
+		wait, err := strconv.ParseUint(strings.TrimSpace(waitStr), 10, 32)
+		if err != nil {
+			continue
+		}
+
+		total += uint32(wait)
+	}
+
+	// the server falls back to killing the VM after the last stage
+	return total + vmConfig.MaxWait
+}
+
+// vmNamesToIDs converts a comma separated list of VM names to a comma separated list of VM IDs
+func vmNamesToIDs(ctx context.Context, vmNames string) (string, error) {
+	var vmIDs []string
+
+	for _, vmName := range strings.Split(vmNames, ",") {
+		vmName = strings.TrimSpace(vmName)
+		if vmName == "" {
+			continue
+		}
+
+		vmID, err := rpc.VMNameToID(ctx, vmName)
+		if err != nil {
+			return "", fmt.Errorf("failed getting VM ID: %w", err)
+		}
+
+		if vmID == "" {
+			return "", fmt.Errorf("%w: %s", errVMNotFound, vmName)
+		}
+
+		vmIDs = append(vmIDs, vmID)
+	}
+
+	return strings.Join(vmIDs, ","), nil
+}
+
+// vmIDsToNames converts a comma separated list of VM IDs to a comma separated list of VM names, IDs which can not be
+// looked up are left as they are
+func vmIDsToNames(ctx context.Context, vmIDs string) string {
+	var vmNames []string
+
+	for _, vmID := range strings.Split(vmIDs, ",") {
+		if vmID == "" {
+			continue
+		}
+
+		vmName, err := rpc.VMIdToName(ctx, vmID)
+		if err != nil || vmName == "" {
+			vmName = vmID
+		}
+
+		vmNames = append(vmNames, vmName)
+	}
+
+	return strings.Join(vmNames, ",")
+}
+
 var VMStopCmd = &cobra.Command{
 	Use:          "stop",
 	Short:        "Stop a VM",
@@ -380,8 +526,10 @@ var VMStopCmd = &cobra.Command{
 			return fmt.Errorf("failed getting VM config: %w", err)
 		}
 
+		stopWait := stopPolicyWait(vmConfig)
+
 		// max wait + 10 seconds just in case
-		timeout := time.Now().Add((time.Duration(int64(vmConfig.MaxWait)) * time.Second) + (time.Second * 10))
+		timeout := time.Now().Add((time.Duration(int64(stopWait)) * time.Second) + (time.Second * 10))
 
 		var reqID string
 		var reqStat rpc.ReqStatus
@@ -396,7 +544,8 @@ var VMStopCmd = &cobra.Command{
 			return nil
 		}
 
-		fmt.Printf("VM Stopping (timeout: %ds): ", vmConfig.MaxWait)
+		fmt.Printf("VM Stopping (timeout: %ds): ", stopWait)
+		var lastStage string
 		for time.Now().Before(timeout) {
 			reqStat, err = rpc.ReqStat(ctx, reqID)
 			if err != nil {
@@ -408,6 +557,12 @@ var VMStopCmd = &cobra.Command{
 			if reqStat.Complete {
 				break
 			}
+			var stage string
+			stage, err = rpc.GetVMStopStage(ctx, VMID)
+			if err == nil && stage != "" && stage != lastStage {
+				fmt.Printf(" %s ", stage)
+				lastStage = stage
+			}
 			fmt.Printf(".")
 			time.Sleep(time.Second)
 		}
@@ -487,6 +642,10 @@ var VMStartCmd = &cobra.Command{
 		}
 		fmt.Printf("\n")
 
+		if reqStat.Complete && !reqStat.Success && reqStat.Result != "" {
+			return fmt.Errorf("%w: %s", errReqFailed, reqStat.Result)
+		}
+
 		return nil
 	},
 }
@@ -510,6 +669,40 @@ var VMConfigCmd = &cobra.Command{
 		RestartChanged = cmd.Flags().Changed("restart")
 		RestartDelayChanged = cmd.Flags().Changed("restart-delay")
 		MaxWaitChanged = cmd.Flags().Changed("max-wait")
+		StopPolicyChanged = cmd.Flags().Changed("stop-policy")
+		RestartPolicyChanged = cmd.Flags().Changed("restart-policy")
+		RestartMaxChanged = cmd.Flags().Changed("restart-max")
+		RestartWindowChanged = cmd.Flags().Changed("restart-window")
+		BootGroupChanged = cmd.Flags().Changed("boot-group")
+		DependsOnChanged = cmd.Flags().Changed("depends-on")
+		ReadyPatternChanged = cmd.Flags().Changed("ready-pattern")
+		ReadyTCPChanged = cmd.Flags().Changed("ready-tcp")
+		ReadyHTTPChanged = cmd.Flags().Changed("ready-http")
+		ReadyDeadlineChanged = cmd.Flags().Changed("ready-deadline")
+		ReadyIntervalChanged = cmd.Flags().Changed("ready-interval")
+		ReadyTimeoutChanged = cmd.Flags().Changed("ready-timeout")
+		LivePatternChanged = cmd.Flags().Changed("live-pattern")
+		LiveTCPChanged = cmd.Flags().Changed("live-tcp")
+		LiveHTTPChanged = cmd.Flags().Changed("live-http")
+		LiveIntervalChanged = cmd.Flags().Changed("live-interval")
+		LiveTimeoutChanged = cmd.Flags().Changed("live-timeout")
+		LiveFailuresChanged = cmd.Flags().Changed("live-failures")
+		LiveActionChanged = cmd.Flags().Changed("live-action")
+		LabelsChanged = cmd.Flags().Changed("labels")
+		HookPreStartChanged = cmd.Flags().Changed("hook-pre-start")
+		HookPostStartChanged = cmd.Flags().Changed("hook-post-start")
+		HookPreStopChanged = cmd.Flags().Changed("hook-pre-stop")
+		HookPostStopChanged = cmd.Flags().Changed("hook-post-stop")
+		HookTimeoutChanged = cmd.Flags().Changed("hook-timeout")
+		PCISlotsChanged = cmd.Flags().Changed("pci-slots")
+		PCIPackChanged = cmd.Flags().Changed("pci-pack")
+		SocketsChanged = cmd.Flags().Changed("sockets")
+		CoresChanged = cmd.Flags().Changed("cores")
+		ThreadsChanged = cmd.Flags().Changed("threads")
+		CPUPinsChanged = cmd.Flags().Changed("cpu-pins")
+		ConsolePortsChanged = cmd.Flags().Changed("console-ports")
+		GuestAgentChanged = cmd.Flags().Changed("guest-agent")
+		FirmwareChanged = cmd.Flags().Changed("firmware")
 		DebugChanged = cmd.Flags().Changed("debug")
 		DebugWaitChanged = cmd.Flags().Changed("debug-wait")
 		DebugPortChanged = cmd.Flags().Changed("debug-port")
@@ -645,6 +838,149 @@ var VMConfigCmd = &cobra.Command{
 			newConfig.MaxWait = &MaxWait
 		}
 
+		if StopPolicyChanged {
+			newConfig.StopPolicy = &StopPolicy
+		}
+
+		if RestartPolicyChanged {
+			newConfig.RestartPolicy = &RestartPolicy
+		}
+
+		if RestartMaxChanged {
+			newConfig.RestartMax = &RestartMax
+		}
+
+		if RestartWindowChanged {
+			newConfig.RestartWindow = &RestartWindow
+		}
+
+		if BootGroupChanged {
+			newConfig.BootGroup = &BootGroup
+		}
+
+		if DependsOnChanged {
+			var dependsOnIDs string
+
+			dependsOnIDs, err = vmNamesToIDs(ctx, DependsOn)
+			if err != nil {
+				return err
+			}
+
+			newConfig.DependsOn = &dependsOnIDs
+		}
+
+		if ReadyPatternChanged {
+			newConfig.ReadyPattern = &ReadyPattern
+		}
+
+		if ReadyTCPChanged {
+			newConfig.ReadyTcp = &ReadyTCP
+		}
+
+		if ReadyHTTPChanged {
+			newConfig.ReadyHttp = &ReadyHTTP
+		}
+
+		if ReadyDeadlineChanged {
+			newConfig.ReadyDeadline = &ReadyDeadline
+		}
+
+		if ReadyIntervalChanged {
+			newConfig.ReadyInterval = &ReadyInterval
+		}
+
+		if ReadyTimeoutChanged {
+			newConfig.ReadyTimeout = &ReadyTimeout
+		}
+
+		if LivePatternChanged {
+			newConfig.LivePattern = &LivePattern
+		}
+
+		if LiveTCPChanged {
+			newConfig.LiveTcp = &LiveTCP
+		}
+
+		if LiveHTTPChanged {
+			newConfig.LiveHttp = &LiveHTTP
+		}
+
+		if LiveIntervalChanged {
+			newConfig.LiveInterval = &LiveInterval
+		}
+
+		if LiveTimeoutChanged {
+			newConfig.LiveTimeout = &LiveTimeout
+		}
+
+		if LiveFailuresChanged {
+			newConfig.LiveFailures = &LiveFailures
+		}
+
+		if LiveActionChanged {
+			newConfig.LiveAction = &LiveAction
+		}
+
+		if LabelsChanged {
+			newConfig.Labels = &Labels
+		}
+
+		if HookPreStartChanged {
+			newConfig.HookPreStart = &HookPreStart
+		}
+
+		if HookPostStartChanged {
+			newConfig.HookPostStart = &HookPostStart
+		}
+
+		if HookPreStopChanged {
+			newConfig.HookPreStop = &HookPreStop
+		}
+
+		if HookPostStopChanged {
+			newConfig.HookPostStop = &HookPostStop
+		}
+
+		if HookTimeoutChanged {
+			newConfig.HookTimeout = &HookTimeout
+		}
+
+		if PCISlotsChanged {
+			newConfig.PciSlots = &PCISlots
+		}
+
+		if PCIPackChanged {
+			newConfig.PciPack = &PCIPack
+		}
+
+		if SocketsChanged {
+			newConfig.Sockets = &Sockets
+		}
+
+		if CoresChanged {
+			newConfig.Cores = &Cores
+		}
+
+		if ThreadsChanged {
+			newConfig.Threads = &Threads
+		}
+
+		if CPUPinsChanged {
+			newConfig.CpuPins = &CPUPins
+		}
+
+		if ConsolePortsChanged {
+			newConfig.ConsolePorts = &ConsolePorts
+		}
+
+		if GuestAgentChanged {
+			newConfig.GuestAgent = &GuestAgent
+		}
+
+		if FirmwareChanged {
+			newConfig.Firmware = &Firmware
+		}
+
 		if ScreenChanged {
 			newConfig.Screen = &Screen
 		}
@@ -923,10 +1259,19 @@ var VMGetCmd = &cobra.Command{
 			return fmt.Errorf("failed getting VM state: %w", err)
 		}
 
+		var liveState string
+		var liveFailures uint32
+		liveState, liveFailures, err = rpc.GetVMLiveState(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting VM state: %w", err)
+		}
+
 		type vmOutStat struct {
 			Status    string `json:"Status"    yaml:"Status"`
 			Vncport   string `json:"Vncport"   yaml:"Vncport"`
 			Debugport string `json:"Debugport" yaml:"Debugport"`
+			Liveness  string `json:"Liveness"  yaml:"Liveness"`
+			LiveFails uint32 `json:"LiveFails" yaml:"LiveFails"`
 		}
 		type vmOutThing struct {
 			Config rpc.VMConfig `json:"Config" yaml:"Config"`
@@ -936,6 +1281,8 @@ var VMGetCmd = &cobra.Command{
 			Status:    vmState,
 			Vncport:   vncPort,
 			Debugport: debugPort,
+			Liveness:  liveState,
+			LiveFails: liveFailures,
 		}
 		vmOutStr := vmOutThing{
 			Config: vmConfig,
@@ -986,7 +1333,41 @@ var VMGetCmd = &cobra.Command{
 			fmt.Printf("auto-start-delay: %v\n", vmConfig.AutostartDelay)
 			fmt.Printf("restart: %v\n", vmConfig.Restart)
 			fmt.Printf("restart-delay: %v\n", vmConfig.RestartDelay)
+			fmt.Printf("restart-policy: %v\n", vmConfig.RestartPolicy)
+			fmt.Printf("restart-max: %v\n", vmConfig.RestartMax)
+			fmt.Printf("restart-window: %v\n", vmConfig.RestartWindow)
+			fmt.Printf("boot-group: %v\n", vmConfig.BootGroup)
+			fmt.Printf("depends-on: %v\n", vmIDsToNames(ctx, vmConfig.DependsOn))
+			fmt.Printf("ready-pattern: %v\n", vmConfig.ReadyPattern)
+			fmt.Printf("ready-tcp: %v\n", vmConfig.ReadyTCP)
+			fmt.Printf("ready-http: %v\n", vmConfig.ReadyHTTP)
+			fmt.Printf("ready-deadline: %v\n", vmConfig.ReadyDeadline)
+			fmt.Printf("ready-interval: %v\n", vmConfig.ReadyInterval)
+			fmt.Printf("ready-timeout: %v\n", vmConfig.ReadyTimeout)
+			fmt.Printf("live-pattern: %v\n", vmConfig.LivePattern)
+			fmt.Printf("live-tcp: %v\n", vmConfig.LiveTCP)
+			fmt.Printf("live-http: %v\n", vmConfig.LiveHTTP)
+			fmt.Printf("live-interval: %v\n", vmConfig.LiveInterval)
+			fmt.Printf("live-timeout: %v\n", vmConfig.LiveTimeout)
+			fmt.Printf("live-failures: %v\n", vmConfig.LiveFailures)
+			fmt.Printf("live-action: %v\n", vmConfig.LiveAction)
+			fmt.Printf("labels: %v\n", vmConfig.Labels)
+			fmt.Printf("hook-pre-start: %v\n", vmConfig.HookPreStart)
+			fmt.Printf("hook-post-start: %v\n", vmConfig.HookPostStart)
+			fmt.Printf("hook-pre-stop: %v\n", vmConfig.HookPreStop)
+			fmt.Printf("hook-post-stop: %v\n", vmConfig.HookPostStop)
+			fmt.Printf("hook-timeout: %v\n", vmConfig.HookTimeout)
+			fmt.Printf("pci-slots: %v\n", vmConfig.PCISlots)
+			fmt.Printf("pci-pack: %v\n", vmConfig.PCIPack)
+			fmt.Printf("sockets: %v\n", vmConfig.Sockets)
+			fmt.Printf("cores: %v\n", vmConfig.Cores)
+			fmt.Printf("threads: %v\n", vmConfig.Threads)
+			fmt.Printf("cpu-pins: %v\n", vmConfig.CPUPins)
+			fmt.Printf("console-ports: %v\n", vmConfig.ConsolePorts)
+			fmt.Printf("guest-agent: %v\n", vmConfig.GuestAgent)
+			fmt.Printf("firmware: %v\n", vmConfig.Firmware)
 			fmt.Printf("max-wait: %v\n", vmConfig.MaxWait)
+			fmt.Printf("stop-policy: %v\n", vmConfig.StopPolicy)
 			fmt.Printf("store-uefi-vars: %v\n", vmConfig.Storeuefi)
 			fmt.Printf("use-utc-time: %v\n", vmConfig.Utc)
 			fmt.Printf("destroy-on-power-off: %v\n", vmConfig.Dpo)
@@ -1003,6 +1384,8 @@ var VMGetCmd = &cobra.Command{
 			fmt.Printf("status: %v\n", vmState)
 			fmt.Printf("vnc-port: %v\n", vncPort)
 			fmt.Printf("debug-port: %v\n", debugPort)
+			fmt.Printf("liveness: %v\n", liveState)
+			fmt.Printf("liveness-failures: %v\n", liveFailures)
 		case JSON:
 			bar, err := json.MarshalIndent(vmOutStr, "", "  ")
 			if err != nil {
@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+// agentFreezeWait is how long the server may wait for the guest to flush its filesystems when freezing or thawing
+const agentFreezeWait = 60 * time.Second
+
+var ConsolePortName string
+
+var VMAgentPingCmd = &cobra.Command{
+	Use:          "ping",
+	Short:        "check the guest agent of a running VM is answering",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.AgentPing(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed pinging guest agent: %w", err)
+		}
+
+		fmt.Printf("Guest agent is running\n")
+
+		return nil
+	},
+}
+
+var VMAgentShutdownCmd = &cobra.Command{
+	Use:          "shutdown",
+	Short:        "ask the guest agent of a running VM to power off the guest",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.AgentShutdown(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed shutting down guest: %w", err)
+		}
+
+		fmt.Printf("Guest shutdown requested\n")
+
+		return nil
+	},
+}
+
+var VMAgentIPCmd = &cobra.Command{
+	Use:          "ip",
+	Short:        "list the network interfaces and addresses reported by the guest agent",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		interfaces, err := rpc.GetAgentInterfaces(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting guest interfaces: %w", err)
+		}
+
+		interfaceTableWriter := table.NewWriter()
+		interfaceTableWriter.SetOutputMirror(os.Stdout)
+		interfaceTableWriter.SetStyle(myTableStyle)
+		interfaceTableWriter.AppendHeader(table.Row{"INTERFACE", "MAC", "TYPE", "ADDRESS"})
+
+		for _, guestInterface := range interfaces {
+			if len(guestInterface.Addresses) == 0 {
+				interfaceTableWriter.AppendRow(table.Row{guestInterface.Name, guestInterface.HardwareAddress, "", ""})
+
+				continue
+			}
+
+			for _, address := range guestInterface.Addresses {
+				interfaceTableWriter.AppendRow(table.Row{
+					guestInterface.Name,
+					guestInterface.HardwareAddress,
+					address.Type,
+					address.Address + "/" + strconv.FormatUint(uint64(address.Prefix), 10),
+				})
+			}
+		}
+
+		interfaceTableWriter.Render()
+
+		return nil
+	},
+}
+
+var VMAgentFreezeCmd = &cobra.Command{
+	Use:          "freeze",
+	Short:        "freeze the filesystems of a running VM using the guest agent",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(),
+			time.Duration(rpc.ServerTimeout)*time.Second+agentFreezeWait)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		frozen, err := rpc.AgentFreeze(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed freezing guest filesystems: %w", err)
+		}
+
+		fmt.Printf("Froze %d filesystems\n", frozen)
+
+		return nil
+	},
+}
+
+var VMAgentThawCmd = &cobra.Command{
+	Use:          "thaw",
+	Short:        "thaw the filesystems of a running VM using the guest agent",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(),
+			time.Duration(rpc.ServerTimeout)*time.Second+agentFreezeWait)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		thawed, err := rpc.AgentThaw(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed thawing guest filesystems: %w", err)
+		}
+
+		fmt.Printf("Thawed %d filesystems\n", thawed)
+
+		return nil
+	},
+}
+
+var VMAgentFSStatusCmd = &cobra.Command{
+	Use:          "fs-status",
+	Short:        "show whether the filesystems of a running VM are frozen",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		status, err := rpc.AgentFreezeStatus(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting guest filesystem status: %w", err)
+		}
+
+		fmt.Printf("%s\n", status)
+
+		return nil
+	},
+}
+
+var VMAgentSyncTimeCmd = &cobra.Command{
+	Use:          "sync-time",
+	Short:        "set the clock of a running VM to the host's time using the guest agent",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.AgentSyncTime(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed setting guest time: %w", err)
+		}
+
+		fmt.Printf("Guest time set\n")
+
+		return nil
+	},
+}
+
+var VMAgentCmd = &cobra.Command{
+	Use:   "agent",
+	Short: "Talk to the guest agent of a running VM",
+}
+
+var VMConsolePortCmd = &cobra.Command{
+	Use:          "useConsolePort",
+	Short:        "Connect to one of a VMs virtio-console ports",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		var err error
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err = vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		var running bool
+
+		running, err = rpc.VMRunning(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed checking VM status: %w", err)
+		}
+
+		if !running {
+			return errVMNotRunning
+		}
+
+		fmt.Print("starting terminal session, press ctrl-\\ to quit\n")
+		time.Sleep(25 * time.Millisecond)
+
+		err = rpc.UseConsolePort(VMID, ConsolePortName)
+		if err != nil {
+			return fmt.Errorf("failed starting console port session: %w", err)
+		}
+
+		return nil
+	},
+}
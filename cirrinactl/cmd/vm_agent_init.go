@@ -0,0 +1,50 @@
+//go:build !test
+
+package cmd
+
+func init() {
+	disableFlagSorting(VMAgentCmd)
+
+	disableFlagSorting(VMAgentPingCmd)
+	addNameOrIDArgs(VMAgentPingCmd, &VMName, &VMID, "VM")
+
+	disableFlagSorting(VMAgentShutdownCmd)
+	addNameOrIDArgs(VMAgentShutdownCmd, &VMName, &VMID, "VM")
+
+	disableFlagSorting(VMAgentIPCmd)
+	addNameOrIDArgs(VMAgentIPCmd, &VMName, &VMID, "VM")
+
+	disableFlagSorting(VMAgentFreezeCmd)
+	addNameOrIDArgs(VMAgentFreezeCmd, &VMName, &VMID, "VM")
+
+	disableFlagSorting(VMAgentThawCmd)
+	addNameOrIDArgs(VMAgentThawCmd, &VMName, &VMID, "VM")
+
+	disableFlagSorting(VMAgentFSStatusCmd)
+	addNameOrIDArgs(VMAgentFSStatusCmd, &VMName, &VMID, "VM")
+
+	disableFlagSorting(VMAgentSyncTimeCmd)
+	addNameOrIDArgs(VMAgentSyncTimeCmd, &VMName, &VMID, "VM")
+
+	disableFlagSorting(VMConsolePortCmd)
+	addNameOrIDArgs(VMConsolePortCmd, &VMName, &VMID, "VM")
+	VMConsolePortCmd.Flags().StringVarP(&ConsolePortName,
+		"port", "p", ConsolePortName, "Name of the console port",
+	)
+
+	err := VMConsolePortCmd.MarkFlagRequired("port")
+	if err != nil {
+		panic(err)
+	}
+
+	VMAgentCmd.AddCommand(VMAgentPingCmd)
+	VMAgentCmd.AddCommand(VMAgentShutdownCmd)
+	VMAgentCmd.AddCommand(VMAgentIPCmd)
+	VMAgentCmd.AddCommand(VMAgentFreezeCmd)
+	VMAgentCmd.AddCommand(VMAgentThawCmd)
+	VMAgentCmd.AddCommand(VMAgentFSStatusCmd)
+	VMAgentCmd.AddCommand(VMAgentSyncTimeCmd)
+
+	VMCmd.AddCommand(VMAgentCmd)
+	VMCmd.AddCommand(VMConsolePortCmd)
+}
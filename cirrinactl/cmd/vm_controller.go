@@ -0,0 +1,330 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+var (
+	ControllerName   string
+	ControllerType   = "AHCI"
+	ControllerMaxQ   uint32
+	ControllerQSz    uint32
+	ControllerSer    string
+	AttachPort       uint32
+	AttachBoot       bool
+	AttachReadOnly   bool
+	AttachSectorSize string
+	AttachCache      bool
+	AttachDirect     bool
+)
+
+func vmControllerLookupVMID(ctx context.Context) error {
+	var err error
+
+	if VMID == "" {
+		VMID, err = rpc.VMNameToID(ctx, VMName)
+		if err != nil {
+			return fmt.Errorf("failed getting VM ID: %w", err)
+		}
+
+		if VMID == "" {
+			return errVMNotFound
+		}
+	}
+
+	return nil
+}
+
+// vmControllerLookupDevice returns the type and ID of the disk or ISO given by name or ID
+func vmControllerLookupDevice(ctx context.Context) (string, string, error) {
+	var err error
+
+	if DiskName != "" || DiskID != "" {
+		if DiskID == "" {
+			DiskID, err = rpc.DiskNameToID(ctx, DiskName)
+			if err != nil {
+				return "", "", fmt.Errorf("failed getting disk ID: %w", err)
+			}
+
+			if DiskID == "" {
+				return "", "", errDiskNotFound
+			}
+		}
+
+		return "disk", DiskID, nil
+	}
+
+	if IsoID == "" {
+		IsoID, err = rpc.IsoNameToID(ctx, IsoName)
+		if err != nil {
+			return "", "", fmt.Errorf("failed getting ISO ID: %w", err)
+		}
+
+		if IsoID == "" {
+			return "", "", errIsoNotFound
+		}
+	}
+
+	return "iso", IsoID, nil
+}
+
+var VMControllerListCmd = &cobra.Command{
+	Use:          "list",
+	Short:        "list storage controllers of a VM",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		controllers, err := rpc.GetVMControllers(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting controller list: %w", err)
+		}
+
+		controllerTableWriter := table.NewWriter()
+		controllerTableWriter.SetOutputMirror(os.Stdout)
+		controllerTableWriter.SetStyle(myTableStyle)
+		controllerTableWriter.AppendHeader(table.Row{"NAME", "TYPE", "MAXQ", "QSZ", "SER"})
+		controllerTableWriter.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "MAXQ", Align: text.AlignRight, AlignHeader: text.AlignRight},
+			{Name: "QSZ", Align: text.AlignRight, AlignHeader: text.AlignRight},
+		})
+
+		for _, controller := range controllers {
+			controllerTableWriter.AppendRow(table.Row{
+				controller.Name, controller.Type, controller.MaxQ, controller.QSz, controller.Ser,
+			})
+		}
+
+		controllerTableWriter.Render()
+
+		return nil
+	},
+}
+
+var VMControllerAddCmd = &cobra.Command{
+	Use:          "add",
+	Short:        "add a storage controller to a VM, used from the next start of the VM",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.AddVMController(ctx, VMID, rpc.VMControllerInfo{
+			Name: ControllerName,
+			Type: ControllerType,
+			MaxQ: ControllerMaxQ,
+			QSz:  ControllerQSz,
+			Ser:  ControllerSer,
+		})
+		if err != nil {
+			return fmt.Errorf("failed adding controller: %w", err)
+		}
+
+		fmt.Printf("Controller added\n")
+
+		return nil
+	},
+}
+
+var VMControllerRmCmd = &cobra.Command{
+	Use:          "rm",
+	Aliases:      []string{"delete"},
+	Short:        "remove a storage controller from a VM, its devices go back to the controller of their disk type",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.RmVMController(ctx, VMID, ControllerName)
+		if err != nil {
+			return fmt.Errorf("failed removing controller: %w", err)
+		}
+
+		fmt.Printf("Controller removed\n")
+
+		return nil
+	},
+}
+
+var VMControllerAttachCmd = &cobra.Command{
+	Use:          "attach",
+	Short:        "place a disk or ISO already attached to a VM on a port of one of its controllers",
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		deviceType, deviceID, err := vmControllerLookupDevice(ctx)
+		if err != nil {
+			return err
+		}
+
+		attachment := rpc.VMAttachmentInfo{
+			Controller: ControllerName,
+			DeviceType: deviceType,
+			DeviceID:   deviceID,
+			Port:       AttachPort,
+			Boot:       AttachBoot,
+			ReadOnly:   AttachReadOnly,
+			SectorSize: AttachSectorSize,
+		}
+
+		if cmd.Flags().Changed("cache") {
+			attachment.Cache = &AttachCache
+		}
+
+		if cmd.Flags().Changed("direct") {
+			attachment.Direct = &AttachDirect
+		}
+
+		err = rpc.SetVMAttachment(ctx, VMID, attachment)
+		if err != nil {
+			return fmt.Errorf("failed attaching device to controller: %w", err)
+		}
+
+		fmt.Printf("Device attached to controller\n")
+
+		return nil
+	},
+}
+
+var VMControllerDetachCmd = &cobra.Command{
+	Use:          "detach",
+	Short:        "take a disk or ISO off its controller, it goes back to the controller of its disk type",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		deviceType, deviceID, err := vmControllerLookupDevice(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.RmVMAttachment(ctx, VMID, deviceType, deviceID)
+		if err != nil {
+			return fmt.Errorf("failed detaching device from controller: %w", err)
+		}
+
+		fmt.Printf("Device detached from controller\n")
+
+		return nil
+	},
+}
+
+var VMControllerPortsCmd = &cobra.Command{
+	Use:          "ports",
+	Short:        "list the disks and ISOs placed on the storage controllers of a VM",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		attachments, err := rpc.GetVMAttachments(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting controller ports: %w", err)
+		}
+
+		portTableWriter := table.NewWriter()
+		portTableWriter.SetOutputMirror(os.Stdout)
+		portTableWriter.SetStyle(myTableStyle)
+		portTableWriter.AppendHeader(table.Row{
+			"CONTROLLER", "PORT", "DEVICE", "BOOT", "READ ONLY", "SECTOR SIZE", "CACHE", "DIRECT",
+		})
+		portTableWriter.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "PORT", Align: text.AlignRight, AlignHeader: text.AlignRight},
+		})
+
+		for _, attachment := range attachments {
+			portTableWriter.AppendRow(table.Row{
+				attachment.Controller,
+				attachment.Port,
+				attachmentDeviceName(ctx, attachment),
+				attachment.Boot,
+				attachment.ReadOnly,
+				attachment.SectorSize,
+				optionalBool(attachment.Cache),
+				optionalBool(attachment.Direct),
+			})
+		}
+
+		portTableWriter.Render()
+
+		return nil
+	},
+}
+
+// attachmentDeviceName returns the type and name of an attached device, or its ID if it can't be looked up
+func attachmentDeviceName(ctx context.Context, attachment rpc.VMAttachmentInfo) string {
+	name := attachment.DeviceID
+
+	switch attachment.DeviceType {
+	case "disk":
+		diskInfo, err := rpc.GetDiskInfo(ctx, attachment.DeviceID)
+		if err == nil {
+			name = diskInfo.Name
+		}
+	case "iso":
+		isoInfo, err := rpc.GetIsoInfo(ctx, attachment.DeviceID)
+		if err == nil {
+			name = isoInfo.Name
+		}
+	}
+
+	return attachment.DeviceType + ":" + name
+}
+
+// optionalBool shows an unset override as default
+func optionalBool(value *bool) string {
+	if value == nil {
+		return "default"
+	}
+
+	return strconv.FormatBool(*value)
+}
+
+var VMControllerCmd = &cobra.Command{
+	Use:   "controller",
+	Short: "Add, list and remove VM storage controllers and place disks and ISOs on their ports",
+}
@@ -0,0 +1,93 @@
+//go:build !test
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+func addControllerDeviceArgs(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&DiskName, "disk-name", "N", DiskName, "Name of Disk")
+	cmd.Flags().StringVarP(&DiskID, "disk-id", "I", DiskID, "ID of Disk")
+	cmd.Flags().StringVar(&IsoName, "iso-name", IsoName, "Name of Iso")
+	cmd.Flags().StringVar(&IsoID, "iso-id", IsoID, "ID of Iso")
+	cmd.MarkFlagsOneRequired("disk-name", "disk-id", "iso-name", "iso-id")
+	cmd.MarkFlagsMutuallyExclusive("disk-name", "disk-id", "iso-name", "iso-id")
+}
+
+func init() {
+	disableFlagSorting(VMControllerCmd)
+
+	disableFlagSorting(VMControllerListCmd)
+	addNameOrIDArgs(VMControllerListCmd, &VMName, &VMID, "VM")
+
+	disableFlagSorting(VMControllerAddCmd)
+	addNameOrIDArgs(VMControllerAddCmd, &VMName, &VMID, "VM")
+	VMControllerAddCmd.Flags().StringVarP(&ControllerName, "controller", "c", ControllerName, "Name of controller")
+	VMControllerAddCmd.Flags().StringVarP(&ControllerType,
+		"type", "t", ControllerType, "Type of controller, one of AHCI or NVME",
+	)
+	VMControllerAddCmd.Flags().Uint32Var(&ControllerMaxQ,
+		"maxq", ControllerMaxQ, "NVMe maximum number of I/O queues, 0 for the bhyve default",
+	)
+	VMControllerAddCmd.Flags().Uint32Var(&ControllerQSz,
+		"qsz", ControllerQSz, "NVMe maximum entries per queue, 0 for the bhyve default",
+	)
+	VMControllerAddCmd.Flags().StringVar(&ControllerSer,
+		"ser", ControllerSer, "NVMe serial number, empty for the bhyve default",
+	)
+
+	err := VMControllerAddCmd.MarkFlagRequired("controller")
+	if err != nil {
+		panic(err)
+	}
+
+	disableFlagSorting(VMControllerRmCmd)
+	addNameOrIDArgs(VMControllerRmCmd, &VMName, &VMID, "VM")
+	VMControllerRmCmd.Flags().StringVarP(&ControllerName, "controller", "c", ControllerName, "Name of controller")
+
+	err = VMControllerRmCmd.MarkFlagRequired("controller")
+	if err != nil {
+		panic(err)
+	}
+
+	disableFlagSorting(VMControllerAttachCmd)
+	addNameOrIDArgs(VMControllerAttachCmd, &VMName, &VMID, "VM")
+	VMControllerAttachCmd.Flags().StringVarP(&ControllerName,
+		"controller", "c", ControllerName, "Name of controller",
+	)
+	addControllerDeviceArgs(VMControllerAttachCmd)
+	VMControllerAttachCmd.Flags().Uint32VarP(&AttachPort,
+		"port", "p", AttachPort, "Controller port, AHCI ports are numbered in port order, NVMe has only port 0",
+	)
+	VMControllerAttachCmd.Flags().BoolVar(&AttachBoot, "boot", AttachBoot, "Boot from this device")
+	VMControllerAttachCmd.Flags().BoolVar(&AttachReadOnly, "read-only", AttachReadOnly, "Attach read only")
+	VMControllerAttachCmd.Flags().StringVar(&AttachSectorSize,
+		"sector-size", AttachSectorSize, "Sector size as logical[/physical], for example 512/4096",
+	)
+	VMControllerAttachCmd.Flags().BoolVar(&AttachCache,
+		"cache", AttachCache, "Override the disk cache setting",
+	)
+	VMControllerAttachCmd.Flags().BoolVar(&AttachDirect,
+		"direct", AttachDirect, "Override the disk direct setting",
+	)
+
+	err = VMControllerAttachCmd.MarkFlagRequired("controller")
+	if err != nil {
+		panic(err)
+	}
+
+	disableFlagSorting(VMControllerDetachCmd)
+	addNameOrIDArgs(VMControllerDetachCmd, &VMName, &VMID, "VM")
+	addControllerDeviceArgs(VMControllerDetachCmd)
+
+	disableFlagSorting(VMControllerPortsCmd)
+	addNameOrIDArgs(VMControllerPortsCmd, &VMName, &VMID, "VM")
+
+	VMControllerCmd.AddCommand(VMControllerListCmd)
+	VMControllerCmd.AddCommand(VMControllerAddCmd)
+	VMControllerCmd.AddCommand(VMControllerRmCmd)
+	VMControllerCmd.AddCommand(VMControllerAttachCmd)
+	VMControllerCmd.AddCommand(VMControllerDetachCmd)
+	VMControllerCmd.AddCommand(VMControllerPortsCmd)
+
+	VMCmd.AddCommand(VMControllerCmd)
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+var VMDevicesCmd = &cobra.Command{
+	Use:          "devices",
+	Short:        "show the PCI slot and AHCI port of each device of a VM",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		var err error
+
+		if VMID == "" {
+			VMID, err = rpc.VMNameToID(ctx, VMName)
+			if err != nil {
+				return fmt.Errorf("failed getting VM ID: %w", err)
+			}
+
+			if VMID == "" {
+				return errVMNotFound
+			}
+		}
+
+		devices, err := rpc.GetVMDeviceMap(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting device map: %w", err)
+		}
+
+		deviceTableWriter := table.NewWriter()
+		deviceTableWriter.SetOutputMirror(os.Stdout)
+		deviceTableWriter.SetStyle(myTableStyle)
+		deviceTableWriter.AppendHeader(table.Row{"ADDRESS", "PORT", "DEVICE", "EMULATION"})
+		deviceTableWriter.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "PORT", Align: text.AlignRight, AlignHeader: text.AlignRight},
+		})
+
+		for _, device := range devices {
+			port := ""
+			if device.Port != nil {
+				port = strconv.FormatUint(uint64(*device.Port), 10)
+			}
+
+			deviceTableWriter.AppendRow(table.Row{device.Address, port, device.Device, device.Emulation})
+		}
+
+		deviceTableWriter.Render()
+
+		return nil
+	},
+}
@@ -0,0 +1,10 @@
+//go:build !test
+
+package cmd
+
+func init() {
+	disableFlagSorting(VMDevicesCmd)
+	addNameOrIDArgs(VMDevicesCmd, &VMName, &VMID, "VM")
+
+	VMCmd.AddCommand(VMDevicesCmd)
+}
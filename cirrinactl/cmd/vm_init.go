@@ -130,9 +130,123 @@ func setupVMConfigStart() {
 	VMConfigCmd.Flags().Uint32Var(&RestartDelay,
 		"restart-delay", RestartDelay, "How long to wait before restarting this VM",
 	)
+	VMConfigCmd.Flags().StringVar(&RestartPolicy,
+		"restart-policy", RestartPolicy, "When to restart this VM, one of never, on-failure or always",
+	)
+	VMConfigCmd.Flags().Uint32Var(&RestartMax,
+		"restart-max", RestartMax, "Maximum restarts within restart-window before the VM is considered crash looping",
+	)
+	VMConfigCmd.Flags().Uint32Var(&RestartWindow,
+		"restart-window", RestartWindow, "Time window in seconds used to count restarts",
+	)
+	VMConfigCmd.Flags().Uint32Var(&BootGroup,
+		"boot-group", BootGroup, "Boot group of this VM, lower boot groups are started first and stopped last",
+	)
+	VMConfigCmd.Flags().StringVar(&DependsOn,
+		"depends-on", DependsOn, "Comma separated names of VMs which must be ready before this VM is auto started",
+	)
+	VMConfigCmd.Flags().StringVar(&ReadyPattern,
+		"ready-pattern", ReadyPattern, "Regular expression matched against com1 output to detect the VM is ready, "+
+			"requires com1 logging",
+	)
+	VMConfigCmd.Flags().StringVar(&ReadyTCP,
+		"ready-tcp", ReadyTCP, "host:port which accepts TCP connections when the VM is ready",
+	)
+	VMConfigCmd.Flags().StringVar(&ReadyHTTP,
+		"ready-http", ReadyHTTP, "URL which returns a successful response to a GET when the VM is ready",
+	)
+	VMConfigCmd.Flags().Uint32Var(&ReadyDeadline,
+		"ready-deadline", ReadyDeadline, "How long to keep probing for readiness after the VM starts, 0 for no limit",
+	)
+	VMConfigCmd.Flags().Uint32Var(&ReadyInterval,
+		"ready-interval", ReadyInterval, "How long to wait between readiness probes",
+	)
+	VMConfigCmd.Flags().Uint32Var(&ReadyTimeout,
+		"ready-timeout", ReadyTimeout, "How long to wait for each TCP or HTTP readiness probe",
+	)
+	VMConfigCmd.Flags().StringVar(&LivePattern,
+		"live-pattern", LivePattern, "Regular expression matched against com1 output as a heartbeat, it must be seen "+
+			"between liveness checks, requires com1 logging",
+	)
+	VMConfigCmd.Flags().StringVar(&LiveTCP,
+		"live-tcp", LiveTCP, "host:port which must accept TCP connections while the VM is healthy",
+	)
+	VMConfigCmd.Flags().StringVar(&LiveHTTP,
+		"live-http", LiveHTTP, "URL which must return a successful response to a GET while the VM is healthy",
+	)
+	VMConfigCmd.Flags().Uint32Var(&LiveInterval,
+		"live-interval", LiveInterval, "How long to wait between liveness checks",
+	)
+	VMConfigCmd.Flags().Uint32Var(&LiveTimeout,
+		"live-timeout", LiveTimeout, "How long to wait for each TCP or HTTP liveness probe",
+	)
+	VMConfigCmd.Flags().Uint32Var(&LiveFailures,
+		"live-failures", LiveFailures, "How many liveness checks must fail in a row before the VM is unhealthy",
+	)
+	VMConfigCmd.Flags().StringVar(&LiveAction,
+		"live-action", LiveAction, "Action to take when the VM is unhealthy, log, reset or restart, "+
+			"recovery is limited by the restart policy",
+	)
+	VMConfigCmd.Flags().StringVar(&Labels,
+		"labels", Labels, "Comma separated key=value labels, used to select VMs for schedules",
+	)
+	VMConfigCmd.Flags().StringVar(&HookPreStart,
+		"hook-pre-start", HookPreStart, "Script to run before the VM starts, the start is aborted if it fails",
+	)
+	VMConfigCmd.Flags().StringVar(&HookPostStart,
+		"hook-post-start", HookPostStart, "Script to run after the VM has started",
+	)
+	VMConfigCmd.Flags().StringVar(&HookPreStop,
+		"hook-pre-stop", HookPreStop, "Script to run before the VM is stopped",
+	)
+	VMConfigCmd.Flags().StringVar(&HookPostStop,
+		"hook-post-stop", HookPostStop, "Script to run after the VM has stopped",
+	)
+	VMConfigCmd.Flags().Uint32Var(&HookTimeout,
+		"hook-timeout", HookTimeout, "How long to wait for each of this VM's hook scripts before killing them",
+	)
+	VMConfigCmd.Flags().StringVar(&PCISlots,
+		"pci-slots", PCISlots,
+		"Comma separated device=bus:slot:function PCI addresses, devices are hostbridge, fbuf, tablet, sound, lpc, "+
+			"ahci, disk:NAME, iso:NAME, nic:NAME, ctl:NAME, ppt:BUS/SLOT/FUNCTION, share:NAME or console, an "+
+			"empty address lets the device take the lowest free slot",
+	)
+	VMConfigCmd.Flags().BoolVar(&PCIPack,
+		"pci-pack", PCIPack,
+		"Put AHCI disks and ISOs on one shared AHCI controller and pack NVMe and virtio-blk disks without an "+
+			"assigned address into the functions of slots already used by disks",
+	)
+	VMConfigCmd.Flags().Uint32Var(&Sockets,
+		"sockets", Sockets, "Number of CPU sockets presented to the guest, 0 leaves the topology to bhyve",
+	)
+	VMConfigCmd.Flags().Uint32Var(&Cores,
+		"cores", Cores, "Number of cores per CPU socket presented to the guest",
+	)
+	VMConfigCmd.Flags().Uint32Var(&Threads,
+		"threads", Threads, "Number of threads per CPU core presented to the guest",
+	)
+	VMConfigCmd.Flags().StringVar(&CPUPins,
+		"cpu-pins", CPUPins, "Comma separated vcpu:hostcpu pairs pinning virtual CPUs to host CPUs",
+	)
+	VMConfigCmd.Flags().StringVar(&ConsolePorts,
+		"console-ports", ConsolePorts,
+		"Comma separated names of virtio-console ports, each is a socket on the host and a named port in the guest",
+	)
+	VMConfigCmd.Flags().BoolVar(&GuestAgent,
+		"guest-agent", GuestAgent, "Add a virtio-console port for qemu-guest-agent running in the guest",
+	)
+	VMConfigCmd.Flags().StringVar(&Firmware,
+		"firmware", Firmware,
+		"Firmware to boot the VM from, see host firmware, or none to boot from a kernel loaded by a pre-start hook",
+	)
 	VMConfigCmd.Flags().Uint32Var(&MaxWait,
 		"max-wait", MaxWait, "How long to wait for this VM to shutdown before forcibly killing it",
 	)
+	VMConfigCmd.Flags().StringVar(&StopPolicy,
+		"stop-policy", StopPolicy,
+		"Comma separated stop stages with waits in seconds, for example agent:30,acpi:60,serial:30,poweroff:10, "+
+			"agent needs guest-agent, empty to only use max-wait",
+	)
 }
 
 func setupVMConfigCom4() {
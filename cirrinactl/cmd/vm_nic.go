@@ -43,6 +43,7 @@ var VMNicsListCmd = &cobra.Command{
 			rateLimited string
 			rateIn      string
 			rateOut     string
+			addresses   string
 		}
 		nicInfos := make(map[string]nicListInfo)
 
@@ -51,6 +52,8 @@ var VMNicsListCmd = &cobra.Command{
 		if err != nil {
 			return fmt.Errorf("failed getting VM NICs: %w", err)
 		}
+		// looked up for all the NICs at once so the guest agent is only asked once
+		vmAddresses, addressesErr := rpc.GetVMAddresses(ctx, VMID)
 		for _, nicID := range nicIDs {
 			nicInfo, err := rpc.GetVMNicInfo(ctx, nicID)
 			if err != nil {
@@ -75,12 +78,17 @@ var VMNicsListCmd = &cobra.Command{
 			} else {
 				rateLimited = "no"
 			}
+			addresses := "unknown"
+			if addressesErr == nil {
+				addresses = nicAddressesText(vmAddresses[nicID])
+			}
 			nicInfos[nicInfo.Name] = nicListInfo{
 				nicID:       nicID,
 				info:        nicInfo,
 				rateLimited: rateLimited,
 				rateIn:      rateIn,
 				rateOut:     rateOut,
+				addresses:   addresses,
 			}
 			names = append(names, nicInfo.Name)
 		}
@@ -92,14 +100,14 @@ var VMNicsListCmd = &cobra.Command{
 			nicTableWriter.AppendHeader(
 				table.Row{
 					"NAME", "UUID", "MAC", "TYPE", "DEV-TYPE", "SWITCH",
-					"RATE-LIMITED", "RATE-IN", "RATE-OUT", "DESCRIPTION",
+					"RATE-LIMITED", "RATE-IN", "RATE-OUT", "ADDRESSES", "DESCRIPTION",
 				},
 			)
 		} else {
 			nicTableWriter.AppendHeader(
 				table.Row{
 					"NAME", "MAC", "TYPE", "DEV-TYPE", "SWITCH",
-					"RATE-LIMITED", "RATE-IN", "RATE-OUT", "DESCRIPTION",
+					"RATE-LIMITED", "RATE-IN", "RATE-OUT", "ADDRESSES", "DESCRIPTION",
 				},
 			)
 		}
@@ -116,6 +124,7 @@ var VMNicsListCmd = &cobra.Command{
 					nicInfos[name].rateLimited,
 					nicInfos[name].rateIn,
 					nicInfos[name].rateOut,
+					nicInfos[name].addresses,
 					nicInfos[name].info.Descr,
 				})
 			} else {
@@ -128,6 +137,7 @@ var VMNicsListCmd = &cobra.Command{
 					nicInfos[name].rateLimited,
 					nicInfos[name].rateIn,
 					nicInfos[name].rateOut,
+					nicInfos[name].addresses,
 					nicInfos[name].info.Descr,
 				})
 			}
@@ -138,6 +148,20 @@ var VMNicsListCmd = &cobra.Command{
 	},
 }
 
+// nicAddressesText returns the addresses the guest has been seen using on a NIC, one per line with where and when
+// each was seen
+func nicAddressesText(addresses []rpc.NicAddress) string {
+	addressLines := make([]string, 0, len(addresses))
+
+	for _, address := range addresses {
+		addressLines = append(addressLines,
+			address.Address+" ("+address.Source+", "+humanize.Time(address.LastSeen)+")",
+		)
+	}
+
+	return strings.Join(addressLines, "\n")
+}
+
 var VMNicsAddCmd = &cobra.Command{
 	Use:          "add",
 	Short:        "Add NIC to VM",
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+var PassthruDevice string
+
+var VMPassthruListCmd = &cobra.Command{
+	Use:          "list",
+	Short:        "list host PCI devices passed through to a VM",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		devices, err := rpc.GetVMPassthrus(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting passthru device list: %w", err)
+		}
+
+		passthruTableWriter := table.NewWriter()
+		passthruTableWriter.SetOutputMirror(os.Stdout)
+		passthruTableWriter.SetStyle(myTableStyle)
+		passthruTableWriter.AppendHeader(table.Row{"DEVICE"})
+
+		for _, device := range devices {
+			passthruTableWriter.AppendRow(table.Row{device})
+		}
+
+		passthruTableWriter.Render()
+
+		return nil
+	},
+}
+
+var VMPassthruAddCmd = &cobra.Command{
+	Use:          "add",
+	Short:        "pass a host PCI device bound to ppt through to a VM, from the next start of the VM",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.AddVMPassthru(ctx, VMID, PassthruDevice)
+		if err != nil {
+			return fmt.Errorf("failed adding passthru device: %w", err)
+		}
+
+		fmt.Printf("Passthru device added\n")
+
+		return nil
+	},
+}
+
+var VMPassthruRmCmd = &cobra.Command{
+	Use:          "rm",
+	Aliases:      []string{"delete"},
+	Short:        "remove a host PCI device from a VM, a running VM keeps it until it stops",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.RmVMPassthru(ctx, VMID, PassthruDevice)
+		if err != nil {
+			return fmt.Errorf("failed removing passthru device: %w", err)
+		}
+
+		fmt.Printf("Passthru device removed\n")
+
+		return nil
+	},
+}
+
+var VMPassthruCmd = &cobra.Command{
+	Use:   "passthru",
+	Short: "Manage host PCI devices passed through to a VM",
+}
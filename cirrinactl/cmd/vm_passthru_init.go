@@ -0,0 +1,38 @@
+//go:build !test
+
+package cmd
+
+func init() {
+	disableFlagSorting(VMPassthruCmd)
+
+	disableFlagSorting(VMPassthruListCmd)
+	addNameOrIDArgs(VMPassthruListCmd, &VMName, &VMID, "VM")
+
+	disableFlagSorting(VMPassthruAddCmd)
+	addNameOrIDArgs(VMPassthruAddCmd, &VMName, &VMID, "VM")
+	VMPassthruAddCmd.Flags().StringVarP(&PassthruDevice,
+		"device", "d", PassthruDevice, "Host PCI device as bus/slot/function, see host pci",
+	)
+
+	err := VMPassthruAddCmd.MarkFlagRequired("device")
+	if err != nil {
+		panic(err)
+	}
+
+	disableFlagSorting(VMPassthruRmCmd)
+	addNameOrIDArgs(VMPassthruRmCmd, &VMName, &VMID, "VM")
+	VMPassthruRmCmd.Flags().StringVarP(&PassthruDevice,
+		"device", "d", PassthruDevice, "Host PCI device as bus/slot/function",
+	)
+
+	err = VMPassthruRmCmd.MarkFlagRequired("device")
+	if err != nil {
+		panic(err)
+	}
+
+	VMPassthruCmd.AddCommand(VMPassthruListCmd)
+	VMPassthruCmd.AddCommand(VMPassthruAddCmd)
+	VMPassthruCmd.AddCommand(VMPassthruRmCmd)
+
+	VMCmd.AddCommand(VMPassthruCmd)
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+// powerOffWait matches how long the server waits for a VM to be cleaned up after a forced power off
+const powerOffWait = 30 * time.Second
+
+// vmPowerReq submits a power request for the VM and optionally waits for it to complete, extraWait is added to the
+// normal server timeout to give the request time to finish
+func vmPowerReq(reqFunc func(context.Context, string) (string, error), msg string, extraWait time.Duration) error {
+	var err error
+
+	ctx, cancel := context.WithTimeout(context.Background(),
+		time.Duration(rpc.ServerTimeout)*time.Second+extraWait)
+	defer cancel()
+
+	if VMID == "" {
+		VMID, err = rpc.VMNameToID(ctx, VMName)
+		if err != nil {
+			return fmt.Errorf("failed getting VM ID: %w", err)
+		}
+
+		if VMID == "" {
+			return errVMNotFound
+		}
+	}
+
+	reqID, err := reqFunc(ctx, VMID)
+	if err != nil {
+		return err
+	}
+
+	if !CheckReqStat {
+		fmt.Printf("%s requested\n", msg)
+
+		return nil
+	}
+
+	return waitReq(ctx, reqID, msg)
+}
+
+var VMPowerOffCmd = &cobra.Command{
+	Use:          "poweroff",
+	Short:        "Immediately power off a VM without shutting down the guest",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return vmPowerReq(rpc.PowerOffVM, "Power off", powerOffWait)
+	},
+}
+
+var VMResetCmd = &cobra.Command{
+	Use:          "reset",
+	Short:        "Immediately reset a VM without shutting down the guest",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return vmPowerReq(rpc.ResetVM, "Reset", 0)
+	},
+}
+
+var VMRebootCmd = &cobra.Command{
+	Use:          "reboot",
+	Short:        "Shut down a VM and start it again",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		var err error
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		if VMID == "" {
+			VMID, err = rpc.VMNameToID(ctx, VMName)
+			if err != nil {
+				return fmt.Errorf("failed getting VM ID: %w", err)
+			}
+
+			if VMID == "" {
+				return errVMNotFound
+			}
+		}
+
+		vmConfig, err := rpc.GetVMConfig(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting VM config: %w", err)
+		}
+
+		return vmPowerReq(rpc.RebootVM, "Reboot", time.Duration(vmConfig.MaxWait)*time.Second+powerOffWait)
+	},
+}
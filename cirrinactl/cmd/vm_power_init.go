@@ -0,0 +1,15 @@
+//go:build !test
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+func init() {
+	for _, powerCmd := range []*cobra.Command{VMPowerOffCmd, VMResetCmd, VMRebootCmd} {
+		disableFlagSorting(powerCmd)
+		addNameOrIDArgs(powerCmd, &VMName, &VMID, "VM")
+		powerCmd.Flags().BoolVarP(&CheckReqStat, "status", "s", CheckReqStat, "Check status")
+
+		VMCmd.AddCommand(powerCmd)
+	}
+}
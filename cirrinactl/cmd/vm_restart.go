@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+var VMRestartsCmd = &cobra.Command{
+	Use:          "restarts",
+	Short:        "show exit and restart history of a VM",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		var err error
+
+		if VMID == "" {
+			VMID, err = rpc.VMNameToID(ctx, VMName)
+			if err != nil {
+				return fmt.Errorf("failed getting VM ID: %w", err)
+			}
+
+			if VMID == "" {
+				return errVMNotFound
+			}
+		}
+
+		restarts, err := rpc.GetVMRestartHistory(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting restart history: %w", err)
+		}
+
+		restartTableWriter := table.NewWriter()
+		restartTableWriter.SetOutputMirror(os.Stdout)
+		restartTableWriter.SetStyle(myTableStyle)
+		restartTableWriter.AppendHeader(table.Row{"TIME", "EXIT CODE", "REASON", "RESTARTED", "DELAY", "CRASH LOOP"})
+		restartTableWriter.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "EXIT CODE", Align: text.AlignRight, AlignHeader: text.AlignRight},
+			{Name: "DELAY", Align: text.AlignRight, AlignHeader: text.AlignRight},
+		})
+
+		for _, restart := range restarts {
+			restartTableWriter.AppendRow(table.Row{
+				restart.Time.Local().Format(time.DateTime),
+				restart.ExitCode,
+				restart.Reason,
+				restart.Restarted,
+				strconv.FormatUint(uint64(restart.Delay), 10) + "s",
+				restart.CrashLoop,
+			})
+		}
+
+		restartTableWriter.Render()
+
+		return nil
+	},
+}
@@ -0,0 +1,10 @@
+//go:build !test
+
+package cmd
+
+func init() {
+	disableFlagSorting(VMRestartsCmd)
+	addNameOrIDArgs(VMRestartsCmd, &VMName, &VMID, "VM")
+
+	VMCmd.AddCommand(VMRestartsCmd)
+}
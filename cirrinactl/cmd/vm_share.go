@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+var (
+	ShareName     string
+	SharePath     string
+	ShareReadOnly bool
+)
+
+var VMShareListCmd = &cobra.Command{
+	Use:          "list",
+	Short:        "list host directories shared with a VM",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		shares, err := rpc.GetVMShares(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting share list: %w", err)
+		}
+
+		shareTableWriter := table.NewWriter()
+		shareTableWriter.SetOutputMirror(os.Stdout)
+		shareTableWriter.SetStyle(myTableStyle)
+		shareTableWriter.AppendHeader(table.Row{"NAME", "PATH", "READ-ONLY"})
+
+		for _, share := range shares {
+			shareTableWriter.AppendRow(table.Row{share.Name, share.Path, share.ReadOnly})
+		}
+
+		shareTableWriter.Render()
+
+		return nil
+	},
+}
+
+var VMShareAddCmd = &cobra.Command{
+	Use:          "add",
+	Short:        "share a host directory with a VM using virtio-9p, from the next start of the VM",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.AddVMShare(ctx, VMID, rpc.VMShareInfo{
+			Name:     ShareName,
+			Path:     SharePath,
+			ReadOnly: ShareReadOnly,
+		})
+		if err != nil {
+			return fmt.Errorf("failed adding share: %w", err)
+		}
+
+		fmt.Printf("Share added\n")
+
+		return nil
+	},
+}
+
+var VMShareRmCmd = &cobra.Command{
+	Use:          "rm",
+	Aliases:      []string{"delete"},
+	Short:        "stop sharing a host directory with a VM, a running VM keeps it until it stops",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.RmVMShare(ctx, VMID, ShareName)
+		if err != nil {
+			return fmt.Errorf("failed removing share: %w", err)
+		}
+
+		fmt.Printf("Share removed\n")
+
+		return nil
+	},
+}
+
+var VMShareCmd = &cobra.Command{
+	Use:   "share",
+	Short: "Manage host directories shared with a VM",
+}
@@ -0,0 +1,47 @@
+//go:build !test
+
+package cmd
+
+func init() {
+	disableFlagSorting(VMShareCmd)
+
+	disableFlagSorting(VMShareListCmd)
+	addNameOrIDArgs(VMShareListCmd, &VMName, &VMID, "VM")
+
+	disableFlagSorting(VMShareAddCmd)
+	addNameOrIDArgs(VMShareAddCmd, &VMName, &VMID, "VM")
+	VMShareAddCmd.Flags().StringVarP(&ShareName,
+		"share", "s", ShareName, "Name the guest mounts the share by",
+	)
+	VMShareAddCmd.Flags().StringVarP(&SharePath,
+		"path", "p", SharePath, "Absolute path of the host directory to share",
+	)
+	VMShareAddCmd.Flags().BoolVar(&ShareReadOnly, "read-only", ShareReadOnly, "Share read only")
+
+	err := VMShareAddCmd.MarkFlagRequired("share")
+	if err != nil {
+		panic(err)
+	}
+
+	err = VMShareAddCmd.MarkFlagRequired("path")
+	if err != nil {
+		panic(err)
+	}
+
+	disableFlagSorting(VMShareRmCmd)
+	addNameOrIDArgs(VMShareRmCmd, &VMName, &VMID, "VM")
+	VMShareRmCmd.Flags().StringVarP(&ShareName,
+		"share", "s", ShareName, "Name of the share",
+	)
+
+	err = VMShareRmCmd.MarkFlagRequired("share")
+	if err != nil {
+		panic(err)
+	}
+
+	VMShareCmd.AddCommand(VMShareListCmd)
+	VMShareCmd.AddCommand(VMShareAddCmd)
+	VMShareCmd.AddCommand(VMShareRmCmd)
+
+	VMCmd.AddCommand(VMShareCmd)
+}
@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+var (
+	VMSnapshotName        string
+	VMSnapshotID          string
+	VMSnapshotDescription string
+)
+
+func vmSnapshotLookupIDs(ctx context.Context) error {
+	var err error
+
+	if VMID == "" {
+		VMID, err = rpc.VMNameToID(ctx, VMName)
+		if err != nil {
+			return fmt.Errorf("failed getting VM ID: %w", err)
+		}
+
+		if VMID == "" {
+			return errVMNotFound
+		}
+	}
+
+	if VMSnapshotID == "" && VMSnapshotName != "" {
+		VMSnapshotID, err = rpc.VMSnapshotNameToID(ctx, VMID, VMSnapshotName)
+		if err != nil {
+			return fmt.Errorf("failed getting snapshot ID: %w", err)
+		}
+	}
+
+	return nil
+}
+
+var VMSnapshotListCmd = &cobra.Command{
+	Use:          "list",
+	Short:        "list snapshots of a VM",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmSnapshotLookupIDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		snapshots, err := rpc.GetVMSnapshots(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting snapshot list: %w", err)
+		}
+
+		snapshotTableWriter := table.NewWriter()
+		snapshotTableWriter.SetOutputMirror(os.Stdout)
+		snapshotTableWriter.SetStyle(myTableStyle)
+
+		if ShowUUID {
+			snapshotTableWriter.AppendHeader(table.Row{"NAME", "UUID", "CREATED", "DISKS", "UEFI VARS", "DESCRIPTION"})
+		} else {
+			snapshotTableWriter.AppendHeader(table.Row{"NAME", "CREATED", "DISKS", "UEFI VARS", "DESCRIPTION"})
+		}
+
+		snapshotTableWriter.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "DISKS", Align: text.AlignRight, AlignHeader: text.AlignRight},
+		})
+
+		for _, snapshot := range snapshots {
+			created := snapshot.Created.Local().Format(time.DateTime)
+			if ShowUUID {
+				snapshotTableWriter.AppendRow(table.Row{
+					snapshot.Name, snapshot.ID, created, len(snapshot.DiskSnapshotIDs), snapshot.UEFIVars, snapshot.Descr,
+				})
+			} else {
+				snapshotTableWriter.AppendRow(table.Row{
+					snapshot.Name, created, len(snapshot.DiskSnapshotIDs), snapshot.UEFIVars, snapshot.Descr,
+				})
+			}
+		}
+
+		snapshotTableWriter.Render()
+
+		return nil
+	},
+}
+
+var VMSnapshotCreateCmd = &cobra.Command{
+	Use:          "create",
+	Short:        "create a snapshot of a VM, including its config, UEFI vars and all attached disks",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmSnapshotLookupIDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		reqID, err := rpc.CreateVMSnapshot(ctx, VMID, VMSnapshotName, VMSnapshotDescription)
+		if err != nil {
+			return fmt.Errorf("failed creating snapshot: %w", err)
+		}
+
+		if !CheckReqStat {
+			fmt.Print("Snapshot requested\n")
+
+			return nil
+		}
+
+		return waitReq(ctx, reqID, "Creating Snapshot")
+	},
+}
+
+var VMSnapshotRestoreCmd = &cobra.Command{
+	Use:          "restore",
+	Short:        "restore a stopped VM from a snapshot, permanently destroys all changes made since the snapshot",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmSnapshotLookupIDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		reqID, err := rpc.RestoreVMSnapshot(ctx, VMID, VMSnapshotID)
+		if err != nil {
+			return fmt.Errorf("failed restoring snapshot: %w", err)
+		}
+
+		if !CheckReqStat {
+			fmt.Print("Restore requested\n")
+
+			return nil
+		}
+
+		return waitReq(ctx, reqID, "Restoring")
+	},
+}
+
+var VMSnapshotDeleteCmd = &cobra.Command{
+	Use:          "delete",
+	Aliases:      []string{"rm"},
+	Short:        "delete a snapshot of a VM and the disk snapshots belonging to it",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmSnapshotLookupIDs(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.RmVMSnapshot(ctx, VMID, VMSnapshotID)
+		if err != nil {
+			return fmt.Errorf("failed removing snapshot: %w", err)
+		}
+
+		fmt.Printf("Snapshot deleted\n")
+
+		return nil
+	},
+}
+
+var VMSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Create, list, restore and delete VM snapshots",
+}
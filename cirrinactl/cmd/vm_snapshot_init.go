@@ -0,0 +1,51 @@
+//go:build !test
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+func addVMSnapshotNameOrIDArgs(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&VMSnapshotName, "snapshot", "S", VMSnapshotName, "Name of snapshot")
+	cmd.Flags().StringVar(&VMSnapshotID, "snapshot-id", VMSnapshotID, "ID of snapshot")
+	cmd.MarkFlagsOneRequired("snapshot", "snapshot-id")
+	cmd.MarkFlagsMutuallyExclusive("snapshot", "snapshot-id")
+}
+
+func init() {
+	disableFlagSorting(VMSnapshotCmd)
+
+	disableFlagSorting(VMSnapshotListCmd)
+	addNameOrIDArgs(VMSnapshotListCmd, &VMName, &VMID, "VM")
+	VMSnapshotListCmd.Flags().BoolVarP(&ShowUUID,
+		"uuid", "u", ShowUUID, "Show UUIDs",
+	)
+
+	disableFlagSorting(VMSnapshotCreateCmd)
+	addNameOrIDArgs(VMSnapshotCreateCmd, &VMName, &VMID, "VM")
+	VMSnapshotCreateCmd.Flags().StringVarP(&VMSnapshotName, "snapshot", "S", VMSnapshotName, "Name of snapshot")
+	VMSnapshotCreateCmd.Flags().StringVarP(&VMSnapshotDescription,
+		"description", "d", VMSnapshotDescription, "description of snapshot",
+	)
+	VMSnapshotCreateCmd.Flags().BoolVarP(&CheckReqStat, "status", "s", CheckReqStat, "Check status")
+
+	err := VMSnapshotCreateCmd.MarkFlagRequired("snapshot")
+	if err != nil {
+		panic(err)
+	}
+
+	disableFlagSorting(VMSnapshotRestoreCmd)
+	addNameOrIDArgs(VMSnapshotRestoreCmd, &VMName, &VMID, "VM")
+	addVMSnapshotNameOrIDArgs(VMSnapshotRestoreCmd)
+	VMSnapshotRestoreCmd.Flags().BoolVarP(&CheckReqStat, "status", "s", CheckReqStat, "Check status")
+
+	disableFlagSorting(VMSnapshotDeleteCmd)
+	addNameOrIDArgs(VMSnapshotDeleteCmd, &VMName, &VMID, "VM")
+	addVMSnapshotNameOrIDArgs(VMSnapshotDeleteCmd)
+
+	VMSnapshotCmd.AddCommand(VMSnapshotListCmd)
+	VMSnapshotCmd.AddCommand(VMSnapshotCreateCmd)
+	VMSnapshotCmd.AddCommand(VMSnapshotRestoreCmd)
+	VMSnapshotCmd.AddCommand(VMSnapshotDeleteCmd)
+
+	VMCmd.AddCommand(VMSnapshotCmd)
+}
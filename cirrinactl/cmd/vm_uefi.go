@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+var (
+	UEFIBootOrder     string
+	UEFIBootNext      string
+	UEFIBootNextClear bool
+	UEFIVarsFilePath  string
+)
+
+// formatUEFIBootNumber formats a boot entry number the way the firmware names its Boot#### variable
+func formatUEFIBootNumber(number uint16) string {
+	return fmt.Sprintf("%04X", number)
+}
+
+func parseUEFIBootNumber(number string) (uint16, error) {
+	parsed, err := strconv.ParseUint(strings.TrimSpace(number), 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errUEFIBootNumber, number)
+	}
+
+	return uint16(parsed), nil
+}
+
+var VMUEFIListCmd = &cobra.Command{
+	Use:          "list",
+	Short:        "list the UEFI boot entries of a VM",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		entries, err := rpc.GetVMUEFIBootEntries(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting UEFI boot entries: %w", err)
+		}
+
+		order, next, err := rpc.GetVMUEFIBootOrder(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting UEFI boot order: %w", err)
+		}
+
+		entryTableWriter := table.NewWriter()
+		entryTableWriter.SetOutputMirror(os.Stdout)
+		entryTableWriter.SetStyle(myTableStyle)
+		entryTableWriter.AppendHeader(table.Row{"NUMBER", "DESCRIPTION", "ACTIVE", "ORDER", "NEXT", "DEVICE-PATH"})
+
+		for _, entry := range entries {
+			position := ""
+			if orderIdx := slices.Index(order, entry.Number); orderIdx >= 0 {
+				position = strconv.Itoa(orderIdx + 1)
+			}
+
+			entryTableWriter.AppendRow(table.Row{
+				formatUEFIBootNumber(entry.Number),
+				entry.Description,
+				entry.Active,
+				position,
+				next != nil && *next == entry.Number,
+				entry.DevicePath,
+			})
+		}
+
+		entryTableWriter.Render()
+
+		return nil
+	},
+}
+
+var VMUEFISetOrderCmd = &cobra.Command{
+	Use:          "set-order",
+	Short:        "set the order the UEFI firmware of a stopped VM tries its boot entries in",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		var order []uint16
+
+		for _, number := range strings.Split(UEFIBootOrder, ",") {
+			parsed, err := parseUEFIBootNumber(number)
+			if err != nil {
+				return err
+			}
+
+			order = append(order, parsed)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.SetVMUEFIBootOrder(ctx, VMID, order)
+		if err != nil {
+			return fmt.Errorf("failed setting UEFI boot order: %w", err)
+		}
+
+		fmt.Printf("UEFI boot order set\n")
+
+		return nil
+	},
+}
+
+var VMUEFISetNextCmd = &cobra.Command{
+	Use:          "set-next",
+	Short:        "set the UEFI boot entry a stopped VM boots the next time it starts only",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		var next *uint16
+
+		if !UEFIBootNextClear {
+			parsed, err := parseUEFIBootNumber(UEFIBootNext)
+			if err != nil {
+				return err
+			}
+
+			next = &parsed
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.SetVMUEFIBootNext(ctx, VMID, next)
+		if err != nil {
+			return fmt.Errorf("failed setting UEFI boot next: %w", err)
+		}
+
+		if next == nil {
+			fmt.Printf("UEFI boot next cleared\n")
+		} else {
+			fmt.Printf("UEFI boot next set\n")
+		}
+
+		return nil
+	},
+}
+
+var VMUEFIBootISOCmd = &cobra.Command{
+	Use:          "boot-iso",
+	Short:        "boot a stopped VM from one of its ISOs the next time it starts only, its first ISO if none is given",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		var err error
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err = vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		if IsoID == "" && IsoName != "" {
+			IsoID, err = rpc.IsoNameToID(ctx, IsoName)
+			if err != nil {
+				return fmt.Errorf("failed getting ISO ID: %w", err)
+			}
+
+			if IsoID == "" {
+				return errIsoNotFound
+			}
+		}
+
+		err = rpc.VMBootISOOnce(ctx, VMID, IsoID)
+		if err != nil {
+			return fmt.Errorf("failed setting VM to boot ISO once: %w", err)
+		}
+
+		fmt.Printf("VM will boot ISO on next start\n")
+
+		return nil
+	},
+}
+
+var VMUEFIBackupCmd = &cobra.Command{
+	Use:          "backup",
+	Short:        "save the UEFI variables of a VM to a file",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		data, err := rpc.GetVMUEFIVars(ctx, VMID)
+		if err != nil {
+			return fmt.Errorf("failed getting UEFI vars: %w", err)
+		}
+
+		err = os.WriteFile(UEFIVarsFilePath, data, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed writing UEFI vars file: %w", err)
+		}
+
+		fmt.Printf("UEFI vars saved\n")
+
+		return nil
+	},
+}
+
+var VMUEFIRestoreCmd = &cobra.Command{
+	Use:          "restore",
+	Short:        "replace the UEFI variables of a stopped VM with ones saved to a file",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		data, err := os.ReadFile(UEFIVarsFilePath)
+		if err != nil {
+			return fmt.Errorf("failed reading UEFI vars file: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err = vmControllerLookupVMID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.SetVMUEFIVars(ctx, VMID, data)
+		if err != nil {
+			return fmt.Errorf("failed restoring UEFI vars: %w", err)
+		}
+
+		fmt.Printf("UEFI vars restored\n")
+
+		return nil
+	},
+}
+
+var VMUEFICmd = &cobra.Command{
+	Use:   "uefi",
+	Short: "Manage the UEFI boot entries and variables of a VM",
+}
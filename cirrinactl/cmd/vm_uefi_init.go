@@ -0,0 +1,67 @@
+//go:build !test
+
+package cmd
+
+func init() {
+	disableFlagSorting(VMUEFICmd)
+
+	disableFlagSorting(VMUEFIListCmd)
+	addNameOrIDArgs(VMUEFIListCmd, &VMName, &VMID, "VM")
+
+	disableFlagSorting(VMUEFISetOrderCmd)
+	addNameOrIDArgs(VMUEFISetOrderCmd, &VMName, &VMID, "VM")
+	VMUEFISetOrderCmd.Flags().StringVarP(&UEFIBootOrder,
+		"order", "o", UEFIBootOrder, "Comma separated boot entry numbers, such as 0002,0001",
+	)
+
+	err := VMUEFISetOrderCmd.MarkFlagRequired("order")
+	if err != nil {
+		panic(err)
+	}
+
+	disableFlagSorting(VMUEFISetNextCmd)
+	addNameOrIDArgs(VMUEFISetNextCmd, &VMName, &VMID, "VM")
+	VMUEFISetNextCmd.Flags().StringVarP(&UEFIBootNext,
+		"entry", "e", UEFIBootNext, "Boot entry number to boot next time only, such as 0002",
+	)
+	VMUEFISetNextCmd.Flags().BoolVar(&UEFIBootNextClear, "clear", UEFIBootNextClear, "Clear boot next")
+	VMUEFISetNextCmd.MarkFlagsOneRequired("entry", "clear")
+	VMUEFISetNextCmd.MarkFlagsMutuallyExclusive("entry", "clear")
+
+	disableFlagSorting(VMUEFIBootISOCmd)
+	addNameOrIDArgs(VMUEFIBootISOCmd, &VMName, &VMID, "VM")
+	VMUEFIBootISOCmd.Flags().StringVarP(&IsoName, "iso-name", "N", IsoName, "Name of Iso")
+	VMUEFIBootISOCmd.Flags().StringVarP(&IsoID, "iso-id", "I", IsoID, "ID of Iso")
+	VMUEFIBootISOCmd.MarkFlagsMutuallyExclusive("iso-name", "iso-id")
+
+	disableFlagSorting(VMUEFIBackupCmd)
+	addNameOrIDArgs(VMUEFIBackupCmd, &VMName, &VMID, "VM")
+	VMUEFIBackupCmd.Flags().StringVarP(&UEFIVarsFilePath,
+		"path", "p", UEFIVarsFilePath, "Path of the file to save UEFI vars to",
+	)
+
+	err = VMUEFIBackupCmd.MarkFlagRequired("path")
+	if err != nil {
+		panic(err)
+	}
+
+	disableFlagSorting(VMUEFIRestoreCmd)
+	addNameOrIDArgs(VMUEFIRestoreCmd, &VMName, &VMID, "VM")
+	VMUEFIRestoreCmd.Flags().StringVarP(&UEFIVarsFilePath,
+		"path", "p", UEFIVarsFilePath, "Path of the file to restore UEFI vars from",
+	)
+
+	err = VMUEFIRestoreCmd.MarkFlagRequired("path")
+	if err != nil {
+		panic(err)
+	}
+
+	VMUEFICmd.AddCommand(VMUEFIListCmd)
+	VMUEFICmd.AddCommand(VMUEFISetOrderCmd)
+	VMUEFICmd.AddCommand(VMUEFISetNextCmd)
+	VMUEFICmd.AddCommand(VMUEFIBootISOCmd)
+	VMUEFICmd.AddCommand(VMUEFIBackupCmd)
+	VMUEFICmd.AddCommand(VMUEFIRestoreCmd)
+
+	VMCmd.AddCommand(VMUEFICmd)
+}
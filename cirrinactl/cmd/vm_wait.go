@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+var (
+	WaitReady   bool
+	WaitTimeout uint32 = 300
+)
+
+var VMWaitCmd = &cobra.Command{
+	Use:          "wait",
+	Short:        "Wait for a VM to be running, or ready",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		var err error
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(WaitTimeout)*time.Second)
+		defer cancel()
+
+		if VMID == "" {
+			VMID, err = rpc.VMNameToID(ctx, VMName)
+			if err != nil {
+				return fmt.Errorf("failed getting VM ID: %w", err)
+			}
+
+			if VMID == "" {
+				return errVMNotFound
+			}
+		}
+
+		for {
+			var done bool
+
+			if WaitReady {
+				done, err = rpc.VMReady(ctx, VMID)
+			} else {
+				done, err = rpc.VMRunning(ctx, VMID)
+			}
+
+			if err != nil {
+				if ctx.Err() != nil {
+					return errVMWaitTimeout
+				}
+
+				return fmt.Errorf("failed checking VM status: %w", err)
+			}
+
+			if done {
+				return nil
+			}
+
+			select {
+			case <-ctx.Done():
+				return errVMWaitTimeout
+			case <-time.After(time.Second):
+			}
+		}
+	},
+}
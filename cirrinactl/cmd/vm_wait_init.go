@@ -0,0 +1,12 @@
+//go:build !test
+
+package cmd
+
+func init() {
+	disableFlagSorting(VMWaitCmd)
+	addNameOrIDArgs(VMWaitCmd, &VMName, &VMID, "VM")
+	VMWaitCmd.Flags().BoolVar(&WaitReady, "ready", WaitReady, "Wait for the VM readiness probes to pass")
+	VMWaitCmd.Flags().Uint32VarP(&WaitTimeout, "timeout", "t", WaitTimeout, "How long to wait in seconds")
+
+	VMCmd.AddCommand(VMWaitCmd)
+}
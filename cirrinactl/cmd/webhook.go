@@ -0,0 +1,212 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"cirrina/cirrinactl/rpc"
+)
+
+var (
+	WebhookName        string
+	WebhookID          string
+	WebhookDescription string
+	WebhookURL         string
+	WebhookSecret      string
+	WebhookEvents      string
+	WebhookMaxRetries  uint32 = 5
+)
+
+var WebhookListCmd = &cobra.Command{
+	Use:          "list",
+	Short:        "list webhooks",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		webhooks, err := rpc.GetWebhooks(ctx)
+		if err != nil {
+			return fmt.Errorf("failed getting webhook list: %w", err)
+		}
+
+		webhookTableWriter := table.NewWriter()
+		webhookTableWriter.SetOutputMirror(os.Stdout)
+		webhookTableWriter.SetStyle(myTableStyle)
+
+		if ShowUUID {
+			webhookTableWriter.AppendHeader(table.Row{"NAME", "UUID", "URL", "EVENTS", "RETRIES", "DESCRIPTION"})
+		} else {
+			webhookTableWriter.AppendHeader(table.Row{"NAME", "URL", "EVENTS", "RETRIES", "DESCRIPTION"})
+		}
+
+		for _, aWebhook := range webhooks {
+			events := aWebhook.Events
+			if events == "" {
+				events = "all"
+			}
+
+			if ShowUUID {
+				webhookTableWriter.AppendRow(table.Row{
+					aWebhook.Name, aWebhook.ID, aWebhook.URL, events, aWebhook.MaxRetries, aWebhook.Descr,
+				})
+			} else {
+				webhookTableWriter.AppendRow(table.Row{
+					aWebhook.Name, aWebhook.URL, events, aWebhook.MaxRetries, aWebhook.Descr,
+				})
+			}
+		}
+
+		webhookTableWriter.Render()
+
+		return nil
+	},
+}
+
+var WebhookAddCmd = &cobra.Command{
+	Use:          "add",
+	Short:        "add a webhook which is sent VM and request events",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		webhookID, err := rpc.AddWebhook(ctx, rpc.WebhookInfo{
+			Name:       WebhookName,
+			Descr:      WebhookDescription,
+			URL:        WebhookURL,
+			Secret:     WebhookSecret,
+			Events:     WebhookEvents,
+			MaxRetries: WebhookMaxRetries,
+		})
+		if err != nil {
+			return fmt.Errorf("failed adding webhook: %w", err)
+		}
+
+		fmt.Printf("Webhook created. id: %s\n", webhookID)
+
+		return nil
+	},
+}
+
+var WebhookRemoveCmd = &cobra.Command{
+	Use:          "rm",
+	Aliases:      []string{"delete"},
+	Short:        "remove a webhook",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := lookupWebhookID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.RemoveWebhook(ctx, WebhookID)
+		if err != nil {
+			return fmt.Errorf("failed removing webhook: %w", err)
+		}
+
+		fmt.Printf("Webhook removed\n")
+
+		return nil
+	},
+}
+
+var WebhookTestCmd = &cobra.Command{
+	Use:          "test",
+	Short:        "send a test event to a webhook, check the delivery log for the result",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := lookupWebhookID(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = rpc.TestWebhook(ctx, WebhookID)
+		if err != nil {
+			return fmt.Errorf("failed testing webhook: %w", err)
+		}
+
+		fmt.Printf("Test event sent\n")
+
+		return nil
+	},
+}
+
+var WebhookLogCmd = &cobra.Command{
+	Use:          "log",
+	Short:        "show the delivery log of a webhook",
+	SilenceUsage: true,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(rpc.ServerTimeout)*time.Second)
+		defer cancel()
+
+		err := lookupWebhookID(ctx)
+		if err != nil {
+			return err
+		}
+
+		deliveries, err := rpc.GetWebhookDeliveries(ctx, WebhookID)
+		if err != nil {
+			return fmt.Errorf("failed getting webhook deliveries: %w", err)
+		}
+
+		deliveryTableWriter := table.NewWriter()
+		deliveryTableWriter.SetOutputMirror(os.Stdout)
+		deliveryTableWriter.SetStyle(myTableStyle)
+		deliveryTableWriter.AppendHeader(table.Row{"TIME", "EVENT", "EVENT ID", "ATTEMPTS", "STATUS", "SUCCESS", "ERROR"})
+
+		for _, aDelivery := range deliveries {
+			status := ""
+			if aDelivery.StatusCode != 0 {
+				status = strconv.FormatInt(int64(aDelivery.StatusCode), 10)
+			}
+
+			deliveryTableWriter.AppendRow(table.Row{
+				aDelivery.Time.Local().Format(time.DateTime), aDelivery.Event, aDelivery.EventID, aDelivery.Attempts,
+				status, aDelivery.Success, aDelivery.Error,
+			})
+		}
+
+		deliveryTableWriter.Render()
+
+		return nil
+	},
+}
+
+var WebhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Add, list, test and remove webhooks and show their delivery log",
+}
+
+// lookupWebhookID sets WebhookID from WebhookName if no ID was given
+func lookupWebhookID(ctx context.Context) error {
+	if WebhookID != "" {
+		return nil
+	}
+
+	var err error
+
+	WebhookID, err = rpc.WebhookNameToID(ctx, WebhookName)
+	if err != nil {
+		if errors.Is(err, rpc.ErrNotFound) {
+			return errWebhookNotFound
+		}
+
+		return fmt.Errorf("failed getting webhook ID: %w", err)
+	}
+
+	return nil
+}
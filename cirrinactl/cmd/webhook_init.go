@@ -0,0 +1,54 @@
+//go:build !test
+
+package cmd
+
+func init() {
+	disableFlagSorting(WebhookCmd)
+
+	disableFlagSorting(WebhookListCmd)
+	WebhookListCmd.Flags().BoolVarP(&ShowUUID,
+		"uuid", "u", ShowUUID, "Show UUIDs",
+	)
+
+	disableFlagSorting(WebhookAddCmd)
+	WebhookAddCmd.Flags().StringVarP(&WebhookName, "name", "n", WebhookName, "Name of webhook")
+	WebhookAddCmd.Flags().StringVarP(&WebhookDescription,
+		"description", "d", WebhookDescription, "description of webhook",
+	)
+	WebhookAddCmd.Flags().StringVar(&WebhookURL, "url", WebhookURL, "http or https URL events are posted to")
+	WebhookAddCmd.Flags().StringVar(&WebhookSecret,
+		"secret", WebhookSecret, "Secret used to sign payloads, sent as an HMAC-SHA256 in the X-Cirrina-Signature header",
+	)
+	WebhookAddCmd.Flags().StringVarP(&WebhookEvents,
+		"events", "e", WebhookEvents,
+		"Comma separated events to send - vm.state, vm.crash, vm.exit, vm.respawn, request.failed, disk.wiped, empty for all",
+	)
+	WebhookAddCmd.Flags().Uint32Var(&WebhookMaxRetries,
+		"max-retries", WebhookMaxRetries, "How many times to retry a failed delivery, backing off between tries",
+	)
+
+	err := WebhookAddCmd.MarkFlagRequired("name")
+	if err != nil {
+		panic(err)
+	}
+
+	err = WebhookAddCmd.MarkFlagRequired("url")
+	if err != nil {
+		panic(err)
+	}
+
+	disableFlagSorting(WebhookRemoveCmd)
+	addNameOrIDArgs(WebhookRemoveCmd, &WebhookName, &WebhookID, "webhook")
+
+	disableFlagSorting(WebhookTestCmd)
+	addNameOrIDArgs(WebhookTestCmd, &WebhookName, &WebhookID, "webhook")
+
+	disableFlagSorting(WebhookLogCmd)
+	addNameOrIDArgs(WebhookLogCmd, &WebhookName, &WebhookID, "webhook")
+
+	WebhookCmd.AddCommand(WebhookListCmd)
+	WebhookCmd.AddCommand(WebhookAddCmd)
+	WebhookCmd.AddCommand(WebhookRemoveCmd)
+	WebhookCmd.AddCommand(WebhookTestCmd)
+	WebhookCmd.AddCommand(WebhookLogCmd)
+}
@@ -115,12 +115,16 @@ func GetDisks(ctx context.Context) ([]string, error) {
 	return disks, nil
 }
 
-func RmDisk(ctx context.Context, idPtr string) error {
+// RmDisk deletes a disk, purge controls removal of the backing file or zvol, nil leaves it to the server default
+func RmDisk(ctx context.Context, idPtr string, purge *bool) error {
 	var err error
 
 	var res *cirrina.ReqBool
 
-	res, err = serverClient.RemoveDisk(ctx, &cirrina.DiskId{Value: idPtr})
+	res, err = serverClient.RemoveDiskWithOptions(ctx, &cirrina.DiskRemoveReq{
+		Diskid: &cirrina.DiskId{Value: idPtr},
+		Purge:  purge,
+	})
 	if err != nil {
 		return fmt.Errorf("unable to remove disk: %w", err)
 	}
@@ -263,6 +267,31 @@ func WipeDisk(ctx context.Context, diskID string) (string, error) {
 	return reqID.GetValue(), nil
 }
 
+func ResizeDisk(ctx context.Context, diskID string, newSize string, force bool) error {
+	if diskID == "" {
+		return errDiskEmptyID
+	}
+
+	if newSize == "" {
+		return errDiskEmptySize
+	}
+
+	res, err := serverClient.ResizeDisk(ctx, &cirrina.DiskResizeReq{
+		Diskid: &cirrina.DiskId{Value: diskID},
+		Size:   newSize,
+		Force:  force,
+	})
+	if err != nil {
+		return fmt.Errorf("error resizing disk: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
 func diskUploadFile(diskID string, diskSize uint64, diskChecksum string,
 	diskFile *os.File, uploadStatChan chan<- UploadStat) {
 	var err error
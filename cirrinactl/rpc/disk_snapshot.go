@@ -0,0 +1,127 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cirrina/cirrina"
+)
+
+func CreateDiskSnapshot(ctx context.Context, diskID string, snapshotName string, snapshotDesc string) (string, error) {
+	if diskID == "" {
+		return "", errDiskEmptyID
+	}
+
+	if snapshotName == "" {
+		return "", errSnapshotEmptyName
+	}
+
+	reqID, err := serverClient.CreateDiskSnapshot(ctx, &cirrina.DiskSnapshotInfo{
+		DiskId:      &diskID,
+		Name:        &snapshotName,
+		Description: &snapshotDesc,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to create disk snapshot: %w", err)
+	}
+
+	return reqID.GetValue(), nil
+}
+
+func GetDiskSnapshots(ctx context.Context, diskID string) ([]DiskSnapshotInfo, error) {
+	if diskID == "" {
+		return []DiskSnapshotInfo{}, errDiskEmptyID
+	}
+
+	res, err := serverClient.ListDiskSnapshots(ctx, &cirrina.DiskId{Value: diskID})
+	if err != nil {
+		return []DiskSnapshotInfo{}, fmt.Errorf("unable to get disk snapshots: %w", err)
+	}
+
+	var snapshots []DiskSnapshotInfo
+
+	for {
+		snapshot, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []DiskSnapshotInfo{}, fmt.Errorf("unable to get disk snapshots: %w", err)
+		}
+
+		snapshots = append(snapshots, DiskSnapshotInfo{
+			ID:      snapshot.GetId(),
+			Name:    snapshot.GetName(),
+			Descr:   snapshot.GetDescription(),
+			Created: snapshot.GetCreated().AsTime(),
+		})
+	}
+
+	return snapshots, nil
+}
+
+func DiskSnapshotNameToID(ctx context.Context, diskID string, snapshotName string) (string, error) {
+	if snapshotName == "" {
+		return "", errSnapshotEmptyName
+	}
+
+	snapshots, err := GetDiskSnapshots(ctx, diskID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.Name == snapshotName {
+			return snapshot.ID, nil
+		}
+	}
+
+	return "", ErrNotFound
+}
+
+func RollbackDiskSnapshot(ctx context.Context, diskID string, snapshotID string) (string, error) {
+	if diskID == "" {
+		return "", errDiskEmptyID
+	}
+
+	if snapshotID == "" {
+		return "", errSnapshotEmptyID
+	}
+
+	reqID, err := serverClient.RollbackDiskSnapshot(ctx, &cirrina.DiskSnapshotReq{
+		Diskid:     &cirrina.DiskId{Value: diskID},
+		Snapshotid: &cirrina.DiskSnapshotId{Value: snapshotID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to roll back disk snapshot: %w", err)
+	}
+
+	return reqID.GetValue(), nil
+}
+
+func RmDiskSnapshot(ctx context.Context, diskID string, snapshotID string) error {
+	if diskID == "" {
+		return errDiskEmptyID
+	}
+
+	if snapshotID == "" {
+		return errSnapshotEmptyID
+	}
+
+	res, err := serverClient.DeleteDiskSnapshot(ctx, &cirrina.DiskSnapshotReq{
+		Diskid:     &cirrina.DiskId{Value: diskID},
+		Snapshotid: &cirrina.DiskSnapshotId{Value: snapshotID},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to remove disk snapshot: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
@@ -18,6 +18,31 @@ var (
 	errDiskDuplicate      = errors.New("duplicate disk found")
 	errDiskTypeUnknown    = errors.New("invalid disk type specified")
 	errDiskDevTypeUnknown = errors.New("invalid disk dev type specified")
+	errDiskEmptySize      = errors.New("disk size not specified")
+	errSnapshotEmptyName  = errors.New("snapshot name not specified")
+	errSnapshotEmptyID    = errors.New("snapshot id not specified")
+)
+
+var (
+	errStorageEmptyName   = errors.New("storage name not specified")
+	errStorageTypeUnknown = errors.New("invalid storage type specified, must be one of file, zvol or iso")
+)
+
+var (
+	errScheduleEmptyName = errors.New("schedule name not specified")
+	errScheduleEmptyID   = errors.New("schedule id not specified")
+)
+
+var (
+	errControllerEmptyName = errors.New("controller name not specified")
+	errAttachmentEmptyID   = errors.New("attached device id not specified")
+	errPassthruEmptyDevice = errors.New("passthru device not specified")
+	errShareEmptyName      = errors.New("share name not specified")
+)
+
+var (
+	errWebhookEmptyName = errors.New("webhook name not specified")
+	errWebhookEmptyID   = errors.New("webhook id not specified")
 )
 
 var (
@@ -48,3 +73,5 @@ var (
 )
 
 var ErrInvalidComNum = errors.New("invalid com number")
+
+var errConsolePortEmptyName = errors.New("console port name not specified")
@@ -40,6 +40,67 @@ func GetHostNics(ctx context.Context) ([]string, error) {
 	return hostNics, nil
 }
 
+func GetHostPCIDevices(ctx context.Context) ([]HostPCIDeviceInfo, error) {
+	res, err := serverClient.GetHostPCIDevices(ctx, &emptypb.Empty{})
+	if err != nil {
+		return []HostPCIDeviceInfo{}, fmt.Errorf("unable to get host PCI devices: %w", err)
+	}
+
+	var hostDevices []HostPCIDeviceInfo
+
+	for {
+		hostDevice, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []HostPCIDeviceInfo{}, fmt.Errorf("unable to get host PCI devices: %w", err)
+		}
+
+		hostDevices = append(hostDevices, HostPCIDeviceInfo{
+			Address:  hostDevice.GetAddress(),
+			Driver:   hostDevice.GetDriver(),
+			PPT:      hostDevice.GetPpt(),
+			Vendor:   hostDevice.GetVendor(),
+			Device:   hostDevice.GetDevice(),
+			Class:    hostDevice.GetClass(),
+			Subclass: hostDevice.GetSubclass(),
+			HeldBy:   hostDevice.GetHeldBy(),
+		})
+	}
+
+	return hostDevices, nil
+}
+
+func GetFirmwares(ctx context.Context) ([]FirmwareInfo, error) {
+	res, err := serverClient.GetFirmwares(ctx, &emptypb.Empty{})
+	if err != nil {
+		return []FirmwareInfo{}, fmt.Errorf("unable to get firmware: %w", err)
+	}
+
+	var firmwares []FirmwareInfo
+
+	for {
+		firmware, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []FirmwareInfo{}, fmt.Errorf("unable to get firmware: %w", err)
+		}
+
+		firmwares = append(firmwares, FirmwareInfo{
+			Name:         firmware.GetName(),
+			Path:         firmware.GetPath(),
+			VarsTemplate: firmware.GetVarsTemplate(),
+		})
+	}
+
+	return firmwares, nil
+}
+
 func GetHostVersion(ctx context.Context) (string, error) {
 	var version string
 
@@ -56,3 +117,13 @@ func GetHostVersion(ctx context.Context) (string, error) {
 
 	return version, nil
 }
+
+// GetPurgeDefault returns whether the server purges backing storage when removing a disk or ISO without saying
+func GetPurgeDefault(ctx context.Context) (bool, error) {
+	res, err := serverClient.GetPurgeDefault(ctx, &emptypb.Empty{})
+	if err != nil {
+		return false, fmt.Errorf("unable to get purge default: %w", err)
+	}
+
+	return res.GetValue(), nil
+}
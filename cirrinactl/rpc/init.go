@@ -3,6 +3,7 @@ package rpc
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -24,6 +25,107 @@ type DiskSizeUsage struct {
 	Usage uint64
 }
 
+type DiskSnapshotInfo struct {
+	ID      string
+	Name    string
+	Descr   string
+	Created time.Time
+}
+
+type VMSnapshotInfo struct {
+	ID              string
+	Name            string
+	Descr           string
+	Created         time.Time
+	DiskSnapshotIDs []string
+	UEFIVars        bool
+}
+
+type VMRestartInfo struct {
+	Time      time.Time
+	ExitCode  int32
+	Reason    string
+	Restarted bool
+	Delay     uint32
+	CrashLoop bool
+}
+
+type VMControllerInfo struct {
+	Name string
+	Type string
+	MaxQ uint32
+	QSz  uint32
+	Ser  string
+}
+
+type VMAttachmentInfo struct {
+	Controller string
+	DeviceType string
+	DeviceID   string
+	Port       uint32
+	Boot       bool
+	ReadOnly   bool
+	SectorSize string
+	Cache      *bool
+	Direct     *bool
+}
+
+type HostPCIDeviceInfo struct {
+	Address  string
+	Driver   string
+	PPT      bool
+	Vendor   string
+	Device   string
+	Class    string
+	Subclass string
+	HeldBy   string
+}
+
+type FirmwareInfo struct {
+	Name         string
+	Path         string
+	VarsTemplate string
+}
+
+type VMUEFIBootEntryInfo struct {
+	Number      uint16
+	Description string
+	Active      bool
+	DevicePath  string
+}
+
+type VMShareInfo struct {
+	Name     string
+	Path     string
+	ReadOnly bool
+}
+
+type GuestIPAddressInfo struct {
+	Type    string
+	Address string
+	Prefix  uint32
+}
+
+type GuestInterfaceInfo struct {
+	Name            string
+	HardwareAddress string
+	Addresses       []GuestIPAddressInfo
+}
+
+type VMDeviceInfo struct {
+	Address   string
+	Device    string
+	Emulation string
+	Port      *uint32
+}
+
+type OrphanedStorageInfo struct {
+	Name string
+	Type string
+	Path string
+	Size uint64
+}
+
 type IsoInfo struct {
 	Name  string
 	Descr string
@@ -43,6 +145,12 @@ type NicInfo struct {
 	RateOut     uint64
 }
 
+type NicAddress struct {
+	Address  string
+	Source   string
+	LastSeen time.Time
+}
+
 type SwitchInfo struct {
 	Name       string
 	Descr      string
@@ -107,6 +215,72 @@ type VMConfig struct {
 	Wbps           uint32 `json:"Wbps"           yaml:"Wbps"`
 	Riops          uint32 `json:"Riops"          yaml:"Riops"`
 	Wiops          uint32 `json:"Wiops"          yaml:"Wiops"`
+	StopPolicy     string `json:"StopPolicy"     yaml:"StopPolicy"`
+	RestartPolicy  string `json:"RestartPolicy"  yaml:"RestartPolicy"`
+	RestartMax     uint32 `json:"RestartMax"     yaml:"RestartMax"`
+	RestartWindow  uint32 `json:"RestartWindow"  yaml:"RestartWindow"`
+	BootGroup      uint32 `json:"BootGroup"      yaml:"BootGroup"`
+	DependsOn      string `json:"DependsOn"      yaml:"DependsOn"`
+	ReadyPattern   string `json:"ReadyPattern"   yaml:"ReadyPattern"`
+	ReadyTCP       string `json:"ReadyTCP"       yaml:"ReadyTCP"`
+	ReadyHTTP      string `json:"ReadyHTTP"      yaml:"ReadyHTTP"`
+	ReadyDeadline  uint32 `json:"ReadyDeadline"  yaml:"ReadyDeadline"`
+	ReadyInterval  uint32 `json:"ReadyInterval"  yaml:"ReadyInterval"`
+	ReadyTimeout   uint32 `json:"ReadyTimeout"   yaml:"ReadyTimeout"`
+	LivePattern    string `json:"LivePattern"    yaml:"LivePattern"`
+	LiveTCP        string `json:"LiveTCP"        yaml:"LiveTCP"`
+	LiveHTTP       string `json:"LiveHTTP"       yaml:"LiveHTTP"`
+	LiveInterval   uint32 `json:"LiveInterval"   yaml:"LiveInterval"`
+	LiveTimeout    uint32 `json:"LiveTimeout"    yaml:"LiveTimeout"`
+	LiveFailures   uint32 `json:"LiveFailures"   yaml:"LiveFailures"`
+	LiveAction     string `json:"LiveAction"     yaml:"LiveAction"`
+	Labels         string `json:"Labels"         yaml:"Labels"`
+	HookPreStart   string `json:"HookPreStart"   yaml:"HookPreStart"`
+	HookPostStart  string `json:"HookPostStart"  yaml:"HookPostStart"`
+	HookPreStop    string `json:"HookPreStop"    yaml:"HookPreStop"`
+	HookPostStop   string `json:"HookPostStop"   yaml:"HookPostStop"`
+	HookTimeout    uint32 `json:"HookTimeout"    yaml:"HookTimeout"`
+	PCISlots       string `json:"PCISlots"       yaml:"PCISlots"`
+	PCIPack        bool   `json:"PCIPack"        yaml:"PCIPack"`
+	Sockets        uint32 `json:"Sockets"        yaml:"Sockets"`
+	Cores          uint32 `json:"Cores"          yaml:"Cores"`
+	Threads        uint32 `json:"Threads"        yaml:"Threads"`
+	CPUPins        string `json:"CPUPins"        yaml:"CPUPins"`
+	ConsolePorts   string `json:"ConsolePorts"   yaml:"ConsolePorts"`
+	GuestAgent     bool   `json:"GuestAgent"     yaml:"GuestAgent"`
+	Firmware       string `json:"Firmware"       yaml:"Firmware"`
+}
+
+type ScheduleInfo struct {
+	ID       string
+	Name     string
+	Descr    string
+	Cron     string
+	Action   string
+	VMID     string
+	Selector string
+	LastRun  time.Time
+	NextRun  time.Time
+}
+
+type WebhookInfo struct {
+	ID         string
+	Name       string
+	Descr      string
+	URL        string
+	Secret     string
+	Events     string
+	MaxRetries uint32
+}
+
+type WebhookDelivery struct {
+	Time       time.Time
+	EventID    string
+	Event      string
+	Attempts   uint32
+	StatusCode int32
+	Success    bool
+	Error      string
 }
 
 type UploadStat struct {
@@ -119,6 +293,7 @@ type UploadStat struct {
 type ReqStatus struct {
 	Complete bool
 	Success  bool
+	Result   string
 }
 
 var (
@@ -61,12 +61,16 @@ func GetIsoIDs(ctx context.Context) ([]string, error) {
 	return IsoIDs, nil
 }
 
-func RmIso(ctx context.Context, id string) error {
+// RmIso deletes an ISO, purge controls removal of the ISO file, nil leaves it to the server default
+func RmIso(ctx context.Context, id string, purge *bool) error {
 	var err error
 
 	var res *cirrina.ReqBool
 
-	res, err = serverClient.RemoveISO(ctx, &cirrina.ISOID{Value: id})
+	res, err = serverClient.RemoveISOWithOptions(ctx, &cirrina.ISORemoveReq{
+		Isoid: &cirrina.ISOID{Value: id},
+		Purge: purge,
+	})
 	if err != nil {
 		return fmt.Errorf("unable to remove iso: %w", err)
 	}
@@ -24,6 +24,7 @@ func ReqStat(ctx context.Context, reqID string) (ReqStatus, error) {
 	rv := ReqStatus{
 		Complete: res.GetComplete(),
 		Success:  res.GetSuccess(),
+		Result:   res.GetResult(),
 	}
 
 	return rv, nil
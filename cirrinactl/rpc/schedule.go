@@ -0,0 +1,110 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"cirrina/cirrina"
+)
+
+func AddSchedule(ctx context.Context, scheduleInfo ScheduleInfo) (string, error) {
+	if scheduleInfo.Name == "" {
+		return "", errScheduleEmptyName
+	}
+
+	res, err := serverClient.AddSchedule(ctx, &cirrina.ScheduleInfo{
+		Name:        &scheduleInfo.Name,
+		Description: &scheduleInfo.Descr,
+		Cron:        &scheduleInfo.Cron,
+		Action:      &scheduleInfo.Action,
+		VmId:        &scheduleInfo.VMID,
+		Selector:    &scheduleInfo.Selector,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to add schedule: %w", err)
+	}
+
+	return res.GetValue(), nil
+}
+
+func GetSchedules(ctx context.Context) ([]ScheduleInfo, error) {
+	res, err := serverClient.ListSchedules(ctx, &emptypb.Empty{})
+	if err != nil {
+		return []ScheduleInfo{}, fmt.Errorf("unable to get schedules: %w", err)
+	}
+
+	var schedules []ScheduleInfo
+
+	for {
+		aSchedule, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []ScheduleInfo{}, fmt.Errorf("unable to get schedules: %w", err)
+		}
+
+		scheduleInfo := ScheduleInfo{
+			ID:       aSchedule.GetId(),
+			Name:     aSchedule.GetName(),
+			Descr:    aSchedule.GetDescription(),
+			Cron:     aSchedule.GetCron(),
+			Action:   aSchedule.GetAction(),
+			VMID:     aSchedule.GetVmId(),
+			Selector: aSchedule.GetSelector(),
+		}
+
+		if aSchedule.LastRun != nil {
+			scheduleInfo.LastRun = aSchedule.GetLastRun().AsTime()
+		}
+
+		if aSchedule.NextRun != nil {
+			scheduleInfo.NextRun = aSchedule.GetNextRun().AsTime()
+		}
+
+		schedules = append(schedules, scheduleInfo)
+	}
+
+	return schedules, nil
+}
+
+func ScheduleNameToID(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		return "", errScheduleEmptyName
+	}
+
+	schedules, err := GetSchedules(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, aSchedule := range schedules {
+		if aSchedule.Name == name {
+			return aSchedule.ID, nil
+		}
+	}
+
+	return "", ErrNotFound
+}
+
+func RemoveSchedule(ctx context.Context, scheduleID string) error {
+	if scheduleID == "" {
+		return errScheduleEmptyID
+	}
+
+	res, err := serverClient.RemoveSchedule(ctx, &cirrina.ScheduleId{Value: scheduleID})
+	if err != nil {
+		return fmt.Errorf("unable to remove schedule: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
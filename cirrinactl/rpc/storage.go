@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"cirrina/cirrina"
+)
+
+func GetOrphanedStorage(ctx context.Context) ([]OrphanedStorageInfo, error) {
+	res, err := serverClient.ListOrphanedStorage(ctx, &emptypb.Empty{})
+	if err != nil {
+		return []OrphanedStorageInfo{}, fmt.Errorf("unable to get orphaned storage: %w", err)
+	}
+
+	var orphans []OrphanedStorageInfo
+
+	for {
+		orphan, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []OrphanedStorageInfo{}, fmt.Errorf("unable to get orphaned storage: %w", err)
+		}
+
+		orphans = append(orphans, OrphanedStorageInfo{
+			Name: orphan.GetName(),
+			Type: mapStorageTypeToString(orphan.GetType()),
+			Path: orphan.GetPath(),
+			Size: orphan.GetSize(),
+		})
+	}
+
+	return orphans, nil
+}
+
+// AdoptStorage registers orphaned storage as a disk or ISO and returns the new ID, diskType is ignored for ISOs
+func AdoptStorage(ctx context.Context, name string, storageType string, description string, diskType string) (string, error) { //nolint:lll
+	if name == "" {
+		return "", errStorageEmptyName
+	}
+
+	thisStorageType, err := mapStorageTypeStringToType(storageType)
+	if err != nil {
+		return "", err
+	}
+
+	adoptReq := &cirrina.AdoptStorageReq{
+		Name:        name,
+		Type:        thisStorageType,
+		Description: &description,
+	}
+
+	if diskType != "" && thisStorageType != cirrina.StorageType_STORAGE_ISO {
+		adoptReq.DiskType, err = mapDiskTypeStringToType(diskType)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	res, err := serverClient.AdoptStorage(ctx, adoptReq)
+	if err != nil {
+		return "", fmt.Errorf("unable to adopt storage: %w", err)
+	}
+
+	return res.GetValue(), nil
+}
+
+func PurgeStorage(ctx context.Context, name string, storageType string) error {
+	if name == "" {
+		return errStorageEmptyName
+	}
+
+	thisStorageType, err := mapStorageTypeStringToType(storageType)
+	if err != nil {
+		return err
+	}
+
+	res, err := serverClient.PurgeStorage(ctx, &cirrina.PurgeStorageReq{
+		Name: name,
+		Type: thisStorageType,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to purge storage: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+func mapStorageTypeStringToType(storageType string) (cirrina.StorageType, error) {
+	switch storageType {
+	case "file", "FILE":
+		return cirrina.StorageType_STORAGE_DISK_FILE, nil
+	case "zvol", "ZVOL":
+		return cirrina.StorageType_STORAGE_DISK_ZVOL, nil
+	case "iso", "ISO":
+		return cirrina.StorageType_STORAGE_ISO, nil
+	default:
+		return cirrina.StorageType_STORAGE_DISK_FILE, errStorageTypeUnknown
+	}
+}
+
+func mapStorageTypeToString(storageType cirrina.StorageType) string {
+	switch storageType {
+	case cirrina.StorageType_STORAGE_DISK_FILE:
+		return "file"
+	case cirrina.StorageType_STORAGE_DISK_ZVOL:
+		return "zvol"
+	case cirrina.StorageType_STORAGE_ISO:
+		return "iso"
+	default:
+		return ""
+	}
+}
@@ -216,11 +216,55 @@ func GetVMState(ctx context.Context, vmID string) (string, string, string, error
 		vmstate = "running"
 	case cirrina.VmStatus_STATUS_STOPPING:
 		vmstate = "stopping"
+	case cirrina.VmStatus_STATUS_CRASHLOOP:
+		vmstate = "crashloop"
 	}
 
 	return vmstate, strconv.FormatInt(int64(res.GetVncPort()), 10), strconv.FormatInt(int64(res.GetDebugPort()), 10), nil
 }
 
+// GetVMStopStage returns the stop policy stage a stopping VM is in, or an empty string
+func GetVMStopStage(ctx context.Context, vmID string) (string, error) {
+	if vmID == "" {
+		return "", errVMEmptyID
+	}
+
+	res, err := serverClient.GetVMState(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return "", fmt.Errorf("unable to get VM state: %w", err)
+	}
+
+	return res.GetStopStage(), nil
+}
+
+// VMReady returns true if the VM is running and its readiness probes, if any, have passed
+func VMReady(ctx context.Context, vmID string) (bool, error) {
+	if vmID == "" {
+		return false, errVMEmptyID
+	}
+
+	res, err := serverClient.GetVMState(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return false, fmt.Errorf("unable to get VM state: %w", err)
+	}
+
+	return res.GetReady(), nil
+}
+
+// GetVMLiveState returns the liveness check state of a running VM and how many checks have failed in a row
+func GetVMLiveState(ctx context.Context, vmID string) (string, uint32, error) {
+	if vmID == "" {
+		return "", 0, errVMEmptyID
+	}
+
+	res, err := serverClient.GetVMState(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return "", 0, fmt.Errorf("unable to get VM state: %w", err)
+	}
+
+	return res.GetLiveState(), res.GetLiveFailures(), nil
+}
+
 func VMRunning(ctx context.Context, vmID string) (bool, error) {
 	r, _, _, err := GetVMState(ctx, vmID)
 	if err != nil {
@@ -387,6 +431,40 @@ func parseOptionalVMConfigStart(res *cirrina.VMConfig, retVMConfig VMConfig) VMC
 	retVMConfig.AutostartDelay = res.GetAutostartDelay()
 	retVMConfig.Restart = res.GetRestart()
 	retVMConfig.RestartDelay = res.GetRestartDelay()
+	retVMConfig.StopPolicy = res.GetStopPolicy()
+	retVMConfig.RestartPolicy = res.GetRestartPolicy()
+	retVMConfig.RestartMax = res.GetRestartMax()
+	retVMConfig.RestartWindow = res.GetRestartWindow()
+	retVMConfig.BootGroup = res.GetBootGroup()
+	retVMConfig.DependsOn = res.GetDependsOn()
+	retVMConfig.ReadyPattern = res.GetReadyPattern()
+	retVMConfig.ReadyTCP = res.GetReadyTcp()
+	retVMConfig.ReadyHTTP = res.GetReadyHttp()
+	retVMConfig.ReadyDeadline = res.GetReadyDeadline()
+	retVMConfig.ReadyInterval = res.GetReadyInterval()
+	retVMConfig.ReadyTimeout = res.GetReadyTimeout()
+	retVMConfig.LivePattern = res.GetLivePattern()
+	retVMConfig.LiveTCP = res.GetLiveTcp()
+	retVMConfig.LiveHTTP = res.GetLiveHttp()
+	retVMConfig.LiveInterval = res.GetLiveInterval()
+	retVMConfig.LiveTimeout = res.GetLiveTimeout()
+	retVMConfig.LiveFailures = res.GetLiveFailures()
+	retVMConfig.LiveAction = res.GetLiveAction()
+	retVMConfig.Labels = res.GetLabels()
+	retVMConfig.HookPreStart = res.GetHookPreStart()
+	retVMConfig.HookPostStart = res.GetHookPostStart()
+	retVMConfig.HookPreStop = res.GetHookPreStop()
+	retVMConfig.HookPostStop = res.GetHookPostStop()
+	retVMConfig.HookTimeout = res.GetHookTimeout()
+	retVMConfig.PCISlots = res.GetPciSlots()
+	retVMConfig.PCIPack = res.GetPciPack()
+	retVMConfig.Sockets = res.GetSockets()
+	retVMConfig.Cores = res.GetCores()
+	retVMConfig.Threads = res.GetThreads()
+	retVMConfig.CPUPins = res.GetCpuPins()
+	retVMConfig.ConsolePorts = res.GetConsolePorts()
+	retVMConfig.GuestAgent = res.GetGuestAgent()
+	retVMConfig.Firmware = res.GetFirmware()
 
 	return retVMConfig
 }
@@ -409,3 +487,42 @@ func parseOptionalVMConfigAdvanced(res *cirrina.VMConfig, retVMConfig VMConfig)
 
 	return retVMConfig
 }
+
+func PowerOffVM(ctx context.Context, vmID string) (string, error) {
+	if vmID == "" {
+		return "", errVMEmptyID
+	}
+
+	reqID, err := serverClient.PowerOffVM(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return "", fmt.Errorf("unable to power off VM: %w", err)
+	}
+
+	return reqID.GetValue(), nil
+}
+
+func ResetVM(ctx context.Context, vmID string) (string, error) {
+	if vmID == "" {
+		return "", errVMEmptyID
+	}
+
+	reqID, err := serverClient.ResetVM(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return "", fmt.Errorf("unable to reset VM: %w", err)
+	}
+
+	return reqID.GetValue(), nil
+}
+
+func RebootVM(ctx context.Context, vmID string) (string, error) {
+	if vmID == "" {
+		return "", errVMEmptyID
+	}
+
+	reqID, err := serverClient.RebootVM(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return "", fmt.Errorf("unable to reboot VM: %w", err)
+	}
+
+	return reqID.GetValue(), nil
+}
@@ -0,0 +1,143 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cirrina/cirrina"
+)
+
+func AgentPing(ctx context.Context, vmID string) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	res, err := serverClient.GuestAgentPing(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return fmt.Errorf("unable to ping guest agent: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+func AgentShutdown(ctx context.Context, vmID string) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	res, err := serverClient.GuestAgentShutdown(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return fmt.Errorf("unable to shutdown guest: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+func GetAgentInterfaces(ctx context.Context, vmID string) ([]GuestInterfaceInfo, error) {
+	if vmID == "" {
+		return []GuestInterfaceInfo{}, errVMEmptyID
+	}
+
+	res, err := serverClient.GuestAgentInterfaces(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return []GuestInterfaceInfo{}, fmt.Errorf("unable to get guest interfaces: %w", err)
+	}
+
+	var interfaces []GuestInterfaceInfo
+
+	for {
+		guestInterface, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []GuestInterfaceInfo{}, fmt.Errorf("unable to get guest interfaces: %w", err)
+		}
+
+		interfaceInfo := GuestInterfaceInfo{
+			Name:            guestInterface.GetName(),
+			HardwareAddress: guestInterface.GetHardwareAddress(),
+		}
+
+		for _, address := range guestInterface.GetAddresses() {
+			interfaceInfo.Addresses = append(interfaceInfo.Addresses, GuestIPAddressInfo{
+				Type:    address.GetType(),
+				Address: address.GetAddress(),
+				Prefix:  address.GetPrefix(),
+			})
+		}
+
+		interfaces = append(interfaces, interfaceInfo)
+	}
+
+	return interfaces, nil
+}
+
+// AgentFreeze freezes the guest's filesystems, returning how many were frozen
+func AgentFreeze(ctx context.Context, vmID string) (uint32, error) {
+	if vmID == "" {
+		return 0, errVMEmptyID
+	}
+
+	res, err := serverClient.GuestAgentFSFreeze(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return 0, fmt.Errorf("unable to freeze guest filesystems: %w", err)
+	}
+
+	return res.GetFilesystems(), nil
+}
+
+// AgentThaw thaws the guest's filesystems, returning how many were thawed
+func AgentThaw(ctx context.Context, vmID string) (uint32, error) {
+	if vmID == "" {
+		return 0, errVMEmptyID
+	}
+
+	res, err := serverClient.GuestAgentFSThaw(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return 0, fmt.Errorf("unable to thaw guest filesystems: %w", err)
+	}
+
+	return res.GetFilesystems(), nil
+}
+
+func AgentFreezeStatus(ctx context.Context, vmID string) (string, error) {
+	if vmID == "" {
+		return "", errVMEmptyID
+	}
+
+	res, err := serverClient.GuestAgentFSStatus(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return "", fmt.Errorf("unable to get guest filesystem freeze status: %w", err)
+	}
+
+	return res.GetStatus(), nil
+}
+
+func AgentSyncTime(ctx context.Context, vmID string) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	res, err := serverClient.GuestAgentSyncTime(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return fmt.Errorf("unable to set guest time: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
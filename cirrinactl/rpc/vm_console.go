@@ -0,0 +1,128 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"cirrina/cirrina"
+)
+
+// UseConsolePort connects the local terminal to one of the VM's virtio-console ports
+func UseConsolePort(vmID string, name string) error {
+	var err error
+
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	if name == "" {
+		return errConsolePortEmptyName
+	}
+
+	portCtx, portCancel := context.WithCancel(context.Background())
+
+	stream, err := serverClient.ConsolePortInteractive(portCtx)
+	if err != nil {
+		portCancel()
+
+		return fmt.Errorf("unable to use console port: %w", err)
+	}
+
+	err = stream.Send(&cirrina.ConsolePortDataRequest{
+		Data: &cirrina.ConsolePortDataRequest_Port{
+			Port: &cirrina.ConsolePortReq{Vmid: &cirrina.VMID{Value: vmID}, Name: name},
+		},
+	})
+	if err != nil {
+		portCancel()
+
+		return fmt.Errorf("unable to use console port: %w", err)
+	}
+
+	oldState, err = comTermSetup()
+	if err != nil {
+		portCancel()
+
+		return err
+	}
+
+	defer func(stream cirrina.VMInfo_ConsolePortInteractiveClient) {
+		_ = stream.CloseSend()
+	}(stream)
+
+	go consolePortSend(portCtx, portCancel, stream)
+
+	go consolePortReceive(portCtx, portCancel, stream)
+
+	return comMonitorVM(portCtx, portCancel, vmID)
+}
+
+// consolePortSend reads data from the local terminal and sends it to the console port
+func consolePortSend(portCtx context.Context, portCancel context.CancelFunc,
+	stream cirrina.VMInfo_ConsolePortInteractiveClient,
+) {
+	bytesBuffer := make([]byte, 1)
+
+	for {
+		select {
+		case <-portCtx.Done():
+			return
+		default:
+			_, err := os.Stdin.Read(bytesBuffer)
+			if err != nil {
+				portCancel()
+				comTermCleanup("failed reading stdin: " + err.Error())
+
+				return
+			}
+
+			if bytesBuffer[0] == 0x1c { // ctrl-\, as for com ports
+				portCancel()
+				comTermCleanup("disconnected")
+
+				return
+			}
+
+			err = stream.Send(&cirrina.ConsolePortDataRequest{
+				Data: &cirrina.ConsolePortDataRequest_PortInBytes{PortInBytes: bytesBuffer},
+			})
+			if err != nil {
+				select {
+				case <-portCtx.Done():
+				default:
+					portCancel()
+					comTermCleanup("failed sending to console port: " + err.Error())
+				}
+
+				return
+			}
+		}
+	}
+}
+
+// consolePortReceive receives data from the console port and outputs it to the local terminal
+func consolePortReceive(portCtx context.Context, portCancel context.CancelFunc,
+	stream cirrina.VMInfo_ConsolePortInteractiveClient,
+) {
+	for {
+		select {
+		case <-portCtx.Done():
+			return
+		default:
+			out, err := stream.Recv()
+			if err != nil {
+				select {
+				case <-portCtx.Done():
+				default:
+					portCancel()
+					comTermCleanup("failed receiving from console port: " + err.Error())
+				}
+
+				return
+			}
+
+			fmt.Print(string(out.GetComOutBytes()))
+		}
+	}
+}
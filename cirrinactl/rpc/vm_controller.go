@@ -0,0 +1,195 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cirrina/cirrina"
+)
+
+func AddVMController(ctx context.Context, vmID string, controller VMControllerInfo) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	if controller.Name == "" {
+		return errControllerEmptyName
+	}
+
+	res, err := serverClient.AddVMController(ctx, &cirrina.VMControllerInfo{
+		Vmid: &cirrina.VMID{Value: vmID},
+		Name: controller.Name,
+		Type: controller.Type,
+		Maxq: controller.MaxQ,
+		Qsz:  controller.QSz,
+		Ser:  controller.Ser,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to add VM controller: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+func GetVMControllers(ctx context.Context, vmID string) ([]VMControllerInfo, error) {
+	if vmID == "" {
+		return []VMControllerInfo{}, errVMEmptyID
+	}
+
+	res, err := serverClient.ListVMControllers(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return []VMControllerInfo{}, fmt.Errorf("unable to get VM controllers: %w", err)
+	}
+
+	var controllers []VMControllerInfo
+
+	for {
+		controller, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []VMControllerInfo{}, fmt.Errorf("unable to get VM controllers: %w", err)
+		}
+
+		controllers = append(controllers, VMControllerInfo{
+			Name: controller.GetName(),
+			Type: controller.GetType(),
+			MaxQ: controller.GetMaxq(),
+			QSz:  controller.GetQsz(),
+			Ser:  controller.GetSer(),
+		})
+	}
+
+	return controllers, nil
+}
+
+func RmVMController(ctx context.Context, vmID string, name string) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	if name == "" {
+		return errControllerEmptyName
+	}
+
+	res, err := serverClient.RemoveVMController(ctx, &cirrina.VMControllerReq{
+		Vmid: &cirrina.VMID{Value: vmID},
+		Name: name,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to remove VM controller: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+func SetVMAttachment(ctx context.Context, vmID string, attachment VMAttachmentInfo) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	if attachment.Controller == "" {
+		return errControllerEmptyName
+	}
+
+	if attachment.DeviceID == "" {
+		return errAttachmentEmptyID
+	}
+
+	res, err := serverClient.SetVMAttachment(ctx, &cirrina.VMAttachmentInfo{
+		Vmid:       &cirrina.VMID{Value: vmID},
+		Controller: attachment.Controller,
+		DeviceType: attachment.DeviceType,
+		DeviceId:   attachment.DeviceID,
+		Port:       attachment.Port,
+		Boot:       attachment.Boot,
+		ReadOnly:   attachment.ReadOnly,
+		SectorSize: attachment.SectorSize,
+		Cache:      attachment.Cache,
+		Direct:     attachment.Direct,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to attach device to VM controller: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+func GetVMAttachments(ctx context.Context, vmID string) ([]VMAttachmentInfo, error) {
+	if vmID == "" {
+		return []VMAttachmentInfo{}, errVMEmptyID
+	}
+
+	res, err := serverClient.ListVMAttachments(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return []VMAttachmentInfo{}, fmt.Errorf("unable to get VM controller attachments: %w", err)
+	}
+
+	var attachments []VMAttachmentInfo
+
+	for {
+		attachment, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []VMAttachmentInfo{}, fmt.Errorf("unable to get VM controller attachments: %w", err)
+		}
+
+		attachments = append(attachments, VMAttachmentInfo{
+			Controller: attachment.GetController(),
+			DeviceType: attachment.GetDeviceType(),
+			DeviceID:   attachment.GetDeviceId(),
+			Port:       attachment.GetPort(),
+			Boot:       attachment.GetBoot(),
+			ReadOnly:   attachment.GetReadOnly(),
+			SectorSize: attachment.GetSectorSize(),
+			Cache:      attachment.Cache,
+			Direct:     attachment.Direct,
+		})
+	}
+
+	return attachments, nil
+}
+
+func RmVMAttachment(ctx context.Context, vmID string, deviceType string, deviceID string) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	if deviceID == "" {
+		return errAttachmentEmptyID
+	}
+
+	res, err := serverClient.RemoveVMAttachment(ctx, &cirrina.VMAttachmentReq{
+		Vmid:       &cirrina.VMID{Value: vmID},
+		DeviceType: deviceType,
+		DeviceId:   deviceID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to remove device from VM controller: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cirrina/cirrina"
+)
+
+// GetVMDeviceMap returns the PCI address and AHCI port of each device of a VM, in address order
+func GetVMDeviceMap(ctx context.Context, vmID string) ([]VMDeviceInfo, error) {
+	if vmID == "" {
+		return []VMDeviceInfo{}, errVMEmptyID
+	}
+
+	res, err := serverClient.GetVMDeviceMap(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return []VMDeviceInfo{}, fmt.Errorf("unable to get VM device map: %w", err)
+	}
+
+	var devices []VMDeviceInfo
+
+	for {
+		device, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []VMDeviceInfo{}, fmt.Errorf("unable to get VM device map: %w", err)
+		}
+
+		aDevice := VMDeviceInfo{
+			Address:   device.GetAddress(),
+			Device:    device.GetDevice(),
+			Emulation: device.GetEmulation(),
+		}
+
+		if device.Port != nil {
+			port := device.GetPort()
+			aDevice.Port = &port
+		}
+
+		devices = append(devices, aDevice)
+	}
+
+	return devices, nil
+}
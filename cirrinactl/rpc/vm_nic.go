@@ -92,3 +92,69 @@ func GetVMNicID(ctx context.Context, name string) (string, error) {
 
 	return res.GetValue(), nil
 }
+
+// GetVMNicAddresses returns the addresses the guest has been seen using on the NIC
+func GetVMNicAddresses(ctx context.Context, nicID string) ([]NicAddress, error) {
+	if nicID == "" {
+		return []NicAddress{}, errNicEmptyID
+	}
+
+	res, err := serverClient.GetVMNicAddresses(ctx, &cirrina.VmNicId{Value: nicID})
+	if err != nil {
+		return []NicAddress{}, fmt.Errorf("unable to get NIC addresses: %w", err)
+	}
+
+	var addresses []NicAddress
+
+	for {
+		address, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []NicAddress{}, fmt.Errorf("unable to get NIC addresses: %w", err)
+		}
+
+		addresses = append(addresses, NicAddress{
+			Address:  address.GetAddress(),
+			Source:   address.GetSource(),
+			LastSeen: address.GetLastSeen().AsTime(),
+		})
+	}
+
+	return addresses, nil
+}
+
+// GetVMAddresses returns the addresses the guest has been seen using on each of the VM's NICs, keyed by NIC ID
+func GetVMAddresses(ctx context.Context, vmID string) (map[string][]NicAddress, error) {
+	if vmID == "" {
+		return map[string][]NicAddress{}, errVMEmptyID
+	}
+
+	res, err := serverClient.GetVMAddresses(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return map[string][]NicAddress{}, fmt.Errorf("unable to get VM addresses: %w", err)
+	}
+
+	addresses := make(map[string][]NicAddress)
+
+	for {
+		address, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return map[string][]NicAddress{}, fmt.Errorf("unable to get VM addresses: %w", err)
+		}
+
+		addresses[address.GetNicId()] = append(addresses[address.GetNicId()], NicAddress{
+			Address:  address.GetAddress(),
+			Source:   address.GetSource(),
+			LastSeen: address.GetLastSeen().AsTime(),
+		})
+	}
+
+	return addresses, nil
+}
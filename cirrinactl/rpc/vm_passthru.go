@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cirrina/cirrina"
+)
+
+func AddVMPassthru(ctx context.Context, vmID string, device string) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	if device == "" {
+		return errPassthruEmptyDevice
+	}
+
+	res, err := serverClient.AddVMPassthru(ctx, &cirrina.VMPassthruReq{
+		Vmid:   &cirrina.VMID{Value: vmID},
+		Device: device,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to add VM passthru device: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+func GetVMPassthrus(ctx context.Context, vmID string) ([]string, error) {
+	if vmID == "" {
+		return []string{}, errVMEmptyID
+	}
+
+	res, err := serverClient.ListVMPassthrus(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return []string{}, fmt.Errorf("unable to get VM passthru devices: %w", err)
+	}
+
+	var devices []string
+
+	for {
+		passthru, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []string{}, fmt.Errorf("unable to get VM passthru devices: %w", err)
+		}
+
+		devices = append(devices, passthru.GetDevice())
+	}
+
+	return devices, nil
+}
+
+func RmVMPassthru(ctx context.Context, vmID string, device string) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	if device == "" {
+		return errPassthruEmptyDevice
+	}
+
+	res, err := serverClient.RemoveVMPassthru(ctx, &cirrina.VMPassthruReq{
+		Vmid:   &cirrina.VMID{Value: vmID},
+		Device: device,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to remove VM passthru device: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
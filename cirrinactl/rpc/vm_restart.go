@@ -0,0 +1,46 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cirrina/cirrina"
+)
+
+// GetVMRestartHistory returns the recorded exits and restarts of a VM, oldest first
+func GetVMRestartHistory(ctx context.Context, vmID string) ([]VMRestartInfo, error) {
+	if vmID == "" {
+		return []VMRestartInfo{}, errVMEmptyID
+	}
+
+	res, err := serverClient.GetVMRestartHistory(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return []VMRestartInfo{}, fmt.Errorf("unable to get VM restart history: %w", err)
+	}
+
+	var restarts []VMRestartInfo
+
+	for {
+		record, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []VMRestartInfo{}, fmt.Errorf("unable to get VM restart history: %w", err)
+		}
+
+		restarts = append(restarts, VMRestartInfo{
+			Time:      record.GetTime().AsTime(),
+			ExitCode:  record.GetExitCode(),
+			Reason:    record.GetReason(),
+			Restarted: record.GetRestarted(),
+			Delay:     record.GetDelay(),
+			CrashLoop: record.GetCrashLoop(),
+		})
+	}
+
+	return restarts, nil
+}
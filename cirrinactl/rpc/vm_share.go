@@ -0,0 +1,92 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cirrina/cirrina"
+)
+
+func AddVMShare(ctx context.Context, vmID string, share VMShareInfo) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	if share.Name == "" {
+		return errShareEmptyName
+	}
+
+	res, err := serverClient.AddVMShare(ctx, &cirrina.VMShareInfo{
+		Vmid:     &cirrina.VMID{Value: vmID},
+		Name:     share.Name,
+		Path:     share.Path,
+		ReadOnly: share.ReadOnly,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to add VM share: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+func GetVMShares(ctx context.Context, vmID string) ([]VMShareInfo, error) {
+	if vmID == "" {
+		return []VMShareInfo{}, errVMEmptyID
+	}
+
+	res, err := serverClient.ListVMShares(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return []VMShareInfo{}, fmt.Errorf("unable to get VM shares: %w", err)
+	}
+
+	var shares []VMShareInfo
+
+	for {
+		share, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []VMShareInfo{}, fmt.Errorf("unable to get VM shares: %w", err)
+		}
+
+		shares = append(shares, VMShareInfo{
+			Name:     share.GetName(),
+			Path:     share.GetPath(),
+			ReadOnly: share.GetReadOnly(),
+		})
+	}
+
+	return shares, nil
+}
+
+func RmVMShare(ctx context.Context, vmID string, name string) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	if name == "" {
+		return errShareEmptyName
+	}
+
+	res, err := serverClient.RemoveVMShare(ctx, &cirrina.VMShareReq{
+		Vmid: &cirrina.VMID{Value: vmID},
+		Name: name,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to remove VM share: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
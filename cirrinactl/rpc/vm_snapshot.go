@@ -0,0 +1,129 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cirrina/cirrina"
+)
+
+func CreateVMSnapshot(ctx context.Context, vmID string, snapshotName string, snapshotDesc string) (string, error) {
+	if vmID == "" {
+		return "", errVMEmptyID
+	}
+
+	if snapshotName == "" {
+		return "", errSnapshotEmptyName
+	}
+
+	reqID, err := serverClient.CreateVMSnapshot(ctx, &cirrina.VMSnapshotInfo{
+		VmId:        &vmID,
+		Name:        &snapshotName,
+		Description: &snapshotDesc,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to create VM snapshot: %w", err)
+	}
+
+	return reqID.GetValue(), nil
+}
+
+func GetVMSnapshots(ctx context.Context, vmID string) ([]VMSnapshotInfo, error) {
+	if vmID == "" {
+		return []VMSnapshotInfo{}, errVMEmptyID
+	}
+
+	res, err := serverClient.ListVMSnapshots(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return []VMSnapshotInfo{}, fmt.Errorf("unable to get VM snapshots: %w", err)
+	}
+
+	var snapshots []VMSnapshotInfo
+
+	for {
+		snapshot, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []VMSnapshotInfo{}, fmt.Errorf("unable to get VM snapshots: %w", err)
+		}
+
+		snapshots = append(snapshots, VMSnapshotInfo{
+			ID:              snapshot.GetId(),
+			Name:            snapshot.GetName(),
+			Descr:           snapshot.GetDescription(),
+			Created:         snapshot.GetCreated().AsTime(),
+			DiskSnapshotIDs: snapshot.GetDiskSnapshotIds(),
+			UEFIVars:        snapshot.GetUefiVars(),
+		})
+	}
+
+	return snapshots, nil
+}
+
+func VMSnapshotNameToID(ctx context.Context, vmID string, snapshotName string) (string, error) {
+	if snapshotName == "" {
+		return "", errSnapshotEmptyName
+	}
+
+	snapshots, err := GetVMSnapshots(ctx, vmID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, snapshot := range snapshots {
+		if snapshot.Name == snapshotName {
+			return snapshot.ID, nil
+		}
+	}
+
+	return "", ErrNotFound
+}
+
+func RestoreVMSnapshot(ctx context.Context, vmID string, snapshotID string) (string, error) {
+	if vmID == "" {
+		return "", errVMEmptyID
+	}
+
+	if snapshotID == "" {
+		return "", errSnapshotEmptyID
+	}
+
+	reqID, err := serverClient.RestoreVMSnapshot(ctx, &cirrina.VMSnapshotReq{
+		Vmid:       &cirrina.VMID{Value: vmID},
+		Snapshotid: &cirrina.VMSnapshotId{Value: snapshotID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to restore VM snapshot: %w", err)
+	}
+
+	return reqID.GetValue(), nil
+}
+
+func RmVMSnapshot(ctx context.Context, vmID string, snapshotID string) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	if snapshotID == "" {
+		return errSnapshotEmptyID
+	}
+
+	res, err := serverClient.DeleteVMSnapshot(ctx, &cirrina.VMSnapshotReq{
+		Vmid:       &cirrina.VMID{Value: vmID},
+		Snapshotid: &cirrina.VMSnapshotId{Value: snapshotID},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to remove VM snapshot: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"cirrina/cirrina"
+)
+
+func GetVMUEFIBootEntries(ctx context.Context, vmID string) ([]VMUEFIBootEntryInfo, error) {
+	if vmID == "" {
+		return []VMUEFIBootEntryInfo{}, errVMEmptyID
+	}
+
+	res, err := serverClient.ListVMUEFIBootEntries(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return []VMUEFIBootEntryInfo{}, fmt.Errorf("unable to get VM UEFI boot entries: %w", err)
+	}
+
+	var entries []VMUEFIBootEntryInfo
+
+	for {
+		entry, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []VMUEFIBootEntryInfo{}, fmt.Errorf("unable to get VM UEFI boot entries: %w", err)
+		}
+
+		entries = append(entries, VMUEFIBootEntryInfo{
+			Number:      uint16(entry.GetNumber()),
+			Description: entry.GetDescription(),
+			Active:      entry.GetActive(),
+			DevicePath:  entry.GetDevicePath(),
+		})
+	}
+
+	return entries, nil
+}
+
+// GetVMUEFIBootOrder returns the VM's UEFI boot order and the entry it boots next time only, or nil if none is set
+func GetVMUEFIBootOrder(ctx context.Context, vmID string) ([]uint16, *uint16, error) {
+	if vmID == "" {
+		return []uint16{}, nil, errVMEmptyID
+	}
+
+	res, err := serverClient.GetVMUEFIBootOrder(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return []uint16{}, nil, fmt.Errorf("unable to get VM UEFI boot order: %w", err)
+	}
+
+	order := make([]uint16, 0, len(res.GetBootOrder()))
+
+	for _, number := range res.GetBootOrder() {
+		order = append(order, uint16(number))
+	}
+
+	var next *uint16
+
+	if res.BootNext != nil {
+		bootNext := uint16(res.GetBootNext())
+		next = &bootNext
+	}
+
+	return order, next, nil
+}
+
+func SetVMUEFIBootOrder(ctx context.Context, vmID string, order []uint16) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	orderReq := cirrina.VMUEFIBootOrderReq{Vmid: &cirrina.VMID{Value: vmID}}
+
+	for _, number := range order {
+		orderReq.BootOrder = append(orderReq.BootOrder, uint32(number))
+	}
+
+	res, err := serverClient.SetVMUEFIBootOrder(ctx, &orderReq)
+	if err != nil {
+		return fmt.Errorf("unable to set VM UEFI boot order: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+// SetVMUEFIBootNext sets the entry the VM boots next time only, nil clears it
+func SetVMUEFIBootNext(ctx context.Context, vmID string, next *uint16) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	nextReq := cirrina.VMUEFIBootNextReq{Vmid: &cirrina.VMID{Value: vmID}}
+
+	if next != nil {
+		bootNext := uint32(*next)
+		nextReq.BootNext = &bootNext
+	}
+
+	res, err := serverClient.SetVMUEFIBootNext(ctx, &nextReq)
+	if err != nil {
+		return fmt.Errorf("unable to set VM UEFI boot next: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+// VMBootISOOnce makes the VM boot an ISO the next time it starts, its first ISO if isoID is empty
+func VMBootISOOnce(ctx context.Context, vmID string, isoID string) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	res, err := serverClient.BootVMFromISOOnce(ctx, &cirrina.VMUEFIBootISOReq{
+		Vmid:  &cirrina.VMID{Value: vmID},
+		IsoId: &cirrina.ISOID{Value: isoID},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set VM to boot ISO once: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+func GetVMUEFIVars(ctx context.Context, vmID string) ([]byte, error) {
+	if vmID == "" {
+		return nil, errVMEmptyID
+	}
+
+	res, err := serverClient.GetVMUEFIVars(ctx, &cirrina.VMID{Value: vmID})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get VM UEFI vars: %w", err)
+	}
+
+	return res.GetData(), nil
+}
+
+func SetVMUEFIVars(ctx context.Context, vmID string, data []byte) error {
+	if vmID == "" {
+		return errVMEmptyID
+	}
+
+	res, err := serverClient.SetVMUEFIVars(ctx, &cirrina.VMUEFIVars{
+		Vmid: &cirrina.VMID{Value: vmID},
+		Data: data,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set VM UEFI vars: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"cirrina/cirrina"
+)
+
+func AddWebhook(ctx context.Context, webhookInfo WebhookInfo) (string, error) {
+	if webhookInfo.Name == "" {
+		return "", errWebhookEmptyName
+	}
+
+	res, err := serverClient.AddWebhook(ctx, &cirrina.WebhookInfo{
+		Name:        &webhookInfo.Name,
+		Description: &webhookInfo.Descr,
+		Url:         &webhookInfo.URL,
+		Secret:      &webhookInfo.Secret,
+		Events:      &webhookInfo.Events,
+		MaxRetries:  &webhookInfo.MaxRetries,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to add webhook: %w", err)
+	}
+
+	return res.GetValue(), nil
+}
+
+func GetWebhooks(ctx context.Context) ([]WebhookInfo, error) {
+	res, err := serverClient.ListWebhooks(ctx, &emptypb.Empty{})
+	if err != nil {
+		return []WebhookInfo{}, fmt.Errorf("unable to get webhooks: %w", err)
+	}
+
+	var webhooks []WebhookInfo
+
+	for {
+		aWebhook, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []WebhookInfo{}, fmt.Errorf("unable to get webhooks: %w", err)
+		}
+
+		webhooks = append(webhooks, WebhookInfo{
+			ID:         aWebhook.GetId(),
+			Name:       aWebhook.GetName(),
+			Descr:      aWebhook.GetDescription(),
+			URL:        aWebhook.GetUrl(),
+			Events:     aWebhook.GetEvents(),
+			MaxRetries: aWebhook.GetMaxRetries(),
+		})
+	}
+
+	return webhooks, nil
+}
+
+func WebhookNameToID(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		return "", errWebhookEmptyName
+	}
+
+	webhooks, err := GetWebhooks(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, aWebhook := range webhooks {
+		if aWebhook.Name == name {
+			return aWebhook.ID, nil
+		}
+	}
+
+	return "", ErrNotFound
+}
+
+func RemoveWebhook(ctx context.Context, webhookID string) error {
+	if webhookID == "" {
+		return errWebhookEmptyID
+	}
+
+	res, err := serverClient.RemoveWebhook(ctx, &cirrina.WebhookId{Value: webhookID})
+	if err != nil {
+		return fmt.Errorf("unable to remove webhook: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+func TestWebhook(ctx context.Context, webhookID string) error {
+	if webhookID == "" {
+		return errWebhookEmptyID
+	}
+
+	res, err := serverClient.TestWebhook(ctx, &cirrina.WebhookId{Value: webhookID})
+	if err != nil {
+		return fmt.Errorf("unable to test webhook: %w", err)
+	}
+
+	if !res.GetSuccess() {
+		return errReqFailed
+	}
+
+	return nil
+}
+
+func GetWebhookDeliveries(ctx context.Context, webhookID string) ([]WebhookDelivery, error) {
+	if webhookID == "" {
+		return []WebhookDelivery{}, errWebhookEmptyID
+	}
+
+	res, err := serverClient.GetWebhookDeliveries(ctx, &cirrina.WebhookId{Value: webhookID})
+	if err != nil {
+		return []WebhookDelivery{}, fmt.Errorf("unable to get webhook deliveries: %w", err)
+	}
+
+	var deliveries []WebhookDelivery
+
+	for {
+		aDelivery, err := res.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return []WebhookDelivery{}, fmt.Errorf("unable to get webhook deliveries: %w", err)
+		}
+
+		deliveries = append(deliveries, WebhookDelivery{
+			Time:       aDelivery.GetTime().AsTime(),
+			EventID:    aDelivery.GetEventId(),
+			Event:      aDelivery.GetEvent(),
+			Attempts:   aDelivery.GetAttempts(),
+			StatusCode: aDelivery.GetStatusCode(),
+			Success:    aDelivery.GetSuccess(),
+			Error:      aDelivery.GetError(),
+		})
+	}
+
+	return deliveries, nil
+}
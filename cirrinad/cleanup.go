@@ -11,6 +11,7 @@ import (
 	_switch "cirrina/cirrinad/switch"
 	"cirrina/cirrinad/util"
 	"cirrina/cirrinad/vm"
+	"cirrina/cirrinad/vmnic"
 )
 
 // cleanupVms checks for leftover VMs and ensures they are killed and marked as stopped in the DB
@@ -19,12 +20,17 @@ import (
 // * When the host was not properly shut down and there are leftover pid files and the DB status is wrong
 // In the first case, we have to kill VMs, remove pid files and update the DB
 // In the second case, we only have to remove pid files and update the DB
+// When keepvms is set, VMs left running by the previous cirrinad are re-attached instead of killed
 func cleanupVms() error {
 	var err error
 
 	vmList := vm.GetAll()
 
 	for _, aVM := range vmList {
+		if config.Config.Sys.KeepVMs && aVM.Adopt() {
+			continue
+		}
+
 		var pidStat bool
 		if aVM.BhyvePid > 0 {
 			pidStat, err = util.PidExists(int(aVM.BhyvePid))
@@ -54,6 +60,10 @@ func cleanupNet() error {
 	vmList := vm.GetAll()
 
 	for _, aVM := range vmList {
+		if aVM.Adopted() {
+			continue
+		}
+
 		slog.Debug("cleaning up VM net(s)", "name", aVM.Name)
 		aVM.NetStop()
 
@@ -65,8 +75,11 @@ func cleanupNet() error {
 		}
 	}
 
+	// the switches and interfaces in use by re-attached VMs are kept
+	keepInterfaces, keepSwitches := adoptedVMNets(vmList)
+
 	// destroy all the bridges we know about
-	err = _switch.DestroySwitches()
+	err = _switch.DestroySwitchesExcept(keepSwitches)
 	if err != nil {
 		slog.Error("error destroying switches", "err", err)
 		panic(err)
@@ -87,7 +100,7 @@ func cleanupNet() error {
 			slog.Error("failed to get interface groups", "err", err)
 		}
 
-		if !util.ContainsStr(intGroups, "cirrinad") {
+		if !util.ContainsStr(intGroups, "cirrinad") || keepInterfaces[inter.Name] {
 			continue
 		}
 
@@ -103,6 +116,63 @@ func cleanupNet() error {
 	return nil
 }
 
+// adoptedVMNets returns the host interfaces and the IDs of the switches used by the nics of re-attached VMs
+func adoptedVMNets(vmList []*vm.VM) (map[string]bool, map[string]bool) {
+	interfaces := make(map[string]bool)
+	switches := make(map[string]bool)
+
+	for _, aVM := range vmList {
+		if !aVM.Adopted() {
+			continue
+		}
+
+		vmNics, err := vmnic.GetNics(aVM.Config.ID)
+		if err != nil {
+			slog.Error("error getting re-attached VM nics", "vm", aVM.Name, "err", err)
+
+			continue
+		}
+
+		for _, vmNic := range vmNics {
+			for _, name := range []string{vmNic.NetDev, vmNic.InstBridge} {
+				if name != "" {
+					interfaces[name] = true
+				}
+			}
+
+			if vmNic.InstEpair != "" {
+				interfaces[vmNic.InstEpair+"a"] = true
+				interfaces[vmNic.InstEpair+"b"] = true
+			}
+
+			if vmNic.SwitchID == "" {
+				continue
+			}
+
+			switches[vmNic.SwitchID] = true
+
+			nicSwitch, err := _switch.GetByID(vmNic.SwitchID)
+			if err == nil {
+				interfaces[nicSwitch.Name] = true
+			}
+		}
+	}
+
+	return interfaces, switches
+}
+
+// reattachVMNets reconnects the nics of re-attached VMs to their switches after the switches are created
+func reattachVMNets() {
+	for _, aVM := range vm.GetAll() {
+		if !aVM.Adopted() {
+			continue
+		}
+
+		slog.Debug("reconnecting VM net(s)", "name", aVM.Name)
+		aVM.NetReattach()
+	}
+}
+
 func cleanupDB() {
 	rowsCleared := requests.FailAllPending()
 	slog.Debug("cleared failed requests", "rowsCleared", rowsCleared)
@@ -2,8 +2,10 @@ package config
 
 type Info struct {
 	Sys struct {
-		Sudo        string
-		PidFilePath string
+		Sudo            string
+		PidFilePath     string
+		ShutdownTimeout uint32 `default:"600"`   // seconds to wait for VMs to stop before powering them off
+		KeepVMs         bool   `default:"false"` // leave VMs running on exit and re-attach to them on startup
 	}
 	DB struct {
 		Path string
@@ -18,7 +20,8 @@ type Info struct {
 			}
 		}
 		Default struct {
-			Size string `default:"1g"`
+			Size  string `default:"1g"`
+			Purge bool   `default:"false"` // remove backing storage when disks and ISOs are deleted
 		}
 	}
 	Log struct {
@@ -41,6 +44,7 @@ type Info struct {
 		Vars struct {
 			Template string
 		}
+		Firmware []Firmware // firmware VMs may choose other than the default ROM above
 	}
 	Vnc struct {
 		// TODO separate settings for IPv4 and IPv6 IP
@@ -52,6 +56,14 @@ type Info struct {
 		IP   string `default:"0.0.0.0"`
 		Port uint16 `default:"2828"`
 	}
+	Hooks struct {
+		// scripts run around the lifecycle of every VM, before the hooks of the VM itself
+		PreStart  string
+		PostStart string
+		PreStop   string
+		PostStop  string
+		Timeout   uint32 `default:"60"` // seconds each hook may run before it is killed
+	}
 	Metrics struct {
 		Enabled bool   `default:"false"`
 		Host    string `default:""`
@@ -59,4 +71,14 @@ type Info struct {
 	}
 }
 
+// Firmware is a named boot ROM, with the template VM UEFI vars files are created from. ROMs which keep no variables
+// leave the template empty.
+type Firmware struct {
+	Name string
+	Path string
+	Vars struct {
+		Template string
+	}
+}
+
 var Config Info
@@ -91,6 +91,12 @@ func DBAutoMigrate() {
 		panic("failed to auto-migrate disk, err: " + err.Error())
 	}
 
+	err = diskDB.AutoMigrate(&Snapshot{})
+	if err != nil {
+		slog.Error("failed to auto-migrate disk snapshot table", "err", err)
+		panic("failed to auto-migrate disk snapshot, err: " + err.Error())
+	}
+
 	err = diskDB.Migrator().DropColumn(&Disk{}, "path")
 	if err != nil {
 		slog.Error("DiskDb DBAutoMigrate failed to drop path column, continuing anyway")
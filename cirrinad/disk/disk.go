@@ -38,10 +38,16 @@ type InfoServicer interface {
 	GetSize(name string) (uint64, error)
 	GetUsage(name string) (uint64, error)
 	SetSize(name string, newSize uint64) error
+	Resize(name string, newSize uint64, force bool) error
 	Exists(name string) (bool, error)
 	Create(name string, size uint64) error
 	GetAll() ([]string, error)
 	RemoveBacking(targetDisk *Disk) error
+	DestroyBacking(targetDisk *Disk) error
+	CreateSnapshot(targetDisk *Disk, snapshotID string) error
+	RollbackSnapshot(targetDisk *Disk, snapshotID string) error
+	RemoveSnapshot(targetDisk *Disk, snapshotID string) error
+	SnapshotExists(targetDisk *Disk, snapshotID string) (bool, error)
 }
 
 var List = &ListType{
@@ -197,6 +203,13 @@ func Create(diskInst *Disk, size string) error {
 		return fmt.Errorf("error creating disk: %w", err)
 	}
 
+	return diskInst.insert()
+}
+
+// insert adds the disk to the database and the disk list, the backing must already exist
+func (d *Disk) insert() error {
+	diskInst := d
+
 	db := getDiskDBFunc()
 
 	res := db.Create(&diskInst)
@@ -255,8 +268,9 @@ func GetByName(name string) (*Disk, error) {
 func (d *Disk) Delete() error {
 	diskDB := GetDiskDB()
 
-	if d.InUse() {
-		return ErrDiskInUse
+	err := d.checkRemovable()
+	if err != nil {
+		return err
 	}
 
 	res := diskDB.Limit(1).Unscoped().Delete(&d)
@@ -269,7 +283,45 @@ func (d *Disk) Delete() error {
 
 	delete(List.DiskList, d.ID)
 
-	// TODO actually delete data from disk, maybe?
+	return nil
+}
+
+// Purge permanently removes the file or zvol backing the disk, it is meant to be called before Delete() so that a
+// failure leaves the disk in place to retry with
+func (d *Disk) Purge() error {
+	err := d.checkRemovable()
+	if err != nil {
+		return err
+	}
+
+	diskService, err := d.getService()
+	if err != nil {
+		return err
+	}
+
+	err = diskService.DestroyBacking(d)
+	if err != nil {
+		return fmt.Errorf("error purging disk: %w", err)
+	}
+
+	return nil
+}
+
+// checkRemovable returns an error if the disk is attached to a VM or has snapshots
+func (d *Disk) checkRemovable() error {
+	if d.InUse() {
+		return ErrDiskInUse
+	}
+
+	snapshots, err := d.GetSnapshots()
+	if err != nil {
+		return err
+	}
+
+	if len(snapshots) > 0 {
+		return ErrDiskHasSnapshots
+	}
+
 	return nil
 }
 
@@ -333,6 +385,22 @@ func (d *Disk) Save() error {
 	return nil
 }
 
+// Resize changes the size of the disk backing, shrinking it requires force as it destroys data. Callers must
+// ensure the disk is not in use by a running VM.
+func (d *Disk) Resize(newSize uint64, force bool) error {
+	diskService, err := d.getService()
+	if err != nil {
+		return err
+	}
+
+	err = diskService.Resize(d.GetPath(), newSize, force)
+	if err != nil {
+		return fmt.Errorf("error resizing disk: %w", err)
+	}
+
+	return nil
+}
+
 // GetPath return path to disk to use with bhyve -- either full disk path for file
 // or zvol name
 func (d *Disk) GetPath() string {
@@ -450,7 +518,8 @@ func CheckAll() {
 		}
 	}
 
-	checkLeftoversFile()
-
-	checkLeftoversZvol()
+	for _, anOrphan := range GetOrphans() {
+		slog.Warn("possible left over disk", "disk.Name", anOrphan.Name, "disk.DevType", anOrphan.DevType,
+			"path", anOrphan.Path)
+	}
 }
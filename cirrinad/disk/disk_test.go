@@ -944,6 +944,8 @@ func TestDisk_Save(t *testing.T) {
 }
 
 func TestDelete(t *testing.T) {
+	createUpdateTime := time.Now()
+
 	type args struct {
 		diskInst *Disk
 	}
@@ -985,6 +987,16 @@ func TestDelete(t *testing.T) {
 					WithArgs("e89be82f-25c7-42b9-823a-df432e64320e").
 					WillReturnRows(sqlmock.NewRows([]string{"vm_id", "disk_id", "position"}))
 
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `disk_snapshots` WHERE disk_id = ? AND " +
+							"`disk_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("e89be82f-25c7-42b9-823a-df432e64320e").
+					WillReturnRows(sqlmock.NewRows(
+						[]string{"id", "created_at", "updated_at", "deleted_at", "disk_id", "name", "description"},
+					))
+
 				mock.ExpectBegin()
 				mock.ExpectExec(
 					regexp.QuoteMeta("DELETE FROM `disks` WHERE `disks`.`id` = ?"),
@@ -1040,6 +1052,44 @@ func TestDelete(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "DiskHasSnapshots",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					DiskDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT vm_id,disk_id,position FROM `vm_disks` WHERE disk_id LIKE ? LIMIT 1"),
+				).
+					WithArgs("e89be82f-25c7-42b9-823a-df432e64320e").
+					WillReturnRows(sqlmock.NewRows([]string{"vm_id", "disk_id", "position"}))
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `disk_snapshots` WHERE disk_id = ? AND " +
+							"`disk_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("e89be82f-25c7-42b9-823a-df432e64320e").
+					WillReturnRows(sqlmock.NewRows(
+						[]string{"id", "created_at", "updated_at", "deleted_at", "disk_id", "name", "description"},
+					).
+						AddRow(
+							"5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10",
+							createUpdateTime,
+							createUpdateTime,
+							nil,
+							"e89be82f-25c7-42b9-823a-df432e64320e",
+							"before-upgrade",
+							"a snapshot",
+						))
+			},
+			args: args{
+				diskInst: &Disk{ID: "e89be82f-25c7-42b9-823a-df432e64320e"},
+			},
+			wantErr: true,
+		},
 		{
 			name: "error1",
 			mockClosure: func(_ *gorm.DB, _ sqlmock.Sqlmock) {
@@ -1080,6 +1130,16 @@ func TestDelete(t *testing.T) {
 					WithArgs("2e1f3582-7bc1-4a7a-beeb-1651e371435e").
 					WillReturnRows(sqlmock.NewRows([]string{"vm_id", "disk_id", "position"}))
 
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `disk_snapshots` WHERE disk_id = ? AND " +
+							"`disk_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("2e1f3582-7bc1-4a7a-beeb-1651e371435e").
+					WillReturnRows(sqlmock.NewRows(
+						[]string{"id", "created_at", "updated_at", "deleted_at", "disk_id", "name", "description"},
+					))
+
 				mock.ExpectBegin()
 				mock.ExpectExec(
 					regexp.QuoteMeta("DELETE FROM `disks` WHERE `disks`.`id` = ?"),
@@ -1124,6 +1184,16 @@ func TestDelete(t *testing.T) {
 					WithArgs("e89be82f-25c7-42b9-823a-df432e64320e").
 					WillReturnRows(sqlmock.NewRows([]string{"vm_id", "disk_id", "position"}))
 
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `disk_snapshots` WHERE disk_id = ? AND " +
+							"`disk_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("e89be82f-25c7-42b9-823a-df432e64320e").
+					WillReturnRows(sqlmock.NewRows(
+						[]string{"id", "created_at", "updated_at", "deleted_at", "disk_id", "name", "description"},
+					))
+
 				mock.ExpectBegin()
 				mock.ExpectExec(
 					regexp.QuoteMeta("DELETE FROM `disks` WHERE `disks`.`id` = ?"),
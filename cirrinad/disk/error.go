@@ -14,4 +14,9 @@ var (
 	errDiskShrinkage          = errors.New("new disk smaller than current disk")
 	errDiskDupe               = errors.New("duplicate disk found")
 	ErrDiskInUse              = errors.New("disk in use")
+	ErrDiskHasSnapshots       = errors.New("disk has snapshots")
+	errSnapshotInvalidName    = errors.New("invalid snapshot name")
+	errSnapshotExists         = errors.New("snapshot exists")
+	errSnapshotNotFound       = errors.New("snapshot not found")
+	errOrphanNotFound         = errors.New("orphaned disk storage not found")
 )
@@ -8,8 +8,8 @@ import (
 	"log/slog"
 	"os"
 	"os/user"
+	"path/filepath"
 	"strconv"
-	"strings"
 	"syscall"
 
 	"github.com/spf13/cast"
@@ -24,6 +24,7 @@ type FileInfoFetcher interface {
 	FetchFileSize(name string) (uint64, error)
 	FetchFileUsage(name string) (uint64, error)
 	ApplyFileSize(volumeName string, volSize uint64) error
+	TruncateFile(name string, newSize uint64) error
 	FetchAll() ([]string, error)
 }
 
@@ -103,6 +104,30 @@ func (n FileInfoService) SetSize(name string, newSize uint64) error {
 	return nil
 }
 
+// Resize sets the size of the disk file. Shrinking the file destroys data at the end of it and is refused unless
+// force is set.
+func (n FileInfoService) Resize(name string, newSize uint64, force bool) error {
+	if !force {
+		return n.SetSize(name, newSize)
+	}
+
+	curSize, err := n.FileInfoImpl.FetchFileSize(name)
+	if err != nil {
+		return fmt.Errorf("failed checking disk: %w", err)
+	}
+
+	if newSize >= curSize {
+		return n.SetSize(name, newSize)
+	}
+
+	err = n.FileInfoImpl.TruncateFile(name, newSize)
+	if err != nil {
+		return fmt.Errorf("failed shrinking disk: %w", err)
+	}
+
+	return nil
+}
+
 func (n FileInfoService) Exists(name string) (bool, error) {
 	exists, err := n.FileInfoImpl.CheckExists(name)
 	if err != nil {
@@ -201,6 +226,16 @@ func (f FileInfoCmds) ApplyFileSize(name string, newSize uint64) error {
 		return nil // nothing to do
 	}
 
+	return truncateFile(name, newSize)
+}
+
+// TruncateFile sets the size of the disk file without the checks ApplyFileSize makes, shrinking it if asked to
+func (f FileInfoCmds) TruncateFile(name string, newSize uint64) error {
+	return truncateFile(name, newSize)
+}
+
+// truncateFile sets the size of a disk file, growing it sparsely or cutting data off the end of it
+func truncateFile(name string, newSize uint64) error {
 	stdOutBytes, stdErrBytes, returnCode, err := util.RunCmd(
 		config.Config.Sys.Sudo,
 		[]string{"/usr/bin/truncate", "-s", strconv.FormatUint(newSize, 10), name},
@@ -298,25 +333,63 @@ func (n FileInfoService) RemoveBacking(targetDisk *Disk) error {
 	return nil
 }
 
-func checkLeftoversFile() {
-	diskFiles, err := os.ReadDir(config.Config.Disk.VM.Path.Image)
+// DestroyBacking removes the disk image, for files this is the same as RemoveBacking
+func (n FileInfoService) DestroyBacking(targetDisk *Disk) error {
+	return n.RemoveBacking(targetDisk)
+}
+
+// CreateSnapshot copies the disk image into the snapshot directory. cp(1) uses copy_file_range(2), so file systems
+// which support block cloning will share blocks with the disk image rather than duplicating them.
+func (n FileInfoService) CreateSnapshot(targetDisk *Disk, snapshotID string) error {
+	snapshotPath := getSnapshotFilePath(targetDisk, snapshotID)
+
+	err := os.MkdirAll(filepath.Dir(snapshotPath), 0o755)
+	if err != nil {
+		return fmt.Errorf("error creating snapshot dir: %w", err)
+	}
+
+	return copyDiskFile(targetDisk.GetPath(), snapshotPath)
+}
+
+func (n FileInfoService) RollbackSnapshot(targetDisk *Disk, snapshotID string) error {
+	return copyDiskFile(getSnapshotFilePath(targetDisk, snapshotID), targetDisk.GetPath())
+}
+
+func (n FileInfoService) RemoveSnapshot(targetDisk *Disk, snapshotID string) error {
+	err := os.Remove(getSnapshotFilePath(targetDisk, snapshotID))
 	if err != nil {
-		slog.Error("failed checking disks", "err", err)
+		return fmt.Errorf("error removing snapshot: %w", err)
+	}
 
-		return
+	return nil
+}
+
+func (n FileInfoService) SnapshotExists(targetDisk *Disk, snapshotID string) (bool, error) {
+	exists, err := PathExistsFunc(getSnapshotFilePath(targetDisk, snapshotID))
+	if err != nil {
+		return true, fmt.Errorf("error checking snapshot exists: %w", err)
 	}
 
-	for _, aDiskFile := range diskFiles {
-		if !strings.HasSuffix(aDiskFile.Name(), ".img") {
-			continue
-		}
+	return exists, nil
+}
 
-		diskName := strings.TrimSuffix(aDiskFile.Name(), ".img")
-		if util.ValidDiskName(diskName) {
-			_, err = GetByName(diskName)
-			if err != nil {
-				slog.Warn("possible left over disk (file)", "disk.Name", diskName, "file.Name", aDiskFile.Name())
-			}
-		}
+func copyDiskFile(src string, dst string) error {
+	stdOutBytes, stdErrBytes, returnCode, err := util.RunCmd(
+		config.Config.Sys.Sudo,
+		[]string{"/bin/cp", "-p", src, dst},
+	)
+	if err != nil || returnCode != 0 {
+		slog.Error("failed to copy disk file",
+			"src", src,
+			"dst", dst,
+			"stdOutBytes", stdOutBytes,
+			"stdErrBytes", stdErrBytes,
+			"returnCode", returnCode,
+			"err", err,
+		)
+
+		return fmt.Errorf("error copying disk file: %w", err)
 	}
+
+	return nil
 }
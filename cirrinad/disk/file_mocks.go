@@ -128,6 +128,20 @@ func (mr *MockFileInfoFetcherMockRecorder) FetchFileUsage(name any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchFileUsage", reflect.TypeOf((*MockFileInfoFetcher)(nil).FetchFileUsage), name)
 }
 
+// TruncateFile mocks base method.
+func (m *MockFileInfoFetcher) TruncateFile(name string, newSize uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TruncateFile", name, newSize)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TruncateFile indicates an expected call of TruncateFile.
+func (mr *MockFileInfoFetcherMockRecorder) TruncateFile(name, newSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateFile", reflect.TypeOf((*MockFileInfoFetcher)(nil).TruncateFile), name, newSize)
+}
+
 // MockLocalFileSystem is a mock of LocalFileSystem interface.
 type MockLocalFileSystem struct {
 	ctrl     *gomock.Controller
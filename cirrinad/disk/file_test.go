@@ -237,6 +237,135 @@ func TestFileInfoService_SetSize(t *testing.T) {
 	}
 }
 
+//nolint:paralleltest
+func TestFileInfoService_Resize(t *testing.T) {
+	tests := []struct {
+		name           string
+		newSize        uint64
+		force          bool
+		curSize        uint64
+		curSizeErr     error
+		expectFetch    bool
+		expectApply    bool
+		applyErr       error
+		expectTruncate bool
+		truncateErr    error
+		wantErr        bool
+	}{
+		{
+			name:        "grow",
+			newSize:     2048,
+			force:       false,
+			expectApply: true,
+			wantErr:     false,
+		},
+		{
+			name:        "shrinkRefused",
+			newSize:     1024,
+			force:       false,
+			expectApply: true,
+			applyErr:    errDiskShrinkage,
+			wantErr:     true,
+		},
+		{
+			name:        "forceGrow",
+			newSize:     2048,
+			force:       true,
+			curSize:     1024,
+			expectFetch: true,
+			expectApply: true,
+			wantErr:     false,
+		},
+		{
+			name:           "forceShrink",
+			newSize:        1024,
+			force:          true,
+			curSize:        2048,
+			expectFetch:    true,
+			expectTruncate: true,
+			wantErr:        false,
+		},
+		{
+			name:           "forceShrinkTruncateError",
+			newSize:        1024,
+			force:          true,
+			curSize:        2048,
+			expectFetch:    true,
+			expectTruncate: true,
+			truncateErr:    errDiskNotFound,
+			wantErr:        true,
+		},
+		{
+			name:        "forceFetchError",
+			newSize:     1024,
+			force:       true,
+			curSizeErr:  errDiskNotFound,
+			expectFetch: true,
+			wantErr:     true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := NewMockFileInfoFetcher(ctrl)
+
+			if testCase.expectFetch {
+				mock.EXPECT().FetchFileSize("aDisk").Return(testCase.curSize, testCase.curSizeErr)
+			}
+
+			if testCase.expectApply {
+				mock.EXPECT().ApplyFileSize("aDisk", testCase.newSize).Return(testCase.applyErr)
+			}
+
+			if testCase.expectTruncate {
+				mock.EXPECT().TruncateFile("aDisk", testCase.newSize).Return(testCase.truncateErr)
+			}
+
+			fileInfoService := NewFileInfoService(mock)
+
+			err := fileInfoService.Resize("aDisk", testCase.newSize, testCase.force)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("Resize() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestFileInfoCmds_TruncateFile(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockCmdFunc string
+		wantErr     bool
+	}{
+		{
+			name:        "success",
+			mockCmdFunc: "TestFileInfoCmds_ApplyFileSizeSuccess1",
+			wantErr:     false,
+		},
+		{
+			name:        "fail",
+			mockCmdFunc: "TestFileInfoCmds_ApplyFileSizeFail1",
+			wantErr:     true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			fakeCommand := cirrinadtest.MakeFakeCommand(testCase.mockCmdFunc)
+			util.SetupTestCmd(fakeCommand)
+
+			t.Cleanup(func() { util.TearDownTestCmd() })
+
+			err := FileInfoCmds{}.TruncateFile("someDisk", 1048576)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("TruncateFile() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
 func TestFileInfoService_Exists(t *testing.T) {
 	type args struct {
 		name string
@@ -1009,3 +1138,12 @@ func TestFileInfoCmds_AddFail2(_ *testing.T) {
 
 	os.Exit(0)
 }
+
+//nolint:paralleltest
+func TestFileInfoCmds_ApplyFileSizeError(_ *testing.T) {
+	if !cirrinadtest.IsTestEnv() {
+		return
+	}
+
+	os.Exit(1)
+}
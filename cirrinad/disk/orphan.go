@@ -0,0 +1,182 @@
+package disk
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+
+	"cirrina/cirrinad/config"
+	"cirrina/cirrinad/util"
+)
+
+// Orphan is a disk image file or zvol in the configured storage location which has no disk in the database
+type Orphan struct {
+	Name    string
+	DevType string
+	Path    string
+	Size    uint64
+}
+
+// GetOrphans returns all disk image files and zvols which are not known to the database, errors listing either
+// type of storage are logged and the other type is still returned
+func GetOrphans() []Orphan {
+	orphans, err := getOrphanFiles(NewFileInfoService(FileInfoFetcherImpl))
+	if err != nil {
+		slog.Error("failed checking disk files", "err", err)
+	}
+
+	if config.Config.Disk.VM.Path.Zpool == "" {
+		return orphans
+	}
+
+	zvolOrphans, err := getOrphanZvols(NewZfsVolInfoService(ZfsInfoFetcherImpl))
+	if err != nil {
+		slog.Error("failed checking zvols", "err", err)
+	}
+
+	return append(orphans, zvolOrphans...)
+}
+
+// GetOrphan finds a single orphan by name and dev type
+func GetOrphan(name string, devType string) (Orphan, error) {
+	for _, anOrphan := range GetOrphans() {
+		if anOrphan.Name == name && anOrphan.DevType == devType {
+			return anOrphan, nil
+		}
+	}
+
+	return Orphan{}, errOrphanNotFound
+}
+
+func getOrphanFiles(diskService InfoServicer) ([]Orphan, error) {
+	fileNames, err := diskService.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("error listing disk files: %w", err)
+	}
+
+	var orphans []Orphan
+
+	for _, fileName := range fileNames {
+		if !strings.HasSuffix(fileName, ".img") {
+			continue
+		}
+
+		diskName := strings.TrimSuffix(fileName, ".img")
+		if !util.ValidDiskName(diskName) {
+			continue
+		}
+
+		_, err = GetByName(diskName)
+		if err == nil {
+			continue
+		}
+
+		orphan := Orphan{
+			Name:    diskName,
+			DevType: "FILE",
+			Path:    filepath.Join(config.Config.Disk.VM.Path.Image, fileName),
+		}
+
+		orphan.Size, err = diskService.GetSize(orphan.Path)
+		if err != nil {
+			slog.Error("error getting orphaned disk size", "path", orphan.Path, "err", err)
+		}
+
+		orphans = append(orphans, orphan)
+	}
+
+	return orphans, nil
+}
+
+func getOrphanZvols(diskService InfoServicer) ([]Orphan, error) {
+	volNames, err := diskService.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("error listing zvols: %w", err)
+	}
+
+	var orphans []Orphan
+
+	for _, volName := range volNames {
+		// match GetPath()
+		if !strings.HasPrefix(volName, config.Config.Disk.VM.Path.Zpool+"/") {
+			continue
+		}
+
+		diskName := strings.TrimPrefix(volName, config.Config.Disk.VM.Path.Zpool+"/")
+		if !util.ValidDiskName(diskName) {
+			continue
+		}
+
+		_, err = GetByName(diskName)
+		if err == nil {
+			continue
+		}
+
+		orphan := Orphan{
+			Name:    diskName,
+			DevType: "ZVOL",
+			Path:    volName,
+		}
+
+		orphan.Size, err = diskService.GetSize(orphan.Path)
+		if err != nil {
+			slog.Error("error getting orphaned zvol size", "path", orphan.Path, "err", err)
+		}
+
+		orphans = append(orphans, orphan)
+	}
+
+	return orphans, nil
+}
+
+// Adopt registers the orphaned storage as a new disk without touching its contents
+func (o Orphan) Adopt(description string, diskType string) (*Disk, error) {
+	diskInst := &Disk{
+		Name:        o.Name,
+		Description: description,
+		Type:        diskType,
+		DevType:     o.DevType,
+		DiskCache:   sql.NullBool{Bool: true, Valid: true},
+		DiskDirect:  sql.NullBool{Bool: false, Valid: true},
+	}
+
+	err := diskInst.validate()
+	if err != nil {
+		return nil, fmt.Errorf("error adopting disk: %w", err)
+	}
+
+	exists, err := diskExistsCacheDBFunc(diskInst)
+	if err != nil {
+		return nil, fmt.Errorf("error checking disk exists: %w", err)
+	}
+
+	if exists {
+		return nil, errDiskExists
+	}
+
+	err = diskInst.insert()
+	if err != nil {
+		return nil, fmt.Errorf("error adopting disk: %w", err)
+	}
+
+	return diskInst, nil
+}
+
+// Purge permanently destroys the orphaned file or zvol
+func (o Orphan) Purge() error {
+	orphanDisk := &Disk{Name: o.Name, DevType: o.DevType}
+
+	diskService, err := orphanDisk.getService()
+	if err != nil {
+		return err
+	}
+
+	err = diskService.DestroyBacking(orphanDisk)
+	if err != nil {
+		return fmt.Errorf("error purging orphaned disk: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package disk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-test/deep"
+	"go.uber.org/mock/gomock"
+
+	"cirrina/cirrinad/config"
+)
+
+//nolint:paralleltest
+func Test_getOrphanFiles(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockClosure func(mock *MockFileInfoFetcher)
+		want        []Orphan
+		wantErr     bool
+	}{
+		{
+			name: "success",
+			mockClosure: func(mock *MockFileInfoFetcher) {
+				mock.EXPECT().FetchAll().
+					Return([]string{"known.img", "leftover.img", "notadisk.txt", ".snapshots", "bad name.img"}, nil)
+				mock.EXPECT().FetchFileSize("/bhyve/disk/leftover.img").
+					Return(uint64(1073741824), nil)
+			},
+			want: []Orphan{
+				{
+					Name:    "leftover",
+					DevType: "FILE",
+					Path:    "/bhyve/disk/leftover.img",
+					Size:    1073741824,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "sizeError",
+			mockClosure: func(mock *MockFileInfoFetcher) {
+				mock.EXPECT().FetchAll().
+					Return([]string{"leftover.img"}, nil)
+				mock.EXPECT().FetchFileSize("/bhyve/disk/leftover.img").
+					Return(uint64(0), errors.New("some error")) //nolint:goerr113
+			},
+			want: []Orphan{
+				{
+					Name:    "leftover",
+					DevType: "FILE",
+					Path:    "/bhyve/disk/leftover.img",
+					Size:    0,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "listError",
+			mockClosure: func(mock *MockFileInfoFetcher) {
+				mock.EXPECT().FetchAll().
+					Return(nil, errors.New("some error")) //nolint:goerr113
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			config.Config.Disk.VM.Path.Image = "/bhyve/disk"
+
+			List.DiskList = map[string]*Disk{
+				"0d4a0338-0b68-4645-b99d-9cbb30df272d": {
+					ID:      "0d4a0338-0b68-4645-b99d-9cbb30df272d",
+					Name:    "known",
+					DevType: "FILE",
+				},
+			}
+
+			t.Cleanup(func() { List.DiskList = map[string]*Disk{} })
+
+			ctrl := gomock.NewController(t)
+			mock := NewMockFileInfoFetcher(ctrl)
+			testCase.mockClosure(mock)
+
+			got, err := getOrphanFiles(NewFileInfoService(mock))
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("getOrphanFiles() error = %v, wantErr %v", err, testCase.wantErr)
+
+				return
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func Test_getOrphanZvols(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockClosure func(mock *MockZfsVolInfoFetcher)
+		want        []Orphan
+		wantErr     bool
+	}{
+		{
+			name: "success",
+			mockClosure: func(mock *MockZfsVolInfoFetcher) {
+				mock.EXPECT().FetchAll().
+					Return([]string{"tank/known", "tank/leftover", "otherpool/leftover", "tank/nested/vol"}, nil)
+				mock.EXPECT().FetchZfsVolumeSize("tank/leftover").
+					Return(uint64(1073741824), nil)
+			},
+			want: []Orphan{
+				{
+					Name:    "leftover",
+					DevType: "ZVOL",
+					Path:    "tank/leftover",
+					Size:    1073741824,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "listError",
+			mockClosure: func(mock *MockZfsVolInfoFetcher) {
+				mock.EXPECT().FetchAll().
+					Return(nil, errors.New("some error")) //nolint:goerr113
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			config.Config.Disk.VM.Path.Zpool = "tank"
+
+			List.DiskList = map[string]*Disk{
+				"0d4a0338-0b68-4645-b99d-9cbb30df272d": {
+					ID:      "0d4a0338-0b68-4645-b99d-9cbb30df272d",
+					Name:    "known",
+					DevType: "ZVOL",
+				},
+			}
+
+			t.Cleanup(func() { List.DiskList = map[string]*Disk{} })
+
+			ctrl := gomock.NewController(t)
+			mock := NewMockZfsVolInfoFetcher(ctrl)
+			testCase.mockClosure(mock)
+
+			got, err := getOrphanZvols(NewZfsVolInfoService(mock))
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("getOrphanZvols() error = %v, wantErr %v", err, testCase.wantErr)
+
+				return
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package disk
+
+import (
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cirrina/cirrinad/config"
+	"cirrina/cirrinad/util"
+)
+
+// Snapshot is a point-in-time copy of a disk backing. For ZVOLs the backing is a native zfs snapshot, for FILE
+// disks it is a copy of the image file kept in a snapshot directory next to the disk images. In both cases the
+// backing is named after the snapshot ID so that renaming the snapshot or the disk does not affect it.
+// Snapshots taken as part of a VM snapshot record the ID of that VM snapshot in VMSnapshotID.
+type Snapshot struct {
+	ID           string `gorm:"uniqueIndex;not null;default:null"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+	DiskID       string         `gorm:"index;not null;default:null"`
+	Name         string         `gorm:"not null;default:null"`
+	Description  string
+	VMSnapshotID string `gorm:"index"`
+}
+
+func (Snapshot) TableName() string {
+	return "disk_snapshots"
+}
+
+func (s *Snapshot) BeforeCreate(_ *gorm.DB) error {
+	if s == nil || s.Name == "" {
+		return errSnapshotInvalidName
+	}
+
+	err := uuid.Validate(s.ID)
+	if err != nil || len(s.ID) != 36 {
+		s.ID = uuid.NewString()
+	}
+
+	return nil
+}
+
+// getService returns the InfoServicer matching the disk's dev type
+func (d *Disk) getService() (InfoServicer, error) {
+	switch d.DevType {
+	case "FILE":
+		return NewFileInfoService(FileInfoFetcherImpl), nil
+	case "ZVOL":
+		return NewZfsVolInfoService(ZfsInfoFetcherImpl), nil
+	default:
+		return nil, errDiskInvalidDevType
+	}
+}
+
+// getSnapshotFilePath returns the path used to store a FILE disk snapshot
+func getSnapshotFilePath(targetDisk *Disk, snapshotID string) string {
+	return filepath.Join(config.Config.Disk.VM.Path.Image, ".snapshots", targetDisk.ID, snapshotID+".img")
+}
+
+// getSnapshotZfsName returns the zfs snapshot name used to store a ZVOL disk snapshot
+func getSnapshotZfsName(targetDisk *Disk, snapshotID string) string {
+	return targetDisk.GetPath() + "@" + snapshotID
+}
+
+// GetSnapshots returns all snapshots of the disk, oldest first
+func (d *Disk) GetSnapshots() ([]*Snapshot, error) {
+	var snapshots []*Snapshot
+
+	db := GetDiskDB()
+
+	res := db.Where("disk_id = ?", d.ID).Order("created_at").Find(&snapshots)
+	if res.Error != nil {
+		slog.Error("error getting disk snapshots", "disk", d.ID, "err", res.Error)
+
+		return nil, errDiskInternalDB
+	}
+
+	return snapshots, nil
+}
+
+// GetSnapshotByID looks up a snapshot of the disk by ID
+func (d *Disk) GetSnapshotByID(snapshotID string) (*Snapshot, error) {
+	var snapshot Snapshot
+
+	if snapshotID == "" {
+		return nil, errSnapshotNotFound
+	}
+
+	db := GetDiskDB()
+
+	res := db.Where("disk_id = ? AND id = ?", d.ID, snapshotID).Limit(1).Find(&snapshot)
+	if res.Error != nil {
+		slog.Error("error getting disk snapshot", "disk", d.ID, "snapshot", snapshotID, "err", res.Error)
+
+		return nil, errDiskInternalDB
+	}
+
+	if res.RowsAffected != 1 {
+		return nil, errSnapshotNotFound
+	}
+
+	return &snapshot, nil
+}
+
+// ValidateNewSnapshotName checks that the name is usable for a new snapshot of the disk
+func (d *Disk) ValidateNewSnapshotName(name string) error {
+	if !util.ValidDiskName(name) {
+		return errSnapshotInvalidName
+	}
+
+	snapshots, err := d.GetSnapshots()
+	if err != nil {
+		return err
+	}
+
+	for _, aSnapshot := range snapshots {
+		if aSnapshot.Name == name {
+			return errSnapshotExists
+		}
+	}
+
+	return nil
+}
+
+// CreateSnapshot snapshots the disk backing and records the snapshot in the database, vmSnapshotID is empty
+// unless the snapshot is taken as part of a VM snapshot
+func (d *Disk) CreateSnapshot(name string, description string, vmSnapshotID string) (*Snapshot, error) {
+	err := d.ValidateNewSnapshotName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	diskService, err := d.getService()
+	if err != nil {
+		return nil, err
+	}
+
+	newSnapshot := &Snapshot{
+		ID:           uuid.NewString(),
+		DiskID:       d.ID,
+		Name:         name,
+		Description:  description,
+		VMSnapshotID: vmSnapshotID,
+	}
+
+	err = diskService.CreateSnapshot(d, newSnapshot.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating snapshot: %w", err)
+	}
+
+	db := GetDiskDB()
+
+	res := db.Create(newSnapshot)
+	if res.Error != nil || res.RowsAffected != 1 {
+		slog.Error("error saving disk snapshot", "res", res)
+
+		// don't leave the backing laying around if we can't keep track of it
+		err = diskService.RemoveSnapshot(d, newSnapshot.ID)
+		if err != nil {
+			slog.Error("error removing snapshot backing", "err", err)
+		}
+
+		return nil, errDiskInternalDB
+	}
+
+	return newSnapshot, nil
+}
+
+// RollbackSnapshot restores the disk backing to the state captured by the snapshot. Callers must ensure the disk
+// is not in use by a running VM.
+func (d *Disk) RollbackSnapshot(snapshot *Snapshot) error {
+	if snapshot == nil || snapshot.DiskID != d.ID {
+		return errSnapshotNotFound
+	}
+
+	diskService, err := d.getService()
+	if err != nil {
+		return err
+	}
+
+	err = diskService.RollbackSnapshot(d, snapshot.ID)
+	if err != nil {
+		return fmt.Errorf("error rolling back snapshot: %w", err)
+	}
+
+	// zfs rollback destroys any later snapshots, forget about those
+	d.PruneSnapshots()
+
+	return nil
+}
+
+// SnapshotBackingExists returns whether the backing of the snapshot, which rolling back needs, still exists
+func (d *Disk) SnapshotBackingExists(snapshot *Snapshot) (bool, error) {
+	if snapshot == nil || snapshot.DiskID != d.ID {
+		return false, errSnapshotNotFound
+	}
+
+	diskService, err := d.getService()
+	if err != nil {
+		return false, err
+	}
+
+	exists, err := diskService.SnapshotExists(d, snapshot.ID)
+	if err != nil {
+		return false, fmt.Errorf("error checking snapshot: %w", err)
+	}
+
+	return exists, nil
+}
+
+// DeleteSnapshot removes the snapshot backing and its database record
+func (d *Disk) DeleteSnapshot(snapshot *Snapshot) error {
+	if snapshot == nil || snapshot.DiskID != d.ID {
+		return errSnapshotNotFound
+	}
+
+	diskService, err := d.getService()
+	if err != nil {
+		return err
+	}
+
+	err = diskService.RemoveSnapshot(d, snapshot.ID)
+	if err != nil {
+		return fmt.Errorf("error removing snapshot: %w", err)
+	}
+
+	db := GetDiskDB()
+
+	res := db.Limit(1).Unscoped().Delete(snapshot)
+	if res.Error != nil || res.RowsAffected != 1 {
+		slog.Error("error deleting disk snapshot", "res", res)
+
+		return errDiskInternalDB
+	}
+
+	return nil
+}
+
+// PruneSnapshots removes database records of snapshots whose backing no longer exists
+func (d *Disk) PruneSnapshots() {
+	diskService, err := d.getService()
+	if err != nil {
+		return
+	}
+
+	snapshots, err := d.GetSnapshots()
+	if err != nil {
+		return
+	}
+
+	db := GetDiskDB()
+
+	for _, aSnapshot := range snapshots {
+		exists, err := diskService.SnapshotExists(d, aSnapshot.ID)
+		if err != nil || exists {
+			continue
+		}
+
+		slog.Debug("removing record of missing disk snapshot", "disk", d.ID, "snapshot", aSnapshot.ID)
+
+		res := db.Limit(1).Unscoped().Delete(aSnapshot)
+		if res.Error != nil {
+			slog.Error("error deleting disk snapshot", "res", res)
+		}
+	}
+}
@@ -0,0 +1,361 @@
+package disk
+
+import (
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-test/deep"
+	"gorm.io/gorm"
+
+	"cirrina/cirrinad/cirrinadtest"
+	"cirrina/cirrinad/config"
+	"cirrina/cirrinad/util"
+)
+
+var snapshotColumns = []string{"id", "created_at", "updated_at", "deleted_at", "disk_id", "name", "description"}
+
+//nolint:paralleltest
+func TestDisk_GetSnapshots(t *testing.T) {
+	createUpdateTime := time.Now()
+
+	tests := []struct {
+		name        string
+		mockClosure func(testDB *gorm.DB, mock sqlmock.Sqlmock)
+		diskInst    *Disk
+		want        []*Snapshot
+		wantErr     bool
+	}{
+		{
+			name: "success",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					DiskDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `disk_snapshots` WHERE disk_id = ? AND " +
+							"`disk_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("e89be82f-25c7-42b9-823a-df432e64320e").
+					WillReturnRows(sqlmock.NewRows(snapshotColumns).
+						AddRow(
+							"5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10",
+							createUpdateTime,
+							createUpdateTime,
+							nil,
+							"e89be82f-25c7-42b9-823a-df432e64320e",
+							"before-upgrade",
+							"a snapshot",
+						))
+			},
+			diskInst: &Disk{ID: "e89be82f-25c7-42b9-823a-df432e64320e"},
+			want: []*Snapshot{
+				{
+					ID:          "5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10",
+					CreatedAt:   createUpdateTime,
+					UpdatedAt:   createUpdateTime,
+					DiskID:      "e89be82f-25c7-42b9-823a-df432e64320e",
+					Name:        "before-upgrade",
+					Description: "a snapshot",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					DiskDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `disk_snapshots` WHERE disk_id = ? AND " +
+							"`disk_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("e89be82f-25c7-42b9-823a-df432e64320e").
+					WillReturnError(gorm.ErrInvalidField) // does not matter what error is returned
+			},
+			diskInst: &Disk{ID: "e89be82f-25c7-42b9-823a-df432e64320e"},
+			want:     nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testDB, mock := cirrinadtest.NewMockDB(t.Name())
+			testCase.mockClosure(testDB, mock)
+
+			got, err := testCase.diskInst.GetSnapshots()
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("GetSnapshots() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+
+			mock.ExpectClose()
+
+			db, err := testDB.DB()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = db.Close()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = mock.ExpectationsWereMet()
+			if err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestDisk_ValidateNewSnapshotName(t *testing.T) {
+	createUpdateTime := time.Now()
+
+	tests := []struct {
+		name         string
+		mockClosure  func(testDB *gorm.DB, mock sqlmock.Sqlmock)
+		snapshotName string
+		wantErr      bool
+	}{
+		{
+			name:         "invalidName",
+			mockClosure:  func(_ *gorm.DB, _ sqlmock.Sqlmock) {},
+			snapshotName: "not/valid",
+			wantErr:      true,
+		},
+		{
+			name: "duplicateName",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					DiskDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `disk_snapshots` WHERE disk_id = ? AND " +
+							"`disk_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("e89be82f-25c7-42b9-823a-df432e64320e").
+					WillReturnRows(sqlmock.NewRows(snapshotColumns).
+						AddRow(
+							"5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10",
+							createUpdateTime,
+							createUpdateTime,
+							nil,
+							"e89be82f-25c7-42b9-823a-df432e64320e",
+							"before-upgrade",
+							"a snapshot",
+						))
+			},
+			snapshotName: "before-upgrade",
+			wantErr:      true,
+		},
+		{
+			name: "success",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					DiskDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `disk_snapshots` WHERE disk_id = ? AND " +
+							"`disk_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("e89be82f-25c7-42b9-823a-df432e64320e").
+					WillReturnRows(sqlmock.NewRows(snapshotColumns).
+						AddRow(
+							"5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10",
+							createUpdateTime,
+							createUpdateTime,
+							nil,
+							"e89be82f-25c7-42b9-823a-df432e64320e",
+							"before-upgrade",
+							"a snapshot",
+						))
+			},
+			snapshotName: "after-upgrade",
+			wantErr:      false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testDB, mock := cirrinadtest.NewMockDB(t.Name())
+			testCase.mockClosure(testDB, mock)
+
+			diskInst := &Disk{ID: "e89be82f-25c7-42b9-823a-df432e64320e"}
+
+			err := diskInst.ValidateNewSnapshotName(testCase.snapshotName)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ValidateNewSnapshotName() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			mock.ExpectClose()
+
+			db, err := testDB.DB()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = db.Close()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = mock.ExpectationsWereMet()
+			if err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestDisk_DeleteSnapshot(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockCmdFunc string
+		mockClosure func(testDB *gorm.DB, mock sqlmock.Sqlmock)
+		snapshot    *Snapshot
+		wantErr     bool
+	}{
+		{
+			name:        "wrongDisk",
+			mockCmdFunc: "TestDisk_DeleteSnapshotSuccess",
+			mockClosure: func(_ *gorm.DB, _ sqlmock.Sqlmock) {},
+			snapshot: &Snapshot{
+				ID:     "5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10",
+				DiskID: "2e1f3582-7bc1-4a7a-beeb-1651e371435e",
+			},
+			wantErr: true,
+		},
+		{
+			name:        "zfsError",
+			mockCmdFunc: "TestDisk_DeleteSnapshotError",
+			mockClosure: func(_ *gorm.DB, _ sqlmock.Sqlmock) {},
+			snapshot: &Snapshot{
+				ID:     "5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10",
+				DiskID: "e89be82f-25c7-42b9-823a-df432e64320e",
+			},
+			wantErr: true,
+		},
+		{
+			name:        "success",
+			mockCmdFunc: "TestDisk_DeleteSnapshotSuccess",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					DiskDB: testDB,
+				}
+
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					regexp.QuoteMeta("DELETE FROM `disk_snapshots` WHERE `disk_snapshots`.`id` = ?"),
+				).
+					WithArgs("5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			snapshot: &Snapshot{
+				ID:     "5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10",
+				DiskID: "e89be82f-25c7-42b9-823a-df432e64320e",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			config.Config.Disk.VM.Path.Zpool = "cirrinad0/disk"
+
+			fakeCommand := cirrinadtest.MakeFakeCommand(testCase.mockCmdFunc)
+			util.SetupTestCmd(fakeCommand)
+
+			t.Cleanup(func() { util.TearDownTestCmd() })
+
+			testDB, mock := cirrinadtest.NewMockDB(t.Name())
+			testCase.mockClosure(testDB, mock)
+
+			diskInst := &Disk{
+				ID:      "e89be82f-25c7-42b9-823a-df432e64320e",
+				Name:    "aDisk",
+				DevType: "ZVOL",
+			}
+
+			err := diskInst.DeleteSnapshot(testCase.snapshot)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("DeleteSnapshot() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			mock.ExpectClose()
+
+			db, err := testDB.DB()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = db.Close()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = mock.ExpectationsWereMet()
+			if err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestGetSnapshotFilePath(t *testing.T) {
+	config.Config.Disk.VM.Path.Image = "/bhyve/disk"
+
+	got := getSnapshotFilePath(&Disk{ID: "e89be82f-25c7-42b9-823a-df432e64320e"}, "5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10")
+	want := "/bhyve/disk/.snapshots/e89be82f-25c7-42b9-823a-df432e64320e/5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10.img"
+
+	if got != want {
+		t.Errorf("getSnapshotFilePath() = %v, want %v", got, want)
+	}
+}
+
+// test helpers from here on down
+
+//nolint:paralleltest
+func TestDisk_DeleteSnapshotSuccess(_ *testing.T) {
+	if !cirrinadtest.IsTestEnv() {
+		return
+	}
+
+	cmdWithArgs := os.Args[4:]
+
+	if cmdWithArgs[0] == "/sbin/zfs" && cmdWithArgs[1] == "destroy" &&
+		cmdWithArgs[2] == "cirrinad0/disk/aDisk@5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10" {
+		os.Exit(0)
+	}
+
+	os.Exit(1)
+}
+
+//nolint:paralleltest
+func TestDisk_DeleteSnapshotError(_ *testing.T) {
+	if !cirrinadtest.IsTestEnv() {
+		return
+	}
+
+	os.Exit(1)
+}
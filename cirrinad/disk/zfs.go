@@ -60,6 +60,12 @@ func (n ZfsVolService) GetUsage(volumeName string) (uint64, error) {
 }
 
 func (n ZfsVolService) SetSize(volumeName string, volSize uint64) error {
+	return n.Resize(volumeName, volSize, false)
+}
+
+// Resize sets the volume size, rounded up to a multiple of the volume block size. Shrinking the volume destroys
+// data at the end of it and is refused unless force is set.
+func (n ZfsVolService) Resize(volumeName string, volSize uint64, force bool) error {
 	var err error
 
 	var currentVolSize uint64
@@ -102,9 +108,13 @@ func (n ZfsVolService) SetSize(volumeName string, volSize uint64) error {
 		volSize += ads
 	}
 
-	if volSize < currentVolSize {
-		// maybe I don't care when uploading new disk image -- will care on disk expand, adjust this later, so
-		// we can force it if the user accepts data loss
+	if volSize == currentVolSize {
+		slog.Debug("SetSize requested vol size already set after rounding to volblocksize")
+
+		return nil
+	}
+
+	if volSize < currentVolSize && !force {
 		slog.Error("SetSize", "error", "new disk smaller than current disk")
 
 		return errDiskShrinkage
@@ -178,6 +188,95 @@ func (n ZfsVolService) RemoveBacking(targetDisk *Disk) error {
 	return nil
 }
 
+// DestroyBacking destroys the zvol along with all of its snapshots, unlike RemoveBacking it does not recreate it
+func (n ZfsVolService) DestroyBacking(targetDisk *Disk) error {
+	return destroyVol(targetDisk.GetPath())
+}
+
+func (n ZfsVolService) CreateSnapshot(targetDisk *Disk, snapshotID string) error {
+	stdOutBytes, stdErrBytes, returnCode, err := util.RunCmd(
+		config.Config.Sys.Sudo,
+		[]string{"/sbin/zfs", "snapshot", getSnapshotZfsName(targetDisk, snapshotID)},
+	)
+	if err != nil || returnCode != 0 {
+		slog.Error("failed to create zfs snapshot",
+			"stdOutBytes", stdOutBytes,
+			"stdErrBytes", stdErrBytes,
+			"returnCode", returnCode,
+			"err", err,
+		)
+
+		return fmt.Errorf("error creating zfs snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// RollbackSnapshot rolls the volume back to the snapshot, note that this destroys any later snapshots
+func (n ZfsVolService) RollbackSnapshot(targetDisk *Disk, snapshotID string) error {
+	stdOutBytes, stdErrBytes, returnCode, err := util.RunCmd(
+		config.Config.Sys.Sudo,
+		[]string{"/sbin/zfs", "rollback", "-r", getSnapshotZfsName(targetDisk, snapshotID)},
+	)
+	if err != nil || returnCode != 0 {
+		slog.Error("failed to roll back zfs snapshot",
+			"stdOutBytes", stdOutBytes,
+			"stdErrBytes", stdErrBytes,
+			"returnCode", returnCode,
+			"err", err,
+		)
+
+		return fmt.Errorf("error rolling back zfs snapshot: %w", err)
+	}
+
+	return nil
+}
+
+func (n ZfsVolService) RemoveSnapshot(targetDisk *Disk, snapshotID string) error {
+	stdOutBytes, stdErrBytes, returnCode, err := util.RunCmd(
+		config.Config.Sys.Sudo,
+		[]string{"/sbin/zfs", "destroy", getSnapshotZfsName(targetDisk, snapshotID)},
+	)
+	if err != nil || returnCode != 0 {
+		slog.Error("failed to destroy zfs snapshot",
+			"stdOutBytes", stdOutBytes,
+			"stdErrBytes", stdErrBytes,
+			"returnCode", returnCode,
+			"err", err,
+		)
+
+		return fmt.Errorf("error destroying zfs snapshot: %w", err)
+	}
+
+	return nil
+}
+
+func (n ZfsVolService) SnapshotExists(targetDisk *Disk, snapshotID string) (bool, error) {
+	snapshotName := getSnapshotZfsName(targetDisk, snapshotID)
+
+	stdOutBytes, stdErrBytes, returnCode, err := util.RunCmd(
+		config.Config.Sys.Sudo,
+		[]string{"/sbin/zfs", "list", "-H", "-t", "snapshot", "-o", "name", snapshotName},
+	)
+	if err != nil {
+		// zfs list exits non-zero when the snapshot does not exist
+		if returnCode != 0 && strings.Contains(string(stdErrBytes), "does not exist") {
+			return false, nil
+		}
+
+		slog.Error("failed to list zfs snapshot",
+			"stdOutBytes", stdOutBytes,
+			"stdErrBytes", stdErrBytes,
+			"returnCode", returnCode,
+			"err", err,
+		)
+
+		return true, fmt.Errorf("error checking zfs snapshot exists: %w", err)
+	}
+
+	return strings.TrimSpace(string(stdOutBytes)) == snapshotName, nil
+}
+
 func (e ZfsVolInfoCmds) FetchZfsVolumeSize(volumeName string) (uint64, error) {
 	var volSize uint64
 
@@ -512,37 +611,3 @@ func rollBackToEmptySnapshot(volName string) error {
 
 	return nil
 }
-
-func checkLeftoversZvol() {
-	stdOutBytes, stdErrBytes, returnCode, err := util.RunCmd(
-		"/sbin/zfs",
-		[]string{"list", "-t", "volume", "-o", "name", "-H"},
-	)
-
-	if err != nil {
-		slog.Error("failed to list zfs volumes",
-			"stdOutBytes", stdOutBytes,
-			"stdErrBytes", stdErrBytes,
-			"returnCode", returnCode,
-			"err", err,
-		)
-
-		return
-	}
-
-	zvols := strings.Split(string(stdOutBytes), "\n")
-	for _, aZVol := range zvols {
-		// match GetPath()
-		if !strings.HasPrefix(aZVol, config.Config.Disk.VM.Path.Zpool+"/") {
-			continue
-		}
-
-		zvolName := strings.TrimPrefix(aZVol, config.Config.Disk.VM.Path.Zpool+"/")
-		if util.ValidDiskName(zvolName) {
-			_, err = GetByName(zvolName)
-			if err != nil {
-				slog.Warn("possible left over disk (zvol)", "disk.Name", zvolName, "vol.Name", zvolName)
-			}
-		}
-	}
-}
@@ -329,6 +329,89 @@ func TestZfsVolService_SetSize(t *testing.T) {
 }
 
 //nolint:paralleltest
+func TestZfsVolService_Resize(t *testing.T) {
+	tests := []struct {
+		name        string
+		curSize     uint64
+		blockSize   uint64
+		newSize     uint64
+		force       bool
+		wantApplied uint64
+		wantErr     bool
+	}{
+		{
+			name:        "grow",
+			curSize:     1073741824,
+			blockSize:   16384,
+			newSize:     2147483648,
+			force:       false,
+			wantApplied: 2147483648,
+			wantErr:     false,
+		},
+		{
+			name:        "shrinkRefused",
+			curSize:     2147483648,
+			blockSize:   16384,
+			newSize:     1073741824,
+			force:       false,
+			wantApplied: 0,
+			wantErr:     true,
+		},
+		{
+			name:        "forceShrink",
+			curSize:     2147483648,
+			blockSize:   16384,
+			newSize:     1073741824,
+			force:       true,
+			wantApplied: 1073741824,
+			wantErr:     false,
+		},
+		{
+			name:        "forceShrinkRoundsUp",
+			curSize:     2147483648,
+			blockSize:   16384,
+			newSize:     1073741825,
+			force:       true,
+			wantApplied: 1073758208,
+			wantErr:     false,
+		},
+		{
+			name:        "sameSizeAfterRounding",
+			curSize:     2147483648,
+			blockSize:   16384,
+			newSize:     2147483647,
+			force:       false,
+			wantApplied: 0,
+			wantErr:     false,
+		},
+	}
+
+	t.Parallel()
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mock := NewMockZfsVolInfoFetcher(ctrl)
+
+			mock.EXPECT().FetchZfsVolumeSize("someVolume").Return(testCase.curSize, nil)
+			mock.EXPECT().FetchZfsVolBlockSize("someVolume").Return(testCase.blockSize, nil)
+
+			if testCase.wantApplied != 0 {
+				mock.EXPECT().ApplyZfsVolumeSize("someVolume", testCase.wantApplied).Return(nil)
+			}
+
+			diskService := NewZfsVolInfoService(mock)
+
+			err := diskService.Resize("someVolume", testCase.newSize, testCase.force)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("Resize() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
 func TestZfsVolService_Exists(t *testing.T) {
 	type args struct {
 		name string
@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"log/slog"
 
+	"github.com/google/uuid"
+
 	"cirrina/cirrinad/disk"
 	"cirrina/cirrinad/requests"
+	"cirrina/cirrinad/webhook"
 )
 
 func diskWipe(request *requests.Request) {
@@ -88,9 +91,151 @@ func diskWipe(request *requests.Request) {
 		slog.Error("error creating disk", "err", err)
 	}
 
+	// rolling back to the empty snapshot or destroying the volume removes any other snapshots
+	targetDisk.PruneSnapshots()
+
 	slog.Debug("wiped disk", "ID", targetDisk.ID)
 
 	request.Succeeded()
+
+	webhook.Emit(webhook.Payload{
+		Event: webhook.EventDiskWiped,
+		Disk:  &webhook.DiskData{ID: targetDisk.ID, Name: targetDisk.Name},
+	})
+}
+
+func diskSnapshot(request *requests.Request) {
+	var err error
+
+	var reqData requests.DiskSnapshotReqData
+
+	var targetDisk *disk.Disk
+
+	if request.Type != requests.DISKSNAPSHOT {
+		slog.Error("disk snapshot request called for wrong request type")
+		request.Failed()
+
+		return
+	}
+
+	err = json.Unmarshal([]byte(request.Data), &reqData)
+	if err != nil {
+		slog.Error("failed unmarshalling request data", "err", err)
+		request.Failed()
+
+		return
+	}
+
+	targetDisk, err = disk.GetByID(reqData.DiskID)
+	if err != nil {
+		slog.Error("disk snapshot error getting disk", "err", err)
+		request.Failed()
+
+		return
+	}
+
+	if diskHasPendingReq(request.ID, targetDisk.ID) {
+		slog.Error("failing request to snapshot disk which has pending request")
+		request.Failed()
+
+		return
+	}
+
+	// prevent changes to the disk, such as uploads, while copying it
+	defer targetDisk.Unlock()
+	targetDisk.Lock()
+
+	// a running VM's filesystems are frozen through its guest agent so the snapshot is consistent
+	diskUUID, err := uuid.Parse(targetDisk.ID)
+	if err == nil {
+		diskVM, err := getDiskVM(diskUUID)
+		if err == nil && diskVM != nil {
+			thaw := diskVM.QuiesceForSnapshot()
+			defer thaw()
+		}
+	}
+
+	newSnapshot, err := targetDisk.CreateSnapshot(reqData.SnapshotName, reqData.SnapshotDesc, "")
+	if err != nil {
+		slog.Error("error creating disk snapshot", "disk", targetDisk.ID, "err", err)
+		request.Failed()
+
+		return
+	}
+
+	slog.Debug("created disk snapshot", "disk", targetDisk.ID, "snapshot", newSnapshot.ID)
+
+	request.Succeeded()
+}
+
+func diskRollback(request *requests.Request) {
+	var err error
+
+	var reqData requests.DiskSnapshotReqData
+
+	var targetDisk *disk.Disk
+
+	if request.Type != requests.DISKROLLBACK {
+		slog.Error("disk rollback request called for wrong request type")
+		request.Failed()
+
+		return
+	}
+
+	err = json.Unmarshal([]byte(request.Data), &reqData)
+	if err != nil {
+		slog.Error("failed unmarshalling request data", "err", err)
+		request.Failed()
+
+		return
+	}
+
+	targetDisk, err = disk.GetByID(reqData.DiskID)
+	if err != nil {
+		slog.Error("disk rollback error getting disk", "err", err)
+		request.Failed()
+
+		return
+	}
+
+	if diskHasPendingReq(request.ID, targetDisk.ID) {
+		slog.Error("failing request to roll back disk which has pending request")
+		request.Failed()
+
+		return
+	}
+
+	defer targetDisk.Unlock()
+	targetDisk.Lock()
+
+	// check again, VM may have been started since the request was made
+	err = diskNotInUseByActiveVM(targetDisk)
+	if err != nil {
+		slog.Error("failing request to roll back disk in use", "disk", targetDisk.ID, "err", err)
+		request.Failed()
+
+		return
+	}
+
+	targetSnapshot, err := targetDisk.GetSnapshotByID(reqData.SnapshotID)
+	if err != nil {
+		slog.Error("disk rollback error getting snapshot", "err", err)
+		request.Failed()
+
+		return
+	}
+
+	err = targetDisk.RollbackSnapshot(targetSnapshot)
+	if err != nil {
+		slog.Error("error rolling back disk snapshot", "disk", targetDisk.ID, "err", err)
+		request.Failed()
+
+		return
+	}
+
+	slog.Debug("rolled back disk", "disk", targetDisk.ID, "snapshot", targetSnapshot.ID)
+
+	request.Succeeded()
 }
 
 // nicHasPendingReq check if the disk has pending requests other than this one
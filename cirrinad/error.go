@@ -17,6 +17,11 @@ var (
 	errPendingReqExists = errors.New("pending request already exists")
 )
 
+var (
+	errStorageInvalidType = errors.New("invalid storage type")
+	errStoragePurge       = errors.New("error purging storage")
+)
+
 var (
 	errInvalidNicID          = errors.New("nic id not specified or invalid")
 	errNicInUseByMultipleVMs = errors.New("nic in use by more than one VM")
@@ -42,6 +47,7 @@ var (
 	errIsoInUse          = errors.New("ISO in use")
 	errISOInternalDB     = errors.New("internal ISO database error")
 	errIsoNotFound       = errors.New("ISO not found")
+	errIsoPurge          = errors.New("removing the ISO file failed, ISO not deleted")
 )
 
 var (
@@ -51,14 +57,20 @@ var (
 	errInvalidVncPort        = errors.New("invalid vnc port")
 	errInvalidScreenWidth    = errors.New("invalid screen width")
 	errInvalidScreenHeight   = errors.New("invalid screen height")
+	errInvalidStopPolicy     = errors.New("invalid stop policy")
+	errInvalidRestartPolicy  = errors.New("invalid restart policy")
+	errInvalidLiveAction     = errors.New("invalid liveness action")
+	errInvalidScheduleAction = errors.New("invalid schedule action")
 )
 
 var (
-	errInvalidVMState           = errors.New("unknown VM state")
-	errInvalidVMStateStop       = errors.New("vm not running")
-	errInvalidVMStateDelete     = errors.New("vm not stopped")
-	errInvalidVMStateStart      = errors.New("vm not stopped")
-	errInvalidVMStateDiskUpload = errors.New("can not upload disk to VM that is not stopped")
+	errInvalidVMState               = errors.New("unknown VM state")
+	errInvalidVMStateStop           = errors.New("vm not running")
+	errInvalidVMStateDelete         = errors.New("vm not stopped")
+	errInvalidVMStateStart          = errors.New("vm not stopped")
+	errInvalidVMStateDiskUpload     = errors.New("can not upload disk to VM that is not stopped")
+	errInvalidVMStateSnapshot       = errors.New("vm not stopped")
+	errInvalidVMStateSnapshotCreate = errors.New("vm not stopped or running")
 )
 
 var (
@@ -71,6 +83,9 @@ var (
 	errDiskSizeFailure        = errors.New("disk upload size incorrect")
 	errDiskUpdateGeneric      = errors.New("error updating disk")
 	errDiskDeleteGeneric      = errors.New("error deleting disk")
+	errDiskInUseByRunningVM   = errors.New("disk attached to running VM")
+	errDiskSnapshotOwnedByVM  = errors.New("disk snapshot is part of a VM snapshot")
+	errDiskPurge              = errors.New("removing the disk backing storage failed, disk not deleted")
 )
 
 var (
@@ -80,6 +95,8 @@ var (
 	errExitCodeMismatch    = errors.New("exitCode mismatch running command")
 )
 
+var errUEFIBootNumber = errors.New("UEFI boot entry number out of range")
+
 var (
 	errVMDupe    = errors.New("VM already exists")
 	errReqExists = errors.New("pending request for already exists")
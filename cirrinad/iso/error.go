@@ -9,4 +9,5 @@ var (
 	errIsoIDEmptyOrInvalid = errors.New("iso id not specified or invalid")
 	errIsoNotFound         = errors.New("iso not found")
 	errIsoInUse            = errors.New("ISO in use")
+	errOrphanNotFound      = errors.New("orphaned iso file not found")
 )
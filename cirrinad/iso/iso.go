@@ -103,6 +103,13 @@ func Create(isoInst *ISO) error {
 		return fmt.Errorf("error creating iso: %w", err)
 	}
 
+	return isoInst.insert()
+}
+
+// insert adds the ISO to the database
+func (i *ISO) insert() error {
+	isoInst := i
+
 	db := GetIsoDB()
 
 	res := db.Create(&isoInst)
@@ -178,7 +185,21 @@ func (i *ISO) Delete() error {
 		return errIsoInternalDB
 	}
 
-	// TODO actually delete data from disk, maybe?
+	return nil
+}
+
+// Purge removes the ISO file, it is meant to be called before Delete() so that a failure leaves the ISO in place to
+// retry with
+func (i *ISO) Purge() error {
+	if i.InUse() {
+		return errIsoInUse
+	}
+
+	err := os.Remove(i.GetPath())
+	if err != nil {
+		return fmt.Errorf("error removing iso file: %w", err)
+	}
+
 	return nil
 }
 
@@ -303,17 +324,14 @@ func CheckAll() {
 		}
 	}
 
-	isoFiles, err := os.ReadDir(config.Config.Disk.VM.Path.Iso)
+	orphans, err := GetOrphans()
 	if err != nil {
 		slog.Error("failed checking isos", "err", err)
-	} else {
-		for _, v := range isoFiles {
-			if util.ValidIsoName(v.Name()) {
-				_, err = GetByName(v.Name())
-				if err != nil {
-					slog.Warn("possible left over iso", "iso.Name", v.Name(), "file.Name", v.Name())
-				}
-			}
-		}
+
+		return
+	}
+
+	for _, anOrphan := range orphans {
+		slog.Warn("possible left over iso", "iso.Name", anOrphan.Name, "file.Name", anOrphan.Path)
 	}
 }
@@ -0,0 +1,114 @@
+package iso
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"cirrina/cirrinad/config"
+	"cirrina/cirrinad/util"
+)
+
+// Orphan is a file in the ISO directory which has no ISO in the database
+type Orphan struct {
+	Name string
+	Path string
+	Size uint64
+}
+
+var osReadDirFunc = os.ReadDir
+
+// GetOrphans returns all files in the ISO directory which are not known to the database
+func GetOrphans() ([]Orphan, error) {
+	isoFiles, err := osReadDirFunc(config.Config.Disk.VM.Path.Iso)
+	if err != nil {
+		return nil, fmt.Errorf("error listing iso files: %w", err)
+	}
+
+	var orphans []Orphan
+
+	for _, isoFile := range isoFiles {
+		if isoFile.IsDir() || !util.ValidIsoName(isoFile.Name()) {
+			continue
+		}
+
+		_, err = GetByName(isoFile.Name())
+		if err == nil {
+			continue
+		}
+
+		orphan := Orphan{
+			Name: isoFile.Name(),
+			Path: filepath.Join(config.Config.Disk.VM.Path.Iso, isoFile.Name()),
+		}
+
+		fileInfo, err := isoFile.Info()
+		if err != nil {
+			slog.Error("error getting orphaned iso size", "path", orphan.Path, "err", err)
+		} else if fileInfo.Size() > 0 {
+			orphan.Size = uint64(fileInfo.Size())
+		}
+
+		orphans = append(orphans, orphan)
+	}
+
+	return orphans, nil
+}
+
+// GetOrphan finds a single orphan by name
+func GetOrphan(name string) (Orphan, error) {
+	orphans, err := GetOrphans()
+	if err != nil {
+		return Orphan{}, err
+	}
+
+	for _, anOrphan := range orphans {
+		if anOrphan.Name == name {
+			return anOrphan, nil
+		}
+	}
+
+	return Orphan{}, errOrphanNotFound
+}
+
+// Adopt registers the orphaned file as a new ISO
+func (o Orphan) Adopt(description string) (*ISO, error) {
+	isoInst := &ISO{
+		Name:        o.Name,
+		Description: description,
+		Path:        o.Path,
+		Size:        o.Size,
+	}
+
+	err := isoInst.validate()
+	if err != nil {
+		return nil, fmt.Errorf("error adopting iso: %w", err)
+	}
+
+	exists, err := isoExistsDB(isoInst.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error checking iso exists: %w", err)
+	}
+
+	if exists {
+		return nil, errIsoExists
+	}
+
+	err = isoInst.insert()
+	if err != nil {
+		return nil, fmt.Errorf("error adopting iso: %w", err)
+	}
+
+	return isoInst, nil
+}
+
+// Purge removes the orphaned file
+func (o Orphan) Purge() error {
+	err := os.Remove(o.Path)
+	if err != nil {
+		return fmt.Errorf("error purging orphaned iso: %w", err)
+	}
+
+	return nil
+}
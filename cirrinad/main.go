@@ -23,10 +23,12 @@ import (
 	"cirrina/cirrinad/disk"
 	"cirrina/cirrinad/iso"
 	"cirrina/cirrinad/requests"
+	"cirrina/cirrinad/schedule"
 	_switch "cirrina/cirrinad/switch"
 	"cirrina/cirrinad/util"
 	"cirrina/cirrinad/vm"
 	"cirrina/cirrinad/vmnic"
+	"cirrina/cirrinad/webhook"
 )
 
 var mainVersion = "unknown"
@@ -161,9 +163,12 @@ func doDBMigrations() {
 	_switch.DBAutoMigrate()
 	vm.DBAutoMigrate()
 	requests.DBAutoMigrate()
+	schedule.DBAutoMigrate()
+	webhook.DBAutoMigrate()
 
 	disk.CacheInit()
 	vm.CacheInit()
+	webhook.Load()
 }
 
 func shutdownHandler() {
@@ -173,7 +178,17 @@ func shutdownHandler() {
 
 	shutdownHandlerRunning = true
 
-	vm.StopAll()
+	if config.Config.Sys.KeepVMs {
+		// leave VMs, their nics and the switches in place to be re-attached on the next start
+		vm.DetachAll()
+		destroyPidFile()
+		slog.Info("Exiting normally, leaving VMs running")
+		shutdownWaitGroup.Done()
+
+		return
+	}
+
+	vm.StopAll(time.Duration(config.Config.Sys.ShutdownTimeout) * time.Second)
 
 	for {
 		runningVMs := vm.GetRunningVMs()
@@ -330,6 +345,7 @@ var rootCmd = &cobra.Command{
 			slog.Error("error creating switches", "err", err)
 			panic(err)
 		}
+		reattachVMNets()
 		slog.Info("Starting Daemon")
 
 		if config.Config.Metrics.Enabled {
@@ -344,6 +360,7 @@ var rootCmd = &cobra.Command{
 		go vm.AutoStartVMs()
 		go rpcServer()
 		go processRequests()
+		go runSchedules()
 
 		shutdownWaitGroup.Add(1)
 		shutdownWaitGroup.Wait()
@@ -19,10 +19,24 @@ func processRequests() {
 				go stopVM(&request)
 			case requests.VMDELETE:
 				go deleteVM(&request)
+			case requests.VMPOWEROFF:
+				go powerOffVM(&request)
+			case requests.VMRESET:
+				go resetVM(&request)
+			case requests.VMREBOOT:
+				go rebootVM(&request)
 			case requests.NICCLONE:
 				go nicClone(&request)
 			case requests.DISKWIPE:
 				go diskWipe(&request)
+			case requests.DISKSNAPSHOT:
+				go diskSnapshot(&request)
+			case requests.DISKROLLBACK:
+				go diskRollback(&request)
+			case requests.VMSNAPSHOT:
+				go vmSnapshot(&request)
+			case requests.VMRESTORE:
+				go vmRestore(&request)
 			}
 		}
 
@@ -11,6 +11,7 @@ import (
 	"gorm.io/gorm"
 
 	"cirrina/cirrinad/util"
+	"cirrina/cirrinad/webhook"
 )
 
 type reqType string
@@ -21,6 +22,14 @@ const (
 	VMDELETE reqType = "VMDELETE"
 	NICCLONE reqType = "NICCLONE"
 	DISKWIPE reqType = "DISKWIPE"
+
+	DISKSNAPSHOT reqType = "DISKSNAPSHOT"
+	DISKROLLBACK reqType = "DISKROLLBACK"
+	VMSNAPSHOT   reqType = "VMSNAPSHOT"
+	VMRESTORE    reqType = "VMRESTORE"
+	VMPOWEROFF   reqType = "VMPOWEROFF"
+	VMRESET      reqType = "VMRESET"
+	VMREBOOT     reqType = "VMREBOOT"
 )
 
 type Request struct {
@@ -33,6 +42,7 @@ type Request struct {
 	Complete   bool           `gorm:"default:False;check:complete IN (0,1)"`
 	Type       reqType        `gorm:"type:req_type"`
 	Data       string
+	Result     string // why the request failed, if known
 }
 
 type VMReqData struct {
@@ -55,6 +65,20 @@ type DiskCloneReqData struct {
 	NewDiskName string `json:"new_disk_name"`
 }
 
+type DiskSnapshotReqData struct {
+	DiskID       string `json:"disk_id"`
+	SnapshotID   string `json:"snapshot_id,omitempty"`
+	SnapshotName string `json:"snapshot_name,omitempty"`
+	SnapshotDesc string `json:"snapshot_desc,omitempty"`
+}
+
+type VMSnapshotReqData struct {
+	VMID         string `json:"vm_id"`
+	SnapshotID   string `json:"snapshot_id,omitempty"`
+	SnapshotName string `json:"snapshot_name,omitempty"`
+	SnapshotDesc string `json:"snapshot_desc,omitempty"`
+}
+
 type VMCloneReqData struct {
 	VMID      string `json:"vm_id"`
 	NewVMName string `json:"new_vm_name"`
@@ -73,6 +97,20 @@ func validVMReqType(aReqType reqType) bool {
 		return false
 	case DISKWIPE:
 		return false
+	case DISKSNAPSHOT:
+		return false
+	case DISKROLLBACK:
+		return false
+	case VMSNAPSHOT:
+		return false
+	case VMRESTORE:
+		return false
+	case VMPOWEROFF:
+		return true
+	case VMRESET:
+		return true
+	case VMREBOOT:
+		return true
 	default:
 		return false
 	}
@@ -90,6 +128,20 @@ func validDiskReqType(aReqType reqType) bool {
 		return false
 	case DISKWIPE:
 		return true
+	case DISKSNAPSHOT:
+		return false
+	case DISKROLLBACK:
+		return false
+	case VMSNAPSHOT:
+		return false
+	case VMRESTORE:
+		return false
+	case VMPOWEROFF:
+		return false
+	case VMRESET:
+		return false
+	case VMREBOOT:
+		return false
 	default:
 		return false
 	}
@@ -135,6 +187,106 @@ func CreateNicCloneReq(nicID string, newName string) (Request, error) {
 	return newReq, nil
 }
 
+// CreateDiskSnapshotReq creates a request to snapshot a disk or roll a disk back to a snapshot
+func CreateDiskSnapshotReq(requestType reqType, reqData DiskSnapshotReqData) (Request, error) {
+	var err error
+
+	_, err = uuid.Parse(reqData.DiskID)
+	if err != nil {
+		return Request{}, ErrInvalidRequest
+	}
+
+	switch requestType {
+	case DISKSNAPSHOT:
+		if !util.ValidDiskName(reqData.SnapshotName) {
+			return Request{}, ErrInvalidRequest
+		}
+	case DISKROLLBACK:
+		_, err = uuid.Parse(reqData.SnapshotID)
+		if err != nil {
+			return Request{}, ErrInvalidRequest
+		}
+	default:
+		return Request{}, ErrInvalidRequest
+	}
+
+	var reqDataBytes []byte
+
+	reqDataBytes, err = json.Marshal(reqData)
+	if err != nil {
+		slog.Error("failed parsing DiskSnapshotReqData", "err", err)
+
+		return Request{}, fmt.Errorf("internal error parsing disk snapshot request: %w", err)
+	}
+
+	reqDB := GetReqDB()
+	newReq := Request{
+		Data: string(reqDataBytes),
+		Type: requestType,
+	}
+
+	res := reqDB.Create(&newReq)
+	if res.Error != nil {
+		return Request{}, res.Error
+	}
+
+	if res.RowsAffected != 1 {
+		return Request{}, errRequestCreateFailure
+	}
+
+	return newReq, nil
+}
+
+// CreateVMSnapshotReq creates a request to snapshot a VM or restore a VM from a snapshot
+func CreateVMSnapshotReq(requestType reqType, reqData VMSnapshotReqData) (Request, error) {
+	var err error
+
+	_, err = uuid.Parse(reqData.VMID)
+	if err != nil {
+		return Request{}, ErrInvalidRequest
+	}
+
+	switch requestType {
+	case VMSNAPSHOT:
+		if !util.ValidDiskName(reqData.SnapshotName) {
+			return Request{}, ErrInvalidRequest
+		}
+	case VMRESTORE:
+		_, err = uuid.Parse(reqData.SnapshotID)
+		if err != nil {
+			return Request{}, ErrInvalidRequest
+		}
+	default:
+		return Request{}, ErrInvalidRequest
+	}
+
+	var reqDataBytes []byte
+
+	reqDataBytes, err = json.Marshal(reqData)
+	if err != nil {
+		slog.Error("failed parsing VMSnapshotReqData", "err", err)
+
+		return Request{}, fmt.Errorf("internal error parsing VM snapshot request: %w", err)
+	}
+
+	reqDB := GetReqDB()
+	newReq := Request{
+		Data: string(reqDataBytes),
+		Type: requestType,
+	}
+
+	res := reqDB.Create(&newReq)
+	if res.Error != nil {
+		return Request{}, res.Error
+	}
+
+	if res.RowsAffected != 1 {
+		return Request{}, errRequestCreateFailure
+	}
+
+	return newReq, nil
+}
+
 // CreateVMReq create Request for a VM type operation only
 func CreateVMReq(requestType reqType, vmID string) (Request, error) {
 	var err error
@@ -262,6 +414,12 @@ func PendingReqExists(objID string) []string {
 			fallthrough
 		case VMSTART:
 			fallthrough
+		case VMPOWEROFF:
+			fallthrough
+		case VMRESET:
+			fallthrough
+		case VMREBOOT:
+			fallthrough
 		case VMDELETE:
 			var reqData VMReqData
 
@@ -295,6 +453,32 @@ func PendingReqExists(objID string) []string {
 			if reqData.DiskID == objID {
 				reqIDs = append(reqIDs, incompleteRequest.ID)
 			}
+		case DISKSNAPSHOT:
+			fallthrough
+		case DISKROLLBACK:
+			var reqData DiskSnapshotReqData
+
+			err = json.Unmarshal([]byte(incompleteRequest.Data), &reqData)
+			if err != nil {
+				continue
+			}
+
+			if reqData.DiskID == objID || reqData.SnapshotID == objID {
+				reqIDs = append(reqIDs, incompleteRequest.ID)
+			}
+		case VMSNAPSHOT:
+			fallthrough
+		case VMRESTORE:
+			var reqData VMSnapshotReqData
+
+			err = json.Unmarshal([]byte(incompleteRequest.Data), &reqData)
+			if err != nil {
+				continue
+			}
+
+			if reqData.VMID == objID || reqData.SnapshotID == objID {
+				reqIDs = append(reqIDs, incompleteRequest.ID)
+			}
 		}
 	}
 
@@ -348,4 +532,27 @@ func (r *Request) Failed() {
 			Complete:   true,
 		},
 	)
+
+	r.emitFailed("")
+}
+
+// FailedWithError marks a request as having completed with failure and records the error as the request result
+func (r *Request) FailedWithError(reqErr error) {
+	db := GetReqDB()
+	db.Model(&r).Limit(1).Updates(
+		Request{
+			Successful: false,
+			Complete:   true,
+			Result:     reqErr.Error(),
+		},
+	)
+
+	r.emitFailed(reqErr.Error())
+}
+
+func (r *Request) emitFailed(result string) {
+	webhook.Emit(webhook.Payload{
+		Event:   webhook.EventRequestFailed,
+		Request: &webhook.RequestData{ID: r.ID, Type: string(r.Type), Result: result},
+	})
 }
@@ -209,10 +209,10 @@ func TestCreateVMReq(t *testing.T) { //nolint:maintidx
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
 					WithArgs(
 						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false,
-						false, "VMSTART", "{\"vm_id\":\"f2d857d8-7625-47da-9545-e339f0468856\"}", sqlmock.AnyArg(),
+						false, "VMSTART", "{\"vm_id\":\"f2d857d8-7625-47da-9545-e339f0468856\"}", "", sqlmock.AnyArg(),
 					).
 					// gorm asks the db to return the id but does not check that it matches what gorm set it
 					// to, so we can fake it and return any value we like
@@ -243,9 +243,9 @@ func TestCreateVMReq(t *testing.T) { //nolint:maintidx
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
 					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false,
-						"VMSTOP", "{\"vm_id\":\"f2d857d8-7625-47da-9545-e339f0468856\"}", sqlmock.AnyArg()).
+						"VMSTOP", "{\"vm_id\":\"f2d857d8-7625-47da-9545-e339f0468856\"}", "", sqlmock.AnyArg()).
 					// gorm asks the db to return the id but does not check that it matches what gorm set it
 					// to, so we can fake it and return any value we like
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).
@@ -275,9 +275,9 @@ func TestCreateVMReq(t *testing.T) { //nolint:maintidx
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
 					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false,
-						"VMDELETE", "{\"vm_id\":\"f2d857d8-7625-47da-9545-e339f0468856\"}", sqlmock.AnyArg()).
+						"VMDELETE", "{\"vm_id\":\"f2d857d8-7625-47da-9545-e339f0468856\"}", "", sqlmock.AnyArg()).
 					// gorm asks the db to return the id but does not check that it matches what gorm set it
 					// to, so we can fake it and return any value we like
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).
@@ -307,9 +307,9 @@ func TestCreateVMReq(t *testing.T) { //nolint:maintidx
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
 					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false,
-										"VMSTART", "{\"vm_id\":\"f2d857d8-7625-47da-9545-e339f0468856\"}", sqlmock.AnyArg()).
+										"VMSTART", "{\"vm_id\":\"f2d857d8-7625-47da-9545-e339f0468856\"}", "", sqlmock.AnyArg()).
 					WillReturnError(gorm.ErrInvalidField) // does not matter what error is returned
 				mock.ExpectRollback()
 			},
@@ -326,9 +326,9 @@ func TestCreateVMReq(t *testing.T) { //nolint:maintidx
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
 					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false,
-						"VMSTART", "{\"vm_id\":\"f2d857d8-7625-47da-9545-e339f0468856\"}", sqlmock.AnyArg()).
+						"VMSTART", "{\"vm_id\":\"f2d857d8-7625-47da-9545-e339f0468856\"}", "", sqlmock.AnyArg()).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}))
 				mock.ExpectCommit()
 			},
@@ -369,10 +369,10 @@ func TestCreateVMReq(t *testing.T) { //nolint:maintidx
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
 					WithArgs(
 						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false,
-						false, "VMSTART", "{\"vm_id\":\"f2d857d8-7625-47da-9545-e339f0468856\"}", sqlmock.AnyArg(),
+						false, "VMSTART", "{\"vm_id\":\"f2d857d8-7625-47da-9545-e339f0468856\"}", "", sqlmock.AnyArg(),
 					).
 					WillReturnError(sqlite3.ErrConstraintUnique)
 				mock.ExpectRollback()
@@ -470,6 +470,31 @@ func Test_validVMReqType(t *testing.T) {
 			args: args{aReqType: NICCLONE},
 			want: false,
 		},
+		{
+			name: "validVMReqTypeVMSnapshot",
+			args: args{aReqType: VMSNAPSHOT},
+			want: false,
+		},
+		{
+			name: "validVMReqTypeVMRestore",
+			args: args{aReqType: VMRESTORE},
+			want: false,
+		},
+		{
+			name: "validVMReqTypeVMPowerOff",
+			args: args{aReqType: VMPOWEROFF},
+			want: true,
+		},
+		{
+			name: "validVMReqTypeVMReset",
+			args: args{aReqType: VMRESET},
+			want: true,
+		},
+		{
+			name: "validVMReqTypeVMReboot",
+			args: args{aReqType: VMREBOOT},
+			want: true,
+		},
 		{
 			name: "validVMReqTypeVMStart",
 			args: args{aReqType: "somegarbage"},
@@ -519,10 +544,10 @@ func TestCreateNicCloneReq(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
 					WithArgs(
 						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false,
-						false, "NICCLONE", "{\"nic_id\":\"f2d857d8-7625-47da-9545-e339f0468856\",\"new_nic_name\":\"somenic\"}", sqlmock.AnyArg(), //nolint:lll
+						false, "NICCLONE", "{\"nic_id\":\"f2d857d8-7625-47da-9545-e339f0468856\",\"new_nic_name\":\"somenic\"}", "", sqlmock.AnyArg(), //nolint:lll
 					).
 					// gorm asks the db to return the id but does not check that it matches what gorm set it
 					// to, so we can fake it and return any value we like
@@ -585,10 +610,10 @@ func TestCreateNicCloneReq(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
 					WithArgs(
 						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false,
-						false, "NICCLONE", "{\"nic_id\":\"f2d857d8-7625-47da-9545-e339f0468856\",\"new_nic_name\":\"somenic\"}", sqlmock.AnyArg(), //nolint:lll
+						false, "NICCLONE", "{\"nic_id\":\"f2d857d8-7625-47da-9545-e339f0468856\",\"new_nic_name\":\"somenic\"}", "", sqlmock.AnyArg(), //nolint:lll
 					).
 					// gorm asks the db to return the id but does not check that it matches what gorm set it
 					// to, so we can fake it and return any value we like
@@ -1437,3 +1462,43 @@ func TestDBInitialized(t *testing.T) {
 		})
 	}
 }
+
+func TestRequest_FailedWithError(t *testing.T) {
+	testDB, mock := cirrinadtest.NewMockDB(t.Name())
+	Instance = &Singleton{ // prevents parallel testing
+		ReqDB: testDB,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(
+		regexp.QuoteMeta(
+			"UPDATE `requests` SET `updated_at`=?,`complete`=?,`result`=? WHERE `requests`.`deleted_at` IS NULL AND `id` = ?")). //nolint:lll
+		WithArgs(sqlmock.AnyArg(), true, "pre-start hook failed", "7a691194-64e7-45d1-ae2a-a064271d7c24").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	req := &Request{
+		ID:   "7a691194-64e7-45d1-ae2a-a064271d7c24",
+		Type: "VMSTART",
+		Data: "{\"vm_id\":\"f5b761a1-8193-4db3-a914-b37edc848d29\"}",
+	}
+
+	req.FailedWithError(errors.New("pre-start hook failed")) //nolint:err113
+
+	mock.ExpectClose()
+
+	db, err := testDB.DB()
+	if err != nil {
+		t.Error(err)
+	}
+
+	err = db.Close()
+	if err != nil {
+		t.Error(err)
+	}
+
+	err = mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
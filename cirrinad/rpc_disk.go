@@ -20,6 +20,7 @@ import (
 	"cirrina/cirrinad/config"
 	"cirrina/cirrinad/disk"
 	"cirrina/cirrinad/requests"
+	"cirrina/cirrinad/util"
 	"cirrina/cirrinad/vm"
 )
 
@@ -195,8 +196,25 @@ func (s *server) GetDiskSizeUsage(_ context.Context, diskID *cirrina.DiskId) (*c
 	return &diskSizeUsage, nil
 }
 
+// RemoveDisk deletes the disk, leaving its backing storage in place as it always has for clients which predate
+// purging
 func (s *server) RemoveDisk(_ context.Context, diskID *cirrina.DiskId) (*cirrina.ReqBool, error) {
-	slog.Debug("deleting disk", "diskID", diskID.GetValue())
+	return removeDisk(diskID, false)
+}
+
+// RemoveDiskWithOptions deletes the disk, and its backing storage if asked to or if the purge default is set and
+// the request does not say
+func (s *server) RemoveDiskWithOptions(_ context.Context, removeReq *cirrina.DiskRemoveReq) (*cirrina.ReqBool, error) {
+	purge := config.Config.Disk.Default.Purge
+	if removeReq.Purge != nil {
+		purge = removeReq.GetPurge()
+	}
+
+	return removeDisk(removeReq.GetDiskid(), purge)
+}
+
+func removeDisk(diskID *cirrina.DiskId, purge bool) (*cirrina.ReqBool, error) {
+	slog.Debug("deleting disk", "diskID", diskID.GetValue(), "purge", purge)
 
 	res := cirrina.ReqBool{}
 	res.Success = false
@@ -223,6 +241,16 @@ func (s *server) RemoveDisk(_ context.Context, diskID *cirrina.DiskId) (*cirrina
 	defer diskInst.Unlock()
 	diskInst.Lock()
 
+	// purge first, so that if it fails the disk is still there to retry with
+	if purge {
+		err = diskInst.Purge()
+		if err != nil {
+			slog.Error("error purging disk", "err", err)
+
+			return &res, errDiskPurge
+		}
+	}
+
 	err = diskInst.Delete()
 	if err != nil {
 		slog.Error("error deleting disk", "err", err)
@@ -277,6 +305,56 @@ func (s *server) WipeDisk(_ context.Context, diskID *cirrina.DiskId) (*cirrina.R
 	return &cirrina.RequestID{Value: newReq.ID}, nil
 }
 
+func (s *server) ResizeDisk(_ context.Context, resizeReq *cirrina.DiskResizeReq) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	diskUUID, err := uuid.Parse(resizeReq.GetDiskid().GetValue())
+	if err != nil {
+		return &res, errInvalidID
+	}
+
+	newSize, err := util.ParseDiskSize(resizeReq.GetSize())
+	if err != nil {
+		return &res, fmt.Errorf("error parsing disk size: %w", err)
+	}
+
+	diskInst, err := disk.GetByID(diskUUID.String())
+	if err != nil {
+		slog.Error("ResizeDisk error getting disk", "disk", diskUUID.String(), "err", err)
+
+		return &res, errNotFound
+	}
+
+	if diskInst.Name == "" {
+		return &res, errNotFound
+	}
+
+	pendingReqIDs := requests.PendingReqExists(diskInst.ID)
+	if len(pendingReqIDs) > 0 {
+		return &res, errReqExists
+	}
+
+	defer diskInst.Unlock()
+	diskInst.Lock()
+
+	err = diskNotInUseByActiveVM(diskInst)
+	if err != nil {
+		return &res, err
+	}
+
+	err = diskInst.Resize(newSize, resizeReq.GetForce())
+	if err != nil {
+		slog.Error("error resizing disk", "disk", diskInst.ID, "err", err)
+
+		return &res, fmt.Errorf("error resizing disk: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
 func (s *server) GetDiskVM(_ context.Context, diskID *cirrina.DiskId) (*cirrina.VMID, error) {
 	var err error
 
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/disk"
+	"cirrina/cirrinad/requests"
+	"cirrina/cirrinad/vm"
+)
+
+func (s *server) CreateDiskSnapshot(_ context.Context, snapshotInfo *cirrina.DiskSnapshotInfo) (*cirrina.RequestID, error) { //nolint:lll
+	diskInst, err := getDiskForSnapshotReq(snapshotInfo.GetDiskId())
+	if err != nil {
+		return &cirrina.RequestID{}, err
+	}
+
+	err = diskInst.ValidateNewSnapshotName(snapshotInfo.GetName())
+	if err != nil {
+		return &cirrina.RequestID{}, fmt.Errorf("error validating snapshot: %w", err)
+	}
+
+	newReq, err := requests.CreateDiskSnapshotReq(requests.DISKSNAPSHOT, requests.DiskSnapshotReqData{
+		DiskID:       diskInst.ID,
+		SnapshotName: snapshotInfo.GetName(),
+		SnapshotDesc: snapshotInfo.GetDescription(),
+	})
+	if err != nil {
+		return &cirrina.RequestID{}, fmt.Errorf("error creating request: %w", err)
+	}
+
+	return &cirrina.RequestID{Value: newReq.ID}, nil
+}
+
+func (s *server) ListDiskSnapshots(diskID *cirrina.DiskId, stream cirrina.VMInfo_ListDiskSnapshotsServer) error {
+	diskUUID, err := uuid.Parse(diskID.GetValue())
+	if err != nil {
+		return errInvalidID
+	}
+
+	diskInst, err := disk.GetByID(diskUUID.String())
+	if err != nil {
+		slog.Error("ListDiskSnapshots error getting disk", "disk", diskID.GetValue(), "err", err)
+
+		return errNotFound
+	}
+
+	snapshots, err := diskInst.GetSnapshots()
+	if err != nil {
+		return fmt.Errorf("error getting snapshots: %w", err)
+	}
+
+	for _, aSnapshot := range snapshots {
+		err = stream.Send(&cirrina.DiskSnapshotInfo{
+			Id:          &aSnapshot.ID,
+			DiskId:      &aSnapshot.DiskID,
+			Name:        &aSnapshot.Name,
+			Description: &aSnapshot.Description,
+			Created:     timestamppb.New(aSnapshot.CreatedAt),
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) RollbackDiskSnapshot(_ context.Context, snapshotReq *cirrina.DiskSnapshotReq) (*cirrina.RequestID, error) { //nolint:lll
+	diskInst, snapshot, err := getDiskSnapshotForReq(snapshotReq)
+	if err != nil {
+		return &cirrina.RequestID{}, err
+	}
+
+	err = diskNotInUseByActiveVM(diskInst)
+	if err != nil {
+		return &cirrina.RequestID{}, err
+	}
+
+	newReq, err := requests.CreateDiskSnapshotReq(requests.DISKROLLBACK, requests.DiskSnapshotReqData{
+		DiskID:     diskInst.ID,
+		SnapshotID: snapshot.ID,
+	})
+	if err != nil {
+		return &cirrina.RequestID{}, fmt.Errorf("error creating request: %w", err)
+	}
+
+	return &cirrina.RequestID{Value: newReq.ID}, nil
+}
+
+func (s *server) DeleteDiskSnapshot(_ context.Context, snapshotReq *cirrina.DiskSnapshotReq) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	diskInst, snapshot, err := getDiskSnapshotForReq(snapshotReq)
+	if err != nil {
+		return &res, err
+	}
+
+	// removing a member of a VM snapshot would leave the VM snapshot unrestorable
+	if snapshot.VMSnapshotID != "" {
+		return &res, errDiskSnapshotOwnedByVM
+	}
+
+	defer diskInst.Unlock()
+	diskInst.Lock()
+
+	err = diskInst.DeleteSnapshot(snapshot)
+	if err != nil {
+		slog.Error("error deleting disk snapshot", "err", err)
+
+		return &res, fmt.Errorf("error deleting snapshot: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+// getDiskForSnapshotReq looks up the disk for a snapshot request and makes sure it has no pending requests
+func getDiskForSnapshotReq(diskID string) (*disk.Disk, error) {
+	diskUUID, err := uuid.Parse(diskID)
+	if err != nil {
+		return nil, errInvalidID
+	}
+
+	diskInst, err := disk.GetByID(diskUUID.String())
+	if err != nil {
+		slog.Error("error getting disk", "disk", diskID, "err", err)
+
+		return nil, errNotFound
+	}
+
+	if diskInst.Name == "" {
+		return nil, errNotFound
+	}
+
+	pendingReqIDs := requests.PendingReqExists(diskInst.ID)
+	if len(pendingReqIDs) > 0 {
+		return nil, errReqExists
+	}
+
+	return diskInst, nil
+}
+
+func getDiskSnapshotForReq(snapshotReq *cirrina.DiskSnapshotReq) (*disk.Disk, *disk.Snapshot, error) {
+	diskInst, err := getDiskForSnapshotReq(snapshotReq.GetDiskid().GetValue())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshotUUID, err := uuid.Parse(snapshotReq.GetSnapshotid().GetValue())
+	if err != nil {
+		return nil, nil, errInvalidID
+	}
+
+	snapshot, err := diskInst.GetSnapshotByID(snapshotUUID.String())
+	if err != nil {
+		return nil, nil, errNotFound
+	}
+
+	return diskInst, snapshot, nil
+}
+
+// diskNotInUseByActiveVM returns an error if the disk is attached to a VM which is not stopped
+func diskNotInUseByActiveVM(diskInst *disk.Disk) error {
+	diskUUID, err := uuid.Parse(diskInst.ID)
+	if err != nil {
+		return errInvalidID
+	}
+
+	var diskVM *vm.VM
+
+	diskVM, err = getDiskVM(diskUUID)
+	if err != nil {
+		return err
+	}
+
+	if diskVM != nil && diskVM.Status != vm.STOPPED {
+		return errDiskInUseByRunningVM
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"cirrina/cirrinad/disk"
+	"cirrina/cirrinad/vm"
+)
+
+//nolint:paralleltest
+func Test_diskNotInUseByActiveVM(t *testing.T) {
+	tests := []struct {
+		name     string
+		vmStatus vm.StatusType
+		attached bool
+		wantErr  error
+	}{
+		{
+			name:     "notAttached",
+			vmStatus: vm.RUNNING,
+			attached: false,
+			wantErr:  nil,
+		},
+		{
+			name:     "attachedStopped",
+			vmStatus: vm.STOPPED,
+			attached: true,
+			wantErr:  nil,
+		},
+		{
+			name:     "attachedRunning",
+			vmStatus: vm.RUNNING,
+			attached: true,
+			wantErr:  errDiskInUseByRunningVM,
+		},
+		{
+			name:     "attachedStarting",
+			vmStatus: vm.STARTING,
+			attached: true,
+			wantErr:  errDiskInUseByRunningVM,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			vm.List.VMList = map[string]*vm.VM{}
+
+			diskInst := &disk.Disk{
+				ID:      "0d4a0338-0b68-4645-b99d-9cbb30df272d",
+				Name:    "aDisk",
+				DevType: "FILE",
+			}
+
+			testVM := &vm.VM{
+				ID:     "7563edac-3a68-4950-9dec-ca53dd8c7fca",
+				Name:   "test2024081701",
+				Status: testCase.vmStatus,
+			}
+
+			if testCase.attached {
+				testVM.Disks = []*disk.Disk{diskInst}
+			}
+
+			vm.List.VMList[testVM.ID] = testVM
+
+			err := diskNotInUseByActiveVM(diskInst)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("diskNotInUseByActiveVM() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -1248,6 +1248,16 @@ func Test_server_RemoveDisk(t *testing.T) {
 					WithArgs("0d4a0338-0b68-4645-b99d-9cbb30df272d").
 					WillReturnRows(sqlmock.NewRows([]string{"vm_id", "disk_id", "position"}))
 
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `disk_snapshots` WHERE disk_id = ? AND " +
+							"`disk_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("0d4a0338-0b68-4645-b99d-9cbb30df272d").
+					WillReturnRows(sqlmock.NewRows(
+						[]string{"id", "created_at", "updated_at", "deleted_at", "disk_id", "name", "description"},
+					))
+
 				mock.ExpectBegin()
 				mock.ExpectExec(
 					regexp.QuoteMeta("DELETE FROM `disks` WHERE `disks`.`id` = ?"),
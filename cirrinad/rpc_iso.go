@@ -262,7 +262,23 @@ func receiveIsoFile(stream cirrina.VMInfo_UploadIsoServer, isoUploadReq *cirrina
 	return nil
 }
 
+// RemoveISO deletes the ISO, leaving the ISO file in place as it always has for clients which predate purging
 func (s *server) RemoveISO(_ context.Context, isoID *cirrina.ISOID) (*cirrina.ReqBool, error) {
+	return removeISO(isoID, false)
+}
+
+// RemoveISOWithOptions deletes the ISO, and the ISO file if asked to or if the purge default is set and the request
+// does not say
+func (s *server) RemoveISOWithOptions(_ context.Context, removeReq *cirrina.ISORemoveReq) (*cirrina.ReqBool, error) {
+	purge := config.Config.Disk.Default.Purge
+	if removeReq.Purge != nil {
+		purge = removeReq.GetPurge()
+	}
+
+	return removeISO(removeReq.GetIsoid(), purge)
+}
+
+func removeISO(isoID *cirrina.ISOID, purge bool) (*cirrina.ReqBool, error) {
 	var err error
 
 	var isoUUID uuid.UUID
@@ -303,6 +319,16 @@ func (s *server) RemoveISO(_ context.Context, isoID *cirrina.ISOID) (*cirrina.Re
 		}
 	}
 
+	// purge first, so that if it fails the ISO is still there to retry with
+	if purge {
+		err = dIso.Purge()
+		if err != nil {
+			slog.Error("error purging iso", "err", err)
+
+			return &res, errIsoPurge
+		}
+	}
+
 	err = dIso.Delete()
 	if err != nil {
 		slog.Error("error deleting iso", "err", err)
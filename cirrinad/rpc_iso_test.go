@@ -629,6 +629,7 @@ func Test_server_RemoveISO(t *testing.T) {
 
 	type args struct {
 		isoID *cirrina.ISOID
+		purge *bool
 	}
 
 	tests := []struct {
@@ -700,6 +701,63 @@ func Test_server_RemoveISO(t *testing.T) {
 			},
 			want: func() *cirrina.ReqBool { r := cirrina.ReqBool{Success: true}; return &r }(), //nolint:nlreturn
 		},
+		{
+			name: "purgeFails",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				iso.Instance = &iso.Singleton{
+					ISODB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `isos` WHERE id = ? AND `isos`.`deleted_at` IS NULL LIMIT 1",
+					),
+				).WithArgs("515df28c-c52d-4fa1-b696-f02f10b1ae1b").
+					WillReturnRows(
+						sqlmock.NewRows(
+							[]string{
+								"id",
+								"created_at",
+								"updated_at",
+								"deleted_at",
+								"name",
+								"description",
+								"path",
+								"size",
+								"checksum",
+							},
+						).AddRow(
+							"515df28c-c52d-4fa1-b696-f02f10b1ae1b",
+							createUpdateTime,
+							createUpdateTime,
+							nil,
+							"machaela.iso",
+							"a very normally named iso",
+							"/some/stupid/iso/path/machaela.iso",
+							"123436789123",
+							"totalGarbage",
+						),
+					)
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT vm_id,iso_id,position FROM `vm_isos` WHERE iso_id LIKE ? LIMIT 1"),
+				).
+					WithArgs("515df28c-c52d-4fa1-b696-f02f10b1ae1b").
+					WillReturnRows(sqlmock.NewRows([]string{"vm_id", "iso_id", "position"}))
+
+			},
+			args: args{
+				isoID: func() *cirrina.ISOID {
+					isoID := cirrina.ISOID{Value: "515df28c-c52d-4fa1-b696-f02f10b1ae1b"}
+
+					return &isoID
+				}(),
+				purge: func() *bool { p := true; return &p }(), //nolint:nlreturn
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "badUUID",
 			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
@@ -915,7 +973,10 @@ func Test_server_RemoveISO(t *testing.T) {
 
 			client := cirrina.NewVMInfoClient(conn)
 
-			got, err := client.RemoveISO(context.Background(), testCase.args.isoID)
+			got, err := client.RemoveISOWithOptions(context.Background(), &cirrina.ISORemoveReq{
+				Isoid: testCase.args.isoID,
+				Purge: testCase.args.purge,
+			})
 			if (err != nil) != testCase.wantErr {
 				t.Errorf("AddISO() error = %v, wantErr %v", err, testCase.wantErr)
 
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"cirrina/cirrina"
+	"cirrina/cirrinad/config"
 	"cirrina/cirrinad/requests"
 	"cirrina/cirrinad/util"
 	"cirrina/cirrinad/vm"
@@ -35,6 +36,7 @@ func (s *server) RequestStatus(_ context.Context, requestID *cirrina.RequestID)
 	res := &cirrina.ReqStatus{
 		Complete: request.Complete,
 		Success:  request.Successful,
+		Result:   request.Result,
 	}
 
 	return res, nil
@@ -88,3 +90,23 @@ func (s *server) GetNetInterfaces(_ *cirrina.NetInterfacesReq, stream cirrina.VM
 func (s *server) GetVersion(_ context.Context, _ *emptypb.Empty) (_ *wrapperspb.StringValue, _ error) {
 	return wrapperspb.String(mainVersion), nil
 }
+
+// GetPurgeDefault returns whether removing a disk or ISO purges its backing storage when the request does not say
+func (s *server) GetPurgeDefault(_ context.Context, _ *emptypb.Empty) (*wrapperspb.BoolValue, error) {
+	return wrapperspb.Bool(config.Config.Disk.Default.Purge), nil
+}
+
+func (s *server) GetFirmwares(_ *emptypb.Empty, stream cirrina.VMInfo_GetFirmwaresServer) error {
+	for _, firmware := range vm.Firmwares() {
+		err := stream.Send(&cirrina.FirmwareInfo{
+			Name:         firmware.Name,
+			Path:         firmware.Path,
+			VarsTemplate: firmware.Vars.Template,
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
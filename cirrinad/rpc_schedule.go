@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/schedule"
+	"cirrina/cirrinad/vm"
+)
+
+func (s *server) AddSchedule(_ context.Context, scheduleInfo *cirrina.ScheduleInfo) (*cirrina.ScheduleId, error) {
+	if scheduleInfo.GetVmId() != "" {
+		vmUUID, err := uuid.Parse(scheduleInfo.GetVmId())
+		if err != nil {
+			return &cirrina.ScheduleId{}, errInvalidID
+		}
+
+		_, err = vm.GetByID(vmUUID.String())
+		if err != nil {
+			return &cirrina.ScheduleId{}, errNotFound
+		}
+	}
+
+	newSchedule := &schedule.Schedule{
+		Name:        scheduleInfo.GetName(),
+		Description: scheduleInfo.GetDescription(),
+		Cron:        scheduleInfo.GetCron(),
+		Action:      scheduleInfo.GetAction(),
+		VMID:        scheduleInfo.GetVmId(),
+		Selector:    scheduleInfo.GetSelector(),
+	}
+
+	err := schedule.Create(newSchedule)
+	if err != nil {
+		return &cirrina.ScheduleId{}, fmt.Errorf("error creating schedule: %w", err)
+	}
+
+	slog.Debug("created schedule", "schedule", newSchedule.Name, "id", newSchedule.ID)
+
+	return &cirrina.ScheduleId{Value: newSchedule.ID}, nil
+}
+
+func (s *server) ListSchedules(_ *emptypb.Empty, stream cirrina.VMInfo_ListSchedulesServer) error {
+	now := time.Now()
+
+	for _, aSchedule := range schedule.GetAll() {
+		scheduleInfo := &cirrina.ScheduleInfo{
+			Id:          &aSchedule.ID,
+			Name:        &aSchedule.Name,
+			Description: &aSchedule.Description,
+			Cron:        &aSchedule.Cron,
+			Action:      &aSchedule.Action,
+			VmId:        &aSchedule.VMID,
+			Selector:    &aSchedule.Selector,
+		}
+
+		if !aSchedule.LastRun.IsZero() {
+			scheduleInfo.LastRun = timestamppb.New(aSchedule.LastRun)
+		}
+
+		nextRun := aSchedule.Next(now)
+		if !nextRun.IsZero() {
+			scheduleInfo.NextRun = timestamppb.New(nextRun)
+		}
+
+		err := stream.Send(scheduleInfo)
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) RemoveSchedule(_ context.Context, scheduleID *cirrina.ScheduleId) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{Success: false}
+
+	scheduleUUID, err := uuid.Parse(scheduleID.GetValue())
+	if err != nil {
+		return &res, errInvalidID
+	}
+
+	aSchedule, err := schedule.GetByID(scheduleUUID.String())
+	if err != nil {
+		slog.Error("error getting schedule", "schedule", scheduleID.GetValue(), "err", err)
+
+		return &res, errNotFound
+	}
+
+	err = aSchedule.Delete()
+	if err != nil {
+		return &res, fmt.Errorf("error deleting schedule: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/disk"
+	"cirrina/cirrinad/iso"
+)
+
+func (s *server) ListOrphanedStorage(_ *emptypb.Empty, stream cirrina.VMInfo_ListOrphanedStorageServer) error {
+	for _, anOrphan := range disk.GetOrphans() {
+		storageType, err := mapDiskDevTypeToStorageType(anOrphan.DevType)
+		if err != nil {
+			continue
+		}
+
+		err = stream.Send(&cirrina.OrphanedStorage{
+			Name: anOrphan.Name,
+			Type: storageType,
+			Path: anOrphan.Path,
+			Size: anOrphan.Size,
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	isoOrphans, err := iso.GetOrphans()
+	if err != nil {
+		slog.Error("error getting orphaned isos", "err", err)
+
+		return fmt.Errorf("error getting orphaned isos: %w", err)
+	}
+
+	for _, anOrphan := range isoOrphans {
+		err = stream.Send(&cirrina.OrphanedStorage{
+			Name: anOrphan.Name,
+			Type: cirrina.StorageType_STORAGE_ISO,
+			Path: anOrphan.Path,
+			Size: anOrphan.Size,
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) AdoptStorage(_ context.Context, adoptReq *cirrina.AdoptStorageReq) (*wrapperspb.StringValue, error) {
+	if adoptReq.GetName() == "" {
+		return wrapperspb.String(""), errInvalidName
+	}
+
+	if adoptReq.GetType() == cirrina.StorageType_STORAGE_ISO {
+		orphan, err := iso.GetOrphan(adoptReq.GetName())
+		if err != nil {
+			return wrapperspb.String(""), errNotFound
+		}
+
+		isoInst, err := orphan.Adopt(adoptReq.GetDescription())
+		if err != nil {
+			return wrapperspb.String(""), fmt.Errorf("error adopting iso: %w", err)
+		}
+
+		slog.Info("adopted orphaned iso", "iso.Name", isoInst.Name, "iso.ID", isoInst.ID)
+
+		return wrapperspb.String(isoInst.ID), nil
+	}
+
+	diskDevType, err := mapStorageTypeToDiskDevType(adoptReq.GetType())
+	if err != nil {
+		return wrapperspb.String(""), err
+	}
+
+	diskType := "NVME"
+	if adoptReq.DiskType != nil {
+		diskType, err = mapDiskTypeTypeToDBString(adoptReq.GetDiskType())
+		if err != nil {
+			return wrapperspb.String(""), err
+		}
+	}
+
+	orphan, err := disk.GetOrphan(adoptReq.GetName(), diskDevType)
+	if err != nil {
+		return wrapperspb.String(""), errNotFound
+	}
+
+	diskInst, err := orphan.Adopt(adoptReq.GetDescription(), diskType)
+	if err != nil {
+		return wrapperspb.String(""), fmt.Errorf("error adopting disk: %w", err)
+	}
+
+	slog.Info("adopted orphaned disk", "disk.Name", diskInst.Name, "disk.ID", diskInst.ID)
+
+	return wrapperspb.String(diskInst.ID), nil
+}
+
+func (s *server) PurgeStorage(_ context.Context, purgeReq *cirrina.PurgeStorageReq) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	if purgeReq.GetName() == "" {
+		return &res, errInvalidName
+	}
+
+	if purgeReq.GetType() == cirrina.StorageType_STORAGE_ISO {
+		orphan, err := iso.GetOrphan(purgeReq.GetName())
+		if err != nil {
+			return &res, errNotFound
+		}
+
+		err = orphan.Purge()
+		if err != nil {
+			slog.Error("error purging orphaned iso", "name", orphan.Name, "err", err)
+
+			return &res, errStoragePurge
+		}
+
+		res.Success = true
+
+		return &res, nil
+	}
+
+	diskDevType, err := mapStorageTypeToDiskDevType(purgeReq.GetType())
+	if err != nil {
+		return &res, err
+	}
+
+	orphan, err := disk.GetOrphan(purgeReq.GetName(), diskDevType)
+	if err != nil {
+		return &res, errNotFound
+	}
+
+	err = orphan.Purge()
+	if err != nil {
+		slog.Error("error purging orphaned disk", "name", orphan.Name, "err", err)
+
+		return &res, errStoragePurge
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
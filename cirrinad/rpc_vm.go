@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cast"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"cirrina/cirrina"
@@ -73,6 +74,11 @@ func updateVMAll(vmConfig *cirrina.VMConfig, vmInst *vm.VM) error {
 		return err
 	}
 
+	err = updateVMCPU(vmConfig, vmInst)
+	if err != nil {
+		return err
+	}
+
 	err = updateVMCom1(vmConfig, vmInst)
 	if err != nil {
 		return err
@@ -108,7 +114,31 @@ func updateVMAll(vmConfig *cirrina.VMConfig, vmInst *vm.VM) error {
 		return err
 	}
 
-	updateVMStart(vmConfig, vmInst)
+	err = updateVMStart(vmConfig, vmInst)
+	if err != nil {
+		return err
+	}
+
+	err = updateVMHooks(vmConfig, vmInst)
+	if err != nil {
+		return err
+	}
+
+	err = updateVMPCISlots(vmConfig, vmInst)
+	if err != nil {
+		return err
+	}
+
+	err = updateVMConsole(vmConfig, vmInst)
+	if err != nil {
+		return err
+	}
+
+	err = updateVMFirmware(vmConfig, vmInst)
+	if err != nil {
+		return err
+	}
+
 	updateVMAdvanced1(vmConfig, vmInst)
 	updateVMAdvanced2(vmConfig, vmInst)
 
@@ -292,7 +322,7 @@ func updateVMAdvanced2(vmConfig *cirrina.VMConfig, vmInst *vm.VM) {
 	}
 }
 
-func updateVMStart(vmConfig *cirrina.VMConfig, vmInst *vm.VM) {
+func updateVMStart(vmConfig *cirrina.VMConfig, vmInst *vm.VM) error {
 	if vmConfig.Autostart != nil {
 		vmInst.Config.AutoStart = vmConfig.GetAutostart()
 	}
@@ -313,9 +343,290 @@ func updateVMStart(vmConfig *cirrina.VMConfig, vmInst *vm.VM) {
 		vmInst.Config.RestartDelay = vmConfig.GetRestartDelay()
 	}
 
+	if vmConfig.RestartPolicy != nil {
+		if !vm.ValidRestartPolicy(vmConfig.GetRestartPolicy()) {
+			return errInvalidRestartPolicy
+		}
+
+		vmInst.Config.RestartPolicy = vmConfig.GetRestartPolicy()
+	}
+
+	if vmConfig.RestartMax != nil {
+		vmInst.Config.RestartMax = vmConfig.GetRestartMax()
+	}
+
+	if vmConfig.RestartWindow != nil {
+		vmInst.Config.RestartWindow = vmConfig.GetRestartWindow()
+	}
+
+	if vmConfig.BootGroup != nil {
+		vmInst.Config.BootGroup = vmConfig.GetBootGroup()
+	}
+
+	if vmConfig.DependsOn != nil {
+		dependsOn := vm.ParseDependsOn(vmConfig.GetDependsOn())
+
+		err := vm.ValidateDependencies(vmInst.ID, dependsOn)
+		if err != nil {
+			return fmt.Errorf("error validating dependencies: %w", err)
+		}
+
+		vmInst.Config.DependsOn = strings.Join(dependsOn, ",")
+	}
+
+	err := updateVMReady(vmConfig, vmInst)
+	if err != nil {
+		return err
+	}
+
+	err = updateVMLive(vmConfig, vmInst)
+	if err != nil {
+		return err
+	}
+
 	if vmConfig.MaxWait != nil {
 		vmInst.Config.MaxWait = vmConfig.GetMaxWait()
 	}
+
+	if vmConfig.StopPolicy != nil {
+		_, err := vm.ParseStopPolicy(vmConfig.GetStopPolicy())
+		if err != nil {
+			return errInvalidStopPolicy
+		}
+
+		vmInst.Config.StopPolicy = vmConfig.GetStopPolicy()
+	}
+
+	return nil
+}
+
+func updateVMReady(vmConfig *cirrina.VMConfig, vmInst *vm.VM) error {
+	readyPattern := vmInst.Config.ReadyPattern
+	if vmConfig.ReadyPattern != nil {
+		readyPattern = vmConfig.GetReadyPattern()
+	}
+
+	readyTCP := vmInst.Config.ReadyTCP
+	if vmConfig.ReadyTcp != nil {
+		readyTCP = vmConfig.GetReadyTcp()
+	}
+
+	readyHTTP := vmInst.Config.ReadyHTTP
+	if vmConfig.ReadyHttp != nil {
+		readyHTTP = vmConfig.GetReadyHttp()
+	}
+
+	err := vm.ValidateProbes(readyPattern, readyTCP, readyHTTP)
+	if err != nil {
+		return fmt.Errorf("error validating readiness probes: %w", err)
+	}
+
+	vmInst.Config.ReadyPattern = readyPattern
+	vmInst.Config.ReadyTCP = readyTCP
+	vmInst.Config.ReadyHTTP = readyHTTP
+
+	if vmConfig.ReadyDeadline != nil {
+		vmInst.Config.ReadyDeadline = vmConfig.GetReadyDeadline()
+	}
+
+	if vmConfig.ReadyInterval != nil {
+		vmInst.Config.ReadyInterval = vmConfig.GetReadyInterval()
+	}
+
+	if vmConfig.ReadyTimeout != nil {
+		vmInst.Config.ReadyTimeout = vmConfig.GetReadyTimeout()
+	}
+
+	return nil
+}
+
+func updateVMLive(vmConfig *cirrina.VMConfig, vmInst *vm.VM) error {
+	livePattern := vmInst.Config.LivePattern
+	if vmConfig.LivePattern != nil {
+		livePattern = vmConfig.GetLivePattern()
+	}
+
+	liveTCP := vmInst.Config.LiveTCP
+	if vmConfig.LiveTcp != nil {
+		liveTCP = vmConfig.GetLiveTcp()
+	}
+
+	liveHTTP := vmInst.Config.LiveHTTP
+	if vmConfig.LiveHttp != nil {
+		liveHTTP = vmConfig.GetLiveHttp()
+	}
+
+	err := vm.ValidateProbes(livePattern, liveTCP, liveHTTP)
+	if err != nil {
+		return fmt.Errorf("error validating liveness probes: %w", err)
+	}
+
+	if vmConfig.LiveAction != nil && !vm.ValidLiveAction(vmConfig.GetLiveAction()) {
+		return errInvalidLiveAction
+	}
+
+	vmInst.Config.LivePattern = livePattern
+	vmInst.Config.LiveTCP = liveTCP
+	vmInst.Config.LiveHTTP = liveHTTP
+
+	if vmConfig.LiveInterval != nil {
+		vmInst.Config.LiveInterval = vmConfig.GetLiveInterval()
+	}
+
+	if vmConfig.LiveTimeout != nil {
+		vmInst.Config.LiveTimeout = vmConfig.GetLiveTimeout()
+	}
+
+	if vmConfig.LiveFailures != nil {
+		vmInst.Config.LiveFailures = vmConfig.GetLiveFailures()
+	}
+
+	if vmConfig.LiveAction != nil {
+		vmInst.Config.LiveAction = vmConfig.GetLiveAction()
+	}
+
+	return nil
+}
+
+func updateVMPCISlots(vmConfig *cirrina.VMConfig, vmInst *vm.VM) error {
+	if vmConfig.PciPack != nil {
+		vmInst.Config.PCIPack = vmConfig.GetPciPack()
+	}
+
+	if vmConfig.PciSlots == nil {
+		return nil
+	}
+
+	pciSlots, err := vmInst.MergePCISlots(vmConfig.GetPciSlots())
+	if err != nil {
+		return fmt.Errorf("error validating PCI slots: %w", err)
+	}
+
+	vmInst.Config.PCISlots = pciSlots
+
+	return nil
+}
+
+func updateVMConsole(vmConfig *cirrina.VMConfig, vmInst *vm.VM) error {
+	if vmConfig.ConsolePorts == nil && vmConfig.GuestAgent == nil {
+		return nil
+	}
+
+	consolePorts := vmInst.Config.ConsolePorts
+	if vmConfig.ConsolePorts != nil {
+		consolePorts = vmConfig.GetConsolePorts()
+	}
+
+	guestAgent := vmInst.Config.GuestAgent
+	if vmConfig.GuestAgent != nil {
+		guestAgent = vmConfig.GetGuestAgent()
+	}
+
+	err := vm.ValidateConsolePorts(consolePorts, guestAgent)
+	if err != nil {
+		return fmt.Errorf("error validating console ports: %w", err)
+	}
+
+	vmInst.Config.ConsolePorts = consolePorts
+	vmInst.Config.GuestAgent = guestAgent
+
+	return nil
+}
+
+func updateVMFirmware(vmConfig *cirrina.VMConfig, vmInst *vm.VM) error {
+	if vmConfig.Firmware == nil {
+		return nil
+	}
+
+	err := vm.ValidateFirmware(vmConfig.GetFirmware())
+	if err != nil {
+		return fmt.Errorf("error validating firmware: %w", err)
+	}
+
+	vmInst.Config.Firmware = vmConfig.GetFirmware()
+
+	return nil
+}
+
+func updateVMCPU(vmConfig *cirrina.VMConfig, vmInst *vm.VM) error {
+	if vmConfig.Cpu == nil && vmConfig.Sockets == nil && vmConfig.Cores == nil && vmConfig.Threads == nil &&
+		vmConfig.CpuPins == nil {
+		return nil
+	}
+
+	sockets := vmInst.Config.Sockets
+	if vmConfig.Sockets != nil {
+		sockets = vmConfig.GetSockets()
+	}
+
+	cores := vmInst.Config.Cores
+	if vmConfig.Cores != nil {
+		cores = vmConfig.GetCores()
+	}
+
+	threads := vmInst.Config.Threads
+	if vmConfig.Threads != nil {
+		threads = vmConfig.GetThreads()
+	}
+
+	cpuPins := vmInst.Config.CPUPins
+	if vmConfig.CpuPins != nil {
+		cpuPins = vmConfig.GetCpuPins()
+	}
+
+	err := vm.ValidateCPUTopology(vmInst.Config.CPU, sockets, cores, threads)
+	if err != nil {
+		return fmt.Errorf("error validating CPU topology: %w", err)
+	}
+
+	err = vm.ValidateCPUPins(cpuPins, vmInst.Config.CPU)
+	if err != nil {
+		return fmt.Errorf("error validating CPU pins: %w", err)
+	}
+
+	vmInst.Config.Sockets = sockets
+	vmInst.Config.Cores = cores
+	vmInst.Config.Threads = threads
+	vmInst.Config.CPUPins = cpuPins
+
+	return nil
+}
+
+func updateVMHooks(vmConfig *cirrina.VMConfig, vmInst *vm.VM) error {
+	for _, hookPath := range []*string{
+		vmConfig.HookPreStart, vmConfig.HookPostStart, vmConfig.HookPreStop, vmConfig.HookPostStop,
+	} {
+		if hookPath == nil {
+			continue
+		}
+
+		err := vm.ValidateHook(*hookPath)
+		if err != nil {
+			return fmt.Errorf("error validating hook: %w", err)
+		}
+	}
+
+	if vmConfig.HookPreStart != nil {
+		vmInst.Config.HookPreStart = vmConfig.GetHookPreStart()
+	}
+
+	if vmConfig.HookPostStart != nil {
+		vmInst.Config.HookPostStart = vmConfig.GetHookPostStart()
+	}
+
+	if vmConfig.HookPreStop != nil {
+		vmInst.Config.HookPreStop = vmConfig.GetHookPreStop()
+	}
+
+	if vmConfig.HookPostStop != nil {
+		vmInst.Config.HookPostStop = vmConfig.GetHookPostStop()
+	}
+
+	if vmConfig.HookTimeout != nil {
+		vmInst.Config.HookTimeout = vmConfig.GetHookTimeout()
+	}
+
+	return nil
 }
 
 func updateVMSound(vmConfig *cirrina.VMConfig, vmInst *vm.VM) error {
@@ -532,6 +843,15 @@ func updateVMBasics(vmConfig *cirrina.VMConfig, vmInst *vm.VM) error {
 		vmInst.Config.Mem = vmConfig.GetMem()
 	}
 
+	if vmConfig.Labels != nil {
+		_, err := vm.ParseLabels(vmConfig.GetLabels())
+		if err != nil {
+			return fmt.Errorf("error validating labels: %w", err)
+		}
+
+		vmInst.Config.Labels = vmConfig.GetLabels()
+	}
+
 	return nil
 }
 
@@ -667,7 +987,42 @@ func getVMConfigStart(pvm *cirrina.VMConfig, vmInst *vm.VM) {
 	pvm.AutostartDelay = &vmInst.Config.AutoStartDelay
 	pvm.Restart = &vmInst.Config.Restart
 	pvm.RestartDelay = &vmInst.Config.RestartDelay
+	pvm.RestartPolicy = &vmInst.Config.RestartPolicy
+	pvm.RestartMax = &vmInst.Config.RestartMax
+	pvm.RestartWindow = &vmInst.Config.RestartWindow
+	pvm.BootGroup = &vmInst.Config.BootGroup
+	pvm.DependsOn = &vmInst.Config.DependsOn
+	pvm.ReadyPattern = &vmInst.Config.ReadyPattern
+	pvm.ReadyTcp = &vmInst.Config.ReadyTCP
+	pvm.ReadyHttp = &vmInst.Config.ReadyHTTP
+	pvm.ReadyDeadline = &vmInst.Config.ReadyDeadline
+	pvm.ReadyInterval = &vmInst.Config.ReadyInterval
+	pvm.ReadyTimeout = &vmInst.Config.ReadyTimeout
+	pvm.LivePattern = &vmInst.Config.LivePattern
+	pvm.LiveTcp = &vmInst.Config.LiveTCP
+	pvm.LiveHttp = &vmInst.Config.LiveHTTP
+	pvm.LiveInterval = &vmInst.Config.LiveInterval
+	pvm.LiveTimeout = &vmInst.Config.LiveTimeout
+	pvm.LiveFailures = &vmInst.Config.LiveFailures
+	pvm.LiveAction = &vmInst.Config.LiveAction
+	pvm.Labels = &vmInst.Config.Labels
+	pvm.HookPreStart = &vmInst.Config.HookPreStart
+	pvm.HookPostStart = &vmInst.Config.HookPostStart
+	pvm.HookPreStop = &vmInst.Config.HookPreStop
+	pvm.HookPostStop = &vmInst.Config.HookPostStop
+	pvm.HookTimeout = &vmInst.Config.HookTimeout
+	pciSlots := vmInst.PCISlotsNamed()
+	pvm.PciSlots = &pciSlots
+	pvm.PciPack = &vmInst.Config.PCIPack
+	pvm.Sockets = &vmInst.Config.Sockets
+	pvm.Cores = &vmInst.Config.Cores
+	pvm.Threads = &vmInst.Config.Threads
+	pvm.CpuPins = &vmInst.Config.CPUPins
+	pvm.ConsolePorts = &vmInst.Config.ConsolePorts
+	pvm.GuestAgent = &vmInst.Config.GuestAgent
+	pvm.Firmware = &vmInst.Config.Firmware
 	pvm.MaxWait = &vmInst.Config.MaxWait
+	pvm.StopPolicy = &vmInst.Config.StopPolicy
 }
 
 func getVMConfigAdvanced1(pvm *cirrina.VMConfig, vmInst *vm.VM) {
@@ -742,6 +1097,9 @@ func (s *server) GetVMState(_ context.Context, vmID *cirrina.VMID) (*cirrina.VMS
 	switch vmInst.Status {
 	case vm.STOPPED:
 		pvm.Status = cirrina.VmStatus_STATUS_STOPPED
+		if vmInst.CrashLooping() {
+			pvm.Status = cirrina.VmStatus_STATUS_CRASHLOOP
+		}
 	case vm.STARTING:
 		pvm.Status = cirrina.VmStatus_STATUS_STARTING
 		pvm.VncPort = vmInst.VNCPort
@@ -750,10 +1108,15 @@ func (s *server) GetVMState(_ context.Context, vmID *cirrina.VMID) (*cirrina.VMS
 		pvm.Status = cirrina.VmStatus_STATUS_RUNNING
 		pvm.VncPort = vmInst.VNCPort
 		pvm.DebugPort = vmInst.DebugPort
+		pvm.Ready = vmInst.Ready()
+		liveState, liveFailures := vmInst.LiveState()
+		pvm.LiveState = string(liveState)
+		pvm.LiveFailures = liveFailures
 	case vm.STOPPING:
 		pvm.Status = cirrina.VmStatus_STATUS_STOPPING
 		pvm.VncPort = vmInst.VNCPort
 		pvm.DebugPort = vmInst.DebugPort
+		pvm.StopStage = string(vmInst.StopStage())
 	default:
 		return &pvm, errInvalidVMState
 	}
@@ -1122,3 +1485,51 @@ func (s *server) GetVMNics(vmID *cirrina.VMID, stream cirrina.VMInfo_GetVMNicsSe
 
 	return nil
 }
+
+// GetVMAddresses returns the addresses the guest has been seen using on each of the VM's NICs, the neighbor tables
+// are read and the guest agent is asked once for all of them
+func (s *server) GetVMAddresses(vmID *cirrina.VMID, stream cirrina.VMInfo_GetVMAddressesServer) error {
+	vmUUID, err := uuid.Parse(vmID.GetValue())
+	if err != nil {
+		return fmt.Errorf("error parsing ID: %w", err)
+	}
+
+	vmInst, err := vm.GetByID(vmUUID.String())
+	if err != nil {
+		slog.Error("GetVMAddresses error getting vm", "vm", vmID.GetValue(), "err", err)
+
+		return fmt.Errorf("error getting VM: %w", err)
+	}
+
+	if vmInst.Name == "" {
+		return errNotFound
+	}
+
+	vmNics, err := vmnic.GetNics(vmInst.Config.ID)
+	if err != nil {
+		return fmt.Errorf("error getting NICs: %w", err)
+	}
+
+	neighbors, err := vmnic.NeighborAddresses()
+	if err != nil {
+		return fmt.Errorf("error getting NIC addresses: %w", err)
+	}
+
+	interfaces := guestInterfaces(vmInst)
+
+	for _, vmNic := range vmNics {
+		for _, address := range nicAddresses(&vmNic, vmInst, neighbors, interfaces) {
+			err = stream.Send(&cirrina.VmNicAddress{
+				Address:  address.Address,
+				Source:   address.Source,
+				LastSeen: timestamppb.New(address.LastSeen),
+				NicId:    vmNic.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("error sending NIC address: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
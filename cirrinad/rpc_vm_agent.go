@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/vm"
+)
+
+// getAgentVM looks up the VM for a guest agent request, the VM must be running
+func getAgentVM(vmID *cirrina.VMID) (*vm.VM, error) {
+	vmInst, err := getControllerVM(vmID.GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	if !vmInst.Running() {
+		return nil, errInvalidVMStateStop
+	}
+
+	return vmInst, nil
+}
+
+func (s *server) GuestAgentPing(_ context.Context, vmID *cirrina.VMID) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getAgentVM(vmID)
+	if err != nil {
+		return &res, err
+	}
+
+	err = vmInst.AgentPing()
+	if err != nil {
+		return &res, fmt.Errorf("error pinging guest agent: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+func (s *server) GuestAgentShutdown(_ context.Context, vmID *cirrina.VMID) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getAgentVM(vmID)
+	if err != nil {
+		return &res, err
+	}
+
+	err = vmInst.AgentShutdown()
+	if err != nil {
+		return &res, fmt.Errorf("error shutting down guest: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+func (s *server) GuestAgentInterfaces(vmID *cirrina.VMID, stream cirrina.VMInfo_GuestAgentInterfacesServer) error {
+	vmInst, err := getAgentVM(vmID)
+	if err != nil {
+		return err
+	}
+
+	interfaces, err := vmInst.AgentInterfaces()
+	if err != nil {
+		return fmt.Errorf("error getting guest interfaces: %w", err)
+	}
+
+	for _, guestInterface := range interfaces {
+		addresses := make([]*cirrina.GuestIPAddress, 0, len(guestInterface.IPAddresses))
+
+		for _, address := range guestInterface.IPAddresses {
+			addresses = append(addresses, &cirrina.GuestIPAddress{
+				Type:    address.Type,
+				Address: address.Address,
+				Prefix:  address.Prefix,
+			})
+		}
+
+		err = stream.Send(&cirrina.GuestInterface{
+			Name:            guestInterface.Name,
+			HardwareAddress: guestInterface.HardwareAddress,
+			Addresses:       addresses,
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) GuestAgentFSFreeze(_ context.Context, vmID *cirrina.VMID) (*cirrina.GuestFSFreezeReply, error) {
+	vmInst, err := getAgentVM(vmID)
+	if err != nil {
+		return &cirrina.GuestFSFreezeReply{}, err
+	}
+
+	frozen, err := vmInst.AgentFreeze()
+	if err != nil {
+		return &cirrina.GuestFSFreezeReply{}, fmt.Errorf("error freezing guest filesystems: %w", err)
+	}
+
+	return &cirrina.GuestFSFreezeReply{Filesystems: frozen, Status: "frozen"}, nil
+}
+
+func (s *server) GuestAgentFSThaw(_ context.Context, vmID *cirrina.VMID) (*cirrina.GuestFSFreezeReply, error) {
+	vmInst, err := getAgentVM(vmID)
+	if err != nil {
+		return &cirrina.GuestFSFreezeReply{}, err
+	}
+
+	thawed, err := vmInst.AgentThaw()
+	if err != nil {
+		return &cirrina.GuestFSFreezeReply{}, fmt.Errorf("error thawing guest filesystems: %w", err)
+	}
+
+	return &cirrina.GuestFSFreezeReply{Filesystems: thawed, Status: "thawed"}, nil
+}
+
+func (s *server) GuestAgentFSStatus(_ context.Context, vmID *cirrina.VMID) (*cirrina.GuestFSFreezeReply, error) {
+	vmInst, err := getAgentVM(vmID)
+	if err != nil {
+		return &cirrina.GuestFSFreezeReply{}, err
+	}
+
+	status, err := vmInst.AgentFreezeStatus()
+	if err != nil {
+		return &cirrina.GuestFSFreezeReply{}, fmt.Errorf("error getting guest filesystem freeze status: %w", err)
+	}
+
+	return &cirrina.GuestFSFreezeReply{Status: status}, nil
+}
+
+func (s *server) GuestAgentSyncTime(_ context.Context, vmID *cirrina.VMID) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getAgentVM(vmID)
+	if err != nil {
+		return &res, err
+	}
+
+	err = vmInst.AgentSyncTime()
+	if err != nil {
+		return &res, fmt.Errorf("error setting guest time: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
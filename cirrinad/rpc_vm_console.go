@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+
+	"cirrina/cirrina"
+)
+
+// ConsolePortInteractive connects the stream to one of a running VM's virtio-console ports. The first message names
+// the port, the following ones carry the bytes written to it.
+func (s *server) ConsolePortInteractive(stream cirrina.VMInfo_ConsolePortInteractiveServer) error {
+	streamInput, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error receiving from console port stream: %w", err)
+	}
+
+	portReq := streamInput.GetPort()
+
+	vmInst, err := getControllerVM(portReq.GetVmid().GetValue())
+	if err != nil {
+		return err
+	}
+
+	if !vmInst.Running() {
+		return errInvalidVMStateStop
+	}
+
+	conn, err := vmInst.DialConsolePort(portReq.GetName())
+	if err != nil {
+		return fmt.Errorf("error connecting to console port: %w", err)
+	}
+
+	defer func(conn net.Conn) {
+		_ = conn.Close()
+	}(conn)
+
+	go consolePortStreamSend(stream, conn)
+
+	for {
+		streamInput, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error receiving from console port stream: %w", err)
+		}
+
+		_, err = conn.Write(streamInput.GetPortInBytes())
+		if err != nil {
+			return fmt.Errorf("error writing to console port: %w", err)
+		}
+	}
+}
+
+// consolePortStreamSend console port -> user, until either side goes away
+func consolePortStreamSend(stream cirrina.VMInfo_ConsolePortInteractiveServer, conn net.Conn) {
+	buffer := make([]byte, 4096)
+
+	for {
+		nBytes, err := conn.Read(buffer)
+		if nBytes > 0 {
+			sendErr := stream.Send(&cirrina.ComDataResponse{ComOutBytes: buffer[:nBytes]})
+			if sendErr != nil {
+				return
+			}
+		}
+
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				slog.Error("error reading console port", "err", err)
+			}
+
+			return
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/vm"
+)
+
+func (s *server) AddVMController(_ context.Context, controllerInfo *cirrina.VMControllerInfo) (*cirrina.ReqBool, error) { //nolint:lll
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getControllerVM(controllerInfo.GetVmid().GetValue())
+	if err != nil {
+		return &res, err
+	}
+
+	err = vmInst.AddController(&vm.Controller{
+		Name: controllerInfo.GetName(),
+		Type: controllerInfo.GetType(),
+		MaxQ: controllerInfo.GetMaxq(),
+		QSz:  controllerInfo.GetQsz(),
+		Ser:  controllerInfo.GetSer(),
+	})
+	if err != nil {
+		return &res, fmt.Errorf("error adding controller: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+func (s *server) ListVMControllers(vmID *cirrina.VMID, stream cirrina.VMInfo_ListVMControllersServer) error {
+	vmInst, err := getControllerVM(vmID.GetValue())
+	if err != nil {
+		return err
+	}
+
+	controllers, err := vmInst.GetControllers()
+	if err != nil {
+		return fmt.Errorf("error getting controllers: %w", err)
+	}
+
+	for _, controller := range controllers {
+		err = stream.Send(&cirrina.VMControllerInfo{
+			Vmid: vmID,
+			Name: controller.Name,
+			Type: controller.Type,
+			Maxq: controller.MaxQ,
+			Qsz:  controller.QSz,
+			Ser:  controller.Ser,
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) RemoveVMController(_ context.Context, controllerReq *cirrina.VMControllerReq) (*cirrina.ReqBool, error) { //nolint:lll
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getControllerVM(controllerReq.GetVmid().GetValue())
+	if err != nil {
+		return &res, err
+	}
+
+	err = vmInst.RemoveController(controllerReq.GetName())
+	if err != nil {
+		return &res, fmt.Errorf("error removing controller: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+func (s *server) SetVMAttachment(_ context.Context, attachmentInfo *cirrina.VMAttachmentInfo) (*cirrina.ReqBool, error) { //nolint:lll
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getControllerVM(attachmentInfo.GetVmid().GetValue())
+	if err != nil {
+		return &res, err
+	}
+
+	attachment := vm.Attachment{
+		DeviceType: attachmentInfo.GetDeviceType(),
+		DeviceID:   attachmentInfo.GetDeviceId(),
+		Port:       attachmentInfo.GetPort(),
+		Boot:       attachmentInfo.GetBoot(),
+		ReadOnly:   attachmentInfo.GetReadOnly(),
+		SectorSize: attachmentInfo.GetSectorSize(),
+	}
+
+	if attachmentInfo.Cache != nil {
+		attachment.Cache = sql.NullBool{Bool: attachmentInfo.GetCache(), Valid: true}
+	}
+
+	if attachmentInfo.Direct != nil {
+		attachment.Direct = sql.NullBool{Bool: attachmentInfo.GetDirect(), Valid: true}
+	}
+
+	err = vmInst.SetAttachment(attachmentInfo.GetController(), &attachment)
+	if err != nil {
+		return &res, fmt.Errorf("error attaching device to controller: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+func (s *server) ListVMAttachments(vmID *cirrina.VMID, stream cirrina.VMInfo_ListVMAttachmentsServer) error {
+	vmInst, err := getControllerVM(vmID.GetValue())
+	if err != nil {
+		return err
+	}
+
+	controllers, err := vmInst.GetControllers()
+	if err != nil {
+		return fmt.Errorf("error getting controllers: %w", err)
+	}
+
+	controllerNames := make(map[uint]string)
+	for _, controller := range controllers {
+		controllerNames[controller.ID] = controller.Name
+	}
+
+	attachments, err := vmInst.GetAttachments()
+	if err != nil {
+		return fmt.Errorf("error getting attachments: %w", err)
+	}
+
+	for _, attachment := range attachments {
+		pAttachment := &cirrina.VMAttachmentInfo{
+			Vmid:       vmID,
+			Controller: controllerNames[attachment.ControllerID],
+			DeviceType: attachment.DeviceType,
+			DeviceId:   attachment.DeviceID,
+			Port:       attachment.Port,
+			Boot:       attachment.Boot,
+			ReadOnly:   attachment.ReadOnly,
+			SectorSize: attachment.SectorSize,
+		}
+
+		if attachment.Cache.Valid {
+			pAttachment.Cache = &attachment.Cache.Bool
+		}
+
+		if attachment.Direct.Valid {
+			pAttachment.Direct = &attachment.Direct.Bool
+		}
+
+		err = stream.Send(pAttachment)
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) RemoveVMAttachment(_ context.Context, attachmentReq *cirrina.VMAttachmentReq) (*cirrina.ReqBool, error) { //nolint:lll
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getControllerVM(attachmentReq.GetVmid().GetValue())
+	if err != nil {
+		return &res, err
+	}
+
+	err = vmInst.RemoveAttachment(attachmentReq.GetDeviceType(), attachmentReq.GetDeviceId())
+	if err != nil {
+		return &res, fmt.Errorf("error removing device from controller: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+// getControllerVM looks up the VM for a controller, passthru or share request
+func getControllerVM(vmID string) (*vm.VM, error) {
+	vmUUID, err := uuid.Parse(vmID)
+	if err != nil {
+		return nil, errInvalidID
+	}
+
+	vmInst, err := vm.GetByID(vmUUID.String())
+	if err != nil {
+		slog.Error("error getting vm", "vm", vmID, "err", err)
+
+		return nil, errNotFound
+	}
+
+	return vmInst, nil
+}
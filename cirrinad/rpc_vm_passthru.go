@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/util"
+	"cirrina/cirrinad/vm"
+)
+
+func (s *server) AddVMPassthru(_ context.Context, passthruReq *cirrina.VMPassthruReq) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getControllerVM(passthruReq.GetVmid().GetValue())
+	if err != nil {
+		return &res, err
+	}
+
+	err = vmInst.AddPassthru(passthruReq.GetDevice())
+	if err != nil {
+		return &res, fmt.Errorf("error adding passthru device: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+func (s *server) ListVMPassthrus(vmID *cirrina.VMID, stream cirrina.VMInfo_ListVMPassthrusServer) error {
+	vmInst, err := getControllerVM(vmID.GetValue())
+	if err != nil {
+		return err
+	}
+
+	passthrus, err := vmInst.GetPassthrus()
+	if err != nil {
+		return fmt.Errorf("error getting passthru devices: %w", err)
+	}
+
+	for _, passthru := range passthrus {
+		err = stream.Send(&cirrina.VMPassthruReq{Vmid: vmID, Device: passthru.Device})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) RemoveVMPassthru(_ context.Context, passthruReq *cirrina.VMPassthruReq) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getControllerVM(passthruReq.GetVmid().GetValue())
+	if err != nil {
+		return &res, err
+	}
+
+	err = vmInst.RemovePassthru(passthruReq.GetDevice())
+	if err != nil {
+		return &res, fmt.Errorf("error removing passthru device: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+func (s *server) GetHostPCIDevices(_ *emptypb.Empty, stream cirrina.VMInfo_GetHostPCIDevicesServer) error {
+	hostDevices, err := util.GetHostPCIDevicesFunc()
+	if err != nil {
+		return fmt.Errorf("error getting host PCI devices: %w", err)
+	}
+
+	for _, hostDevice := range hostDevices {
+		err = stream.Send(&cirrina.HostPCIDevice{
+			Address:  hostDevice.Address(),
+			Driver:   hostDevice.Driver,
+			Ppt:      hostDevice.PPT(),
+			Vendor:   hostDevice.Vendor,
+			Device:   hostDevice.Device,
+			Class:    hostDevice.Class,
+			Subclass: hostDevice.Subclass,
+			HeldBy:   vm.PassthruHolder(hostDevice.Address()),
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cast"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/vm"
+)
+
+func (s *server) GetVMDeviceMap(vmID *cirrina.VMID, stream cirrina.VMInfo_GetVMDeviceMapServer) error {
+	vmUUID, err := uuid.Parse(vmID.GetValue())
+	if err != nil {
+		return errInvalidID
+	}
+
+	vmInst, err := vm.GetByID(vmUUID.String())
+	if err != nil {
+		slog.Error("GetVMDeviceMap error getting vm", "vm", vmID.GetValue(), "err", err)
+
+		return errNotFound
+	}
+
+	devices, err := vmInst.DeviceMap()
+	if err != nil {
+		return fmt.Errorf("error getting device map: %w", err)
+	}
+
+	for _, aDevice := range devices {
+		pDevice := &cirrina.VMDevice{
+			Address:   aDevice.Addr.String(),
+			Device:    aDevice.Device,
+			Emulation: aDevice.Emulation,
+		}
+
+		if aDevice.Port >= 0 {
+			port := cast.ToUint32(aDevice.Port)
+			pDevice.Port = &port
+		}
+
+		err = stream.Send(pDevice)
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/requests"
+	"cirrina/cirrinad/vm"
+)
+
+func (s *server) PowerOffVM(_ context.Context, vmID *cirrina.VMID) (*cirrina.RequestID, error) {
+	vmInst, err := getVMForPowerReq(vmID)
+	if err != nil {
+		return &cirrina.RequestID{}, err
+	}
+
+	// powering off is allowed while a stop request is pending, that's the point of it
+	if vmHasPendingNonStopReq("", vmInst.ID) {
+		return &cirrina.RequestID{}, errReqExists
+	}
+
+	if !vmInst.Running() {
+		return &cirrina.RequestID{}, errInvalidVMStateStop
+	}
+
+	newReq, err := requests.CreateVMReq(requests.VMPOWEROFF, vmInst.ID)
+	if err != nil {
+		return &cirrina.RequestID{}, fmt.Errorf("error creating request: %w", err)
+	}
+
+	return &cirrina.RequestID{Value: newReq.ID}, nil
+}
+
+func (s *server) ResetVM(_ context.Context, vmID *cirrina.VMID) (*cirrina.RequestID, error) {
+	vmInst, err := getRunningVMForPowerReq(vmID)
+	if err != nil {
+		return &cirrina.RequestID{}, err
+	}
+
+	newReq, err := requests.CreateVMReq(requests.VMRESET, vmInst.ID)
+	if err != nil {
+		return &cirrina.RequestID{}, fmt.Errorf("error creating request: %w", err)
+	}
+
+	return &cirrina.RequestID{Value: newReq.ID}, nil
+}
+
+func (s *server) RebootVM(_ context.Context, vmID *cirrina.VMID) (*cirrina.RequestID, error) {
+	vmInst, err := getRunningVMForPowerReq(vmID)
+	if err != nil {
+		return &cirrina.RequestID{}, err
+	}
+
+	newReq, err := requests.CreateVMReq(requests.VMREBOOT, vmInst.ID)
+	if err != nil {
+		return &cirrina.RequestID{}, fmt.Errorf("error creating request: %w", err)
+	}
+
+	return &cirrina.RequestID{Value: newReq.ID}, nil
+}
+
+// getRunningVMForPowerReq looks up the VM and makes sure it is running and has no pending requests
+func getRunningVMForPowerReq(vmID *cirrina.VMID) (*vm.VM, error) {
+	vmInst, err := getVMForPowerReq(vmID)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingReqIDs := requests.PendingReqExists(vmInst.ID)
+	if len(pendingReqIDs) > 0 {
+		return nil, errReqExists
+	}
+
+	if vmInst.Status != vm.RUNNING {
+		return nil, errInvalidVMStateStop
+	}
+
+	return vmInst, nil
+}
+
+func getVMForPowerReq(vmID *cirrina.VMID) (*vm.VM, error) {
+	vmUUID, err := uuid.Parse(vmID.GetValue())
+	if err != nil {
+		return nil, errInvalidID
+	}
+
+	vmInst, err := vm.GetByID(vmUUID.String())
+	if err != nil {
+		slog.Error("error getting vm", "vm", vmID.GetValue(), "err", err)
+
+		return nil, errNotFound
+	}
+
+	if vmInst.Name == "" {
+		return nil, errNotFound
+	}
+
+	return vmInst, nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cast"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/vm"
+)
+
+func (s *server) GetVMRestartHistory(vmID *cirrina.VMID, stream cirrina.VMInfo_GetVMRestartHistoryServer) error {
+	vmUUID, err := uuid.Parse(vmID.GetValue())
+	if err != nil {
+		return errInvalidID
+	}
+
+	vmInst, err := vm.GetByID(vmUUID.String())
+	if err != nil {
+		slog.Error("GetVMRestartHistory error getting vm", "vm", vmID.GetValue(), "err", err)
+
+		return errNotFound
+	}
+
+	records, err := vmInst.GetRestartHistory()
+	if err != nil {
+		return fmt.Errorf("error getting restart history: %w", err)
+	}
+
+	for _, aRecord := range records {
+		err = stream.Send(&cirrina.VMRestartRecord{
+			Time:      timestamppb.New(aRecord.CreatedAt),
+			ExitCode:  cast.ToInt32(aRecord.ExitCode),
+			Reason:    aRecord.Reason,
+			Restarted: aRecord.Restarted,
+			Delay:     aRecord.Delay,
+			CrashLoop: aRecord.CrashLoop,
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/vm"
+)
+
+func (s *server) AddVMShare(_ context.Context, shareInfo *cirrina.VMShareInfo) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getControllerVM(shareInfo.GetVmid().GetValue())
+	if err != nil {
+		return &res, err
+	}
+
+	err = vmInst.AddShare(&vm.Share{
+		Name:     shareInfo.GetName(),
+		Path:     shareInfo.GetPath(),
+		ReadOnly: shareInfo.GetReadOnly(),
+	})
+	if err != nil {
+		return &res, fmt.Errorf("error adding share: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+func (s *server) ListVMShares(vmID *cirrina.VMID, stream cirrina.VMInfo_ListVMSharesServer) error {
+	vmInst, err := getControllerVM(vmID.GetValue())
+	if err != nil {
+		return err
+	}
+
+	shares, err := vmInst.GetShares()
+	if err != nil {
+		return fmt.Errorf("error getting shares: %w", err)
+	}
+
+	for _, share := range shares {
+		err = stream.Send(&cirrina.VMShareInfo{
+			Vmid:     vmID,
+			Name:     share.Name,
+			Path:     share.Path,
+			ReadOnly: share.ReadOnly,
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) RemoveVMShare(_ context.Context, shareReq *cirrina.VMShareReq) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getControllerVM(shareReq.GetVmid().GetValue())
+	if err != nil {
+		return &res, err
+	}
+
+	err = vmInst.RemoveShare(shareReq.GetName())
+	if err != nil {
+		return &res, fmt.Errorf("error removing share: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/requests"
+	"cirrina/cirrinad/vm"
+)
+
+func (s *server) CreateVMSnapshot(_ context.Context, snapshotInfo *cirrina.VMSnapshotInfo) (*cirrina.RequestID, error) { //nolint:lll
+	vmInst, err := getVMForSnapshotReq(snapshotInfo.GetVmId())
+	if err != nil {
+		return &cirrina.RequestID{}, err
+	}
+
+	err = vmInst.ValidateNewSnapshotName(snapshotInfo.GetName())
+	if err != nil {
+		return &cirrina.RequestID{}, fmt.Errorf("error validating snapshot: %w", err)
+	}
+
+	newReq, err := requests.CreateVMSnapshotReq(requests.VMSNAPSHOT, requests.VMSnapshotReqData{
+		VMID:         vmInst.ID,
+		SnapshotName: snapshotInfo.GetName(),
+		SnapshotDesc: snapshotInfo.GetDescription(),
+	})
+	if err != nil {
+		return &cirrina.RequestID{}, fmt.Errorf("error creating request: %w", err)
+	}
+
+	return &cirrina.RequestID{Value: newReq.ID}, nil
+}
+
+func (s *server) ListVMSnapshots(vmID *cirrina.VMID, stream cirrina.VMInfo_ListVMSnapshotsServer) error {
+	vmUUID, err := uuid.Parse(vmID.GetValue())
+	if err != nil {
+		return errInvalidID
+	}
+
+	vmInst, err := vm.GetByID(vmUUID.String())
+	if err != nil {
+		slog.Error("ListVMSnapshots error getting vm", "vm", vmID.GetValue(), "err", err)
+
+		return errNotFound
+	}
+
+	snapshots, err := vmInst.GetSnapshots()
+	if err != nil {
+		return fmt.Errorf("error getting snapshots: %w", err)
+	}
+
+	for _, aSnapshot := range snapshots {
+		diskSnapshots, err := aSnapshot.GetDiskSnapshots()
+		if err != nil {
+			slog.Error("error getting snapshot disks", "snapshot", aSnapshot.ID, "err", err)
+		}
+
+		diskSnapshotIDs := make([]string, 0, len(diskSnapshots))
+		for _, aDiskSnapshot := range diskSnapshots {
+			diskSnapshotIDs = append(diskSnapshotIDs, aDiskSnapshot.SnapshotID)
+		}
+
+		err = stream.Send(&cirrina.VMSnapshotInfo{
+			Id:              &aSnapshot.ID,
+			VmId:            &aSnapshot.VMID,
+			Name:            &aSnapshot.Name,
+			Description:     &aSnapshot.Description,
+			Created:         timestamppb.New(aSnapshot.CreatedAt),
+			DiskSnapshotIds: diskSnapshotIDs,
+			UefiVars:        &aSnapshot.UEFIVars,
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) RestoreVMSnapshot(_ context.Context, snapshotReq *cirrina.VMSnapshotReq) (*cirrina.RequestID, error) { //nolint:lll
+	vmInst, snapshot, err := getVMSnapshotForReq(snapshotReq)
+	if err != nil {
+		return &cirrina.RequestID{}, err
+	}
+
+	if vmInst.Status != vm.STOPPED {
+		return &cirrina.RequestID{}, errInvalidVMStateSnapshot
+	}
+
+	newReq, err := requests.CreateVMSnapshotReq(requests.VMRESTORE, requests.VMSnapshotReqData{
+		VMID:       vmInst.ID,
+		SnapshotID: snapshot.ID,
+	})
+	if err != nil {
+		return &cirrina.RequestID{}, fmt.Errorf("error creating request: %w", err)
+	}
+
+	return &cirrina.RequestID{Value: newReq.ID}, nil
+}
+
+func (s *server) DeleteVMSnapshot(_ context.Context, snapshotReq *cirrina.VMSnapshotReq) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, snapshot, err := getVMSnapshotForReq(snapshotReq)
+	if err != nil {
+		return &res, err
+	}
+
+	err = vmInst.DeleteSnapshot(snapshot)
+	if err != nil {
+		slog.Error("error deleting VM snapshot", "err", err)
+
+		return &res, fmt.Errorf("error deleting snapshot: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+// getVMForSnapshotReq looks up the VM for a snapshot request and makes sure it is stopped or running and has no
+// pending requests, a running VM is quiesced when the snapshot is taken
+func getVMForSnapshotReq(vmID string) (*vm.VM, error) {
+	vmUUID, err := uuid.Parse(vmID)
+	if err != nil {
+		return nil, errInvalidID
+	}
+
+	vmInst, err := vm.GetByID(vmUUID.String())
+	if err != nil {
+		slog.Error("error getting vm", "vm", vmID, "err", err)
+
+		return nil, errNotFound
+	}
+
+	if vmInst.Name == "" {
+		return nil, errNotFound
+	}
+
+	pendingReqIDs := requests.PendingReqExists(vmInst.ID)
+	if len(pendingReqIDs) > 0 {
+		return nil, errReqExists
+	}
+
+	if vmInst.Status != vm.STOPPED && vmInst.Status != vm.RUNNING {
+		return nil, errInvalidVMStateSnapshotCreate
+	}
+
+	return vmInst, nil
+}
+
+func getVMSnapshotForReq(snapshotReq *cirrina.VMSnapshotReq) (*vm.VM, *vm.Snapshot, error) {
+	vmUUID, err := uuid.Parse(snapshotReq.GetVmid().GetValue())
+	if err != nil {
+		return nil, nil, errInvalidID
+	}
+
+	vmInst, err := vm.GetByID(vmUUID.String())
+	if err != nil || vmInst.Name == "" {
+		return nil, nil, errNotFound
+	}
+
+	pendingReqIDs := requests.PendingReqExists(vmInst.ID)
+	if len(pendingReqIDs) > 0 {
+		return nil, nil, errReqExists
+	}
+
+	snapshotUUID, err := uuid.Parse(snapshotReq.GetSnapshotid().GetValue())
+	if err != nil {
+		return nil, nil, errInvalidID
+	}
+
+	snapshot, err := vmInst.GetSnapshotByID(snapshotUUID.String())
+	if err != nil {
+		return nil, nil, errNotFound
+	}
+
+	return vmInst, snapshot, nil
+}
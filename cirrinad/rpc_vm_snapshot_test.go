@@ -0,0 +1,358 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-test/deep"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/gorm"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/cirrinadtest"
+	"cirrina/cirrinad/config"
+	"cirrina/cirrinad/requests"
+	"cirrina/cirrinad/vm"
+	"cirrina/cirrinad/vmnic"
+)
+
+var snapshotTestRequestColumns = []string{
+	"id", "created_at", "updated_at", "deleted_at", "started_at", "successful", "complete", "type", "data",
+}
+
+// expectSnapshotRequestQueued sets up the lookups done by CreateVMSnapshot and the insert of the snapshot request
+func expectSnapshotRequestQueued(mock sqlmock.Sqlmock, vmID string, reqID string, name string, desc string) {
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT * FROM `requests` WHERE `complete` = ? AND `requests`.`deleted_at` IS NULL"),
+	).
+		WithArgs(false).
+		WillReturnRows(sqlmock.NewRows(snapshotTestRequestColumns))
+	mock.ExpectQuery(
+		regexp.QuoteMeta(
+			"SELECT * FROM `vm_snapshots` WHERE vm_id = ? AND `vm_snapshots`.`deleted_at` IS NULL ORDER BY created_at",
+		),
+	).
+		WithArgs(vmID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectBegin()
+	mock.ExpectQuery(
+		regexp.QuoteMeta(
+			"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
+		),
+	).
+		WithArgs(
+			sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMSNAPSHOT",
+			"{\"vm_id\":\""+vmID+"\",\"snapshot_name\":\""+name+"\",\"snapshot_desc\":\""+desc+"\"}", "",
+			sqlmock.AnyArg(),
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(reqID))
+	mock.ExpectCommit()
+}
+
+//nolint:paralleltest
+func Test_server_CreateVMSnapshot(t *testing.T) {
+	tests := []struct {
+		name        string
+		vmStatus    vm.StatusType
+		mockClosure func(mock sqlmock.Sqlmock)
+		want        *cirrina.RequestID
+		wantErr     bool
+	}{
+		{
+			name:     "SuccessStopped",
+			vmStatus: vm.STOPPED,
+			mockClosure: func(mock sqlmock.Sqlmock) {
+				expectSnapshotRequestQueued(mock, "3b8f2c4e-7d1a-4f6b-9e2c-5a0d8b1c7e43",
+					"9c2e4f6a-1b3d-4e5f-8a7b-0c1d2e3f4a5b", "nightly", "a snapshot")
+			},
+			want:    &cirrina.RequestID{Value: "9c2e4f6a-1b3d-4e5f-8a7b-0c1d2e3f4a5b"},
+			wantErr: false,
+		},
+		{
+			name:     "SuccessRunning",
+			vmStatus: vm.RUNNING,
+			mockClosure: func(mock sqlmock.Sqlmock) {
+				expectSnapshotRequestQueued(mock, "3b8f2c4e-7d1a-4f6b-9e2c-5a0d8b1c7e43",
+					"9c2e4f6a-1b3d-4e5f-8a7b-0c1d2e3f4a5b", "nightly", "a snapshot")
+			},
+			want:    &cirrina.RequestID{Value: "9c2e4f6a-1b3d-4e5f-8a7b-0c1d2e3f4a5b"},
+			wantErr: false,
+		},
+		{
+			name:     "ErrorStarting",
+			vmStatus: vm.STARTING,
+			mockClosure: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					regexp.QuoteMeta("SELECT * FROM `requests` WHERE `complete` = ? AND `requests`.`deleted_at` IS NULL"),
+				).
+					WithArgs(false).
+					WillReturnRows(sqlmock.NewRows(snapshotTestRequestColumns))
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testDB, mockDB := cirrinadtest.NewMockDB(t.Name())
+			vm.Instance = &vm.Singleton{VMDB: testDB}
+			requests.Instance = &requests.Singleton{ReqDB: testDB}
+
+			testVM := vm.VM{
+				ID:     "3b8f2c4e-7d1a-4f6b-9e2c-5a0d8b1c7e43",
+				Name:   "snaptest",
+				Status: testCase.vmStatus,
+			}
+
+			vm.List.VMList = map[string]*vm.VM{testVM.ID: &testVM}
+
+			testCase.mockClosure(mockDB)
+
+			lis := bufconn.Listen(1024 * 1024)
+			s := grpc.NewServer()
+			reflection.Register(s)
+			cirrina.RegisterVMInfoServer(s, &server{})
+
+			go func() {
+				if err := s.Serve(lis); err != nil {
+					log.Fatalf("Server exited with error: %v", err)
+				}
+			}()
+
+			resolver.SetDefaultScheme("passthrough")
+
+			conn, err := grpc.NewClient("bufnet", grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return lis.Dial()
+			}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				t.Fatalf("Failed to dial bufnet: %v", err)
+			}
+
+			defer func(conn *grpc.ClientConn) {
+				_ = conn.Close()
+			}(conn)
+
+			client := cirrina.NewVMInfoClient(conn)
+
+			snapshotName := "nightly"
+			snapshotDesc := "a snapshot"
+
+			got, err := client.CreateVMSnapshot(context.Background(), &cirrina.VMSnapshotInfo{
+				VmId:        &testVM.ID,
+				Name:        &snapshotName,
+				Description: &snapshotDesc,
+			})
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("CreateVMSnapshot() error = %v, wantErr %v", err, testCase.wantErr)
+
+				return
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+
+			mockDB.ExpectClose()
+
+			db, err := testDB.DB()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = db.Close()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			if err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+// startSnapshotTestAgent answers the guest agent commands sent while snapshotting a running VM and sends the ones it
+// received on the returned channel
+func startSnapshotTestAgent(t *testing.T, vmInst *vm.VM) chan string {
+	t.Helper()
+
+	listener, err := net.Listen("unix",
+		filepath.Join(config.Config.Disk.VM.Path.State, vmInst.Name, "vtcon."+vm.GuestAgentPort))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	received := make(chan string, 10)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			reader := bufio.NewReader(conn)
+
+			for {
+				line, err := reader.ReadBytes('\n')
+				if err != nil {
+					break
+				}
+
+				var request struct {
+					Execute   string `json:"execute"`
+					Arguments struct {
+						ID int64 `json:"id"`
+					} `json:"arguments"`
+				}
+
+				// the sync request comes after the delimiter which resets the agent's parser
+				if line[0] == 0xff {
+					line = line[1:]
+				}
+
+				_ = json.Unmarshal(line, &request)
+
+				if request.Execute == "guest-sync-delimited" {
+					syncReply, _ := json.Marshal(map[string]int64{"return": request.Arguments.ID})
+					_, _ = conn.Write(append(append([]byte{0xff}, syncReply...), '\n'))
+
+					continue
+				}
+
+				received <- request.Execute
+
+				_, _ = conn.Write([]byte("{\"return\": 1}\n"))
+			}
+
+			_ = conn.Close()
+		}
+	}()
+
+	return received
+}
+
+//nolint:paralleltest
+func Test_vmSnapshotRunning(t *testing.T) {
+	config.Config.Disk.VM.Path.State = t.TempDir()
+
+	t.Cleanup(func() { config.Config.Disk.VM.Path.State = "" })
+
+	testDB, mockDB := cirrinadtest.NewMockDB(t.Name())
+	vm.Instance = &vm.Singleton{VMDB: testDB}
+	vmnic.Instance = &vmnic.Singleton{VMNicDB: testDB}
+	requests.Instance = &requests.Singleton{ReqDB: testDB}
+
+	testVM := vm.VM{
+		ID:     "3b8f2c4e-7d1a-4f6b-9e2c-5a0d8b1c7e43",
+		Name:   "snaptest",
+		Status: vm.RUNNING,
+		Config: vm.Config{
+			Model:      gorm.Model{ID: 812},
+			GuestAgent: true,
+		},
+	}
+
+	vm.List.VMList = map[string]*vm.VM{testVM.ID: &testVM}
+
+	err := os.MkdirAll(filepath.Join(config.Config.Disk.VM.Path.State, testVM.Name), 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := startSnapshotTestAgent(t, &testVM)
+
+	expectSnapshotRequestQueued(mockDB, testVM.ID, "9c2e4f6a-1b3d-4e5f-8a7b-0c1d2e3f4a5b", "nightly", "a snapshot")
+
+	snapshotName := "nightly"
+	snapshotDesc := "a snapshot"
+
+	reqID, err := (&server{}).CreateVMSnapshot(context.Background(), &cirrina.VMSnapshotInfo{
+		VmId:        &testVM.ID,
+		Name:        &snapshotName,
+		Description: &snapshotDesc,
+	})
+	if err != nil {
+		t.Fatalf("CreateVMSnapshot() error = %v", err)
+	}
+
+	// processing the queued request freezes the guest, takes the snapshot and thaws the guest
+	mockDB.ExpectQuery(
+		regexp.QuoteMeta("SELECT * FROM `requests` WHERE `complete` = ? AND `requests`.`deleted_at` IS NULL"),
+	).
+		WithArgs(false).
+		WillReturnRows(sqlmock.NewRows(snapshotTestRequestColumns))
+	mockDB.ExpectQuery(
+		regexp.QuoteMeta(
+			"SELECT * FROM `vm_snapshots` WHERE vm_id = ? AND `vm_snapshots`.`deleted_at` IS NULL ORDER BY created_at",
+		),
+	).
+		WithArgs(testVM.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mockDB.ExpectQuery(
+		regexp.QuoteMeta("SELECT * FROM `vm_nics` WHERE config_id = ? AND `vm_nics`.`deleted_at` IS NULL"),
+	).
+		WithArgs(812).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mockDB.ExpectBegin()
+	mockDB.ExpectQuery(
+		regexp.QuoteMeta(
+			"INSERT INTO `vm_snapshots` (`created_at`,`updated_at`,`deleted_at`,`description`,`config`,`isos`,`nics`,`disk_snapshots`,`uefi_vars`,`id`,`vm_id`,`name`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?) RETURNING `id`,`vm_id`,`name`", //nolint:lll
+		),
+	).
+		WithArgs(
+			sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "a snapshot", sqlmock.AnyArg(), "[]", "[]", "null", false,
+			sqlmock.AnyArg(), testVM.ID, "nightly",
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "vm_id", "name"}).
+			AddRow("5e0c7a1d-2f4b-4c8e-9d3a-6b1f0e2c4a7d", testVM.ID, "nightly"))
+	mockDB.ExpectCommit()
+	mockDB.ExpectBegin()
+	mockDB.ExpectExec(
+		regexp.QuoteMeta(
+			"UPDATE `requests` SET `updated_at`=?,`successful`=?,`complete`=? WHERE `requests`.`deleted_at` IS NULL AND `id` = ?", //nolint:lll
+		),
+	).
+		WithArgs(sqlmock.AnyArg(), true, true, reqID.GetValue()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mockDB.ExpectCommit()
+
+	vmSnapshot(&requests.Request{
+		ID:   reqID.GetValue(),
+		Type: requests.VMSNAPSHOT,
+		Data: "{\"vm_id\":\"" + testVM.ID + "\",\"snapshot_name\":\"nightly\",\"snapshot_desc\":\"a snapshot\"}",
+	})
+
+	var got []string
+
+	for range 2 {
+		got = append(got, <-received)
+	}
+
+	diff := deep.Equal(got, []string{"guest-fsfreeze-freeze", "guest-fsfreeze-thaw"})
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	err = mockDB.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -433,6 +433,7 @@ func Test_server_GetVMState(t *testing.T) {
 					Status:    cirrina.VmStatus_STATUS_RUNNING,
 					VncPort:   6901,
 					DebugPort: 3435,
+					Ready:     true,
 				}
 
 				return &vmState
@@ -865,6 +866,40 @@ func Test_server_GetVMConfig(t *testing.T) {
 					Wbps:           func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
 					Riops:          func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
 					Wiops:          func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					StopPolicy:     func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					RestartPolicy:  func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					RestartMax:     func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					RestartWindow:  func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					BootGroup:      func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					DependsOn:      func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					ReadyPattern:   func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					ReadyTcp:       func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					ReadyHttp:      func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					ReadyDeadline:  func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					ReadyInterval:  func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					ReadyTimeout:   func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					LivePattern:    func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					LiveTcp:        func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					LiveHttp:       func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					LiveInterval:   func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					LiveTimeout:    func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					LiveFailures:   func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					LiveAction:     func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					Labels:         func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					HookPreStart:   func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					HookPostStart:  func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					HookPreStop:    func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					HookPostStop:   func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					HookTimeout:    func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					PciSlots:       func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					PciPack:        func() *bool { r := false; return &r }(),              //nolint:nlreturn
+					Sockets:        func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					Cores:          func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					Threads:        func() *uint32 { var r uint32; return &r }(),          //nolint:nlreturn
+					CpuPins:        func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					ConsolePorts:   func() *string { r := ""; return &r }(),               //nolint:nlreturn
+					GuestAgent:     func() *bool { r := false; return &r }(),              //nolint:nlreturn
+					Firmware:       func() *string { r := ""; return &r }(),               //nolint:nlreturn
 				}
 
 				return &testConfig
@@ -1656,10 +1691,10 @@ func Test_server_AddVM(t *testing.T) {
 						AddRow("c9478af2-8a18-4a86-8234-5be5ceb80d95"))
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `configs` (`created_at`,`updated_at`,`deleted_at`,`vm_id`,`cpu`,`mem`,`max_wait`,`restart`,`restart_delay`,`screen`,`screen_width`,`screen_height`,`vnc_wait`,`vnc_port`,`tablet`,`store_uefi_vars`,`utc_time`,`host_bridge`,`acpi`,`use_hlt`,`exit_on_pause`,`wire_guest_mem`,`destroy_power_off`,`ignore_unknown_msr`,`kbd_layout`,`auto_start`,`sound`,`sound_in`,`sound_out`,`com1`,`com1_dev`,`com1_log`,`com2`,`com2_dev`,`com2_log`,`com3`,`com3_dev`,`com3_log`,`com4`,`com4_dev`,`com4_log`,`extra_args`,`com1_speed`,`com2_speed`,`com3_speed`,`com4_speed`,`auto_start_delay`,`debug`,`debug_wait`,`debug_port`,`priority`,`protect`,`pcpu`,`rbps`,`wbps`,`riops`,`wiops`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON CONFLICT (`id`) DO UPDATE SET `vm_id`=`excluded`.`vm_id` RETURNING `id`", //nolint:lll
+						"INSERT INTO `configs` (`created_at`,`updated_at`,`deleted_at`,`vm_id`,`cpu`,`mem`,`max_wait`,`restart`,`restart_delay`,`screen`,`screen_width`,`screen_height`,`vnc_wait`,`vnc_port`,`tablet`,`store_uefi_vars`,`utc_time`,`host_bridge`,`acpi`,`use_hlt`,`exit_on_pause`,`wire_guest_mem`,`destroy_power_off`,`ignore_unknown_msr`,`kbd_layout`,`auto_start`,`sound`,`sound_in`,`sound_out`,`com1`,`com1_dev`,`com1_log`,`com2`,`com2_dev`,`com2_log`,`com3`,`com3_dev`,`com3_log`,`com4`,`com4_dev`,`com4_log`,`extra_args`,`com1_speed`,`com2_speed`,`com3_speed`,`com4_speed`,`auto_start_delay`,`debug`,`debug_wait`,`debug_port`,`priority`,`protect`,`pcpu`,`rbps`,`wbps`,`riops`,`wiops`,`stop_policy`,`restart_policy`,`restart_max`,`restart_window`,`boot_group`,`depends_on`,`ready_pattern`,`ready_tcp`,`ready_http`,`ready_deadline`,`ready_interval`,`ready_timeout`,`live_pattern`,`live_tcp`,`live_http`,`live_interval`,`live_timeout`,`live_failures`,`live_action`,`labels`,`hook_pre_start`,`hook_post_start`,`hook_pre_stop`,`hook_post_stop`,`hook_timeout`,`pci_slots`,`pci_pack`,`sockets`,`cores`,`threads`,`cpu_pins`,`console_ports`,`guest_agent`,`firmware`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON CONFLICT (`id`) DO UPDATE SET `vm_id`=`excluded`.`vm_id` RETURNING `id`", //nolint:lll
 					),
 				).WithArgs(
-					sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "c9478af2-8a18-4a86-8234-5be5ceb80d95", 1, 128, 120, true, 1, true, 1920, 1080, false, "AUTO", true, true, true, true, true, true, true, false, true, true, "default", false, false, "/dev/dsp0", "/dev/dsp0", true, "AUTO", false, false, "AUTO", false, false, "AUTO", false, false, "AUTO", false, "", 115200, 115200, 115200, 115200, 0, false, false, "AUTO", 0, true, 0, 0, 0, 0, 0). //nolint:lll
+					sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "c9478af2-8a18-4a86-8234-5be5ceb80d95", 1, 128, 120, true, 1, true, 1920, 1080, false, "AUTO", true, true, true, true, true, true, true, false, true, true, "default", false, false, "/dev/dsp0", "/dev/dsp0", true, "AUTO", false, false, "AUTO", false, false, "AUTO", false, false, "AUTO", false, "", 115200, 115200, 115200, 115200, 0, false, false, "AUTO", 0, true, 0, 0, 0, 0, 0, "", "on-failure", 5, 300, 0, "", "", "", "", 300, 5, 2, "", "", "", 30, 5, 3, "log", "", "", "", "", "", 60, "", false, 0, 0, 0, "", "", false, "uefi"). //nolint:lll
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).
 						AddRow("489"))
 				mock.ExpectCommit()
@@ -1696,10 +1731,10 @@ func Test_server_AddVM(t *testing.T) {
 						AddRow("c9478af2-8a18-4a86-8234-5be5ceb80d95"))
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `configs` (`created_at`,`updated_at`,`deleted_at`,`vm_id`,`cpu`,`mem`,`max_wait`,`restart`,`restart_delay`,`screen`,`screen_width`,`screen_height`,`vnc_wait`,`vnc_port`,`tablet`,`store_uefi_vars`,`utc_time`,`host_bridge`,`acpi`,`use_hlt`,`exit_on_pause`,`wire_guest_mem`,`destroy_power_off`,`ignore_unknown_msr`,`kbd_layout`,`auto_start`,`sound`,`sound_in`,`sound_out`,`com1`,`com1_dev`,`com1_log`,`com2`,`com2_dev`,`com2_log`,`com3`,`com3_dev`,`com3_log`,`com4`,`com4_dev`,`com4_log`,`extra_args`,`com1_speed`,`com2_speed`,`com3_speed`,`com4_speed`,`auto_start_delay`,`debug`,`debug_wait`,`debug_port`,`priority`,`protect`,`pcpu`,`rbps`,`wbps`,`riops`,`wiops`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON CONFLICT (`id`) DO UPDATE SET `vm_id`=`excluded`.`vm_id` RETURNING `id`", //nolint:lll
+						"INSERT INTO `configs` (`created_at`,`updated_at`,`deleted_at`,`vm_id`,`cpu`,`mem`,`max_wait`,`restart`,`restart_delay`,`screen`,`screen_width`,`screen_height`,`vnc_wait`,`vnc_port`,`tablet`,`store_uefi_vars`,`utc_time`,`host_bridge`,`acpi`,`use_hlt`,`exit_on_pause`,`wire_guest_mem`,`destroy_power_off`,`ignore_unknown_msr`,`kbd_layout`,`auto_start`,`sound`,`sound_in`,`sound_out`,`com1`,`com1_dev`,`com1_log`,`com2`,`com2_dev`,`com2_log`,`com3`,`com3_dev`,`com3_log`,`com4`,`com4_dev`,`com4_log`,`extra_args`,`com1_speed`,`com2_speed`,`com3_speed`,`com4_speed`,`auto_start_delay`,`debug`,`debug_wait`,`debug_port`,`priority`,`protect`,`pcpu`,`rbps`,`wbps`,`riops`,`wiops`,`stop_policy`,`restart_policy`,`restart_max`,`restart_window`,`boot_group`,`depends_on`,`ready_pattern`,`ready_tcp`,`ready_http`,`ready_deadline`,`ready_interval`,`ready_timeout`,`live_pattern`,`live_tcp`,`live_http`,`live_interval`,`live_timeout`,`live_failures`,`live_action`,`labels`,`hook_pre_start`,`hook_post_start`,`hook_pre_stop`,`hook_post_stop`,`hook_timeout`,`pci_slots`,`pci_pack`,`sockets`,`cores`,`threads`,`cpu_pins`,`console_ports`,`guest_agent`,`firmware`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON CONFLICT (`id`) DO UPDATE SET `vm_id`=`excluded`.`vm_id` RETURNING `id`", //nolint:lll
 					),
 				).WithArgs(
-					sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "c9478af2-8a18-4a86-8234-5be5ceb80d95", 1, 128, 120, true, 1, true, 1920, 1080, false, "AUTO", true, true, true, true, true, true, true, false, true, true, "default", false, false, "/dev/dsp0", "/dev/dsp0", true, "AUTO", false, false, "AUTO", false, false, "AUTO", false, false, "AUTO", false, "", 115200, 115200, 115200, 115200, 0, false, false, "AUTO", 0, true, 0, 0, 0, 0, 0). //nolint:lll
+					sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "c9478af2-8a18-4a86-8234-5be5ceb80d95", 1, 128, 120, true, 1, true, 1920, 1080, false, "AUTO", true, true, true, true, true, true, true, false, true, true, "default", false, false, "/dev/dsp0", "/dev/dsp0", true, "AUTO", false, false, "AUTO", false, false, "AUTO", false, false, "AUTO", false, "", 115200, 115200, 115200, 115200, 0, false, false, "AUTO", 0, true, 0, 0, 0, 0, 0, "", "on-failure", 5, 300, 0, "", "", "", "", 300, 5, 2, "", "", "", 30, 5, 3, "log", "", "", "", "", "", 60, "", false, 0, 0, 0, "", "", false, "uefi"). //nolint:lll
 					WillReturnError(gorm.ErrInvalidData)
 				mock.ExpectRollback()
 			},
@@ -1904,11 +1939,11 @@ func Test_server_DeleteVM(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
 					),
 				).
 					WithArgs(
-						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMDELETE", "{\"vm_id\":\"0a973853-bd42-476d-8701-be07cab19895\"}", sqlmock.AnyArg(), //nolint:lll
+						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMDELETE", "{\"vm_id\":\"0a973853-bd42-476d-8701-be07cab19895\"}", "", sqlmock.AnyArg(), //nolint:lll
 					).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("019185cb-7ebb-7882-8e32-96ca3041d3f4"))
 				mock.ExpectCommit()
@@ -1972,11 +2007,11 @@ func Test_server_DeleteVM(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
 					),
 				).
 					WithArgs(
-						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMDELETE", "{\"vm_id\":\"0a973853-bd42-476d-8701-be07cab19895\"}", sqlmock.AnyArg(), //nolint:lll
+						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMDELETE", "{\"vm_id\":\"0a973853-bd42-476d-8701-be07cab19895\"}", "", sqlmock.AnyArg(), //nolint:lll
 					).
 					WillReturnError(gorm.ErrInvalidData)
 				mock.ExpectRollback()
@@ -2317,7 +2352,7 @@ func Test_server_UpdateVM(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
 					WithArgs(
 						false,
@@ -2326,6 +2361,7 @@ func Test_server_UpdateVM(t *testing.T) {
 						false,
 						true,
 						82,
+						0,
 						"/dev/nmdm-test2024082408-c1",
 						false,
 						9600,
@@ -2339,33 +2375,66 @@ func Test_server_UpdateVM(t *testing.T) {
 						"/dev/nmdm-test2024082408-c4",
 						true,
 						9600,
+						"",
+						0,
 						4,
+						"",
 						true,
 						"7123",
 						true,
+						"",
 						false,
 						false,
 						"-somejunk",
+						"",
+						false,
+						"",
+						"",
+						"",
+						"",
+						0,
 						false,
 						false,
 						"us_unix",
+						"",
+						"",
+						0,
+						"",
+						0,
+						"",
+						"",
+						0,
 						1200,
 						4096,
+						false,
+						"",
 						11,
 						12,
 						true,
 						1001,
+						0,
+						"",
+						0,
+						"",
+						"",
+						0,
 						true,
 						765,
+						0,
+						"",
+						0,
 						1003,
 						true,
 						1080,
 						1920,
+						0,
 						true,
 						"/dev/dsp0",
 						"/dev/dsp0",
+						"",
 						false,
 						false,
+						0,
 						false,
 						false,
 						"7900",
@@ -2772,7 +2841,7 @@ func Test_server_UpdateVM(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
 					WithArgs(
 						false,
@@ -2781,6 +2850,7 @@ func Test_server_UpdateVM(t *testing.T) {
 						false,
 						true,
 						3600,
+						0,
 						"/dev/nmdm-test2024082408-c1",
 						false,
 						9600,
@@ -2794,33 +2864,66 @@ func Test_server_UpdateVM(t *testing.T) {
 						"/dev/nmdm-test2024082408-c4",
 						true,
 						9600,
+						"",
+						0,
 						4,
+						"",
 						true,
 						"7123",
 						true,
+						"",
 						false,
 						false,
 						"-somejunk",
+						"",
+						false,
+						"",
+						"",
+						"",
+						"",
+						0,
 						false,
 						false,
 						"us_unix",
+						"",
+						"",
+						0,
+						"",
+						0,
+						"",
+						"",
+						0,
 						1200,
 						4096,
+						false,
+						"",
 						11,
 						12,
 						true,
 						1001,
+						0,
+						"",
+						0,
+						"",
+						"",
+						0,
 						true,
 						765,
+						0,
+						"",
+						0,
 						1003,
 						true,
 						1080,
 						1920,
+						0,
 						true,
 						"/dev/dsp0",
 						"/dev/dsp0",
+						"",
 						false,
 						false,
+						0,
 						false,
 						false,
 						"7900",
@@ -4595,7 +4698,7 @@ func Test_server_UpdateVM(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
 					WithArgs(
 						true,
@@ -4604,6 +4707,7 @@ func Test_server_UpdateVM(t *testing.T) {
 						true,
 						false,
 						60,
+						0,
 						"AUTO",
 						false,
 						115200,
@@ -4617,32 +4721,65 @@ func Test_server_UpdateVM(t *testing.T) {
 						"AUTO",
 						false,
 						115200,
+						"",
+						0,
 						2,
+						"",
 						false,
 						"AUTO",
 						false,
+						"",
 						true,
 						true,
 						"",
+						"",
+						false,
+						"",
+						"",
+						"",
+						"",
+						0,
 						true,
 						true,
 						"default",
+						"",
+						"",
+						0,
+						"",
+						0,
+						"",
+						"",
+						0,
 						60, 2048,
+						false,
+						"",
 						0,
 						0,
 						nil,
 						0,
+						0,
+						"",
+						0,
+						"",
+						"",
+						0,
 						false,
 						0,
 						0,
+						"",
+						0,
+						0,
 						false,
 						768,
 						1024,
+						0,
 						false,
 						"/dev/dsp1",
 						"/dev/dsp1",
+						"",
 						true,
 						true,
+						0,
 						true,
 						true,
 						"AUTO",
@@ -5233,9 +5370,9 @@ func Test_server_SetVMISOs(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
-					WithArgs(false, false, false, false, false, 0, "", false, 0, "", false, 0, "", false, 0, false, "", false, 0, 0, false, "", false, false, false, "", false, false, "", 0, 0, 0, 0, nil, 0, false, 0, 0, false, 0, 0, false, "", "", false, false, false, false, "", false, 0, 0, false, sqlmock.AnyArg(), 8202). //nolint:lll
+					WithArgs(false, false, false, false, false, 0, 0, "", false, 0, "", false, 0, "", false, 0, false, "", false, 0, "", 0, 0, "", false, "", false, "", false, false, "", "", false, "", "", "", "", 0, false, false, "", "", "", 0, "", 0, "", "", 0, 0, 0, false, "", 0, 0, nil, 0, 0, "", 0, "", "", 0, false, 0, 0, "", 0, 0, false, 0, 0, 0, false, "", "", "", false, false, 0, false, false, "", false, 0, 0, false, sqlmock.AnyArg(), 8202). //nolint:lll
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 
@@ -5339,9 +5476,9 @@ func Test_server_SetVMISOs(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
-					WithArgs(false, false, false, false, false, 0, "", false, 0, "", false, 0, "", false, 0, false, "", false, 0, 0, false, "", false, false, false, "", false, false, "", 0, 0, 0, 0, nil, 0, false, 0, 0, false, 0, 0, false, "", "", false, false, false, false, "", false, 0, 0, false, sqlmock.AnyArg(), 8202). //nolint:lll
+					WithArgs(false, false, false, false, false, 0, 0, "", false, 0, "", false, 0, "", false, 0, false, "", false, 0, "", 0, 0, "", false, "", false, "", false, false, "", "", false, "", "", "", "", 0, false, false, "", "", "", 0, "", 0, "", "", 0, 0, 0, false, "", 0, 0, nil, 0, 0, "", 0, "", "", 0, false, 0, 0, "", 0, 0, false, 0, 0, 0, false, "", "", "", false, false, 0, false, false, "", false, 0, 0, false, sqlmock.AnyArg(), 8202). //nolint:lll
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 
@@ -6220,9 +6357,9 @@ func Test_server_SetVMDisks(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
-					WithArgs(false, false, false, false, false, 0, "", false, 0, "", false, 0, "", false, 0, false, "", false, 0, 0, false, "", false, false, false, "", false, false, "", 0, 0, 0, 0, nil, 0, false, 0, 0, false, 0, 0, false, "", "", false, false, false, false, "", false, 0, 0, false, sqlmock.AnyArg(), 693). //nolint:lll
+					WithArgs(false, false, false, false, false, 0, 0, "", false, 0, "", false, 0, "", false, 0, false, "", false, 0, "", 0, 0, "", false, "", false, "", false, false, "", "", false, "", "", "", "", 0, false, false, "", "", "", 0, "", 0, "", "", 0, 0, 0, false, "", 0, 0, nil, 0, 0, "", 0, "", "", 0, false, 0, 0, "", 0, 0, false, 0, 0, 0, false, "", "", "", false, false, 0, false, false, "", false, 0, 0, false, sqlmock.AnyArg(), 693). //nolint:lll
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 
@@ -6309,9 +6446,9 @@ func Test_server_SetVMDisks(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
-					WithArgs(false, false, false, false, false, 0, "", false, 0, "", false, 0, "", false, 0, false, "", false, 0, 0, false, "", false, false, false, "", false, false, "", 0, 0, 0, 0, nil, 0, false, 0, 0, false, 0, 0, false, "", "", false, false, false, false, "", false, 0, 0, false, sqlmock.AnyArg(), 693). //nolint:lll
+					WithArgs(false, false, false, false, false, 0, 0, "", false, 0, "", false, 0, "", false, 0, false, "", false, 0, "", 0, 0, "", false, "", false, "", false, false, "", "", false, "", "", "", "", 0, false, false, "", "", "", 0, "", 0, "", "", 0, 0, 0, false, "", 0, 0, nil, 0, 0, "", 0, "", "", 0, false, 0, 0, "", 0, 0, false, 0, 0, 0, false, "", "", "", false, false, 0, false, false, "", false, 0, 0, false, sqlmock.AnyArg(), 693). //nolint:lll
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 
@@ -6612,11 +6749,11 @@ func Test_server_StopVM(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
 					),
 				).
 					WithArgs(
-						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMSTOP", "{\"vm_id\":\"ef7f4777-5a93-4159-b3b2-807860929ddc\"}", sqlmock.AnyArg(), //nolint:lll
+						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMSTOP", "{\"vm_id\":\"ef7f4777-5a93-4159-b3b2-807860929ddc\"}", "", sqlmock.AnyArg(), //nolint:lll
 					).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("92b56d70-7001-4598-8895-761791234678"))
 				mock.ExpectCommit()
@@ -6677,11 +6814,11 @@ func Test_server_StopVM(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
 					),
 				).
 					WithArgs(
-						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMSTOP", "{\"vm_id\":\"ef7f4777-5a93-4159-b3b2-807860929ddc\"}", sqlmock.AnyArg(), //nolint:lll
+						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMSTOP", "{\"vm_id\":\"ef7f4777-5a93-4159-b3b2-807860929ddc\"}", "", sqlmock.AnyArg(), //nolint:lll
 					).
 					WillReturnError(gorm.ErrInvalidData)
 				mock.ExpectRollback()
@@ -6985,11 +7122,11 @@ func Test_server_StartVM(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
 					),
 				).
 					WithArgs(
-						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMSTART", "{\"vm_id\":\"46153591-b8b1-419f-8bdb-d82981abb115\"}", sqlmock.AnyArg(), //nolint:lll
+						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMSTART", "{\"vm_id\":\"46153591-b8b1-419f-8bdb-d82981abb115\"}", "", sqlmock.AnyArg(), //nolint:lll
 					).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("e382c142-c38e-44b5-8746-72e282b2f6b8"))
 				mock.ExpectCommit()
@@ -7050,11 +7187,11 @@ func Test_server_StartVM(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`", //nolint:lll
 					),
 				).
 					WithArgs(
-						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMSTART", "{\"vm_id\":\"46153591-b8b1-419f-8bdb-d82981abb115\"}", sqlmock.AnyArg(), //nolint:lll
+						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false, false, "VMSTART", "{\"vm_id\":\"46153591-b8b1-419f-8bdb-d82981abb115\"}", "", sqlmock.AnyArg(), //nolint:lll
 					).
 					WillReturnError(gorm.ErrInvalidData)
 				mock.ExpectRollback()
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"cirrina/cirrina"
+)
+
+func (s *server) ListVMUEFIBootEntries(vmID *cirrina.VMID, stream cirrina.VMInfo_ListVMUEFIBootEntriesServer) error {
+	vmInst, err := getControllerVM(vmID.GetValue())
+	if err != nil {
+		return err
+	}
+
+	entries, err := vmInst.GetUEFIBootEntries()
+	if err != nil {
+		return fmt.Errorf("error getting UEFI boot entries: %w", err)
+	}
+
+	for _, entry := range entries {
+		err = stream.Send(&cirrina.VMUEFIBootEntry{
+			Number:      uint32(entry.Number),
+			Description: entry.Description,
+			Active:      entry.Active,
+			DevicePath:  entry.DevicePath,
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) GetVMUEFIBootOrder(_ context.Context, vmID *cirrina.VMID) (*cirrina.VMUEFIBootOrder, error) {
+	vmInst, err := getControllerVM(vmID.GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	order, next, err := vmInst.GetUEFIBootOrder()
+	if err != nil {
+		return nil, fmt.Errorf("error getting UEFI boot order: %w", err)
+	}
+
+	res := cirrina.VMUEFIBootOrder{}
+
+	for _, number := range order {
+		res.BootOrder = append(res.BootOrder, uint32(number))
+	}
+
+	if next != nil {
+		bootNext := uint32(*next)
+		res.BootNext = &bootNext
+	}
+
+	return &res, nil
+}
+
+func (s *server) SetVMUEFIBootOrder(_ context.Context, orderReq *cirrina.VMUEFIBootOrderReq) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getControllerVM(orderReq.GetVmid().GetValue())
+	if err != nil {
+		return &res, err
+	}
+
+	order := make([]uint16, 0, len(orderReq.GetBootOrder()))
+
+	for _, number := range orderReq.GetBootOrder() {
+		if number > math.MaxUint16 {
+			return &res, fmt.Errorf("%w: %d", errUEFIBootNumber, number)
+		}
+
+		order = append(order, uint16(number))
+	}
+
+	err = vmInst.SetUEFIBootOrder(order)
+	if err != nil {
+		return &res, fmt.Errorf("error setting UEFI boot order: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+func (s *server) SetVMUEFIBootNext(_ context.Context, nextReq *cirrina.VMUEFIBootNextReq) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getControllerVM(nextReq.GetVmid().GetValue())
+	if err != nil {
+		return &res, err
+	}
+
+	var next *uint16
+
+	if nextReq.BootNext != nil {
+		if nextReq.GetBootNext() > math.MaxUint16 {
+			return &res, fmt.Errorf("%w: %d", errUEFIBootNumber, nextReq.GetBootNext())
+		}
+
+		bootNext := uint16(nextReq.GetBootNext())
+		next = &bootNext
+	}
+
+	err = vmInst.SetUEFIBootNext(next)
+	if err != nil {
+		return &res, fmt.Errorf("error setting UEFI boot next: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+func (s *server) BootVMFromISOOnce(_ context.Context, isoReq *cirrina.VMUEFIBootISOReq) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getControllerVM(isoReq.GetVmid().GetValue())
+	if err != nil {
+		return &res, err
+	}
+
+	_, err = vmInst.BootISOOnce(isoReq.GetIsoId().GetValue())
+	if err != nil {
+		return &res, fmt.Errorf("error setting VM to boot ISO once: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+func (s *server) GetVMUEFIVars(_ context.Context, vmID *cirrina.VMID) (*cirrina.VMUEFIVars, error) {
+	vmInst, err := getControllerVM(vmID.GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := vmInst.GetUEFIVars()
+	if err != nil {
+		return nil, fmt.Errorf("error getting UEFI vars: %w", err)
+	}
+
+	return &cirrina.VMUEFIVars{Vmid: vmID, Data: data}, nil
+}
+
+func (s *server) SetVMUEFIVars(_ context.Context, uefiVars *cirrina.VMUEFIVars) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{}
+	res.Success = false
+
+	vmInst, err := getControllerVM(uefiVars.GetVmid().GetValue())
+	if err != nil {
+		return &res, err
+	}
+
+	err = vmInst.SetUEFIVars(uefiVars.GetData())
+	if err != nil {
+		return &res, fmt.Errorf("error restoring UEFI vars: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
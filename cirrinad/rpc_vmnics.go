@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"cirrina/cirrina"
@@ -577,3 +580,119 @@ func (s *server) GetVMNicName(_ context.Context, vmNicID *cirrina.VmNicId) (*wra
 
 	return wrapperspb.String(vmNic.Name), nil
 }
+
+// GetVMNicAddresses returns the addresses the guest has been seen using on the NIC, from the host neighbor tables
+// and the guest agent if the VM has one
+func (s *server) GetVMNicAddresses(vmNicID *cirrina.VmNicId, stream cirrina.VMInfo_GetVMNicAddressesServer) error {
+	nicUUID, err := uuid.Parse(vmNicID.GetValue())
+	if err != nil {
+		return fmt.Errorf("error parsing ID: %w", err)
+	}
+
+	vmNic, err := vmnic.GetByID(nicUUID.String())
+	if err != nil {
+		slog.Error("error getting nic", "nic", nicUUID.String(), "err", err)
+
+		return fmt.Errorf("error looking up NIC: %w", err)
+	}
+
+	if vmNic.Name == "" {
+		return errNotFound
+	}
+
+	neighbors, err := vmnic.NeighborAddresses()
+	if err != nil {
+		return fmt.Errorf("error getting NIC addresses: %w", err)
+	}
+
+	nicVM := getNicVM(vmNic)
+
+	for _, address := range nicAddresses(vmNic, nicVM, neighbors, guestInterfaces(nicVM)) {
+		err = stream.Send(&cirrina.VmNicAddress{
+			Address:  address.Address,
+			Source:   address.Source,
+			LastSeen: timestamppb.New(address.LastSeen),
+		})
+		if err != nil {
+			return fmt.Errorf("error sending NIC address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// nicAddresses records and returns the addresses seen on the NIC, interfaces are those the guest agent reports
+func nicAddresses(vmNic *vmnic.VMNic, nicVM *vm.VM, neighbors map[string][]vmnic.Address,
+	interfaces []vm.GuestInterface,
+) []vmnic.Address {
+	// generated MACs depend on the VM, a NIC without a VM has no MAC to look for unless it is set
+	if nicVM == nil && vmNic.Mac == "AUTO" {
+		return nil
+	}
+
+	var macAddress string
+	if nicVM == nil {
+		macAddress = vmnic.NormalizeMac(vmNic.GetMAC("", ""))
+	} else {
+		macAddress = vmnic.NormalizeMac(vmNic.GetMAC(nicVM.ID, nicVM.Name))
+	}
+
+	if macAddress == "" {
+		return nil
+	}
+
+	found := slices.Concat(neighbors[macAddress], agentNicAddresses(interfaces, macAddress))
+
+	return vmNic.RecordAddresses(macAddress, found)
+}
+
+// getNicVM returns the VM the NIC is attached to, or nil if it is not attached
+func getNicVM(vmNic *vmnic.VMNic) *vm.VM {
+	for _, thisVM := range vm.GetAll() {
+		if thisVM.Config.ID == vmNic.ConfigID {
+			return thisVM
+		}
+	}
+
+	return nil
+}
+
+// guestInterfaces returns the guest's network interfaces if the VM is running with the guest agent, or nil
+func guestInterfaces(nicVM *vm.VM) []vm.GuestInterface {
+	if nicVM == nil || !nicVM.Running() || !nicVM.Config.GuestAgent {
+		return nil
+	}
+
+	interfaces, err := nicVM.AgentInterfaces()
+	if err != nil {
+		// the guest may not have started the agent yet
+		slog.Debug("unable to get guest interfaces", "vm", nicVM.Name, "err", err)
+
+		return nil
+	}
+
+	return interfaces
+}
+
+// agentNicAddresses returns the addresses the guest agent reports on the guest interface with the MAC address
+func agentNicAddresses(interfaces []vm.GuestInterface, macAddress string) []vmnic.Address {
+	var addresses []vmnic.Address
+
+	seen := time.Now()
+
+	for _, guestInterface := range interfaces {
+		if vmnic.NormalizeMac(guestInterface.HardwareAddress) != macAddress {
+			continue
+		}
+
+		for _, address := range guestInterface.IPAddresses {
+			addresses = append(addresses, vmnic.Address{
+				Address:  address.Address,
+				Source:   vmnic.AddressSourceAgent,
+				LastSeen: seen,
+			})
+		}
+	}
+
+	return addresses
+}
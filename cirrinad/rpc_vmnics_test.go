@@ -4696,10 +4696,10 @@ func Test_server_CloneVMNic(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
 					WithArgs(
 						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false,
-						false, "NICCLONE", "{\"nic_id\":\"4abdb6d2-a80f-4522-8e87-a4b93cb4ccbf\",\"new_nic_name\":\"test2024050503_int0\"}", sqlmock.AnyArg(), //nolint:lll
+						false, "NICCLONE", "{\"nic_id\":\"4abdb6d2-a80f-4522-8e87-a4b93cb4ccbf\",\"new_nic_name\":\"test2024050503_int0\"}", "", sqlmock.AnyArg(), //nolint:lll
 					).
 					WillReturnError(gorm.ErrInvalidData)
 
@@ -4801,10 +4801,10 @@ func Test_server_CloneVMNic(t *testing.T) {
 				mock.ExpectBegin()
 				mock.ExpectQuery(
 					regexp.QuoteMeta(
-						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`id`) VALUES (?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
+						"INSERT INTO `requests` (`created_at`,`updated_at`,`deleted_at`,`started_at`,`successful`,`complete`,`type`,`data`,`result`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?) RETURNING `id`")). //nolint:lll
 					WithArgs(
 						sqlmock.AnyArg(), sqlmock.AnyArg(), nil, nil, false,
-						false, "NICCLONE", "{\"nic_id\":\"4abdb6d2-a80f-4522-8e87-a4b93cb4ccbf\",\"new_nic_name\":\"test2024050503_int0\"}", sqlmock.AnyArg(), //nolint:lll
+						false, "NICCLONE", "{\"nic_id\":\"4abdb6d2-a80f-4522-8e87-a4b93cb4ccbf\",\"new_nic_name\":\"test2024050503_int0\"}", "", sqlmock.AnyArg(), //nolint:lll
 					).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).
 						AddRow("0874dc01-44ef-4962-b676-ca8824ae5e9f"))
@@ -4891,3 +4891,30 @@ func Test_server_CloneVMNic(t *testing.T) {
 		})
 	}
 }
+
+//nolint:paralleltest
+func Test_agentNicAddresses(t *testing.T) {
+	interfaces := []vm.GuestInterface{
+		{Name: "lo", IPAddresses: []vm.GuestIPAddress{{Type: "ipv4", Address: "127.0.0.1", Prefix: 8}}},
+		{
+			Name:            "vtnet0",
+			HardwareAddress: "58:9C:FC:0A:1B:2C",
+			IPAddresses:     []vm.GuestIPAddress{{Type: "ipv4", Address: "10.0.0.5", Prefix: 24}},
+		},
+		{
+			Name:            "vtnet1",
+			HardwareAddress: "58:9c:fc:0a:1b:2d",
+			IPAddresses:     []vm.GuestIPAddress{{Type: "ipv4", Address: "10.0.1.5", Prefix: 24}},
+		},
+	}
+
+	got := agentNicAddresses(interfaces, "58:9c:fc:0a:1b:2c")
+	if len(got) != 1 || got[0].Address != "10.0.0.5" || got[0].Source != vmnic.AddressSourceAgent {
+		t.Errorf("agentNicAddresses() = %v, want only 10.0.0.5 from the agent", got)
+	}
+
+	got = agentNicAddresses(nil, "58:9c:fc:0a:1b:2c")
+	if len(got) != 0 {
+		t.Errorf("agentNicAddresses() without an agent = %v, want none", got)
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cast"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/webhook"
+)
+
+func (s *server) AddWebhook(_ context.Context, webhookInfo *cirrina.WebhookInfo) (*cirrina.WebhookId, error) {
+	newEndpoint := &webhook.Endpoint{
+		Name:        webhookInfo.GetName(),
+		Description: webhookInfo.GetDescription(),
+		URL:         webhookInfo.GetUrl(),
+		Secret:      webhookInfo.GetSecret(),
+		Events:      webhookInfo.GetEvents(),
+		MaxRetries:  webhookInfo.GetMaxRetries(),
+	}
+
+	err := webhook.Create(newEndpoint)
+	if err != nil {
+		return &cirrina.WebhookId{}, fmt.Errorf("error creating webhook: %w", err)
+	}
+
+	slog.Debug("created webhook", "webhook", newEndpoint.Name, "id", newEndpoint.ID)
+
+	return &cirrina.WebhookId{Value: newEndpoint.ID}, nil
+}
+
+// ListWebhooks returns all webhooks, secrets are never sent back to clients
+func (s *server) ListWebhooks(_ *emptypb.Empty, stream cirrina.VMInfo_ListWebhooksServer) error {
+	for _, anEndpoint := range webhook.GetAll() {
+		err := stream.Send(&cirrina.WebhookInfo{
+			Id:          &anEndpoint.ID,
+			Name:        &anEndpoint.Name,
+			Description: &anEndpoint.Description,
+			Url:         &anEndpoint.URL,
+			Events:      &anEndpoint.Events,
+			MaxRetries:  &anEndpoint.MaxRetries,
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *server) RemoveWebhook(_ context.Context, webhookID *cirrina.WebhookId) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{Success: false}
+
+	anEndpoint, err := getWebhook(webhookID)
+	if err != nil {
+		return &res, err
+	}
+
+	err = anEndpoint.Delete()
+	if err != nil {
+		return &res, fmt.Errorf("error deleting webhook: %w", err)
+	}
+
+	res.Success = true
+
+	return &res, nil
+}
+
+// TestWebhook sends a test event to the webhook, the result shows up in its delivery log
+func (s *server) TestWebhook(_ context.Context, webhookID *cirrina.WebhookId) (*cirrina.ReqBool, error) {
+	res := cirrina.ReqBool{Success: false}
+
+	anEndpoint, err := getWebhook(webhookID)
+	if err != nil {
+		return &res, err
+	}
+
+	anEndpoint.SendTest()
+
+	res.Success = true
+
+	return &res, nil
+}
+
+func (s *server) GetWebhookDeliveries(webhookID *cirrina.WebhookId,
+	stream cirrina.VMInfo_GetWebhookDeliveriesServer,
+) error {
+	anEndpoint, err := getWebhook(webhookID)
+	if err != nil {
+		return err
+	}
+
+	deliveries, err := anEndpoint.GetDeliveries()
+	if err != nil {
+		return fmt.Errorf("error getting webhook deliveries: %w", err)
+	}
+
+	for _, aDelivery := range deliveries {
+		err = stream.Send(&cirrina.WebhookDelivery{
+			Time:       timestamppb.New(aDelivery.CreatedAt),
+			EventId:    aDelivery.EventID,
+			Event:      aDelivery.Event,
+			Attempts:   aDelivery.Attempts,
+			StatusCode: cast.ToInt32(aDelivery.StatusCode),
+			Success:    aDelivery.Success,
+			Error:      aDelivery.Error,
+		})
+		if err != nil {
+			return fmt.Errorf("error writing to stream: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func getWebhook(webhookID *cirrina.WebhookId) (*webhook.Endpoint, error) {
+	webhookUUID, err := uuid.Parse(webhookID.GetValue())
+	if err != nil {
+		return nil, errInvalidID
+	}
+
+	anEndpoint, err := webhook.GetByID(webhookUUID.String())
+	if err != nil {
+		slog.Error("error getting webhook", "webhook", webhookID.GetValue(), "err", err)
+
+		return nil, errNotFound
+	}
+
+	return anEndpoint, nil
+}
@@ -0,0 +1,219 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchYears limits how far ahead Next looks, an expression such as "0 0 30 2 *" never matches
+const cronSearchYears = 5
+
+// Cron is a parsed cron expression with the usual five fields: minute, hour, day of month, month and day of week.
+// Each field is a bit set of the values it matches.
+type Cron struct {
+	minute  uint64
+	hour    uint64
+	dom     uint64
+	month   uint64
+	dow     uint64
+	domStar bool
+	dowStar bool
+}
+
+type cronField struct {
+	name  string
+	min   uint
+	max   uint
+	names map[string]uint
+}
+
+var cronFields = [5]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}},
+	// both 0 and 7 are sunday
+	{name: "day of week", min: 0, max: 7, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}},
+}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseCron parses a five field cron expression. Fields may be *, a value, a range such as 1-5, a list such as 1,3,5
+// and may have a step such as */15 or 0-30/10. Months and days of the week may be given by their three letter names.
+// The @yearly, @monthly, @weekly, @daily and @hourly shortcuts are also accepted.
+func ParseCron(expr string) (*Cron, error) {
+	expr = strings.TrimSpace(expr)
+
+	macro, isMacro := cronMacros[strings.ToLower(expr)]
+	if isMacro {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("%w: expected %d fields, got %d", errCronInvalid, len(cronFields), len(fields))
+	}
+
+	var bits [5]uint64
+
+	for fieldIdx, field := range fields {
+		fieldBits, err := parseCronField(field, cronFields[fieldIdx])
+		if err != nil {
+			return nil, err
+		}
+
+		bits[fieldIdx] = fieldBits
+	}
+
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+		bits[4] &^= 1 << 7
+	}
+
+	return &Cron{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+func parseCronField(field string, fieldInfo cronField) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := uint(1)
+
+		if hasStep {
+			parsedStep, err := strconv.ParseUint(stepPart, 10, 8)
+			if err != nil || parsedStep == 0 {
+				return 0, fmt.Errorf("%w: invalid step %q in %s", errCronInvalid, stepPart, fieldInfo.name)
+			}
+
+			step = uint(parsedStep)
+		}
+
+		var low, high uint
+
+		switch {
+		case rangePart == "*":
+			low, high = fieldInfo.min, fieldInfo.max
+		case strings.Contains(rangePart, "-"):
+			lowPart, highPart, _ := strings.Cut(rangePart, "-")
+
+			var err error
+
+			low, err = parseCronValue(lowPart, fieldInfo)
+			if err != nil {
+				return 0, err
+			}
+
+			high, err = parseCronValue(highPart, fieldInfo)
+			if err != nil {
+				return 0, err
+			}
+
+			if high < low {
+				return 0, fmt.Errorf("%w: invalid range %q in %s", errCronInvalid, rangePart, fieldInfo.name)
+			}
+		default:
+			var err error
+
+			low, err = parseCronValue(rangePart, fieldInfo)
+			if err != nil {
+				return 0, err
+			}
+
+			// a single value with a step, such as 5/15, means from that value to the end of the range
+			high = low
+			if hasStep {
+				high = fieldInfo.max
+			}
+		}
+
+		for value := low; value <= high; value += step {
+			bits |= 1 << value
+		}
+	}
+
+	return bits, nil
+}
+
+func parseCronValue(value string, fieldInfo cronField) (uint, error) {
+	named, isNamed := fieldInfo.names[strings.ToLower(value)]
+	if isNamed {
+		return named, nil
+	}
+
+	parsed, err := strconv.ParseUint(value, 10, 8)
+	if err != nil || uint(parsed) < fieldInfo.min || uint(parsed) > fieldInfo.max {
+		return 0, fmt.Errorf("%w: invalid value %q in %s", errCronInvalid, value, fieldInfo.name)
+	}
+
+	return uint(parsed), nil
+}
+
+// Matches returns true if the expression matches the minute t is in
+func (c *Cron) Matches(t time.Time) bool {
+	return c.minute&(1<<uint(t.Minute())) != 0 &&
+		c.hour&(1<<uint(t.Hour())) != 0 &&
+		c.month&(1<<uint(t.Month())) != 0 &&
+		c.dayMatches(t)
+}
+
+// dayMatches follows the traditional cron rule, if both the day of month and the day of week are restricted then a
+// day matching either one matches
+func (c *Cron) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
+
+// Next returns the first minute after t which the expression matches, or the zero time if there is none within the
+// next few years
+func (c *Cron) Next(after time.Time) time.Time {
+	next := after.Truncate(time.Minute).Add(time.Minute)
+	limit := next.AddDate(cronSearchYears, 0, 0)
+
+	for next.Before(limit) {
+		switch {
+		case c.month&(1<<uint(next.Month())) == 0:
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, next.Location())
+		case !c.dayMatches(next):
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, next.Location())
+		case c.hour&(1<<uint(next.Hour())) == 0:
+			next = time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, next.Location())
+		case c.minute&(1<<uint(next.Minute())) == 0:
+			next = next.Add(time.Minute)
+		default:
+			return next
+		}
+	}
+
+	return time.Time{}
+}
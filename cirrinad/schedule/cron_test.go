@@ -0,0 +1,102 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+//nolint:paralleltest
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "everyMinute", expr: "* * * * *"},
+		{name: "nightly", expr: "30 22 * * mon-fri"},
+		{name: "listsAndSteps", expr: "0,30 */2 1-15/7 jan,jul 0"},
+		{name: "sundaySeven", expr: "0 0 * * 7"},
+		{name: "macro", expr: "@daily"},
+		{name: "tooFewFields", expr: "0 0 * *", wantErr: true},
+		{name: "minuteOutOfRange", expr: "60 0 * * *", wantErr: true},
+		{name: "zeroDayOfMonth", expr: "0 0 0 * *", wantErr: true},
+		{name: "zeroStep", expr: "*/0 * * * *", wantErr: true},
+		{name: "backwardsRange", expr: "0 5-1 * * *", wantErr: true},
+		{name: "badName", expr: "0 0 * foo *", wantErr: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ParseCron(testCase.expr)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseCron() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestCron_Next(t *testing.T) {
+	// a saturday
+	start := time.Date(2026, time.October, 17, 12, 34, 56, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		want time.Time
+	}{
+		{
+			name: "everyMinute",
+			expr: "* * * * *",
+			want: time.Date(2026, time.October, 17, 12, 35, 0, 0, time.UTC),
+		},
+		{
+			name: "laterToday",
+			expr: "0 22 * * *",
+			want: time.Date(2026, time.October, 17, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekdayMorning",
+			expr: "0 7 * * mon-fri",
+			want: time.Date(2026, time.October, 19, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "sundaySeven",
+			expr: "15 3 * * 7",
+			want: time.Date(2026, time.October, 18, 3, 15, 0, 0, time.UTC),
+		},
+		{
+			name: "domOrDow",
+			expr: "0 0 1 * sun",
+			want: time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "nextYear",
+			expr: "@yearly",
+			want: time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "never",
+			expr: "0 0 30 feb *",
+			want: time.Time{},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cronSpec, err := ParseCron(testCase.expr)
+			if err != nil {
+				t.Fatalf("ParseCron() error = %v", err)
+			}
+
+			got := cronSpec.Next(start)
+			if !got.Equal(testCase.want) {
+				t.Errorf("Next() = %v, want %v", got, testCase.want)
+			}
+
+			if !got.IsZero() && !cronSpec.Matches(got) {
+				t.Errorf("Matches(%v) = false, want true", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package schedule
+
+import (
+	"log"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"cirrina/cirrinad/config"
+)
+
+type Singleton struct {
+	ScheduleDB *gorm.DB
+}
+
+var Instance *Singleton
+
+var once sync.Once
+
+func GetScheduleDB() *gorm.DB {
+	noColorLogger := logger.New(
+		log.New(os.Stdout, "ScheduleDb: ", log.LstdFlags),
+		logger.Config{
+			SlowThreshold:             200 * time.Millisecond,
+			LogLevel:                  logger.Warn,
+			IgnoreRecordNotFoundError: false,
+			Colorful:                  false,
+		},
+	)
+
+	once.Do(func() {
+		// allow override for testing
+		if Instance != nil {
+			return
+		}
+
+		Instance = &Singleton{}
+
+		scheduleDB, err := gorm.Open(
+			sqlite.Open(config.Config.DB.Path),
+			&gorm.Config{
+				Logger:      noColorLogger,
+				PrepareStmt: true,
+			},
+		)
+		if err != nil {
+			slog.Error("failed to connect to database", "err", err)
+			panic("failed to connect database, err: " + err.Error())
+		}
+
+		sqlDB, err := scheduleDB.DB()
+		if err != nil {
+			slog.Error("failed to create sqlDB database", "err", err)
+			panic("failed to create sqlDB database, err: " + err.Error())
+		}
+
+		sqlDB.SetMaxIdleConns(1)
+		sqlDB.SetMaxOpenConns(1)
+
+		Instance.ScheduleDB = scheduleDB
+	})
+
+	return Instance.ScheduleDB
+}
+
+func (s *Schedule) BeforeCreate(_ *gorm.DB) error {
+	if s == nil || s.Name == "" {
+		return errScheduleInvalidName
+	}
+
+	err := uuid.Validate(s.ID)
+	if err != nil || len(s.ID) != 36 {
+		s.ID = uuid.NewString()
+	}
+
+	return nil
+}
+
+func DBAutoMigrate() {
+	db := GetScheduleDB()
+
+	err := db.AutoMigrate(&Schedule{})
+	if err != nil {
+		slog.Error("failed to auto-migrate Schedules", "err", err)
+		panic("failed to auto-migrate Schedules, err: " + err.Error())
+	}
+}
@@ -0,0 +1,15 @@
+package schedule
+
+import "errors"
+
+var (
+	errScheduleInvalidName   = errors.New("invalid schedule name")
+	errScheduleExists        = errors.New("schedule exists")
+	errScheduleInvalidAction = errors.New("invalid schedule action, must be one of start, stop, reboot or snapshot")
+	errScheduleTarget        = errors.New("schedule must have either a VM or a label selector")
+	errScheduleSelector      = errors.New("invalid schedule label selector")
+	errScheduleInternalDB    = errors.New("internal schedule database error")
+	errScheduleIDEmpty       = errors.New("schedule id not specified or invalid")
+	errScheduleNotFound      = errors.New("schedule not found")
+	errCronInvalid           = errors.New("invalid cron expression")
+)
@@ -0,0 +1,202 @@
+package schedule
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+
+	"cirrina/cirrinad/util"
+	"cirrina/cirrinad/vm"
+)
+
+type ActionType string
+
+const (
+	ActionStart    ActionType = "start"
+	ActionStop     ActionType = "stop"
+	ActionReboot   ActionType = "reboot"
+	ActionSnapshot ActionType = "snapshot"
+)
+
+// Schedule runs an action on a VM, or on every VM matching a label selector, whenever the cron expression matches
+type Schedule struct {
+	ID          string `gorm:"uniqueIndex;not null;default:null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	Name        string         `gorm:"uniqueIndex;not null;default:null"`
+	Description string
+	Cron        string `gorm:"not null;default:null"`
+	Action      string `gorm:"not null;default:null"`
+	VMID        string // the VM to run the action on, empty if Selector is used
+	Selector    string // comma separated key=value labels a VM must all have for the action to run on it
+	LastRun     time.Time
+}
+
+// ValidAction checks the action is one of the known schedule actions
+func ValidAction(action string) bool {
+	switch ActionType(action) {
+	case ActionStart, ActionStop, ActionReboot, ActionSnapshot:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Schedule) validate() error {
+	// schedule names are used in the names of the snapshots they create
+	if !util.ValidDiskName(s.Name) {
+		return errScheduleInvalidName
+	}
+
+	_, err := ParseCron(s.Cron)
+	if err != nil {
+		return err
+	}
+
+	if !ValidAction(s.Action) {
+		return errScheduleInvalidAction
+	}
+
+	if (s.VMID == "") == (s.Selector == "") {
+		return errScheduleTarget
+	}
+
+	if s.Selector != "" {
+		selector, err := vm.ParseLabels(s.Selector)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errScheduleSelector, err)
+		}
+
+		if len(selector) == 0 {
+			return errScheduleSelector
+		}
+	}
+
+	return nil
+}
+
+// Create validates and saves a new schedule
+func Create(scheduleInst *Schedule) error {
+	err := scheduleInst.validate()
+	if err != nil {
+		return err
+	}
+
+	_, err = GetByName(scheduleInst.Name)
+	if err == nil {
+		return errScheduleExists
+	}
+
+	db := GetScheduleDB()
+
+	res := db.Create(&scheduleInst)
+	if res.Error != nil {
+		return fmt.Errorf("error creating schedule: %w", res.Error)
+	}
+
+	if res.RowsAffected != 1 {
+		return errScheduleInternalDB
+	}
+
+	return nil
+}
+
+// GetAll returns all schedules, sorted by name
+func GetAll() []*Schedule {
+	var result []*Schedule
+
+	db := GetScheduleDB()
+	db.Order("name").Find(&result)
+
+	return result
+}
+
+func GetByID(scheduleID string) (*Schedule, error) {
+	if scheduleID == "" {
+		return nil, errScheduleIDEmpty
+	}
+
+	var result *Schedule
+
+	db := GetScheduleDB()
+
+	res := db.Limit(1).Find(&result, "id = ?", scheduleID)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	if res.RowsAffected != 1 {
+		return nil, errScheduleNotFound
+	}
+
+	return result, nil
+}
+
+func GetByName(name string) (*Schedule, error) {
+	var result *Schedule
+
+	db := GetScheduleDB()
+
+	res := db.Limit(1).Find(&result, "name = ?", name)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	if res.RowsAffected != 1 {
+		return nil, errScheduleNotFound
+	}
+
+	return result, nil
+}
+
+func (s *Schedule) Delete() error {
+	db := GetScheduleDB()
+
+	res := db.Limit(1).Unscoped().Delete(&s)
+	if res.RowsAffected != 1 {
+		slog.Error("schedule delete error", "RowsAffected", res.RowsAffected)
+
+		return errScheduleInternalDB
+	}
+
+	return nil
+}
+
+// DeleteByVMID removes the schedules of a VM, used when the VM is deleted
+func DeleteByVMID(vmID string) error {
+	db := GetScheduleDB()
+
+	res := db.Unscoped().Where("vm_id = ?", vmID).Delete(&Schedule{})
+	if res.Error != nil {
+		return fmt.Errorf("error deleting VM schedules: %w", res.Error)
+	}
+
+	return nil
+}
+
+// SetLastRun records the minute the schedule last ran, so it runs only once per matching minute
+func (s *Schedule) SetLastRun(lastRun time.Time) error {
+	db := GetScheduleDB()
+
+	res := db.Model(&s).Update("last_run", lastRun)
+	if res.Error != nil {
+		return fmt.Errorf("error updating schedule: %w", res.Error)
+	}
+
+	s.LastRun = lastRun
+
+	return nil
+}
+
+// Next returns when the schedule will next run, or the zero time if its cron expression is invalid or never matches
+func (s *Schedule) Next(after time.Time) time.Time {
+	cronSpec, err := ParseCron(s.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return cronSpec.Next(after)
+}
@@ -0,0 +1,69 @@
+package schedule
+
+import (
+	"testing"
+)
+
+//nolint:paralleltest
+func TestSchedule_validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule Schedule
+		wantErr  bool
+	}{
+		{
+			name:     "vm",
+			schedule: Schedule{Name: "nightly-stop", Cron: "0 22 * * *", Action: "stop", VMID: "a-vm-id"},
+		},
+		{
+			name:     "selector",
+			schedule: Schedule{Name: "morning-start", Cron: "0 7 * * 1-5", Action: "start", Selector: "env=dev"},
+		},
+		{
+			name:     "badName",
+			schedule: Schedule{Name: "nightly stop", Cron: "0 22 * * *", Action: "stop", VMID: "a-vm-id"},
+			wantErr:  true,
+		},
+		{
+			name:     "badCron",
+			schedule: Schedule{Name: "nightly-stop", Cron: "0 22 * *", Action: "stop", VMID: "a-vm-id"},
+			wantErr:  true,
+		},
+		{
+			name:     "badAction",
+			schedule: Schedule{Name: "nightly-stop", Cron: "0 22 * * *", Action: "destroy", VMID: "a-vm-id"},
+			wantErr:  true,
+		},
+		{
+			name:     "noTarget",
+			schedule: Schedule{Name: "nightly-stop", Cron: "0 22 * * *", Action: "stop"},
+			wantErr:  true,
+		},
+		{
+			name: "bothTargets",
+			schedule: Schedule{
+				Name: "nightly-stop", Cron: "0 22 * * *", Action: "stop", VMID: "a-vm-id", Selector: "env=dev",
+			},
+			wantErr: true,
+		},
+		{
+			name:     "badSelector",
+			schedule: Schedule{Name: "nightly-stop", Cron: "0 22 * * *", Action: "stop", Selector: "env"},
+			wantErr:  true,
+		},
+		{
+			name:     "emptySelector",
+			schedule: Schedule{Name: "nightly-stop", Cron: "0 22 * * *", Action: "stop", Selector: " , "},
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.schedule.validate()
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"cirrina/cirrina"
+	"cirrina/cirrinad/schedule"
+	"cirrina/cirrinad/vm"
+)
+
+// runSchedules wakes at the start of every minute and runs the schedules which match it. Runs missed while cirrinad
+// was not running are not made up.
+func runSchedules() {
+	for {
+		now := time.Now()
+		time.Sleep(now.Truncate(time.Minute).Add(time.Minute).Sub(now))
+
+		runDueSchedules(time.Now().Truncate(time.Minute))
+	}
+}
+
+func runDueSchedules(minute time.Time) {
+	for _, aSchedule := range schedule.GetAll() {
+		cronSpec, err := schedule.ParseCron(aSchedule.Cron)
+		if err != nil {
+			slog.Error("invalid schedule cron expression", "schedule", aSchedule.Name, "err", err)
+
+			continue
+		}
+
+		if !cronSpec.Matches(minute) || !aSchedule.LastRun.Before(minute) {
+			continue
+		}
+
+		err = aSchedule.SetLastRun(minute)
+		if err != nil {
+			slog.Error("error recording schedule run", "schedule", aSchedule.Name, "err", err)
+
+			continue
+		}
+
+		for _, vmInst := range scheduleTargets(aSchedule) {
+			reqID, err := runScheduleAction(aSchedule, vmInst, minute)
+			if err != nil {
+				slog.Warn("scheduled action not run",
+					"schedule", aSchedule.Name, "vm", vmInst.Name, "action", aSchedule.Action, "err", err,
+				)
+
+				continue
+			}
+
+			slog.Info("scheduled action requested",
+				"schedule", aSchedule.Name, "vm", vmInst.Name, "action", aSchedule.Action, "request", reqID,
+			)
+		}
+	}
+}
+
+// scheduleTargets returns the VM of the schedule, or the VMs matching its label selector
+func scheduleTargets(aSchedule *schedule.Schedule) []*vm.VM {
+	if aSchedule.VMID != "" {
+		vmInst, err := vm.GetByID(aSchedule.VMID)
+		if err != nil {
+			slog.Error("schedule VM not found", "schedule", aSchedule.Name, "vm", aSchedule.VMID, "err", err)
+
+			return nil
+		}
+
+		return []*vm.VM{vmInst}
+	}
+
+	selector, err := vm.ParseLabels(aSchedule.Selector)
+	if err != nil {
+		slog.Error("invalid schedule selector", "schedule", aSchedule.Name, "err", err)
+
+		return nil
+	}
+
+	return vm.GetByLabels(selector)
+}
+
+// runScheduleAction requests the action of the schedule on the VM, going through the same checks and request queue
+// as the equivalent RPC so each run is recorded as a request
+func runScheduleAction(aSchedule *schedule.Schedule, vmInst *vm.VM, minute time.Time) (string, error) {
+	var reqID *cirrina.RequestID
+
+	var err error
+
+	ctx := context.Background()
+	srv := &server{}
+	vmID := &cirrina.VMID{Value: vmInst.ID}
+
+	switch schedule.ActionType(aSchedule.Action) {
+	case schedule.ActionStart:
+		reqID, err = srv.StartVM(ctx, vmID)
+	case schedule.ActionStop:
+		reqID, err = srv.StopVM(ctx, vmID)
+	case schedule.ActionReboot:
+		reqID, err = srv.RebootVM(ctx, vmID)
+	case schedule.ActionSnapshot:
+		snapshotName := aSchedule.Name + "-" + minute.Format("20060102-1504")
+		snapshotDesc := "created by schedule " + aSchedule.Name
+		reqID, err = srv.CreateVMSnapshot(ctx, &cirrina.VMSnapshotInfo{
+			VmId:        &vmInst.ID,
+			Name:        &snapshotName,
+			Description: &snapshotDesc,
+		})
+	default:
+		return "", errInvalidScheduleAction
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("error requesting %s: %w", aSchedule.Action, err)
+	}
+
+	return reqID.GetValue(), nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"cirrina/cirrinad/cirrinadtest"
+	"cirrina/cirrinad/requests"
+	"cirrina/cirrinad/schedule"
+	"cirrina/cirrinad/vm"
+)
+
+//nolint:paralleltest
+func Test_runScheduleAction(t *testing.T) {
+	minute := time.Date(2026, 10, 18, 3, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name        string
+		action      schedule.ActionType
+		vmStatus    vm.StatusType
+		mockClosure func(mock sqlmock.Sqlmock)
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:     "SnapshotRunning",
+			action:   schedule.ActionSnapshot,
+			vmStatus: vm.RUNNING,
+			mockClosure: func(mock sqlmock.Sqlmock) {
+				expectSnapshotRequestQueued(mock, "3b8f2c4e-7d1a-4f6b-9e2c-5a0d8b1c7e43",
+					"9c2e4f6a-1b3d-4e5f-8a7b-0c1d2e3f4a5b", "nightly-20261018-0300", "created by schedule nightly")
+			},
+			want:    "9c2e4f6a-1b3d-4e5f-8a7b-0c1d2e3f4a5b",
+			wantErr: false,
+		},
+		{
+			name:     "SnapshotStopped",
+			action:   schedule.ActionSnapshot,
+			vmStatus: vm.STOPPED,
+			mockClosure: func(mock sqlmock.Sqlmock) {
+				expectSnapshotRequestQueued(mock, "3b8f2c4e-7d1a-4f6b-9e2c-5a0d8b1c7e43",
+					"9c2e4f6a-1b3d-4e5f-8a7b-0c1d2e3f4a5b", "nightly-20261018-0300", "created by schedule nightly")
+			},
+			want:    "9c2e4f6a-1b3d-4e5f-8a7b-0c1d2e3f4a5b",
+			wantErr: false,
+		},
+		{
+			name:        "InvalidAction",
+			action:      "rewind",
+			vmStatus:    vm.RUNNING,
+			mockClosure: func(_ sqlmock.Sqlmock) {},
+			want:        "",
+			wantErr:     true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testDB, mockDB := cirrinadtest.NewMockDB(t.Name())
+			vm.Instance = &vm.Singleton{VMDB: testDB}
+			requests.Instance = &requests.Singleton{ReqDB: testDB}
+
+			testVM := vm.VM{
+				ID:     "3b8f2c4e-7d1a-4f6b-9e2c-5a0d8b1c7e43",
+				Name:   "snaptest",
+				Status: testCase.vmStatus,
+			}
+
+			vm.List.VMList = map[string]*vm.VM{testVM.ID: &testVM}
+
+			testCase.mockClosure(mockDB)
+
+			aSchedule := &schedule.Schedule{
+				Name:   "nightly",
+				Cron:   "0 3 * * *",
+				Action: string(testCase.action),
+				VMID:   testVM.ID,
+			}
+
+			got, err := runScheduleAction(aSchedule, &testVM, minute)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("runScheduleAction() error = %v, wantErr %v", err, testCase.wantErr)
+
+				return
+			}
+
+			if got != testCase.want {
+				t.Errorf("runScheduleAction() got = %v, want %v", got, testCase.want)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			if err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -272,6 +272,13 @@ func CreateSwitches() error {
 			slog.Debug("creating if switch", "name", aSwitch.Name)
 
 			err := aSwitch.buildIfSwitch()
+			if errors.Is(err, ErrSwitchExists) {
+				// kept for VMs left running across a restart
+				slog.Debug("if switch already exists, skipping", "name", aSwitch.Name)
+
+				continue
+			}
+
 			if err != nil {
 				slog.Error("error creating if switch", "err", err)
 
@@ -281,6 +288,13 @@ func CreateSwitches() error {
 			slog.Debug("creating ng switch", "name", aSwitch.Name)
 
 			err := aSwitch.buildNgSwitch()
+			if errors.Is(err, ErrSwitchExists) {
+				// kept for VMs left running across a restart
+				slog.Debug("ng switch already exists, skipping", "name", aSwitch.Name)
+
+				continue
+			}
+
 			if err != nil {
 				slog.Error("error creating ng switch",
 					"name", aSwitch.Name,
@@ -333,6 +347,11 @@ func (s *Switch) inUse() bool {
 }
 
 func DestroySwitches() error {
+	return DestroySwitchesExcept(nil)
+}
+
+// DestroySwitchesExcept destroys all the switches other than those whose ID is in keep
+func DestroySwitchesExcept(keep map[string]bool) error {
 	allSwitches := GetAll()
 
 	exitingIfSwitches, err := getAllIfSwitches()
@@ -350,6 +369,12 @@ func DestroySwitches() error {
 	}
 
 	for _, aSwitch := range allSwitches {
+		if keep[aSwitch.ID] {
+			slog.Debug("keeping switch", "name", aSwitch.Name)
+
+			continue
+		}
+
 		switch aSwitch.Type {
 		case "IF":
 			if util.ContainsStr(exitingIfSwitches, aSwitch.Name) {
@@ -667,6 +692,47 @@ func (s *Switch) ConnectNic(vmNic *vmnic.VMNic) error {
 	return nil
 }
 
+// ReconnectNic adds the existing interface of a NIC of a running VM back to the switch if it is not already a member.
+// Unlike ConnectNic no rate limiting interfaces are created, the ones the VM was started with are reused.
+func (s *Switch) ReconnectNic(vmNic *vmnic.VMNic) error {
+	if !s.nicTypeMatch(vmNic) {
+		return errSwitchUplinkWrongType
+	}
+
+	switch s.Type {
+	case "IF":
+		thisMemberName := vmNic.NetDev
+		if vmNic.RateLimit && vmNic.InstEpair != "" {
+			thisMemberName = vmNic.InstEpair + "b"
+		}
+
+		if thisMemberName == "" {
+			return nil
+		}
+
+		members, err := getIfBridgeMembers(s.Name)
+		if err != nil {
+			return fmt.Errorf("error reconnecting nic: %w", err)
+		}
+
+		if util.ContainsStr(members, thisMemberName) {
+			return nil
+		}
+
+		err = switchIfAddMember(s.Name, thisMemberName)
+		if err != nil {
+			return fmt.Errorf("error reconnecting nic: %w", err)
+		}
+	case "NG":
+		// nothing to do, bhyve connects to the ng bridge itself
+		return nil
+	default: // unreachable
+		return ErrSwitchInvalidType // unreachable
+	}
+
+	return nil
+}
+
 func (s *Switch) DisconnectNic(vmNic *vmnic.VMNic) error {
 	if !s.nicTypeMatch(vmNic) {
 		return errSwitchUplinkWrongType
@@ -27,6 +27,28 @@ func mapDiskDevTypeDBStringToType(diskDevType string) (*cirrina.DiskDevType, err
 	}
 }
 
+func mapStorageTypeToDiskDevType(storageType cirrina.StorageType) (string, error) {
+	switch storageType {
+	case cirrina.StorageType_STORAGE_DISK_FILE:
+		return "FILE", nil
+	case cirrina.StorageType_STORAGE_DISK_ZVOL:
+		return "ZVOL", nil
+	default:
+		return "", errStorageInvalidType
+	}
+}
+
+func mapDiskDevTypeToStorageType(diskDevType string) (cirrina.StorageType, error) {
+	switch diskDevType {
+	case "FILE":
+		return cirrina.StorageType_STORAGE_DISK_FILE, nil
+	case "ZVOL":
+		return cirrina.StorageType_STORAGE_DISK_ZVOL, nil
+	default:
+		return cirrina.StorageType_STORAGE_DISK_FILE, errStorageInvalidType
+	}
+}
+
 func mapDiskTypeTypeToDBString(diskType cirrina.DiskType) (string, error) {
 	switch diskType {
 	case cirrina.DiskType_NVME:
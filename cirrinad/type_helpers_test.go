@@ -112,6 +112,54 @@ func Test_mapDiskDevTypeDBStringToType(t *testing.T) {
 	}
 }
 
+func Test_mapStorageTypeToDiskDevType(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageType cirrina.StorageType
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "file",
+			storageType: cirrina.StorageType_STORAGE_DISK_FILE,
+			want:        "FILE",
+			wantErr:     false,
+		},
+		{
+			name:        "zvol",
+			storageType: cirrina.StorageType_STORAGE_DISK_ZVOL,
+			want:        "ZVOL",
+			wantErr:     false,
+		},
+		{
+			name:        "iso",
+			storageType: cirrina.StorageType_STORAGE_ISO,
+			want:        "",
+			wantErr:     true,
+		},
+	}
+
+	t.Parallel()
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := mapStorageTypeToDiskDevType(testCase.storageType)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("mapStorageTypeToDiskDevType() error = %v, wantErr %v", err, testCase.wantErr)
+
+				return
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
+
 func Test_mapDiskTypeTypeToDBString(t *testing.T) {
 	type args struct {
 		diskType cirrina.DiskType
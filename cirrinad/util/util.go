@@ -32,6 +32,8 @@ import (
 // allow testing
 var execute = exec.Command
 var GetHostMaxVMCpusFunc = GetHostMaxVMCpus
+var GetHostCpusFunc = GetHostCpus
+var GetHostPCIDevicesFunc = GetHostPCIDevices
 var GetIntGroupsFunc = GetIntGroups
 var NetInterfacesFunc = net.Interfaces
 var osOpenFunc = os.Open
@@ -732,7 +734,16 @@ func ParseDiskSize(diskSize string) (uint64, error) {
 }
 
 func GetHostMaxVMCpus() (uint16, error) {
-	stdOutBytes, stdErrBytes, rc, err := RunCmd("/sbin/sysctl", []string{"-n", "hw.vmm.maxcpu"})
+	return getSysctlCPUCount("hw.vmm.maxcpu")
+}
+
+// GetHostCpus returns the number of CPUs of the host, host CPUs are numbered from 0
+func GetHostCpus() (uint16, error) {
+	return getSysctlCPUCount("hw.ncpu")
+}
+
+func getSysctlCPUCount(name string) (uint16, error) {
+	stdOutBytes, stdErrBytes, rc, err := RunCmd("/sbin/sysctl", []string{"-n", name})
 	if string(stdErrBytes) != "" || rc != 0 || err != nil {
 		return 0, fmt.Errorf("error running sysctl: stderr: %s, rc: %d, err: %w", string(stdErrBytes), rc, err)
 	}
@@ -837,3 +848,116 @@ func CheckInterfaceExists(interfaceName string) bool {
 
 	return false
 }
+
+// HostPCIDevice is a PCI device of the host as listed by pciconf
+type HostPCIDevice struct {
+	Driver   string // driver and unit the device is bound to, e.g. ppt0, none0 when no driver is attached
+	Bus      uint8
+	Slot     uint8
+	Function uint8
+	Vendor   string
+	Device   string
+	Class    string
+	Subclass string
+}
+
+// Address returns the bus/slot/function address of the device as used by the bhyve passthru device
+func (d HostPCIDevice) Address() string {
+	return fmt.Sprintf("%d/%d/%d", d.Bus, d.Slot, d.Function)
+}
+
+// PPT returns true if the device is bound to the ppt driver and so can be passed through to a VM
+func (d HostPCIDevice) PPT() bool {
+	return strings.TrimRightFunc(d.Driver, unicode.IsDigit) == "ppt"
+}
+
+// GetHostPCIDevices returns the PCI devices of the host in PCI domain 0, the only domain bhyve can pass through from
+func GetHostPCIDevices() ([]HostPCIDevice, error) {
+	stdOutBytes, stdErrBytes, rc, err := RunCmd("/usr/sbin/pciconf", []string{"-lv"})
+	if string(stdErrBytes) != "" || rc != 0 || err != nil {
+		return nil, fmt.Errorf("error running pciconf: stderr: %s, rc: %d, err: %w", string(stdErrBytes), rc, err)
+	}
+
+	return parsePciconfOutput(string(stdOutBytes))
+}
+
+// parsePciconfOutput parses the output of pciconf -lv, a line per device such as
+// "ppt0@pci0:2:0:0: class=0x020000 ..." followed by indented "name = 'value'" lines describing it
+func parsePciconfOutput(output string) ([]HostPCIDevice, error) {
+	var devices []HostPCIDevice
+
+	var current *HostPCIDevice
+
+	for _, line := range strings.Split(output, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if line[0] == ' ' || line[0] == '\t' {
+			if current != nil {
+				parsePciconfDetail(current, line)
+			}
+
+			continue
+		}
+
+		current = nil
+
+		driver, selector, found := strings.Cut(strings.Fields(line)[0], "@pci")
+		if !found {
+			return nil, fmt.Errorf("%w: %s", errFailedParsing, line)
+		}
+
+		parts := strings.Split(strings.TrimSuffix(selector, ":"), ":")
+		if len(parts) != 4 {
+			return nil, fmt.Errorf("%w: %s", errFailedParsing, line)
+		}
+
+		var addr [4]uint64
+
+		for partIdx, part := range parts {
+			value, err := strconv.ParseUint(part, 10, 8)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", errFailedParsing, line)
+			}
+
+			addr[partIdx] = value
+		}
+
+		if addr[0] != 0 {
+			slog.Debug("skipping PCI device outside domain 0", "device", driver, "selector", selector)
+
+			continue
+		}
+
+		devices = append(devices, HostPCIDevice{
+			Driver:   driver,
+			Bus:      uint8(addr[1]),
+			Slot:     uint8(addr[2]),
+			Function: uint8(addr[3]),
+		})
+		current = &devices[len(devices)-1]
+	}
+
+	return devices, nil
+}
+
+func parsePciconfDetail(device *HostPCIDevice, line string) {
+	name, value, found := strings.Cut(line, "=")
+	if !found {
+		return
+	}
+
+	value = strings.Trim(strings.TrimSpace(value), "'")
+
+	switch strings.TrimSpace(name) {
+	case "vendor":
+		device.Vendor = value
+	case "device":
+		device.Device = value
+	case "class":
+		device.Class = value
+	case "subclass":
+		device.Subclass = value
+	}
+}
@@ -2173,6 +2173,114 @@ func TestGetHostMaxVMCpus(t *testing.T) {
 	}
 }
 
+func TestGetHostCpus(t *testing.T) {
+	tests := []struct {
+		name        string
+		want        uint16
+		mockCmdFunc string
+		wantErr     bool
+	}{
+		{
+			name:        "success1",
+			want:        32,
+			mockCmdFunc: "TestGetHostMaxVMCpusSuccess1",
+			wantErr:     false,
+		},
+		{
+			name:        "error1",
+			mockCmdFunc: "TestGetHostMaxVMCpusError2",
+			want:        0,
+			wantErr:     true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			fakeCommand := cirrinadtest.MakeFakeCommand(testCase.mockCmdFunc)
+			SetupTestCmd(fakeCommand)
+
+			t.Cleanup(func() { TearDownTestCmd() }) // prevents parallel testing
+
+			got, err := GetHostCpus()
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("GetHostCpus() error = %v, wantErr %v", err, testCase.wantErr)
+
+				return
+			}
+
+			if got != testCase.want {
+				t.Errorf("GetHostCpus() got = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestGetHostPCIDevices(t *testing.T) {
+	tests := []struct {
+		name        string
+		want        []HostPCIDevice
+		mockCmdFunc string
+		wantErr     bool
+	}{
+		{
+			name: "success1",
+			want: []HostPCIDevice{
+				{
+					Driver: "hostb0", Bus: 0, Slot: 0, Function: 0, Vendor: "Intel Corporation",
+					Device: "Xeon E3-1200 v6/7th Gen Core Processor Host Bridge/DRAM Registers", Class: "bridge",
+					Subclass: "HOST-PCI",
+				},
+				{
+					Driver: "ppt0", Bus: 2, Slot: 0, Function: 0, Vendor: "Intel Corporation",
+					Device: "82599ES 10-Gigabit SFI/SFP+ Network Connection", Class: "network", Subclass: "ethernet",
+				},
+				{Driver: "none0", Bus: 0, Slot: 31, Function: 4, Vendor: "Intel Corporation", Class: "serial bus"},
+			},
+			mockCmdFunc: "TestGetHostPCIDevicesSuccess1",
+			wantErr:     false,
+		},
+		{
+			name:        "error1",
+			mockCmdFunc: "TestGetHostMaxVMCpusError1",
+			want:        nil,
+			wantErr:     true,
+		},
+		{
+			name:        "error2",
+			mockCmdFunc: "TestGetHostPCIDevicesError2",
+			want:        nil,
+			wantErr:     true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			fakeCommand := cirrinadtest.MakeFakeCommand(testCase.mockCmdFunc)
+			SetupTestCmd(fakeCommand)
+
+			t.Cleanup(func() { TearDownTestCmd() }) // prevents parallel testing
+
+			got, err := GetHostPCIDevices()
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("GetHostPCIDevices() error = %v, wantErr %v", err, testCase.wantErr)
+
+				return
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+
+			for _, device := range got {
+				if device.PPT() != (device.Driver == "ppt0") {
+					t.Errorf("PPT() of %s got %v", device.Driver, device.PPT())
+				}
+			}
+		})
+	}
+}
+
 func TestNumCpusValid(t *testing.T) {
 	type args struct {
 		numCpus uint16
@@ -2513,6 +2621,47 @@ func TestGetIntGroups(t *testing.T) {
 
 // test helpers from here down
 
+func TestGetHostPCIDevicesSuccess1(_ *testing.T) {
+	if !cirrinadtest.IsTestEnv() {
+		return
+	}
+
+	cmdWithParams := strings.Split(os.Args[len(os.Args)-2], " ")
+	if cmdWithParams[0] != "/usr/sbin/pciconf" {
+		os.Exit(1)
+	}
+
+	//nolint:lll
+	pciconfStdout := `hostb0@pci0:0:0:0:	class=0x060000 rev=0x05 hdr=0x00 vendor=0x8086 device=0x5918 subvendor=0x15d9 subdevice=0x089a
+    vendor     = 'Intel Corporation'
+    device     = 'Xeon E3-1200 v6/7th Gen Core Processor Host Bridge/DRAM Registers'
+    class      = bridge
+    subclass   = HOST-PCI
+ppt0@pci0:2:0:0:	class=0x020000 rev=0x01 hdr=0x00 vendor=0x8086 device=0x10fb subvendor=0x8086 subdevice=0x000c
+    vendor     = 'Intel Corporation'
+    device     = '82599ES 10-Gigabit SFI/SFP+ Network Connection'
+    class      = network
+    subclass   = ethernet
+none0@pci0:0:31:4:	class=0x0c0500 rev=0x00 hdr=0x00 vendor=0x8086 device=0xa2a3 subvendor=0x15d9 subdevice=0x089a
+    vendor     = 'Intel Corporation'
+    class      = serial bus
+none1@pci1:0:0:0:	class=0x010802 rev=0x00 hdr=0x00 vendor=0x144d device=0xa808 subvendor=0x144d subdevice=0xa801
+    class      = mass storage
+`
+	_, _ = fmt.Print(pciconfStdout) //nolint:forbidigo
+
+	os.Exit(0)
+}
+
+func TestGetHostPCIDevicesError2(_ *testing.T) {
+	if !cirrinadtest.IsTestEnv() {
+		return
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "ppt0 at pci0:2:0:0\n")
+	os.Exit(0)
+}
+
 func TestGetHostMaxVMCpusError4(_ *testing.T) {
 	if !cirrinadtest.IsTestEnv() {
 		return
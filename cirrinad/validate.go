@@ -477,6 +477,32 @@ func validateRomConfig() {
 		slog.Error("rom vars template config points to directory, please reconfigure")
 		os.Exit(1)
 	}
+
+	validateFirmwareConfig()
+}
+
+func validateFirmwareConfig() {
+	firmwareNames := make(map[string]bool)
+
+	for _, firmware := range config.Config.Rom.Firmware {
+		if firmware.Name == "" || firmware.Path == "" {
+			slog.Error("rom firmware entries need a name and path, please reconfigure")
+			os.Exit(1)
+		}
+
+		if firmware.Name == vm.FirmwareDefault || firmware.Name == vm.FirmwareNone || firmwareNames[firmware.Name] {
+			slog.Error("rom firmware name reserved or duplicated, please reconfigure", "name", firmware.Name)
+			os.Exit(1)
+		}
+
+		firmwareNames[firmware.Name] = true
+
+		// optional firmware may be uninstalled, VMs using it fail to start rather than preventing startup
+		err := vm.ValidateFirmware(firmware.Name)
+		if err != nil {
+			slog.Warn("rom firmware not usable", "name", firmware.Name, "err", err)
+		}
+	}
 }
 
 func validateLogConfig() {
@@ -620,6 +646,22 @@ func validateConfig() {
 	validateZpoolConf()
 	// validateLogConfig called early in main.rootCmd.RunE
 	validateNetworkConf()
+	validateHooksConfig()
+}
+
+func validateHooksConfig() {
+	for _, hookPath := range []string{
+		config.Config.Hooks.PreStart,
+		config.Config.Hooks.PostStart,
+		config.Config.Hooks.PreStop,
+		config.Config.Hooks.PostStop,
+	} {
+		err := vm.ValidateHook(hookPath)
+		if err != nil {
+			slog.Error("invalid hook in config, please reconfigure", "err", err)
+			os.Exit(1)
+		}
+	}
 }
 
 func validateSysctlSeeOtherGIDs() {
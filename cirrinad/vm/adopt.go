@@ -0,0 +1,176 @@
+package vm
+
+import (
+	"log/slog"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"cirrina/cirrinad/config"
+	"cirrina/cirrinad/util"
+)
+
+// adoptPollInterval is how often the bhyve process of a re-attached VM is checked for exit
+const adoptPollInterval = time.Second
+
+// ExitUndetermined is recorded when a re-attached VM exits and the reason can not be worked out. It is not treated as a
+// failure, so only the always restart policy restarts the VM.
+const ExitUndetermined ExitReason = "undetermined"
+
+// bhyveOutputFile is the file in the VM's state directory bhyve's output goes to when VMs are kept running
+const bhyveOutputFile = "bhyve.log"
+
+// detachedCommand wraps the command line so bhyve does not hold pipes to cirrinad, which would break when cirrinad
+// exits and kill bhyve the next time it writes. stdin is /dev/null and its output is appended to bhyveOutputFile.
+func (v *VM) detachedCommand(name string, args []string) (string, []string) {
+	outputPath := filepath.Join(config.Config.Disk.VM.Path.State, v.Name, bhyveOutputFile)
+
+	return "/bin/sh", append([]string{
+		"-c", `out=$1; shift; exec "$@" </dev/null >>"$out" 2>&1`, "sh", outputPath, name,
+	}, args...)
+}
+
+// DetachAll prepares all running VMs to be left running when cirrinad exits. Restarts are disabled and the com port
+// loggers are closed, the status and bhyve pid of each VM are left in the database so Adopt can re-attach on startup.
+func DetachAll() {
+	restartsDisabled.Store(true)
+
+	defer List.Mu.RUnlock()
+	List.Mu.RLock()
+
+	for _, vmInst := range List.VMList {
+		if vmInst.Status != RUNNING {
+			continue
+		}
+
+		slog.Info("leaving VM running", "vm", vmInst.Name, "pid", vmInst.BhyvePid)
+		vmInst.log.Info("detaching, leaving VM running", "pid", vmInst.BhyvePid)
+		vmInst.killComLoggers()
+	}
+}
+
+// Adopt re-attaches a VM which was left running by DetachAll. It returns false if the bhyve process recorded for the
+// VM is gone or is not the VM, in which case the VM should be cleaned up as usual.
+func (v *VM) Adopt() bool {
+	if v.Status != RUNNING || v.BhyvePid == 0 {
+		return false
+	}
+
+	pidExists, err := util.PidExists(int(v.BhyvePid))
+	if err != nil || !pidExists {
+		return false
+	}
+
+	// the pid may have been reused since cirrinad exited
+	if !strings.Contains(findProcName(v.BhyvePid), "bhyve") {
+		slog.Debug("VM pid is not bhyve, not re-attaching", "vm", v.Name, "pid", v.BhyvePid)
+
+		return false
+	}
+
+	vmmExists, err := PathExistsFunc("/dev/vmm/" + v.Name)
+	if err != nil || !vmmExists {
+		return false
+	}
+
+	v.mu.Lock()
+	v.adopted = true
+	v.mu.Unlock()
+
+	v.lockDisks()
+	v.adoptPassthrus()
+	v.startReadyProbes()
+	v.startLiveProbes()
+	v.setupComLoggers()
+
+	go v.adoptedMonitor()
+
+	if config.Config.Metrics.Enabled {
+		runningVMsGauge.Inc()
+		cpuVMGauge.Add(float64(v.Config.CPU))
+		memVMGauge.Add(float64(v.Config.Mem))
+	}
+
+	slog.Info("re-attached to running VM", "vm", v.Name, "pid", v.BhyvePid)
+	v.log.Info("re-attached", "pid", v.BhyvePid)
+
+	return true
+}
+
+// Adopted returns true if the VM was re-attached at startup rather than started by this cirrinad
+func (v *VM) Adopted() bool {
+	defer v.mu.RUnlock()
+	v.mu.RLock()
+
+	return v.adopted
+}
+
+// adoptedMonitor does the job of monitor() for a re-attached VM. bhyve is not a child of this cirrinad so there is no
+// supervisor and no exit code, the pid is polled until it goes away.
+func (v *VM) adoptedMonitor() {
+	for {
+		time.Sleep(adoptPollInterval)
+
+		pidExists, err := util.PidExists(int(v.BhyvePid))
+		if err != nil {
+			slog.Debug("error checking VM pid, assuming VM exited", "vm", v.Name, "err", err)
+
+			break
+		}
+
+		if !pidExists {
+			break
+		}
+	}
+
+	stopRequested := v.Status == STOPPING
+
+	// checked before Done() destroys the VM
+	reason, exitCode := v.adoptedExitReason()
+
+	v.mu.Lock()
+	v.adopted = false
+	v.mu.Unlock()
+
+	v.Done()
+
+	if !stopRequested {
+		v.handleExitReason(reason, exitCode)
+	}
+}
+
+// adoptedExitReason works out why the bhyve process of a re-attached VM exited, its exit status is lost as it is not
+// a child of this cirrinad. bhyve only destroys the VM itself when the guest powers off and DestroyPowerOff is set,
+// every other exit leaves it behind, so only that one can be told apart.
+func (v *VM) adoptedExitReason() (ExitReason, int) {
+	if v.Config.DestroyPowerOff {
+		vmmExists, err := PathExistsFunc("/dev/vmm/" + v.Name)
+		if err == nil && !vmmExists {
+			return ExitPowerOff, 1
+		}
+	}
+
+	return ExitUndetermined, -1
+}
+
+// termAdopted asks the bhyve process of a re-attached VM to shut down, bhyve turns SIGTERM into an ACPI power button
+// press
+func (v *VM) termAdopted() error {
+	stdOutBytes, stdErrBytes, returnCode, err := util.RunCmd(
+		config.Config.Sys.Sudo,
+		[]string{"/bin/kill", "-TERM", strconv.FormatUint(uint64(v.BhyvePid), 10)},
+	)
+	if string(stdErrBytes) != "" || returnCode != 0 || err != nil {
+		slog.Error("error signaling VM",
+			"stdOutBytes", stdOutBytes,
+			"stdErrBytes", stdErrBytes,
+			"returnCode", returnCode,
+			"err", err,
+		)
+
+		return errVMStopFail
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package vm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-test/deep"
+
+	"cirrina/cirrinad/config"
+	"cirrina/cirrinad/util"
+)
+
+//nolint:paralleltest
+func TestVM_AdoptNotRunning(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   StatusType
+		bhyvePid uint32
+	}{
+		{
+			name:     "stopped",
+			status:   STOPPED,
+			bhyvePid: 0,
+		},
+		{
+			name:     "stopping",
+			status:   STOPPING,
+			bhyvePid: 1234,
+		},
+		{
+			name:     "runningNoPid",
+			status:   RUNNING,
+			bhyvePid: 0,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testVM := &VM{
+				Name:     "test2024062901",
+				Status:   testCase.status,
+				BhyvePid: testCase.bhyvePid,
+			}
+
+			if testVM.Adopt() {
+				t.Errorf("Adopt() = true, want false")
+			}
+
+			if testVM.Adopted() {
+				t.Errorf("Adopted() = true, want false")
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_detachedCommand(t *testing.T) {
+	config.Config.Disk.VM.Path.State = "/var/lib/cirrinad/state"
+
+	testVM := &VM{Name: "test2024062901"}
+
+	gotName, gotArgs := testVM.detachedCommand("/usr/local/bin/sudo", []string{"/usr/sbin/bhyve", "test2024062901"})
+
+	wantArgs := []string{
+		"-c", `out=$1; shift; exec "$@" </dev/null >>"$out" 2>&1`, "sh",
+		"/var/lib/cirrinad/state/test2024062901/bhyve.log", "/usr/local/bin/sudo", "/usr/sbin/bhyve", "test2024062901",
+	}
+
+	if gotName != "/bin/sh" {
+		t.Errorf("detachedCommand() gotName = %v, want /bin/sh", gotName)
+	}
+
+	diff := deep.Equal(gotArgs, wantArgs)
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	config.Config.Disk.VM.Path.State = ""
+}
+
+//nolint:paralleltest
+func TestVM_adoptedExitReason(t *testing.T) {
+	tests := []struct {
+		name            string
+		destroyPowerOff bool
+		vmmExists       bool
+		vmmErr          error
+		wantReason      ExitReason
+		wantExitCode    int
+	}{
+		{
+			name:            "destroyedOnPowerOff",
+			destroyPowerOff: true,
+			vmmExists:       false,
+			wantReason:      ExitPowerOff,
+			wantExitCode:    1,
+		},
+		{
+			name:            "notDestroyed",
+			destroyPowerOff: true,
+			vmmExists:       true,
+			wantReason:      ExitUndetermined,
+			wantExitCode:    -1,
+		},
+		{
+			name:            "vmmError",
+			destroyPowerOff: true,
+			vmmExists:       false,
+			vmmErr:          errors.New("another error"), //nolint:goerr113
+			wantReason:      ExitUndetermined,
+			wantExitCode:    -1,
+		},
+		{
+			name:            "noDestroyPowerOff",
+			destroyPowerOff: false,
+			vmmExists:       false,
+			wantReason:      ExitUndetermined,
+			wantExitCode:    -1,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testVM := &VM{
+				Name:   "test2024062901",
+				Config: Config{DestroyPowerOff: testCase.destroyPowerOff},
+			}
+
+			PathExistsFunc = func(testPath string) (bool, error) {
+				if testPath != "/dev/vmm/test2024062901" {
+					t.Errorf("unexpected path checked: %s", testPath)
+				}
+
+				return testCase.vmmExists, testCase.vmmErr
+			}
+
+			t.Cleanup(func() { PathExistsFunc = util.PathExists })
+
+			gotReason, gotExitCode := testVM.adoptedExitReason()
+			if gotReason != testCase.wantReason || gotExitCode != testCase.wantExitCode {
+				t.Errorf("adoptedExitReason() = %v, %v, want %v, %v",
+					gotReason, gotExitCode, testCase.wantReason, testCase.wantExitCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,351 @@
+package vm
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"math/rand/v2"
+	"net"
+	"time"
+)
+
+// timeouts of guest agent commands, freezing has to wait for the guest to flush its filesystems
+const (
+	agentTimeout       = 10 * time.Second
+	agentFreezeTimeout = 60 * time.Second
+)
+
+// a guest left frozen hangs on its next write, so a failed thaw after a snapshot is retried for a while
+const (
+	agentThawRetryDeadline = 5 * time.Minute
+	agentThawRetryInterval = 5 * time.Second
+)
+
+// agentDelimiter is sent to the guest agent to reset its parser, and sent back by the agent before the reply to a
+// guest-sync-delimited so that anything left over from an earlier connection can be skipped
+const agentDelimiter = 0xff
+
+type agentRequest struct {
+	Execute   string `json:"execute"`
+	Arguments any    `json:"arguments,omitempty"`
+}
+
+type agentResponse struct {
+	Return json.RawMessage `json:"return"`
+	Error  *agentError     `json:"error"`
+}
+
+type agentError struct {
+	Class string `json:"class"`
+	Desc  string `json:"desc"`
+}
+
+// GuestIPAddress is an address of a guest network interface as reported by the guest agent
+type GuestIPAddress struct {
+	Type    string `json:"ip-address-type"`
+	Address string `json:"ip-address"`
+	Prefix  uint32 `json:"prefix"`
+}
+
+// GuestInterface is a guest network interface as reported by the guest agent
+type GuestInterface struct {
+	Name            string           `json:"name"`
+	HardwareAddress string           `json:"hardware-address"`
+	IPAddresses     []GuestIPAddress `json:"ip-addresses"`
+}
+
+// agentConn is a connection to the guest agent, held for as long as the console port is needed
+type agentConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// agentDial connects to the guest agent console port, no one else can use the port until the connection is closed
+func (v *VM) agentDial() (*agentConn, error) {
+	if !v.Config.GuestAgent {
+		return nil, errVMGuestAgentDisabled
+	}
+
+	conn, err := v.DialConsolePort(GuestAgentPort)
+	if err != nil {
+		return nil, err
+	}
+
+	return &agentConn{conn: conn, reader: bufio.NewReader(conn)}, nil
+}
+
+func (a *agentConn) Close() error {
+	err := a.conn.Close()
+	if err != nil {
+		return fmt.Errorf("error closing guest agent connection: %w", err)
+	}
+
+	return nil
+}
+
+// agentExecute runs a qemu-guest-agent command over the guest agent console port and decodes its reply into result.
+// A nil result is used for commands the agent does not reply to when they succeed.
+func (v *VM) agentExecute(execute string, arguments any, result any, timeout time.Duration) error {
+	conn, err := v.agentDial()
+	if err != nil {
+		return err
+	}
+
+	defer func(conn io.Closer) {
+		_ = conn.Close()
+	}(conn)
+
+	return conn.execute(execute, arguments, result, timeout)
+}
+
+// execute runs a command over an open guest agent connection, see agentExecute
+func (a *agentConn) execute(execute string, arguments any, result any, timeout time.Duration) error {
+	err := a.conn.SetDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return fmt.Errorf("error setting guest agent deadline: %w", err)
+	}
+
+	err = agentSync(a.conn, a.reader)
+	if err != nil {
+		return err
+	}
+
+	err = agentSend(a.conn, agentRequest{Execute: execute, Arguments: arguments})
+	if err != nil {
+		return err
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	response, err := agentReceive(a.reader)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(response.Return, result)
+	if err != nil {
+		return fmt.Errorf("%w: bad reply to %s: %w", errVMGuestAgent, execute, err)
+	}
+
+	return nil
+}
+
+// agentSync discards anything left over on the port and checks the agent is answering
+func agentSync(conn io.Writer, reader *bufio.Reader) error {
+	syncID := rand.Int64N(math.MaxInt32)
+
+	_, err := conn.Write([]byte{agentDelimiter})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errVMGuestAgent, err)
+	}
+
+	err = agentSend(conn, agentRequest{Execute: "guest-sync-delimited", Arguments: map[string]int64{"id": syncID}})
+	if err != nil {
+		return err
+	}
+
+	for {
+		delimiter, err := reader.ReadByte()
+		if err != nil {
+			return fmt.Errorf("%w: no reply to sync: %w", errVMGuestAgent, err)
+		}
+
+		if delimiter == agentDelimiter {
+			break
+		}
+	}
+
+	for {
+		response, err := agentReceive(reader)
+		if err != nil {
+			return err
+		}
+
+		var gotID int64
+
+		err = json.Unmarshal(response.Return, &gotID)
+		if err == nil && gotID == syncID {
+			return nil
+		}
+	}
+}
+
+func agentSend(conn io.Writer, request agentRequest) error {
+	requestJSON, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("error encoding guest agent request: %w", err)
+	}
+
+	_, err = conn.Write(append(requestJSON, '\n'))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errVMGuestAgent, err)
+	}
+
+	return nil
+}
+
+// agentReceive reads the next reply from the agent, returning the error the agent gave if the command failed
+func agentReceive(reader *bufio.Reader) (*agentResponse, error) {
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errVMGuestAgent, err)
+		}
+
+		if len(line) == 1 {
+			continue
+		}
+
+		var response agentResponse
+
+		err = json.Unmarshal(line, &response)
+		if err != nil {
+			return nil, fmt.Errorf("%w: bad reply: %w", errVMGuestAgent, err)
+		}
+
+		if response.Error != nil {
+			return nil, fmt.Errorf("%w: %s: %s", errVMGuestAgent, response.Error.Class, response.Error.Desc)
+		}
+
+		return &response, nil
+	}
+}
+
+// AgentPing checks the guest agent is running
+func (v *VM) AgentPing() error {
+	var result struct{}
+
+	return v.agentExecute("guest-ping", nil, &result, agentTimeout)
+}
+
+// AgentShutdown asks the guest agent to power the guest off
+func (v *VM) AgentShutdown() error {
+	return v.agentExecute("guest-shutdown", map[string]string{"mode": "powerdown"}, nil, agentTimeout)
+}
+
+// AgentInterfaces returns the guest's network interfaces and their addresses
+func (v *VM) AgentInterfaces() ([]GuestInterface, error) {
+	var interfaces []GuestInterface
+
+	err := v.agentExecute("guest-network-get-interfaces", nil, &interfaces, agentTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return interfaces, nil
+}
+
+// AgentFreeze freezes the guest's filesystems and returns how many were frozen
+func (v *VM) AgentFreeze() (uint32, error) {
+	var frozen uint32
+
+	err := v.agentExecute("guest-fsfreeze-freeze", nil, &frozen, agentFreezeTimeout)
+	if err != nil {
+		return 0, err
+	}
+
+	return frozen, nil
+}
+
+// AgentThaw thaws the guest's filesystems and returns how many were thawed
+func (v *VM) AgentThaw() (uint32, error) {
+	var thawed uint32
+
+	err := v.agentExecute("guest-fsfreeze-thaw", nil, &thawed, agentFreezeTimeout)
+	if err != nil {
+		return 0, err
+	}
+
+	return thawed, nil
+}
+
+// AgentFreezeStatus returns whether the guest's filesystems are frozen or thawed
+func (v *VM) AgentFreezeStatus() (string, error) {
+	var status string
+
+	err := v.agentExecute("guest-fsfreeze-status", nil, &status, agentTimeout)
+	if err != nil {
+		return "", err
+	}
+
+	return status, nil
+}
+
+// AgentSyncTime sets the guest's clock to the host's time
+func (v *VM) AgentSyncTime() error {
+	var result struct{}
+
+	return v.agentExecute("guest-set-time", map[string]int64{"time": time.Now().UnixNano()}, &result, agentTimeout)
+}
+
+// QuiesceForSnapshot freezes the guest's filesystems if the VM is running with the guest agent enabled and returns
+// a function which thaws them again. Snapshots are still taken if freezing fails, they are just crash consistent.
+// The agent connection is held from the freeze to the thaw so nothing else can take the port in between.
+func (v *VM) QuiesceForSnapshot() func() {
+	if v.Status != RUNNING || !v.Config.GuestAgent {
+		return func() {}
+	}
+
+	conn, err := v.agentDial()
+	if err != nil {
+		slog.Warn("failed freezing guest filesystems, snapshot will only be crash consistent", "vm", v.Name, "err", err)
+
+		return func() {}
+	}
+
+	var frozen uint32
+
+	err = conn.execute("guest-fsfreeze-freeze", nil, &frozen, agentFreezeTimeout)
+	if err != nil {
+		slog.Warn("failed freezing guest filesystems, snapshot will only be crash consistent", "vm", v.Name, "err", err)
+
+		// a freeze which timed out may still complete in the guest
+		v.agentThaw(conn)
+
+		return func() {}
+	}
+
+	slog.Debug("froze guest filesystems", "vm", v.Name, "filesystems", frozen)
+
+	return func() {
+		v.agentThaw(conn)
+	}
+}
+
+// agentThaw thaws the guest's filesystems over the connection they were frozen on and closes it. If that fails the
+// thaw is retried on new connections until agentThawRetryDeadline.
+func (v *VM) agentThaw(conn *agentConn) {
+	var thawed uint32
+
+	err := conn.execute("guest-fsfreeze-thaw", nil, &thawed, agentFreezeTimeout)
+
+	_ = conn.Close()
+
+	if err == nil {
+		return
+	}
+
+	deadline := time.Now().Add(agentThawRetryDeadline)
+
+	for time.Now().Before(deadline) {
+		slog.Warn("failed thawing guest filesystems, retrying", "vm", v.Name, "err", err)
+
+		time.Sleep(agentThawRetryInterval)
+
+		_, err = v.AgentThaw()
+		if err == nil {
+			slog.Info("thawed guest filesystems", "vm", v.Name)
+
+			return
+		}
+	}
+
+	slog.Error("failed thawing guest filesystems, the guest may hang until they are thawed by hand",
+		"vm", v.Name, "err", err)
+	v.log.Error("failed thawing guest filesystems, the guest may hang until they are thawed by hand", "err", err)
+}
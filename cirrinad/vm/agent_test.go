@@ -0,0 +1,226 @@
+package vm
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-test/deep"
+
+	"cirrina/cirrinad/config"
+)
+
+// newAgentTestVM returns a running VM with the guest agent enabled, its state directory in a temporary directory
+func newAgentTestVM(t *testing.T) *VM {
+	t.Helper()
+
+	config.Config.Disk.VM.Path.State = t.TempDir()
+
+	t.Cleanup(func() { config.Config.Disk.VM.Path.State = "" })
+
+	testVM := &VM{
+		ID:     "5d1e6a42-8f0b-4c3e-9a7d-2b6c1f0e9d84",
+		Name:   "agent",
+		Status: RUNNING,
+		Config: Config{GuestAgent: true},
+	}
+
+	err := os.MkdirAll(filepath.Join(config.Config.Disk.VM.Path.State, testVM.Name), 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return testVM
+}
+
+// startFakeAgent listens on the VM's guest agent port the way bhyve does and answers like qemu-guest-agent, replies
+// maps each command to the reply line sent for it, commands without a reply get none. The commands received are
+// sent on the returned channel.
+func startFakeAgent(t *testing.T, testVM *VM, replies map[string]string) chan agentRequest {
+	t.Helper()
+
+	listener, err := net.Listen("unix", testVM.consolePortPath(GuestAgentPort))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	received := make(chan agentRequest, 10)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			fakeAgentConn(conn, replies, received)
+		}
+	}()
+
+	return received
+}
+
+func fakeAgentConn(conn net.Conn, replies map[string]string, received chan agentRequest) {
+	defer func(conn net.Conn) {
+		_ = conn.Close()
+	}(conn)
+
+	reader := bufio.NewReader(conn)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return
+		}
+
+		// the delimiter resetting the parser comes before the sync request
+		if line[0] == agentDelimiter {
+			line = line[1:]
+		}
+
+		var request struct {
+			Execute   string          `json:"execute"`
+			Arguments json.RawMessage `json:"arguments"`
+		}
+
+		err = json.Unmarshal(line, &request)
+		if err != nil {
+			return
+		}
+
+		if request.Execute == "guest-sync-delimited" {
+			var syncArgs struct {
+				ID int64 `json:"id"`
+			}
+
+			_ = json.Unmarshal(request.Arguments, &syncArgs)
+			syncReply, _ := json.Marshal(map[string]int64{"return": syncArgs.ID})
+
+			// a reply left over from an earlier connection, which must be skipped
+			_, _ = conn.Write([]byte("{\"return\": 1}\n"))
+			_, _ = conn.Write(append(append([]byte{agentDelimiter}, syncReply...), '\n'))
+
+			continue
+		}
+
+		received <- agentRequest{Execute: request.Execute, Arguments: string(request.Arguments)}
+
+		reply, found := replies[request.Execute]
+		if found {
+			_, _ = conn.Write([]byte(reply + "\n"))
+		}
+	}
+}
+
+//nolint:paralleltest
+func TestVM_AgentInterfaces(t *testing.T) {
+	testVM := newAgentTestVM(t)
+	startFakeAgent(t, testVM, map[string]string{
+		"guest-network-get-interfaces": `{"return": [{"name": "lo", "ip-addresses": [{"ip-address-type": "ipv4", ` +
+			`"ip-address": "127.0.0.1", "prefix": 8}]}, {"name": "vtnet0", "hardware-address": "58:9c:fc:0a:1b:2c", ` +
+			`"ip-addresses": [{"ip-address-type": "ipv4", "ip-address": "10.0.0.5", "prefix": 24}, ` +
+			`{"ip-address-type": "ipv6", "ip-address": "fe80::5a9c:fcff:fe0a:1b2c", "prefix": 64}]}]}`,
+	})
+
+	got, err := testVM.AgentInterfaces()
+	if err != nil {
+		t.Fatalf("AgentInterfaces() error = %v", err)
+	}
+
+	want := []GuestInterface{
+		{Name: "lo", IPAddresses: []GuestIPAddress{{Type: "ipv4", Address: "127.0.0.1", Prefix: 8}}},
+		{
+			Name:            "vtnet0",
+			HardwareAddress: "58:9c:fc:0a:1b:2c",
+			IPAddresses: []GuestIPAddress{
+				{Type: "ipv4", Address: "10.0.0.5", Prefix: 24},
+				{Type: "ipv6", Address: "fe80::5a9c:fcff:fe0a:1b2c", Prefix: 64},
+			},
+		},
+	}
+
+	diff := deep.Equal(got, want)
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+}
+
+//nolint:paralleltest
+func TestVM_AgentFreeze(t *testing.T) {
+	testVM := newAgentTestVM(t)
+	received := startFakeAgent(t, testVM, map[string]string{
+		"guest-fsfreeze-freeze": `{"return": 2}`,
+		"guest-fsfreeze-thaw":   `{"return": 2}`,
+		"guest-fsfreeze-status": `{"return": "thawed"}`,
+	})
+
+	thaw := testVM.QuiesceForSnapshot()
+
+	if got := (<-received).Execute; got != "guest-fsfreeze-freeze" {
+		t.Errorf("QuiesceForSnapshot() ran %v, want guest-fsfreeze-freeze", got)
+	}
+
+	// the port is held until the thaw, so nothing else can get in the way of it
+	_, err := testVM.DialConsolePort(GuestAgentPort)
+	if !errors.Is(err, errVMConsolePortBusy) {
+		t.Errorf("DialConsolePort() while frozen error = %v, wantErr %v", err, errVMConsolePortBusy)
+	}
+
+	thaw()
+
+	if got := (<-received).Execute; got != "guest-fsfreeze-thaw" {
+		t.Errorf("thaw ran %v, want guest-fsfreeze-thaw", got)
+	}
+
+	status, err := testVM.AgentFreezeStatus()
+	if err != nil || status != "thawed" {
+		t.Errorf("AgentFreezeStatus() = %v, %v, want thawed", status, err)
+	}
+}
+
+//nolint:paralleltest
+func TestVM_AgentCommands(t *testing.T) {
+	testVM := newAgentTestVM(t)
+	received := startFakeAgent(t, testVM, map[string]string{
+		"guest-ping":     `{"return": {}}`,
+		"guest-set-time": `{"error": {"class": "GenericError", "desc": "hwclock failed"}}`,
+	})
+
+	err := testVM.AgentPing()
+	if err != nil {
+		t.Errorf("AgentPing() error = %v", err)
+	}
+
+	<-received
+
+	err = testVM.AgentShutdown()
+	if err != nil {
+		t.Errorf("AgentShutdown() error = %v", err)
+	}
+
+	shutdown := <-received
+	if shutdown.Execute != "guest-shutdown" || shutdown.Arguments != `{"mode":"powerdown"}` {
+		t.Errorf("AgentShutdown() sent %v", shutdown)
+	}
+
+	err = testVM.AgentSyncTime()
+	if !errors.Is(err, errVMGuestAgent) {
+		t.Errorf("AgentSyncTime() error = %v, wantErr %v", err, errVMGuestAgent)
+	}
+
+	testVM.Config.GuestAgent = false
+
+	err = testVM.AgentPing()
+	if !errors.Is(err, errVMGuestAgentDisabled) {
+		t.Errorf("AgentPing() error = %v, wantErr %v", err, errVMGuestAgentDisabled)
+	}
+
+	// without the agent nothing is frozen
+	testVM.QuiesceForSnapshot()()
+}
@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"database/sql"
 	"fmt"
 	"log/slog"
 	"math"
@@ -39,6 +40,34 @@ func (v *VM) getACPIArg() []string {
 	return []string{}
 }
 
+// getAHCIArg returns the AHCI devices for the VM's ISOs, and its AHCI disks if it packs its devices, followed by the
+// AHCI controllers
+func (v *VM) getAHCIArg(slot int) ([]string, int) {
+	var ahciArg []string
+
+	if v.Config.PCIPack {
+		ahciArg, slot = v.getSharedAHCIArg(slot)
+	} else {
+		ahciArg, slot = v.getCDArg(slot)
+	}
+
+	for _, controller := range v.usedControllers(ControllerAHCI) {
+		controllerPorts := v.getControllerPortsArg(&controller)
+		if len(controllerPorts) == 0 {
+			continue
+		}
+
+		ahciArg = append(ahciArg,
+			"-s", v.pciSlotArg(pciControllerPrefix+controller.Name, strconv.FormatInt(int64(slot), 10))+",ahci,"+
+				strings.Join(controllerPorts, ","),
+		)
+		slot++
+	}
+
+	return ahciArg, slot
+}
+
+// getCDArg returns an AHCI device in its own slot for each ISO
 func (v *VM) getCDArg(slot int) ([]string, int) {
 	var cdString []string
 
@@ -46,8 +75,10 @@ func (v *VM) getCDArg(slot int) ([]string, int) {
 	maxSataDevs := 31 - slot - 1
 	devCount := 0
 
-	for _, isoItem := range v.ISOs {
-		if isoItem == nil {
+	isoKeys := isoPCIKeys(v.ISOs)
+
+	for isoIdx, isoItem := range v.ISOs {
+		if isoItem == nil || v.placed(AttachISO, isoItem.ID) {
 			continue
 		}
 
@@ -59,7 +90,9 @@ func (v *VM) getCDArg(slot int) ([]string, int) {
 		slog.Debug("getCDArg", "name", isoItem.Name, "id", isoItem.ID, "path", isoItem.Path)
 
 		if devCount <= maxSataDevs {
-			thisCd := []string{"-s", strconv.FormatInt(int64(slot), 10) + ":0,ahci,cd:" + isoItem.Path}
+			thisCd := []string{
+				"-s", v.pciSlotArg(isoKeys[isoIdx], strconv.FormatInt(int64(slot), 10)+":0") + ",ahci,cd:" + isoItem.Path,
+			}
 			cdString = append(cdString, thisCd...)
 			devCount++
 			slot++
@@ -71,6 +104,66 @@ func (v *VM) getCDArg(slot int) ([]string, int) {
 	return cdString, slot
 }
 
+// getSharedAHCIArg returns a single AHCI controller with a port for each AHCI disk, followed by a port for each ISO
+func (v *VM) getSharedAHCIArg(slot int) ([]string, int) {
+	var ports []string
+
+	for _, diskItem := range v.Disks {
+		if diskItem == nil || diskItem.ID == "" {
+			continue
+		}
+
+		thisDisk, err := disk.GetByID(diskItem.ID)
+		if err != nil || thisDisk.Type != "AHCI-HD" || v.placed(AttachDisk, thisDisk.ID) {
+			continue
+		}
+
+		if len(ports) >= ahciMaxPorts {
+			slog.Error("unable to add disk due to lack of AHCI ports", "diskName", thisDisk.Name)
+
+			continue
+		}
+
+		diskPathArg, err := v.getDiskPathArg(thisDisk)
+		if err != nil {
+			slog.Error("error adding disk, skipping", "diskID", diskItem.ID, "err", err)
+
+			continue
+		}
+
+		ports = append(ports, "hd:"+diskPathArg)
+	}
+
+	for _, isoItem := range v.ISOs {
+		if isoItem == nil || v.placed(AttachISO, isoItem.ID) {
+			continue
+		}
+
+		if isoItem.Path == "" {
+			slog.Error("empty iso path, correcting", "iso", isoItem.Name, "id", isoItem.ID, "path", isoItem.Path)
+			isoItem.Path = config.Config.Disk.VM.Path.Iso + string(os.PathSeparator) + isoItem.Name
+		}
+
+		slog.Debug("getSharedAHCIArg", "name", isoItem.Name, "id", isoItem.ID, "path", isoItem.Path)
+
+		if len(ports) >= ahciMaxPorts {
+			slog.Error("unable to add iso due to lack of AHCI ports", "isoName", isoItem.Name)
+
+			continue
+		}
+
+		ports = append(ports, "cd:"+isoItem.Path)
+	}
+
+	if len(ports) == 0 {
+		return nil, slot
+	}
+
+	return []string{
+		"-s", v.pciSlotArg(PCIAHCI, strconv.FormatInt(int64(slot), 10)) + ",ahci," + strings.Join(ports, ","),
+	}, slot + 1
+}
+
 func (v *VM) getCPUArg() []string {
 	var vmCpus uint16
 
@@ -85,14 +178,50 @@ func (v *VM) getCPUArg() []string {
 		vmCpus = cast.ToUint16(v.Config.CPU)
 	}
 
-	return []string{"-c", strconv.FormatInt(int64(vmCpus), 10)}
+	cpuArg := strconv.FormatInt(int64(vmCpus), 10)
+
+	// the topology only applies when it matches the vCPU count, it may not if the count fell back to the host's
+	if topologyCPUs(v.Config.Sockets, v.Config.Cores, v.Config.Threads) == uint64(vmCpus) {
+		cpuArg = "cpus=" + cpuArg +
+			",sockets=" + strconv.FormatUint(uint64(max(v.Config.Sockets, 1)), 10) +
+			",cores=" + strconv.FormatUint(uint64(max(v.Config.Cores, 1)), 10) +
+			",threads=" + strconv.FormatUint(uint64(max(v.Config.Threads, 1)), 10)
+	}
+
+	return append([]string{"-c", cpuArg}, v.getCPUPinArgs(vmCpus)...)
 }
 
 func (v *VM) getOneDiskArg(thisDisk *disk.Disk) (string, error) {
-	var err error
-
 	var diskController string
 
+	switch thisDisk.Type {
+	case "NVME":
+		diskController = "nvme"
+	case "AHCI-HD":
+		diskController = "ahci-hd"
+	case "VIRTIO-BLK":
+		diskController = "virtio-blk"
+	default:
+		slog.Error("unknown disk type", "type", thisDisk.Type)
+
+		return "", errVMUnknownDiskType
+	}
+
+	diskPathArg, err := v.getDiskPathArg(thisDisk)
+	if err != nil {
+		return "", err
+	}
+
+	return diskController + "," + diskPathArg, nil
+}
+
+// getDiskPathArg returns the path of the disk followed by its cache options, the same for every disk controller
+func (v *VM) getDiskPathArg(thisDisk *disk.Disk) (string, error) {
+	return v.getDiskPathOptArg(thisDisk, thisDisk.DiskCache, thisDisk.DiskDirect)
+}
+
+// getDiskPathOptArg returns the path of the disk followed by the given cache options
+func (v *VM) getDiskPathOptArg(thisDisk *disk.Disk, diskCache sql.NullBool, diskDirect sql.NullBool) (string, error) {
 	nocache := ""
 	direct := ""
 
@@ -111,40 +240,23 @@ func (v *VM) getOneDiskArg(thisDisk *disk.Disk) (string, error) {
 		return "", fmt.Errorf("disk path does not exist: %w", err)
 	}
 
-	switch thisDisk.Type {
-	case "NVME":
-		diskController = "nvme"
-	case "AHCI-HD":
-		diskController = "ahci-hd"
-	case "VIRTIO-BLK":
-		diskController = "virtio-blk"
-	default:
-		slog.Error("unknown disk type", "type", thisDisk.Type)
-
-		return "", errVMUnknownDiskType
-	}
-
 	if thisDisk.DevType == "ZVOL" {
 		diskPath = filepath.Join("/dev/zvol/", diskPath)
 	}
 
-	if thisDisk.DiskCache.Valid && !thisDisk.DiskCache.Bool {
+	if diskCache.Valid && !diskCache.Bool {
 		nocache = ",nocache"
 	}
 
-	if thisDisk.DiskDirect.Valid && thisDisk.DiskDirect.Bool {
+	if diskDirect.Valid && diskDirect.Bool {
 		direct = ",direct"
 	}
 
-	return diskController + "," + diskPath + nocache + direct, nil
+	return diskPath + nocache + direct, nil
 }
 
+// getDiskArg returns the NVMe and virtio-blk disks, AHCI disks are ports of the AHCI controller
 func (v *VM) getDiskArg(slot int) ([]string, int) {
-	// TODO don't use one PCI slot per ahci (SATA) disk device, attach multiple disks to each controller
-	// FIXME -- this is awful but needed until we attach multiple sata disks to each controller
-	maxSataDevs := 31 - slot - 1
-	sataDevCount := 0
-
 	var diskString []string
 	// TODO remove all these de-normalizations in favor of gorm native "Has Many" relationships
 
@@ -164,12 +276,16 @@ func (v *VM) getDiskArg(slot int) ([]string, int) {
 			continue
 		}
 
-		if thisDisk.Type == "AHCI-HD" {
-			sataDevCount++
+		// packed AHCI disks are ports of the shared AHCI controller
+		if (v.Config.PCIPack && thisDisk.Type == "AHCI-HD") || v.placed(AttachDisk, thisDisk.ID) {
+			continue
 		}
 
-		if sataDevCount > maxSataDevs {
-			slog.Error("sata dev count exceeded, skipping disk", "diskID", diskItem.ID)
+		diskKey := pciDiskPrefix + diskItem.ID
+
+		// without assigned addresses each disk takes the next slot, be sure to leave room for the lpc
+		if _, assigned := v.pciSlots[diskKey]; !assigned && slot >= pciLPCSlot {
+			slog.Error("unable to add disk due to lack of slots, skipping disk", "diskID", diskItem.ID)
 
 			continue
 		}
@@ -181,11 +297,27 @@ func (v *VM) getDiskArg(slot int) ([]string, int) {
 			continue
 		}
 
-		thisHd := []string{"-s", strconv.FormatInt(int64(slot), 10) + "," + oneHdString}
+		thisHd := []string{
+			"-s", v.pciSlotArg(diskKey, strconv.FormatInt(int64(slot), 10)) + "," + oneHdString,
+		}
 		diskString = append(diskString, thisHd...)
 		slot++
 	}
 
+	for _, controller := range v.usedControllers(ControllerNVMe) {
+		nvmeArg, err := v.getNVMeControllerArg(&controller)
+		if err != nil {
+			slog.Error("error adding NVMe controller, skipping", "controller", controller.Name, "err", err)
+
+			continue
+		}
+
+		diskString = append(diskString,
+			"-s", v.pciSlotArg(pciControllerPrefix+controller.Name, strconv.FormatInt(int64(slot), 10))+","+nvmeArg,
+		)
+		slot++
+	}
+
 	return diskString, slot
 }
 
@@ -222,7 +354,7 @@ func (v *VM) getHostBridgeArg(slot int) ([]string, int) {
 		return []string{}, slot
 	}
 
-	hostBridgeArg := []string{"-s", strconv.FormatInt(int64(slot), 10) + ",hostbridge"}
+	hostBridgeArg := []string{"-s", v.pciSlotArg(PCIHostBridge, strconv.FormatInt(int64(slot), 10)) + ",hostbridge"}
 	slot++
 
 	return hostBridgeArg, slot
@@ -243,16 +375,21 @@ func (v *VM) getMSRArg() []string {
 func (v *VM) getROMArg() []string {
 	var romArg []string
 
-	if v.Config.StoreUEFIVars {
+	firmware, hasROM := v.getFirmware()
+	if !hasROM {
+		return []string{}
+	}
+
+	if v.Config.StoreUEFIVars && firmware.Vars.Template != "" {
 		uefiVarsPath := filepath.Join(config.Config.Disk.VM.Path.State, filepath.Join(v.Name, "BHYVE_UEFI_VARS.fd"))
 		romArg = []string{
 			"-l",
-			"bootrom," + config.Config.Rom.Path + "," + uefiVarsPath,
+			"bootrom," + firmware.Path + "," + uefiVarsPath,
 		}
 	} else {
 		romArg = []string{
 			"-l",
-			"bootrom," + config.Config.Rom.Path,
+			"bootrom," + firmware.Path,
 		}
 	}
 
@@ -358,7 +495,7 @@ func (v *VM) getSoundArg(slot int) ([]string, int) {
 		slog.Debug("sound input path does not exist", "path", v.Config.SoundIn)
 	}
 
-	return []string{"-s", strconv.FormatInt(int64(slot), 10) + soundString}, slot + 1
+	return []string{"-s", v.pciSlotArg(PCISound, strconv.FormatInt(int64(slot), 10)) + soundString}, slot + 1
 }
 
 func (v *VM) getUTCArg() []string {
@@ -370,7 +507,8 @@ func (v *VM) getUTCArg() []string {
 }
 
 func (v *VM) getWireArg() []string {
-	if v.Config.WireGuestMem {
+	// bhyve requires guest memory to be wired when passing through devices
+	if v.Config.WireGuestMem || len(v.passthrus) > 0 {
 		return []string{"-S"}
 	}
 
@@ -378,7 +516,7 @@ func (v *VM) getWireArg() []string {
 }
 
 func (v *VM) getLPCArg(slot int) ([]string, int) {
-	return []string{"-s", "31,lpc"}, slot
+	return []string{"-s", v.pciSlotArg(PCILPC, "31") + ",lpc"}, slot
 }
 
 func (v *VM) getTabletArg(slot int) ([]string, int) {
@@ -386,7 +524,7 @@ func (v *VM) getTabletArg(slot int) ([]string, int) {
 		return []string{}, slot
 	}
 
-	tabletArg := []string{"-s", strconv.FormatInt(int64(slot), 10) + ",xhci,tablet"}
+	tabletArg := []string{"-s", v.pciSlotArg(PCITablet, strconv.FormatInt(int64(slot), 10)) + ",xhci,tablet"}
 	slot++
 
 	return tabletArg, slot
@@ -432,7 +570,7 @@ func (v *VM) getVideoArg(slot int) ([]string, int) {
 
 	fbufArg := []string{
 		"-s",
-		strconv.FormatInt(int64(slot), 10) +
+		v.pciSlotArg(PCIFbuf, strconv.FormatInt(int64(slot), 10)) +
 			",fbuf" +
 			",w=" + strconv.FormatInt(int64(v.Config.ScreenWidth), 10) +
 			",h=" + strconv.FormatInt(int64(v.Config.ScreenHeight), 10) +
@@ -542,7 +680,11 @@ func (v *VM) getNetArgs(slot int) ([]string, int) {
 			macString = ",mac=" + macAddress
 		}
 
-		netArg := []string{"-s", strconv.FormatInt(int64(slot), 10) + "," + netType + "," + netDevArg + macString}
+		netArg := []string{
+			"-s",
+			v.pciSlotArg(pciNicPrefix+nicItem.ID, strconv.FormatInt(int64(slot), 10)) + "," + netType + "," +
+				netDevArg + macString,
+		}
 		slot++
 
 		netArgs = append(netArgs, netArg...)
@@ -630,7 +772,8 @@ func (v *VM) generateCommandLine() (string, []string) {
 	name := config.Config.Sys.Sudo
 
 	slot := 0
-	hostBridgeArg, fbufArg, tabletArg, netArg, diskArg, cdArg, soundArg, lpcArg := v.getSlotArgs(slot)
+	hostBridgeArg, fbufArg, tabletArg, netArg, diskArg, ahciArg, soundArg, passthruArg, shareArg, consoleArg, lpcArg :=
+		v.getSlotArgs(slot)
 
 	com1Arg, com2Arg, com3Arg, com4Arg := v.getComArgs()
 
@@ -639,7 +782,8 @@ func (v *VM) generateCommandLine() (string, []string) {
 	args = append(args, "/usr/sbin/bhyve")
 	args = append(args, "-U", v.ID)
 	args = v.addSomeArgs(args)
-	args = addSlotArgs(args, hostBridgeArg, cdArg, fbufArg, tabletArg, netArg, diskArg, soundArg, lpcArg)
+	args = addSlotArgs(args, hostBridgeArg, ahciArg, fbufArg, tabletArg, netArg, diskArg, soundArg, passthruArg,
+		shareArg, consoleArg, lpcArg)
 	args = addComArgs(args, com1Arg, com2Arg, com3Arg, com4Arg)
 	args = append(args, v.getExtraArg()...)
 	args = append(args, v.Name)
@@ -685,15 +829,19 @@ func addComArgs(args []string, com1Arg []string, com2Arg []string, com3Arg []str
 	return args
 }
 
-func addSlotArgs(args []string, hostBridgeArg []string, cdArg []string, fbufArg []string, tabletArg []string,
-	netArg []string, diskArg []string, soundArg []string, lpcArg []string) []string {
+func addSlotArgs(args []string, hostBridgeArg []string, ahciArg []string, fbufArg []string, tabletArg []string,
+	netArg []string, diskArg []string, soundArg []string, passthruArg []string, shareArg []string,
+	consoleArg []string, lpcArg []string) []string {
 	args = append(args, hostBridgeArg...)
-	args = append(args, cdArg...)
+	args = append(args, ahciArg...)
 	args = append(args, fbufArg...)
 	args = append(args, tabletArg...)
 	args = append(args, netArg...)
 	args = append(args, diskArg...)
 	args = append(args, soundArg...)
+	args = append(args, passthruArg...)
+	args = append(args, shareArg...)
+	args = append(args, consoleArg...)
 	args = append(args, lpcArg...)
 
 	return args
@@ -716,18 +864,23 @@ func (v *VM) addSomeArgs(args []string) []string {
 	return args
 }
 
-func (v *VM) getSlotArgs(slot int) ([]string, []string, []string, []string, []string, []string, []string, []string) {
+func (v *VM) getSlotArgs(slot int) ([]string, []string, []string, []string, []string, []string, []string, []string,
+	[]string, []string, []string) {
 	hostBridgeArg, slot := v.getHostBridgeArg(slot)
 	fbufArg, slot := v.getVideoArg(slot)
 	tabletArg, slot := v.getTabletArg(slot)
 	netArg, slot := v.getNetArgs(slot)
 	diskArg, slot := v.getDiskArg(slot)
-	cdArg, slot := v.getCDArg(slot)
+	ahciArg, slot := v.getAHCIArg(slot)
 	soundArg, slot := v.getSoundArg(slot)
+	passthruArg, slot := v.getPassthruArg(slot)
+	shareArg, slot := v.getShareArg(slot)
+	consoleArg, slot := v.getConsoleArg(slot)
 	lpcArg, slot := v.getLPCArg(slot)
 	slog.Debug("last slot", "slot", slot)
 
-	return hostBridgeArg, fbufArg, tabletArg, netArg, diskArg, cdArg, soundArg, lpcArg
+	return hostBridgeArg, fbufArg, tabletArg, netArg, diskArg, ahciArg, soundArg, passthruArg, shareArg, consoleArg,
+		lpcArg
 }
 
 func (v *VM) getComArgs() ([]string, []string, []string, []string) {
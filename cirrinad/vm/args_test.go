@@ -438,6 +438,54 @@ func TestVM_getCPUArg(t *testing.T) {
 			},
 			want: []string{"-c", "16"},
 		},
+		{
+			name:                 "topology",
+			mockGetHostMaxVMCpus: func() (uint16, error) { return 128, nil },
+			fields: fields{
+				Config: Config{CPU: 8, Sockets: 2, Cores: 2, Threads: 2},
+			},
+			want: []string{"-c", "cpus=8,sockets=2,cores=2,threads=2"},
+		},
+		{
+			name:                 "topologyDefaultsToOne",
+			mockGetHostMaxVMCpus: func() (uint16, error) { return 128, nil },
+			fields: fields{
+				Config: Config{CPU: 4, Cores: 4},
+			},
+			want: []string{"-c", "cpus=4,sockets=1,cores=4,threads=1"},
+		},
+		{
+			name:                 "topologyMismatchAfterFallback",
+			mockGetHostMaxVMCpus: func() (uint16, error) { return 4, nil },
+			fields: fields{
+				Config: Config{CPU: 8, Sockets: 2, Cores: 4},
+			},
+			want: []string{"-c", "4"},
+		},
+		{
+			name:                 "pins",
+			mockGetHostMaxVMCpus: func() (uint16, error) { return 128, nil },
+			fields: fields{
+				Config: Config{CPU: 2, CPUPins: "1:5,0:4"},
+			},
+			want: []string{"-c", "2", "-p", "0:4", "-p", "1:5"},
+		},
+		{
+			name:                 "pinsSkipMissingVCPU",
+			mockGetHostMaxVMCpus: func() (uint16, error) { return 2, nil },
+			fields: fields{
+				Config: Config{CPU: 4, CPUPins: "0:4,3:7"},
+			},
+			want: []string{"-c", "2", "-p", "0:4"},
+		},
+		{
+			name:                 "pinsInvalid",
+			mockGetHostMaxVMCpus: func() (uint16, error) { return 128, nil },
+			fields: fields{
+				Config: Config{CPU: 2, CPUPins: "0-4"},
+			},
+			want: []string{"-c", "2"},
+		},
 	}
 
 	for _, testCase := range tests {
@@ -577,6 +625,38 @@ func TestVM_getROMArg(t *testing.T) {
 			},
 			want: []string{"-l", "bootrom,/usr/local/share/uefi-firmware/BHYVE_UEFI.fd,/var/tmp/cirrinad/state/someTestVM/BHYVE_UEFI_VARS.fd"}, //nolint:lll
 		},
+		{
+			name: "csm",
+			fields: fields{
+				Name:   "someTestVM",
+				Config: Config{StoreUEFIVars: true, Firmware: "csm"},
+			},
+			want: []string{"-l", "bootrom,/usr/local/share/uefi-firmware/BHYVE_UEFI_CSM.fd,/var/tmp/cirrinad/state/someTestVM/BHYVE_UEFI_VARS.fd"}, //nolint:lll
+		},
+		{
+			name: "noVarsTemplate",
+			fields: fields{
+				Name:   "someTestVM",
+				Config: Config{StoreUEFIVars: true, Firmware: "custom"},
+			},
+			want: []string{"-l", "bootrom,/usr/local/share/custom/ROM.fd"},
+		},
+		{
+			name: "none",
+			fields: fields{
+				Name:   "someTestVM",
+				Config: Config{StoreUEFIVars: true, Firmware: "none"},
+			},
+			want: []string{},
+		},
+		{
+			name: "unknown",
+			fields: fields{
+				Name:   "someTestVM",
+				Config: Config{StoreUEFIVars: true, Firmware: "removed"},
+			},
+			want: []string{},
+		},
 	}
 
 	t.Parallel()
@@ -585,7 +665,15 @@ func TestVM_getROMArg(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
+			csm := config.Firmware{Name: "csm", Path: "/usr/local/share/uefi-firmware/BHYVE_UEFI_CSM.fd"}
+			csm.Vars.Template = "/usr/local/share/uefi-firmware/BHYVE_UEFI_VARS.fd"
+
 			config.Config.Rom.Path = "/usr/local/share/uefi-firmware/BHYVE_UEFI.fd"
+			config.Config.Rom.Vars.Template = "/usr/local/share/uefi-firmware/BHYVE_UEFI_VARS.fd"
+			config.Config.Rom.Firmware = []config.Firmware{
+				csm,
+				{Name: "custom", Path: "/usr/local/share/custom/ROM.fd"},
+			}
 			config.Config.Disk.VM.Path.State = "/var/tmp/cirrinad/state/"
 
 			vm := &VM{
@@ -686,9 +774,10 @@ func TestVM_getExtraArg(t *testing.T) {
 	}
 }
 
-func TestVM_getCDArg(t *testing.T) {
+func TestVM_getAHCIArg(t *testing.T) {
 	type fields struct {
-		ISOs []*iso.ISO
+		ISOs    []*iso.ISO
+		PCIPack bool
 	}
 
 	type args struct {
@@ -784,6 +873,43 @@ func TestVM_getCDArg(t *testing.T) {
 			},
 			wantSlot: 31,
 		},
+		{
+			name: "threeIsosOneController",
+			fields: fields{
+				ISOs: []*iso.ISO{
+					{
+						Name:        "someTestThing.iso",
+						Description: "a test iso",
+						Path:        "/some/path/to/someTestThing.iso",
+						Size:        292911919,
+						Checksum:    "unusedHere",
+					},
+					{
+						Name:        "anotherTestIso.iso",
+						Description: "a test iso",
+						Path:        "/some/path/to/anotherTestIso.iso",
+						Size:        291413919,
+						Checksum:    "unusedHere",
+					},
+					{
+						Name:        "thirdTest.iso",
+						Description: "a test iso",
+						Path:        "/some/path/to/thirdTest.iso",
+						Size:        291413111,
+						Checksum:    "unusedHere",
+					},
+				},
+				PCIPack: true,
+			},
+			args: args{
+				slot: 29,
+			},
+			wantArg: []string{
+				"-s", "29,ahci,cd:/some/path/to/someTestThing.iso,cd:/some/path/to/anotherTestIso.iso," +
+					"cd:/some/path/to/thirdTest.iso",
+			},
+			wantSlot: 30,
+		},
 	}
 
 	t.Parallel()
@@ -795,10 +921,11 @@ func TestVM_getCDArg(t *testing.T) {
 			config.Config.Disk.VM.Path.Iso = "/the/config/path/for/isos"
 
 			vm := &VM{
-				ISOs: testCase.fields.ISOs,
+				ISOs:   testCase.fields.ISOs,
+				Config: Config{PCIPack: testCase.fields.PCIPack},
 			}
 
-			gotArg, gotSlot := vm.getCDArg(testCase.args.slot)
+			gotArg, gotSlot := vm.getAHCIArg(testCase.args.slot)
 
 			diff := deep.Equal(gotArg, testCase.wantArg)
 			if diff != nil {
@@ -2595,6 +2722,66 @@ func TestVM_getDiskArg(t *testing.T) {
 	}
 }
 
+//nolint:paralleltest
+func TestVM_getAHCIArgDisks(t *testing.T) {
+	disk.List.DiskList = map[string]*disk.Disk{
+		"25b5e67d-915d-4b0e-bb3a-42f3233510a2": {
+			ID:         "25b5e67d-915d-4b0e-bb3a-42f3233510a2",
+			Name:       "test2024081001_hd0",
+			Type:       "AHCI-HD",
+			DevType:    "FILE",
+			DiskCache:  sql.NullBool{Bool: false, Valid: true},
+			DiskDirect: sql.NullBool{Bool: false, Valid: true},
+		},
+		"0e2ff6e6-1ff8-4e57-8bc3-2ab2cd7a4e5a": {
+			ID:         "0e2ff6e6-1ff8-4e57-8bc3-2ab2cd7a4e5a",
+			Name:       "test2024081001_hd1",
+			Type:       "NVME",
+			DevType:    "FILE",
+			DiskCache:  sql.NullBool{Bool: true, Valid: true},
+			DiskDirect: sql.NullBool{Bool: false, Valid: true},
+		},
+	}
+
+	disk.PathExistsFunc = func(_ string) (bool, error) {
+		return true, nil
+	}
+
+	testVM := &VM{
+		Config: Config{PCIPack: true},
+		Disks: []*disk.Disk{
+			{ID: "25b5e67d-915d-4b0e-bb3a-42f3233510a2"},
+			{ID: "0e2ff6e6-1ff8-4e57-8bc3-2ab2cd7a4e5a"},
+		},
+		ISOs: []*iso.ISO{
+			{ID: "0", Name: "someTestThing.iso", Path: "/some/path/to/someTestThing.iso"},
+		},
+	}
+
+	// packed AHCI disks are ports of the shared AHCI controller rather than devices of their own
+	gotDiskArgs, _ := testVM.getDiskArg(4)
+
+	diff := deep.Equal(gotDiskArgs, []string{"-s", "4,nvme,test2024081001_hd1.img"})
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	gotArgs, gotSlot := testVM.getAHCIArg(5)
+
+	wantArgs := []string{"-s", "5,ahci,hd:test2024081001_hd0.img,nocache,cd:/some/path/to/someTestThing.iso"}
+
+	diff = deep.Equal(gotArgs, wantArgs)
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	if gotSlot != 6 {
+		t.Errorf("getAHCIArg() gotSlot = %v, want 6", gotSlot)
+	}
+
+	disk.List.DiskList = map[string]*disk.Disk{}
+}
+
 //nolint:paralleltest
 func TestVM_getSoundArg(t *testing.T) {
 	type fields struct {
@@ -2989,9 +3176,9 @@ func TestVM_getVideoArg(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
-					WithArgs(true, false, false, true, false, 60, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, 2, false, "AUTO", false, true, true, "", true, true, "default", 60, 2048, 0, 0, nil, 0, true, 0, 0, true, 1080, 1920, false, "/dev/dsp0", "/dev/dsp0", true, true, true, true, "AUTO", false, 0, 0, false, sqlmock.AnyArg(), 81). //nolint:lll
+					WithArgs(true, false, false, true, false, 60, 0, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, "", 0, 2, "", false, "AUTO", false, "", true, true, "", "", false, "", "", "", "", 0, true, true, "default", "", "", 0, "", 0, "", "", 0, 60, 2048, false, "", 0, 0, nil, 0, 0, "", 0, "", "", 0, true, 0, 0, "", 0, 0, true, 1080, 1920, 0, false, "/dev/dsp0", "/dev/dsp0", "", true, true, 0, true, true, "AUTO", false, 0, 0, false, sqlmock.AnyArg(), 81). //nolint:lll
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 
@@ -3110,9 +3297,9 @@ func TestVM_getVideoArg(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
-					WithArgs(true, false, false, true, false, 60, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, 2, false, "AUTO", false, true, true, "", true, true, "default", 60, 2048, 0, 0, nil, 0, true, 0, 0, true, 1080, 1920, false, "/dev/dsp0", "/dev/dsp0", true, true, true, true, "8901", false, 0, 0, false, sqlmock.AnyArg(), 81). //nolint:lll
+					WithArgs(true, false, false, true, false, 60, 0, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, "", 0, 2, "", false, "AUTO", false, "", true, true, "", "", false, "", "", "", "", 0, true, true, "default", "", "", 0, "", 0, "", "", 0, 60, 2048, false, "", 0, 0, nil, 0, 0, "", 0, "", "", 0, true, 0, 0, "", 0, 0, true, 1080, 1920, 0, false, "/dev/dsp0", "/dev/dsp0", "", true, true, 0, true, true, "8901", false, 0, 0, false, sqlmock.AnyArg(), 81). //nolint:lll
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 
@@ -3231,9 +3418,9 @@ func TestVM_getVideoArg(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
-					WithArgs(true, false, false, true, false, 60, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, 2, false, "AUTO", false, true, true, "", true, true, "default", 60, 2048, 0, 0, nil, 0, true, 0, 0, true, 1080, 1920, false, "/dev/dsp0", "/dev/dsp0", true, true, true, true, "AUTO", true, 0, 0, false, sqlmock.AnyArg(), 81). //nolint:lll
+					WithArgs(true, false, false, true, false, 60, 0, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, "", 0, 2, "", false, "AUTO", false, "", true, true, "", "", false, "", "", "", "", 0, true, true, "default", "", "", 0, "", 0, "", "", 0, 60, 2048, false, "", 0, 0, nil, 0, 0, "", 0, "", "", 0, true, 0, 0, "", 0, 0, true, 1080, 1920, 0, false, "/dev/dsp0", "/dev/dsp0", "", true, true, 0, true, true, "AUTO", true, 0, 0, false, sqlmock.AnyArg(), 81). //nolint:lll
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 
@@ -3353,9 +3540,9 @@ func TestVM_getVideoArg(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
-					WithArgs(true, false, false, true, false, 60, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, 2, false, "AUTO", false, true, true, "", true, true, "default", 60, 2048, 0, 0, nil, 0, true, 0, 0, true, 1080, 1920, false, "/dev/dsp0", "/dev/dsp0", true, true, true, true, "8901", true, 0, 0, false, sqlmock.AnyArg(), 81). //nolint:lll
+					WithArgs(true, false, false, true, false, 60, 0, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, "", 0, 2, "", false, "AUTO", false, "", true, true, "", "", false, "", "", "", "", 0, true, true, "default", "", "", 0, "", 0, "", "", 0, 60, 2048, false, "", 0, 0, nil, 0, 0, "", 0, "", "", 0, true, 0, 0, "", 0, 0, true, 1080, 1920, 0, false, "/dev/dsp0", "/dev/dsp0", "", true, true, 0, true, true, "8901", true, 0, 0, false, sqlmock.AnyArg(), 81). //nolint:lll
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 
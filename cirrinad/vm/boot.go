@@ -0,0 +1,288 @@
+package vm
+
+import (
+	"cmp"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// dependencyWait is how long a VM waits for the VMs it depends on before being started anyway, and how long each boot
+// stage waits for its VMs to come up before moving on to the next stage
+const dependencyWait = 5 * time.Minute
+
+type bootKey struct {
+	group uint32 // boot group, raised to the boot group of any VM depended on
+	depth int    // length of the longest dependency chain within the boot group
+}
+
+// ParseDependsOn splits a comma separated list of VM IDs
+func ParseDependsOn(dependsOn string) []string {
+	var vmIDs []string
+
+	for _, vmID := range strings.Split(dependsOn, ",") {
+		vmID = strings.TrimSpace(vmID)
+		if vmID == "" {
+			continue
+		}
+
+		vmIDs = append(vmIDs, vmID)
+	}
+
+	return vmIDs
+}
+
+// ValidateDependencies checks that each VM in dependsOn exists and that the VM with vmID depending on them would not
+// create a dependency cycle
+func ValidateDependencies(vmID string, dependsOn []string) error {
+	graph := make(map[string][]string)
+
+	List.Mu.RLock()
+	for id, vmInst := range List.VMList {
+		graph[id] = ParseDependsOn(vmInst.Config.DependsOn)
+	}
+	List.Mu.RUnlock()
+
+	for _, depID := range dependsOn {
+		if depID == vmID {
+			return errVMDependsOnSelf
+		}
+
+		_, exists := graph[depID]
+		if !exists {
+			return fmt.Errorf("%w: %s", errVMDependencyNotFound, depID)
+		}
+	}
+
+	graph[vmID] = dependsOn
+
+	if dependencyCycle(graph, vmID) {
+		return errVMDependencyCycle
+	}
+
+	return nil
+}
+
+// RemoveFromDependents removes the VM from the dependencies of all other VMs, used when the VM is deleted
+// note, callers must lock the in memory cache via List.Mu.Lock()
+func (v *VM) RemoveFromDependents() error {
+	for _, vmInst := range List.VMList {
+		dependsOn := ParseDependsOn(vmInst.Config.DependsOn)
+		if !slices.Contains(dependsOn, v.ID) {
+			continue
+		}
+
+		dependsOn = slices.DeleteFunc(dependsOn, func(depID string) bool {
+			return depID == v.ID
+		})
+		vmInst.Config.DependsOn = strings.Join(dependsOn, ",")
+
+		err := vmInst.Save()
+		if err != nil {
+			return fmt.Errorf("error removing VM dependency: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// dependencyCycle returns true if following the dependencies of start leads back to start
+func dependencyCycle(graph map[string][]string, start string) bool {
+	visited := make(map[string]bool)
+
+	var visit func(vmID string) bool
+
+	visit = func(vmID string) bool {
+		for _, depID := range graph[vmID] {
+			if depID == start {
+				return true
+			}
+
+			if visited[depID] {
+				continue
+			}
+
+			visited[depID] = true
+
+			if visit(depID) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return visit(start)
+}
+
+// bootStages sorts VMs into the stages they are started in. Lower boot groups come first and a VM always comes after
+// the VMs it depends on. Shutdown goes through the stages in reverse.
+func bootStages(vms []*VM) [][]*VM {
+	allVMs := make(map[string]*VM)
+	for _, vmInst := range GetAll() {
+		allVMs[vmInst.ID] = vmInst
+	}
+
+	for _, vmInst := range vms {
+		allVMs[vmInst.ID] = vmInst
+	}
+
+	keys := make(map[string]bootKey)
+	inProgress := make(map[string]bool)
+
+	var keyOf func(vmInst *VM) bootKey
+
+	keyOf = func(vmInst *VM) bootKey {
+		key, done := keys[vmInst.ID]
+		if done {
+			return key
+		}
+
+		key = bootKey{group: vmInst.Config.BootGroup}
+
+		// cycles are rejected when saving, but don't loop forever if one got into the database anyway
+		if inProgress[vmInst.ID] {
+			return key
+		}
+
+		inProgress[vmInst.ID] = true
+
+		var depKeys []bootKey
+
+		for _, depID := range ParseDependsOn(vmInst.Config.DependsOn) {
+			depVM, exists := allVMs[depID]
+			if !exists {
+				continue
+			}
+
+			depKey := keyOf(depVM)
+			key.group = max(key.group, depKey.group)
+			depKeys = append(depKeys, depKey)
+		}
+
+		for _, depKey := range depKeys {
+			if depKey.group == key.group {
+				key.depth = max(key.depth, depKey.depth+1)
+			}
+		}
+
+		delete(inProgress, vmInst.ID)
+		keys[vmInst.ID] = key
+
+		return key
+	}
+
+	stageMap := make(map[bootKey][]*VM)
+
+	for _, vmInst := range vms {
+		key := keyOf(vmInst)
+		stageMap[key] = append(stageMap[key], vmInst)
+	}
+
+	stageKeys := make([]bootKey, 0, len(stageMap))
+	for key := range stageMap {
+		stageKeys = append(stageKeys, key)
+	}
+
+	slices.SortFunc(stageKeys, func(a, b bootKey) int {
+		if a.group != b.group {
+			return cmp.Compare(a.group, b.group)
+		}
+
+		return cmp.Compare(a.depth, b.depth)
+	})
+
+	stages := make([][]*VM, 0, len(stageKeys))
+
+	for _, key := range stageKeys {
+		stage := stageMap[key]
+		slices.SortFunc(stage, func(a, b *VM) int {
+			return cmp.Compare(a.Name, b.Name)
+		})
+
+		stages = append(stages, stage)
+	}
+
+	return stages
+}
+
+// dependencySatisfied returns true if a VM depended on is up, which is when it is ready if it has readiness probes or
+// else when it is running
+func (v *VM) dependencySatisfied() bool {
+	return v.Ready()
+}
+
+// waitSatisfied waits for the VM to be up, returning false on timeout
+func (v *VM) waitSatisfied(timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+
+	for !v.dependencySatisfied() {
+		if time.Now().After(deadline) {
+			return false
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	return true
+}
+
+// waitDependencies waits for the VMs this VM depends on to be up. If they are not up within dependencyWait, the VM is
+// started anyway.
+func (v *VM) waitDependencies() {
+	deadline := time.Now().Add(dependencyWait)
+
+	for _, depID := range ParseDependsOn(v.Config.DependsOn) {
+		depVM, err := GetByID(depID)
+		if err != nil {
+			slog.Error("VM dependency not found", "vm", v.Name, "dependency", depID)
+
+			continue
+		}
+
+		slog.Debug("waiting for VM dependency", "vm", v.Name, "dependency", depVM.Name)
+
+		if !depVM.waitSatisfied(time.Until(deadline)) {
+			slog.Warn("timed out waiting for VM dependency, starting anyway", "vm", v.Name, "dependency", depVM.Name)
+		}
+	}
+}
+
+// AutoStartVMs starts the autostart VMs in boot order, each stage waiting for the VMs of the previous stage to be up
+func AutoStartVMs() {
+	var autoStartVMs []*VM
+
+	List.Mu.RLock()
+	for _, vmInst := range List.VMList {
+		if vmInst.Config.AutoStart && vmInst.Status == STOPPED {
+			autoStartVMs = append(autoStartVMs, vmInst)
+		}
+	}
+	List.Mu.RUnlock()
+
+	for _, stage := range bootStages(autoStartVMs) {
+		var stageWaitGroup sync.WaitGroup
+
+		for _, vmInst := range stage {
+			stageWaitGroup.Add(1)
+
+			go func(aVMInst *VM) {
+				defer stageWaitGroup.Done()
+
+				err := aVMInst.doAutostart()
+				if err != nil {
+					return
+				}
+
+				if !aVMInst.waitSatisfied(dependencyWait) {
+					slog.Warn("timed out waiting for VM to come up", "vm", aVMInst.Name)
+				}
+			}(vmInst)
+		}
+
+		stageWaitGroup.Wait()
+	}
+}
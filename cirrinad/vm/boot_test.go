@@ -0,0 +1,128 @@
+package vm
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+//nolint:paralleltest
+func TestParseDependsOn(t *testing.T) {
+	tests := []struct {
+		name      string
+		dependsOn string
+		want      []string
+	}{
+		{
+			name:      "empty",
+			dependsOn: "",
+			want:      nil,
+		},
+		{
+			name:      "one",
+			dependsOn: "b9a9b5a8-43d8-4a4c-9c32-0d2cd6d1c4a1",
+			want:      []string{"b9a9b5a8-43d8-4a4c-9c32-0d2cd6d1c4a1"},
+		},
+		{
+			name:      "spacesAndEmpty",
+			dependsOn: " a , ,b,",
+			want:      []string{"a", "b"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := ParseDependsOn(testCase.dependsOn)
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func Test_dependencyCycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph map[string][]string
+		start string
+		want  bool
+	}{
+		{
+			name:  "noDeps",
+			graph: map[string][]string{"a": nil, "b": nil},
+			start: "a",
+			want:  false,
+		},
+		{
+			name:  "chain",
+			graph: map[string][]string{"a": {"b"}, "b": {"c"}, "c": nil},
+			start: "a",
+			want:  false,
+		},
+		{
+			name:  "diamond",
+			graph: map[string][]string{"a": {"b", "c"}, "b": {"d"}, "c": {"d"}, "d": nil},
+			start: "a",
+			want:  false,
+		},
+		{
+			name:  "direct",
+			graph: map[string][]string{"a": {"b"}, "b": {"a"}},
+			start: "a",
+			want:  true,
+		},
+		{
+			name:  "indirect",
+			graph: map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"a"}},
+			start: "a",
+			want:  true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := dependencyCycle(testCase.graph, testCase.start)
+			if got != testCase.want {
+				t.Errorf("dependencyCycle() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func Test_bootStages(t *testing.T) {
+	router := &VM{ID: "router", Name: "router", Config: Config{BootGroup: 0}}
+	dns := &VM{ID: "dns", Name: "dns", Config: Config{BootGroup: 0, DependsOn: "router"}}
+	app1 := &VM{ID: "app1", Name: "app1", Config: Config{BootGroup: 1}}
+	app2 := &VM{ID: "app2", Name: "app2", Config: Config{BootGroup: 1, DependsOn: "dns"}}
+	// depends on a VM in a later boot group, so is moved to that group
+	early := &VM{ID: "early", Name: "early", Config: Config{BootGroup: 0, DependsOn: "app1"}}
+
+	got := bootStages([]*VM{app2, early, app1, dns, router})
+
+	var gotNames [][]string
+
+	for _, stage := range got {
+		var stageNames []string
+		for _, vmInst := range stage {
+			stageNames = append(stageNames, vmInst.Name)
+		}
+
+		gotNames = append(gotNames, stageNames)
+	}
+
+	want := [][]string{
+		{"router"},
+		{"dns"},
+		{"app1", "app2"},
+		{"early"},
+	}
+
+	diff := deep.Equal(gotNames, want)
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+}
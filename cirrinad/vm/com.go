@@ -188,6 +188,10 @@ func (v *VM) comLoggerRead(comNum int, thisCom *serial.Port, logFile *os.File, t
 		// write to log file
 		_, err = logFile.Write(logBuffer)
 
+		if comNum == 1 {
+			v.serialFeed(logBuffer[0])
+		}
+
 		// write to channel used by remote users, if someone is reading from it
 		if v.Status != STOPPED && thisRChan != nil && thisComChanWriteFlag {
 			nb2 := copy(streamBuffer, logBuffer)
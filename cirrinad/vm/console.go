@@ -0,0 +1,207 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cirrina/cirrinad/config"
+)
+
+// GuestAgentPort is the virtio-console port qemu-guest-agent looks for in the guest
+const GuestAgentPort = "org.qemu.guest_agent.0"
+
+// consoleMaxPorts is the number of ports bhyve supports on a virtio-console device
+const consoleMaxPorts = 16
+
+// consoleDialTimeout is how long to wait for bhyve to accept a connection to a console port socket
+const consoleDialTimeout = 5 * time.Second
+
+// consolePortsInUse holds the console ports cirrinad is connected to, keyed by VM ID and port name. bhyve only
+// accepts one connection to each port at a time.
+var consolePortsInUse = struct {
+	mu    sync.Mutex
+	ports map[string]bool
+}{ports: make(map[string]bool)}
+
+// validConsolePortName checks the name only uses characters which are safe in bhyve's arguments and in file names,
+// guest agents use dotted names such as org.qemu.guest_agent.0
+func validConsolePortName(name string) bool {
+	if name == "" || name == "." || name == ".." {
+		return false
+	}
+
+	for _, char := range name {
+		switch {
+		case char >= 'a' && char <= 'z', char >= 'A' && char <= 'Z', char >= '0' && char <= '9':
+		case char == '.', char == '_', char == '-':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseConsolePorts parses comma separated virtio-console port names
+func ParseConsolePorts(ports string) ([]string, error) {
+	var names []string
+
+	for _, name := range strings.Split(ports, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if !validConsolePortName(name) {
+			return nil, fmt.Errorf("%w: %s", errVMConsolePortsInvalid, name)
+		}
+
+		if slices.Contains(names, name) {
+			return nil, fmt.Errorf("%w: %s given more than once", errVMConsolePortsInvalid, name)
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// ValidateConsolePorts checks the port names and that they fit on the virtio-console device along with the guest
+// agent port if it is enabled
+func ValidateConsolePorts(ports string, guestAgent bool) error {
+	names, err := ParseConsolePorts(ports)
+	if err != nil {
+		return err
+	}
+
+	if guestAgent {
+		if slices.Contains(names, GuestAgentPort) {
+			return fmt.Errorf("%w: %s is used by the guest agent", errVMConsolePortsInvalid, GuestAgentPort)
+		}
+
+		names = append(names, GuestAgentPort)
+	}
+
+	if len(names) > consoleMaxPorts {
+		return fmt.Errorf("%w: %d ports, at most %d are supported", errVMConsolePortsInvalid, len(names),
+			consoleMaxPorts)
+	}
+
+	return nil
+}
+
+// ConsolePorts returns the names of the VM's virtio-console ports, including the guest agent port if it is enabled
+func (v *VM) ConsolePorts() []string {
+	names, err := ParseConsolePorts(v.Config.ConsolePorts)
+	if err != nil {
+		slog.Error("invalid console ports", "vm", v.Name, "err", err)
+
+		names = nil
+	}
+
+	if v.Config.GuestAgent && !slices.Contains(names, GuestAgentPort) {
+		names = append(names, GuestAgentPort)
+	}
+
+	return names
+}
+
+// consolePortPath returns the path of the socket bhyve creates for the console port
+func (v *VM) consolePortPath(name string) string {
+	return filepath.Join(config.Config.Disk.VM.Path.State, v.Name, "vtcon."+name)
+}
+
+// removeConsoleSockets removes sockets left behind by a previous run so bhyve can create them again
+func (v *VM) removeConsoleSockets() {
+	for _, name := range v.ConsolePorts() {
+		err := os.Remove(v.consolePortPath(name))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			slog.Error("error removing console port socket", "vm", v.Name, "port", name, "err", err)
+		}
+	}
+}
+
+// getConsoleArg returns a virtio-console device with a socket for each of the VM's console ports
+func (v *VM) getConsoleArg(slot int) ([]string, int) {
+	names := v.ConsolePorts()
+	if len(names) == 0 {
+		return []string{}, slot
+	}
+
+	portArgs := make([]string, 0, len(names))
+	for _, name := range names {
+		portArgs = append(portArgs, name+"="+v.consolePortPath(name))
+	}
+
+	return []string{
+		"-s",
+		v.pciSlotArg(PCIConsole, strconv.FormatInt(int64(slot), 10)) + ",virtio-console," + strings.Join(portArgs, ","),
+	}, slot + 1
+}
+
+// consolePortConn is a connection to a console port, closing it releases the port for the next user
+type consolePortConn struct {
+	net.Conn
+	key  string
+	once sync.Once
+}
+
+func (c *consolePortConn) Close() error {
+	c.once.Do(func() {
+		consolePortsInUse.mu.Lock()
+		delete(consolePortsInUse.ports, c.key)
+		consolePortsInUse.mu.Unlock()
+	})
+
+	err := c.Conn.Close()
+	if err != nil {
+		return fmt.Errorf("error closing console port: %w", err)
+	}
+
+	return nil
+}
+
+// DialConsolePort connects to one of the running VM's console ports. Only one connection to each port is allowed,
+// the caller must close the connection when done with it.
+func (v *VM) DialConsolePort(name string) (net.Conn, error) {
+	if v.Status != RUNNING {
+		return nil, errVMNotRunning
+	}
+
+	if !slices.Contains(v.ConsolePorts(), name) {
+		return nil, fmt.Errorf("%w: %s", errVMConsolePortNotFound, name)
+	}
+
+	key := v.ID + "/" + name
+
+	consolePortsInUse.mu.Lock()
+	if consolePortsInUse.ports[key] {
+		consolePortsInUse.mu.Unlock()
+
+		return nil, fmt.Errorf("%w: %s", errVMConsolePortBusy, name)
+	}
+
+	consolePortsInUse.ports[key] = true
+	consolePortsInUse.mu.Unlock()
+
+	conn, err := net.DialTimeout("unix", v.consolePortPath(name), consoleDialTimeout)
+	if err != nil {
+		consolePortsInUse.mu.Lock()
+		delete(consolePortsInUse.ports, key)
+		consolePortsInUse.mu.Unlock()
+
+		return nil, fmt.Errorf("error connecting to console port %s: %w", name, err)
+	}
+
+	return &consolePortConn{Conn: conn, key: key}, nil
+}
@@ -0,0 +1,148 @@
+package vm
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-test/deep"
+
+	"cirrina/cirrinad/config"
+)
+
+//nolint:paralleltest
+func TestValidateConsolePorts(t *testing.T) {
+	tests := []struct {
+		name       string
+		ports      string
+		guestAgent bool
+		wantErr    bool
+	}{
+		{name: "empty", ports: ""},
+		{name: "agentOnly", ports: "", guestAgent: true},
+		{name: "names", ports: "log, com.example.data_1", guestAgent: true},
+		{name: "badChar", ports: "log/1", wantErr: true},
+		{name: "comma", ports: "log,", wantErr: false},
+		{name: "dotDot", ports: "..", wantErr: true},
+		{name: "dupe", ports: "log,log", wantErr: true},
+		{name: "agentName", ports: GuestAgentPort, guestAgent: true, wantErr: true},
+		{name: "agentNameNoAgent", ports: GuestAgentPort},
+		{name: "tooMany", ports: "a,b,c,d,e,f,g,h,i,j,k,l,m,n,o,p", guestAgent: true, wantErr: true},
+		{name: "max", ports: "a,b,c,d,e,f,g,h,i,j,k,l,m,n,o", guestAgent: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateConsolePorts(testCase.ports, testCase.guestAgent)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ValidateConsolePorts() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_getConsoleArg(t *testing.T) {
+	stateDir := t.TempDir()
+	config.Config.Disk.VM.Path.State = stateDir
+
+	t.Cleanup(func() { config.Config.Disk.VM.Path.State = "" })
+
+	tests := []struct {
+		name     string
+		vm       *VM
+		wantArgs []string
+		wantSlot int
+	}{
+		{
+			name:     "none",
+			vm:       &VM{Name: "test2024"},
+			wantArgs: []string{},
+			wantSlot: 5,
+		},
+		{
+			name: "portsAndAgent",
+			vm: &VM{
+				Name:   "test2024",
+				Config: Config{ConsolePorts: "log,data", GuestAgent: true},
+			},
+			wantArgs: []string{
+				"-s",
+				"5,virtio-console,log=" + filepath.Join(stateDir, "test2024", "vtcon.log") +
+					",data=" + filepath.Join(stateDir, "test2024", "vtcon.data") +
+					",org.qemu.guest_agent.0=" + filepath.Join(stateDir, "test2024", "vtcon.org.qemu.guest_agent.0"),
+			},
+			wantSlot: 6,
+		},
+		{
+			name: "assignedSlot",
+			vm: &VM{
+				Name:     "test2024",
+				Config:   Config{GuestAgent: true},
+				pciSlots: map[string]PCIAddr{PCIConsole: {Slot: 12}},
+			},
+			wantArgs: []string{
+				"-s",
+				"0:12:0,virtio-console,org.qemu.guest_agent.0=" +
+					filepath.Join(stateDir, "test2024", "vtcon.org.qemu.guest_agent.0"),
+			},
+			wantSlot: 6,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			gotArgs, gotSlot := testCase.vm.getConsoleArg(5)
+
+			diff := deep.Equal(gotArgs, testCase.wantArgs)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+
+			if gotSlot != testCase.wantSlot {
+				t.Errorf("getConsoleArg() gotSlot = %v, want %v", gotSlot, testCase.wantSlot)
+			}
+		})
+	}
+
+	if pciEmulation(PCIConsole) != "virtio-console" {
+		t.Errorf("pciEmulation() = %v, want virtio-console", pciEmulation(PCIConsole))
+	}
+}
+
+//nolint:paralleltest
+func TestVM_DialConsolePort(t *testing.T) {
+	testVM := newAgentTestVM(t)
+	startFakeAgent(t, testVM, nil)
+
+	conn, err := testVM.DialConsolePort(GuestAgentPort)
+	if err != nil {
+		t.Fatalf("DialConsolePort() error = %v", err)
+	}
+
+	_, err = testVM.DialConsolePort(GuestAgentPort)
+	if !errors.Is(err, errVMConsolePortBusy) {
+		t.Errorf("DialConsolePort() error = %v, wantErr %v", err, errVMConsolePortBusy)
+	}
+
+	_, err = testVM.DialConsolePort("missing")
+	if !errors.Is(err, errVMConsolePortNotFound) {
+		t.Errorf("DialConsolePort() error = %v, wantErr %v", err, errVMConsolePortNotFound)
+	}
+
+	_ = conn.Close()
+
+	conn, err = testVM.DialConsolePort(GuestAgentPort)
+	if err != nil {
+		t.Fatalf("DialConsolePort() after close error = %v", err)
+	}
+
+	_ = conn.Close()
+
+	testVM.Status = STOPPED
+
+	_, err = testVM.DialConsolePort(GuestAgentPort)
+	if !errors.Is(err, errVMNotRunning) {
+		t.Errorf("DialConsolePort() error = %v, wantErr %v", err, errVMNotRunning)
+	}
+}
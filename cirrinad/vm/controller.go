@@ -0,0 +1,549 @@
+package vm
+
+import (
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"cirrina/cirrinad/disk"
+	"cirrina/cirrinad/iso"
+	"cirrina/cirrinad/util"
+)
+
+// types of storage controller disks and ISOs can be placed on, virtio-scsi will be added once bhyve can back it
+// with a disk image
+const (
+	ControllerAHCI = "AHCI"
+	ControllerNVMe = "NVME"
+)
+
+// kinds of device which can be attached to a controller
+const (
+	AttachDisk = "disk"
+	AttachISO  = "iso"
+)
+
+const (
+	nvmeMaxSerial  = 20 // bytes in the NVMe serial number field
+	minSectorSize  = 512
+	maxSectorSize  = 65536
+	nvmeMaxNSPorts = 1 // bhyve NVMe controllers have a single namespace
+)
+
+// Controller is a storage controller of a VM. Disks and ISOs which are not attached to one of the VM's controllers
+// keep their own slot (NVMe and virtio-blk) or are ports of the shared AHCI controller (AHCI disks and ISOs).
+type Controller struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	VMID      string `gorm:"uniqueIndex:idx_vm_controller_name;not null;default:null"`
+	Name      string `gorm:"uniqueIndex:idx_vm_controller_name;not null;default:null"`
+	Type      string `gorm:"not null;default:null"`
+	MaxQ      uint32 // NVMe: maximum number of I/O queues, 0 for the bhyve default
+	QSz       uint32 // NVMe: maximum number of entries per queue, 0 for the bhyve default
+	Ser       string // NVMe: serial number, empty for the bhyve default
+}
+
+func (Controller) TableName() string {
+	return "vm_controllers"
+}
+
+// Attachment places a disk or ISO of a VM on a port of one of the VM's controllers
+type Attachment struct {
+	ID           uint   `gorm:"primarykey"`
+	VMID         string `gorm:"index;not null;default:null"`
+	ControllerID uint   `gorm:"index;not null;default:null"`
+	DeviceType   string `gorm:"not null;default:null"`
+	DeviceID     string `gorm:"not null;default:null"`
+	Port         uint32
+	Boot         bool
+	ReadOnly     bool
+	SectorSize   string       // logical[/physical] sector size, empty for the default
+	Cache        sql.NullBool // overrides the disk's cache setting when valid
+	Direct       sql.NullBool // overrides the disk's direct setting when valid
+}
+
+func (Attachment) TableName() string {
+	return "vm_attachments"
+}
+
+// ValidControllerType checks the controller type is one of the known types
+func ValidControllerType(controllerType string) bool {
+	switch controllerType {
+	case ControllerAHCI, ControllerNVMe:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidSectorSize checks the sector size is empty or logical[/physical] with each a power of two from 512 to 64k
+// and the physical size no smaller than the logical size
+func ValidSectorSize(sectorSize string) bool {
+	if sectorSize == "" {
+		return true
+	}
+
+	logical, physical, found := strings.Cut(sectorSize, "/")
+	if !found {
+		physical = logical
+	}
+
+	var sizes [2]uint64
+
+	for sizeIdx, size := range []string{logical, physical} {
+		value, err := strconv.ParseUint(size, 10, 32)
+		if err != nil || value < minSectorSize || value > maxSectorSize || value&(value-1) != 0 {
+			return false
+		}
+
+		sizes[sizeIdx] = value
+	}
+
+	return sizes[1] >= sizes[0]
+}
+
+func (c *Controller) validate() error {
+	if !util.ValidVMName(c.Name) {
+		return errVMControllerInvalidName
+	}
+
+	if !ValidControllerType(c.Type) {
+		return fmt.Errorf("%w: %s", errVMControllerInvalidType, c.Type)
+	}
+
+	if c.Type != ControllerNVMe && (c.MaxQ != 0 || c.QSz != 0 || c.Ser != "") {
+		return fmt.Errorf("%w: maxq, qsz and ser are only supported by NVMe controllers", errVMControllerInvalidOption)
+	}
+
+	if len(c.Ser) > nvmeMaxSerial || strings.ContainsAny(c.Ser, ",=") {
+		return fmt.Errorf("%w: ser must be at most %d characters without , or =",
+			errVMControllerInvalidOption, nvmeMaxSerial)
+	}
+
+	return nil
+}
+
+// maxPorts returns the number of ports of the controller
+func (c *Controller) maxPorts() uint32 {
+	if c.Type == ControllerNVMe {
+		return nvmeMaxNSPorts
+	}
+
+	return ahciMaxPorts
+}
+
+// AddController adds a storage controller to the VM, it is used from the next start of the VM
+func (v *VM) AddController(controller *Controller) error {
+	err := controller.validate()
+	if err != nil {
+		return err
+	}
+
+	_, err = v.GetControllerByName(controller.Name)
+	if err == nil {
+		return errVMControllerExists
+	}
+
+	controller.ID = 0
+	controller.VMID = v.ID
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Create(controller)
+	if res.Error != nil || res.RowsAffected != 1 {
+		slog.Error("error adding VM controller", "vm", v.ID, "controller", controller.Name, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	return nil
+}
+
+// GetControllers returns the storage controllers of the VM sorted by name
+func (v *VM) GetControllers() ([]Controller, error) {
+	var controllers []Controller
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ?", v.ID).Order("name").Find(&controllers)
+	if res.Error != nil {
+		slog.Error("error getting VM controllers", "vm", v.ID, "err", res.Error)
+
+		return nil, errVMInternalDB
+	}
+
+	return controllers, nil
+}
+
+// GetControllerByName looks up a storage controller of the VM by name
+func (v *VM) GetControllerByName(name string) (*Controller, error) {
+	var controller Controller
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ? AND name = ?", v.ID, name).Limit(1).Find(&controller)
+	if res.Error != nil {
+		slog.Error("error getting VM controller", "vm", v.ID, "controller", name, "err", res.Error)
+
+		return nil, errVMInternalDB
+	}
+
+	if res.RowsAffected != 1 {
+		return nil, errVMControllerNotFound
+	}
+
+	return &controller, nil
+}
+
+// RemoveController removes a storage controller of the VM, the devices attached to it go back to the controller
+// of their disk type
+func (v *VM) RemoveController(name string) error {
+	controller, err := v.GetControllerByName(name)
+	if err != nil {
+		return err
+	}
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("controller_id = ?", controller.ID).Delete(&Attachment{})
+	if res.Error != nil {
+		slog.Error("error removing VM controller attachments", "vm", v.ID, "controller", name, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	res = vmDB.Delete(controller)
+	if res.Error != nil {
+		slog.Error("error removing VM controller", "vm", v.ID, "controller", name, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	return nil
+}
+
+// DeleteControllers removes all storage controllers and attachments of the VM
+func (v *VM) DeleteControllers() error {
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ?", v.ID).Delete(&Attachment{})
+	if res.Error != nil {
+		slog.Error("error deleting VM attachments", "vm", v.ID, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	res = vmDB.Where("vm_id = ?", v.ID).Delete(&Controller{})
+	if res.Error != nil {
+		slog.Error("error deleting VM controllers", "vm", v.ID, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	return nil
+}
+
+// GetAttachments returns the placement of the VM's disks and ISOs on its controllers
+func (v *VM) GetAttachments() ([]Attachment, error) {
+	var attachments []Attachment
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ?", v.ID).Order("controller_id").Order("port").Find(&attachments)
+	if res.Error != nil {
+		slog.Error("error getting VM attachments", "vm", v.ID, "err", res.Error)
+
+		return nil, errVMInternalDB
+	}
+
+	return attachments, nil
+}
+
+// hasDevice checks the disk or ISO is attached to the VM
+func (v *VM) hasDevice(deviceType string, deviceID string) bool {
+	switch deviceType {
+	case AttachDisk:
+		return slices.ContainsFunc(v.Disks, func(aDisk *disk.Disk) bool {
+			return aDisk != nil && aDisk.ID == deviceID
+		})
+	case AttachISO:
+		return v.attachedISO(deviceID) != nil
+	default:
+		return false
+	}
+}
+
+// attachedISO returns the ISO attached to the VM with the given ID, or nil
+func (v *VM) attachedISO(isoID string) *iso.ISO {
+	for _, anISO := range v.ISOs {
+		if anISO != nil && anISO.ID == isoID {
+			return anISO
+		}
+	}
+
+	return nil
+}
+
+// SetAttachment places one of the VM's disks or ISOs on a port of the named controller, replacing any placement
+// the device already had
+func (v *VM) SetAttachment(controllerName string, attachment *Attachment) error {
+	controller, err := v.GetControllerByName(controllerName)
+	if err != nil {
+		return err
+	}
+
+	if !v.hasDevice(attachment.DeviceType, attachment.DeviceID) {
+		return fmt.Errorf("%w: %s %s", errVMAttachmentDevice, attachment.DeviceType, attachment.DeviceID)
+	}
+
+	if attachment.DeviceType == AttachISO && controller.Type != ControllerAHCI {
+		return fmt.Errorf("%w: ISOs can only be attached to AHCI controllers", errVMAttachmentInvalid)
+	}
+
+	if attachment.Port >= controller.maxPorts() {
+		return fmt.Errorf("%w: port must be below %d", errVMAttachmentInvalid, controller.maxPorts())
+	}
+
+	if !ValidSectorSize(attachment.SectorSize) {
+		return fmt.Errorf("%w: sector size %s", errVMAttachmentInvalid, attachment.SectorSize)
+	}
+
+	existing, err := v.GetAttachments()
+	if err != nil {
+		return err
+	}
+
+	for _, other := range existing {
+		if other.DeviceType == attachment.DeviceType && other.DeviceID == attachment.DeviceID {
+			continue
+		}
+
+		if other.ControllerID == controller.ID && other.Port == attachment.Port {
+			return fmt.Errorf("%w: port %d of %s", errVMAttachmentPortUsed, attachment.Port, controller.Name)
+		}
+
+		if attachment.Boot && other.Boot && v.hasDevice(other.DeviceType, other.DeviceID) {
+			return errVMAttachmentBootDupe
+		}
+	}
+
+	attachment.ID = 0
+	attachment.VMID = v.ID
+	attachment.ControllerID = controller.ID
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ? AND device_type = ? AND device_id = ?", v.ID, attachment.DeviceType,
+		attachment.DeviceID).Delete(&Attachment{})
+	if res.Error != nil {
+		slog.Error("error replacing VM attachment", "vm", v.ID, "device", attachment.DeviceID, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	res = vmDB.Create(attachment)
+	if res.Error != nil || res.RowsAffected != 1 {
+		slog.Error("error adding VM attachment", "vm", v.ID, "device", attachment.DeviceID, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	return nil
+}
+
+// RemoveAttachment takes a disk or ISO off its controller, it goes back to the controller of its disk type
+func (v *VM) RemoveAttachment(deviceType string, deviceID string) error {
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ? AND device_type = ? AND device_id = ?", v.ID, deviceType, deviceID).
+		Delete(&Attachment{})
+	if res.Error != nil {
+		slog.Error("error removing VM attachment", "vm", v.ID, "device", deviceID, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	if res.RowsAffected == 0 {
+		return errVMAttachmentNotFound
+	}
+
+	return nil
+}
+
+// loadControllers reads the VM's controllers and the placement of its disks and ISOs for generating its arguments
+func (v *VM) loadControllers() error {
+	controllers, err := v.GetControllers()
+	if err != nil {
+		return err
+	}
+
+	attachments, err := v.GetAttachments()
+	if err != nil {
+		return err
+	}
+
+	defer v.mu.Unlock()
+	v.mu.Lock()
+	v.controllers = controllers
+	v.attachments = attachments
+
+	return nil
+}
+
+// controllerAttachments returns the attachments of a controller in port order, skipping devices which are no
+// longer attached to the VM
+func (v *VM) controllerAttachments(controller *Controller) []Attachment {
+	var attachments []Attachment
+
+	for _, attachment := range v.attachments {
+		if attachment.ControllerID == controller.ID && v.hasDevice(attachment.DeviceType, attachment.DeviceID) {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	slices.SortFunc(attachments, func(a, b Attachment) int {
+		return int(a.Port) - int(b.Port)
+	})
+
+	return attachments
+}
+
+// placed checks the disk or ISO is attached to one of the VM's controllers
+func (v *VM) placed(deviceType string, deviceID string) bool {
+	for _, attachment := range v.attachments {
+		if attachment.DeviceType != deviceType || attachment.DeviceID != deviceID {
+			continue
+		}
+
+		if slices.ContainsFunc(v.controllers, func(c Controller) bool { return c.ID == attachment.ControllerID }) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// usedControllers returns the VM's controllers of the given type which have at least one device attached
+func (v *VM) usedControllers(controllerType string) []Controller {
+	var used []Controller
+
+	for _, controller := range v.controllers {
+		if controller.Type == controllerType && len(v.controllerAttachments(&controller)) > 0 {
+			used = append(used, controller)
+		}
+	}
+
+	return used
+}
+
+// attachmentOptions returns the bhyve options of a placed device, sectorKey is the name the emulation uses for
+// the sector size
+func attachmentOptions(attachment *Attachment, sectorKey string) string {
+	var options string
+
+	if attachment.ReadOnly {
+		options += ",ro"
+	}
+
+	if attachment.SectorSize != "" {
+		options += "," + sectorKey + "=" + attachment.SectorSize
+	}
+
+	if attachment.Boot {
+		options += ",bootindex=1"
+	}
+
+	return options
+}
+
+// attachedDiskArg returns the path and options of a disk placed on a controller, with the attachment's cache and
+// direct settings overriding the disk's own
+func (v *VM) attachedDiskArg(attachment *Attachment, sectorKey string) (string, error) {
+	thisDisk, err := disk.GetByID(attachment.DeviceID)
+	if err != nil {
+		return "", fmt.Errorf("error getting disk: %w", err)
+	}
+
+	diskCache := thisDisk.DiskCache
+	if attachment.Cache.Valid {
+		diskCache = attachment.Cache
+	}
+
+	diskDirect := thisDisk.DiskDirect
+	if attachment.Direct.Valid {
+		diskDirect = attachment.Direct
+	}
+
+	diskPathArg, err := v.getDiskPathOptArg(thisDisk, diskCache, diskDirect)
+	if err != nil {
+		return "", err
+	}
+
+	return diskPathArg + attachmentOptions(attachment, sectorKey), nil
+}
+
+// getControllerPortsArg returns the hd: and cd: ports of an AHCI controller, bhyve numbers ports in the order
+// they are given so gaps between port numbers close up
+func (v *VM) getControllerPortsArg(controller *Controller) []string {
+	var ports []string
+
+	for _, attachment := range v.controllerAttachments(controller) {
+		switch attachment.DeviceType {
+		case AttachDisk:
+			diskArg, err := v.attachedDiskArg(&attachment, "sectorsize")
+			if err != nil {
+				slog.Error("error adding disk to controller, skipping", "controller", controller.Name,
+					"diskID", attachment.DeviceID, "err", err)
+
+				continue
+			}
+
+			ports = append(ports, "hd:"+diskArg)
+		case AttachISO:
+			anISO := v.attachedISO(attachment.DeviceID)
+			if anISO == nil || anISO.Path == "" {
+				slog.Error("error adding iso to controller, skipping", "controller", controller.Name,
+					"isoID", attachment.DeviceID)
+
+				continue
+			}
+
+			ports = append(ports, "cd:"+anISO.Path+attachmentOptions(&attachment, "sectorsize"))
+		}
+	}
+
+	return ports
+}
+
+// getNVMeControllerArg returns the backing disk and options of an NVMe controller
+func (v *VM) getNVMeControllerArg(controller *Controller) (string, error) {
+	attachments := v.controllerAttachments(controller)
+	if len(attachments) == 0 {
+		return "", errVMAttachmentNotFound
+	}
+
+	diskArg, err := v.attachedDiskArg(&attachments[0], "sectsz")
+	if err != nil {
+		return "", err
+	}
+
+	nvmeArg := "nvme," + diskArg
+
+	if controller.MaxQ != 0 {
+		nvmeArg += ",maxq=" + strconv.FormatUint(uint64(controller.MaxQ), 10)
+	}
+
+	if controller.QSz != 0 {
+		nvmeArg += ",qsz=" + strconv.FormatUint(uint64(controller.QSz), 10)
+	}
+
+	if controller.Ser != "" {
+		nvmeArg += ",ser=" + controller.Ser
+	}
+
+	return nvmeArg, nil
+}
@@ -0,0 +1,312 @@
+package vm
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-test/deep"
+	"gorm.io/gorm"
+
+	"cirrina/cirrinad/cirrinadtest"
+	"cirrina/cirrinad/disk"
+	"cirrina/cirrinad/iso"
+)
+
+//nolint:paralleltest
+func TestValidSectorSize(t *testing.T) {
+	tests := []struct {
+		sectorSize string
+		want       bool
+	}{
+		{sectorSize: "", want: true},
+		{sectorSize: "512", want: true},
+		{sectorSize: "512/4096", want: true},
+		{sectorSize: "4096/512", want: false},
+		{sectorSize: "256", want: false},
+		{sectorSize: "1000", want: false},
+		{sectorSize: "131072", want: false},
+		{sectorSize: "512/", want: false},
+		{sectorSize: "abc", want: false},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.sectorSize, func(t *testing.T) {
+			if got := ValidSectorSize(testCase.sectorSize); got != testCase.want {
+				t.Errorf("ValidSectorSize() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestController_validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		controller Controller
+		wantErr    error
+	}{
+		{
+			name:       "ahci",
+			controller: Controller{Name: "sata0", Type: ControllerAHCI},
+		},
+		{
+			name:       "nvmeOptions",
+			controller: Controller{Name: "nvme0", Type: ControllerNVMe, MaxQ: 4, QSz: 512, Ser: "CIRRINA0001"},
+		},
+		{
+			name:       "badName",
+			controller: Controller{Name: "sata:0", Type: ControllerAHCI},
+			wantErr:    errVMControllerInvalidName,
+		},
+		{
+			name:       "badType",
+			controller: Controller{Name: "scsi0", Type: "VIRTIO-SCSI"},
+			wantErr:    errVMControllerInvalidType,
+		},
+		{
+			name:       "nvmeOptionOnAHCI",
+			controller: Controller{Name: "sata0", Type: ControllerAHCI, MaxQ: 4},
+			wantErr:    errVMControllerInvalidOption,
+		},
+		{
+			name:       "serialTooLong",
+			controller: Controller{Name: "nvme0", Type: ControllerNVMe, Ser: "012345678901234567890"},
+			wantErr:    errVMControllerInvalidOption,
+		},
+		{
+			name:       "serialComma",
+			controller: Controller{Name: "nvme0", Type: ControllerNVMe, Ser: "a,b"},
+			wantErr:    errVMControllerInvalidOption,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.controller.validate()
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("validate() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func Test_attachmentOptions(t *testing.T) {
+	tests := []struct {
+		name       string
+		attachment Attachment
+		sectorKey  string
+		want       string
+	}{
+		{
+			name:       "none",
+			attachment: Attachment{},
+			sectorKey:  "sectorsize",
+			want:       "",
+		},
+		{
+			name:       "all",
+			attachment: Attachment{ReadOnly: true, SectorSize: "512/4096", Boot: true},
+			sectorKey:  "sectorsize",
+			want:       ",ro,sectorsize=512/4096,bootindex=1",
+		},
+		{
+			name:       "nvmeSectorSize",
+			attachment: Attachment{SectorSize: "4096"},
+			sectorKey:  "sectsz",
+			want:       ",sectsz=4096",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := attachmentOptions(&testCase.attachment, testCase.sectorKey); got != testCase.want {
+				t.Errorf("attachmentOptions() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_controllerArgs(t *testing.T) {
+	disk.List.DiskList = map[string]*disk.Disk{
+		"25b5e67d-915d-4b0e-bb3a-42f3233510a2": {
+			ID:         "25b5e67d-915d-4b0e-bb3a-42f3233510a2",
+			Name:       "test2024081001_hd0",
+			Type:       "NVME",
+			DevType:    "FILE",
+			DiskCache:  sql.NullBool{Bool: true, Valid: true},
+			DiskDirect: sql.NullBool{Bool: false, Valid: true},
+		},
+		"0e2ff6e6-1ff8-4e57-8bc3-2ab2cd7a4e5a": {
+			ID:         "0e2ff6e6-1ff8-4e57-8bc3-2ab2cd7a4e5a",
+			Name:       "test2024081001_hd1",
+			Type:       "NVME",
+			DevType:    "FILE",
+			DiskCache:  sql.NullBool{Bool: true, Valid: true},
+			DiskDirect: sql.NullBool{Bool: false, Valid: true},
+		},
+		"5c8a9cb6-7a35-4f5c-8fd6-0e1d9b6a3a3e": {
+			ID:         "5c8a9cb6-7a35-4f5c-8fd6-0e1d9b6a3a3e",
+			Name:       "test2024081001_hd2",
+			Type:       "AHCI-HD",
+			DevType:    "FILE",
+			DiskCache:  sql.NullBool{Bool: true, Valid: true},
+			DiskDirect: sql.NullBool{Bool: false, Valid: true},
+		},
+	}
+
+	disk.PathExistsFunc = func(_ string) (bool, error) {
+		return true, nil
+	}
+
+	testVM := &VM{
+		Disks: []*disk.Disk{
+			{ID: "25b5e67d-915d-4b0e-bb3a-42f3233510a2"},
+			{ID: "0e2ff6e6-1ff8-4e57-8bc3-2ab2cd7a4e5a"},
+			{ID: "5c8a9cb6-7a35-4f5c-8fd6-0e1d9b6a3a3e"},
+		},
+		ISOs: []*iso.ISO{
+			{ID: "a", Name: "someTestThing.iso", Path: "/some/path/to/someTestThing.iso"},
+		},
+		controllers: []Controller{
+			{ID: 1, Name: "nvme0", Type: ControllerNVMe, MaxQ: 4, Ser: "CIRRINA0001"},
+			{ID: 2, Name: "sata0", Type: ControllerAHCI},
+			{ID: 3, Name: "unused", Type: ControllerAHCI},
+		},
+		attachments: []Attachment{
+			{
+				ControllerID: 1, DeviceType: AttachDisk, DeviceID: "0e2ff6e6-1ff8-4e57-8bc3-2ab2cd7a4e5a",
+				SectorSize: "4096", Cache: sql.NullBool{Bool: false, Valid: true}, Boot: true,
+			},
+			{ControllerID: 2, DeviceType: AttachISO, DeviceID: "a", Port: 3},
+			{
+				ControllerID: 2, DeviceType: AttachDisk, DeviceID: "5c8a9cb6-7a35-4f5c-8fd6-0e1d9b6a3a3e", Port: 1,
+				ReadOnly: true,
+			},
+			// no longer attached to the VM
+			{ControllerID: 3, DeviceType: AttachDisk, DeviceID: "68e2b7b2-4f05-4a07-a4d1-0b0f8ac4cbf1"},
+		},
+	}
+
+	gotDiskArgs, gotSlot := testVM.getDiskArg(3)
+
+	wantDiskArgs := []string{
+		"-s", "3,nvme,test2024081001_hd0.img",
+		"-s", "4,nvme,test2024081001_hd1.img,nocache,sectsz=4096,bootindex=1,maxq=4,ser=CIRRINA0001",
+	}
+
+	diff := deep.Equal(gotDiskArgs, wantDiskArgs)
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	gotAHCIArgs, gotSlot := testVM.getAHCIArg(gotSlot)
+
+	wantAHCIArgs := []string{
+		"-s", "5,ahci,hd:test2024081001_hd2.img,ro,cd:/some/path/to/someTestThing.iso",
+	}
+
+	diff = deep.Equal(gotAHCIArgs, wantAHCIArgs)
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	if gotSlot != 6 {
+		t.Errorf("getAHCIArg() gotSlot = %v, want 6", gotSlot)
+	}
+
+	disk.List.DiskList = map[string]*disk.Disk{}
+}
+
+//nolint:paralleltest
+func TestVM_GetControllers(t *testing.T) {
+	createTime := time.Now()
+
+	tests := []struct {
+		name        string
+		mockClosure func(testDB *gorm.DB, mock sqlmock.Sqlmock)
+		want        []Controller
+		wantErr     bool
+	}{
+		{
+			name: "success",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					VMDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta("SELECT * FROM `vm_controllers` WHERE vm_id = ? ORDER BY name"),
+				).
+					WithArgs("22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1").
+					WillReturnRows(sqlmock.NewRows(
+						[]string{"id", "created_at", "vm_id", "name", "type", "max_q", "q_sz", "ser"}).
+						AddRow(1, createTime, "22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1", "nvme0", "NVME", 4, 0, "abc"),
+					)
+			},
+			want: []Controller{
+				{
+					ID: 1, CreatedAt: createTime, VMID: "22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1", Name: "nvme0",
+					Type: "NVME", MaxQ: 4, Ser: "abc",
+				},
+			},
+		},
+		{
+			name: "error",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					VMDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta("SELECT * FROM `vm_controllers` WHERE vm_id = ? ORDER BY name"),
+				).
+					WithArgs("22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1").
+					WillReturnError(gorm.ErrInvalidField) // does not matter what error is returned
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testDB, mock := cirrinadtest.NewMockDB(t.Name())
+			testCase.mockClosure(testDB, mock)
+
+			testVM := &VM{ID: "22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1"}
+
+			got, err := testVM.GetControllers()
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("GetControllers() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+
+			mock.ExpectClose()
+
+			db, err := testDB.DB()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = db.Close()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = mock.ExpectationsWereMet()
+			if err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
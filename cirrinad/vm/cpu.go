@@ -0,0 +1,183 @@
+package vm
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cirrina/cirrinad/util"
+)
+
+// CPUPin pins a vCPU of the VM to a host CPU
+type CPUPin struct {
+	VCPU    uint32
+	HostCPU uint32
+}
+
+// topologyCPUs returns the number of vCPUs the topology describes, 0 when no topology is set
+func topologyCPUs(sockets uint32, cores uint32, threads uint32) uint64 {
+	if sockets == 0 && cores == 0 && threads == 0 {
+		return 0
+	}
+
+	total := uint64(1)
+
+	for _, count := range []uint32{sockets, cores, threads} {
+		if count != 0 {
+			total *= uint64(count)
+		}
+	}
+
+	return total
+}
+
+// ValidateCPUTopology checks the sockets, cores and threads of a VM. All 0 leaves the topology to bhyve, otherwise
+// a value of 0 counts as 1 and their product must equal the number of vCPUs, which the host must support.
+func ValidateCPUTopology(cpus uint32, sockets uint32, cores uint32, threads uint32) error {
+	total := topologyCPUs(sockets, cores, threads)
+	if total == 0 {
+		return nil
+	}
+
+	if total != uint64(cpus) {
+		return fmt.Errorf("%w: %d sockets, %d cores and %d threads make %d vCPUs, not %d",
+			errVMCPUTopologyInvalid, max(sockets, 1), max(cores, 1), max(threads, 1), total, cpus)
+	}
+
+	hostCpus, err := util.GetHostMaxVMCpusFunc()
+	if err != nil {
+		return fmt.Errorf("error getting host max vm cpus: %w", err)
+	}
+
+	if total > uint64(hostCpus) {
+		return fmt.Errorf("%w: %d vCPUs, the host allows %d", errVMCPUTopologyInvalid, total, hostCpus)
+	}
+
+	return nil
+}
+
+// ParseCPUPins parses comma separated vcpu:hostcpu pairs, sorted by vCPU
+func ParseCPUPins(pins string) ([]CPUPin, error) {
+	var parsed []CPUPin
+
+	for _, pair := range strings.Split(pins, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		vcpuStr, hostCPUStr, found := strings.Cut(pair, ":")
+		if !found {
+			return nil, fmt.Errorf("%w: %s", errVMCPUPinsInvalid, pair)
+		}
+
+		vcpu, err := strconv.ParseUint(strings.TrimSpace(vcpuStr), 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errVMCPUPinsInvalid, pair)
+		}
+
+		hostCPU, err := strconv.ParseUint(strings.TrimSpace(hostCPUStr), 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errVMCPUPinsInvalid, pair)
+		}
+
+		if slices.ContainsFunc(parsed, func(pin CPUPin) bool { return pin.VCPU == uint32(vcpu) }) {
+			return nil, fmt.Errorf("%w: vCPU %d pinned more than once", errVMCPUPinsInvalid, vcpu)
+		}
+
+		parsed = append(parsed, CPUPin{VCPU: uint32(vcpu), HostCPU: uint32(hostCPU)})
+	}
+
+	slices.SortFunc(parsed, func(a, b CPUPin) int {
+		return int(a.VCPU) - int(b.VCPU)
+	})
+
+	return parsed, nil
+}
+
+// ValidateCPUPins checks each pinned vCPU exists in the VM and each host CPU exists on the host
+func ValidateCPUPins(pins string, cpus uint32) error {
+	parsed, err := ParseCPUPins(pins)
+	if err != nil {
+		return err
+	}
+
+	if len(parsed) == 0 {
+		return nil
+	}
+
+	hostCpus, err := util.GetHostCpusFunc()
+	if err != nil {
+		return fmt.Errorf("error getting host cpus: %w", err)
+	}
+
+	for _, pin := range parsed {
+		if pin.VCPU >= cpus {
+			return fmt.Errorf("%w: vCPU %d does not exist, the VM has %d vCPUs", errVMCPUPinsInvalid, pin.VCPU, cpus)
+		}
+
+		if pin.HostCPU >= uint32(hostCpus) {
+			return fmt.Errorf("%w: host CPU %d does not exist, the host has %d CPUs",
+				errVMCPUPinsInvalid, pin.HostCPU, hostCpus)
+		}
+	}
+
+	return nil
+}
+
+// getCPUPinArgs returns a -p argument for each pinned vCPU of the VM
+func (v *VM) getCPUPinArgs(vmCpus uint16) []string {
+	pins, err := ParseCPUPins(v.Config.CPUPins)
+	if err != nil {
+		slog.Error("invalid cpu pins, not pinning", "vm", v.Name, "err", err)
+
+		return []string{}
+	}
+
+	pinArgs := make([]string, 0, 2*len(pins))
+
+	for _, pin := range pins {
+		if pin.VCPU >= uint32(vmCpus) {
+			slog.Error("pinned vCPU does not exist, skipping", "vm", v.Name, "vcpu", pin.VCPU, "cpus", vmCpus)
+
+			continue
+		}
+
+		pinArgs = append(pinArgs, "-p", strconv.FormatUint(uint64(pin.VCPU), 10)+":"+
+			strconv.FormatUint(uint64(pin.HostCPU), 10))
+	}
+
+	v.warnSharedCPUPins(pins)
+
+	return pinArgs
+}
+
+// warnSharedCPUPins logs a warning for each host CPU the VM pins which another running VM also pins
+func (v *VM) warnSharedCPUPins(pins []CPUPin) {
+	if len(pins) == 0 {
+		return
+	}
+
+	defer List.Mu.RUnlock()
+	List.Mu.RLock()
+
+	for _, vmInst := range List.VMList {
+		if vmInst.ID == v.ID || vmInst.Status == STOPPED {
+			continue
+		}
+
+		otherPins, err := ParseCPUPins(vmInst.Config.CPUPins)
+		if err != nil {
+			continue
+		}
+
+		for _, pin := range pins {
+			if slices.ContainsFunc(otherPins, func(other CPUPin) bool { return other.HostCPU == pin.HostCPU }) {
+				slog.Warn("host CPU is also pinned by another running VM",
+					"vm", v.Name, "hostCPU", pin.HostCPU, "otherVM", vmInst.Name)
+			}
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package vm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-test/deep"
+
+	"cirrina/cirrinad/util"
+)
+
+//nolint:paralleltest
+func TestValidateCPUTopology(t *testing.T) {
+	type args struct {
+		cpus    uint32
+		sockets uint32
+		cores   uint32
+		threads uint32
+	}
+
+	tests := []struct {
+		name                 string
+		mockGetHostMaxVMCpus func() (uint16, error)
+		args                 args
+		wantErr              error
+	}{
+		{
+			name:                 "unset",
+			mockGetHostMaxVMCpus: func() (uint16, error) { return 0, util.ErrInvalidNumCPUs },
+			args:                 args{cpus: 4},
+		},
+		{
+			name:                 "matches",
+			mockGetHostMaxVMCpus: func() (uint16, error) { return 16, nil },
+			args:                 args{cpus: 8, sockets: 2, cores: 2, threads: 2},
+		},
+		{
+			name:                 "zeroCountsAsOne",
+			mockGetHostMaxVMCpus: func() (uint16, error) { return 16, nil },
+			args:                 args{cpus: 4, cores: 4},
+		},
+		{
+			name:                 "mismatch",
+			mockGetHostMaxVMCpus: func() (uint16, error) { return 16, nil },
+			args:                 args{cpus: 4, sockets: 2, cores: 4},
+			wantErr:              errVMCPUTopologyInvalid,
+		},
+		{
+			name:                 "tooManyForHost",
+			mockGetHostMaxVMCpus: func() (uint16, error) { return 4, nil },
+			args:                 args{cpus: 8, sockets: 2, cores: 4},
+			wantErr:              errVMCPUTopologyInvalid,
+		},
+		{
+			name:                 "hostErr",
+			mockGetHostMaxVMCpus: func() (uint16, error) { return 0, util.ErrInvalidNumCPUs },
+			args:                 args{cpus: 2, sockets: 2},
+			wantErr:              util.ErrInvalidNumCPUs,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			util.GetHostMaxVMCpusFunc = testCase.mockGetHostMaxVMCpus
+
+			t.Cleanup(func() { util.GetHostMaxVMCpusFunc = util.GetHostMaxVMCpus })
+
+			err := ValidateCPUTopology(testCase.args.cpus, testCase.args.sockets, testCase.args.cores,
+				testCase.args.threads)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("ValidateCPUTopology() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestParseCPUPins(t *testing.T) {
+	tests := []struct {
+		name    string
+		pins    string
+		want    []CPUPin
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			pins: "",
+			want: nil,
+		},
+		{
+			name: "sorted",
+			pins: "2:6, 0:4,1:5",
+			want: []CPUPin{{VCPU: 0, HostCPU: 4}, {VCPU: 1, HostCPU: 5}, {VCPU: 2, HostCPU: 6}},
+		},
+		{
+			name:    "noColon",
+			pins:    "0-4",
+			wantErr: true,
+		},
+		{
+			name:    "notNumber",
+			pins:    "0:a",
+			wantErr: true,
+		},
+		{
+			name:    "vcpuTwice",
+			pins:    "0:4,0:5",
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseCPUPins(testCase.pins)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseCPUPins() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestValidateCPUPins(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockGetHostCpus func() (uint16, error)
+		pins            string
+		cpus            uint32
+		wantErr         error
+	}{
+		{
+			name:            "empty",
+			mockGetHostCpus: func() (uint16, error) { return 0, util.ErrInvalidNumCPUs },
+			pins:            "",
+			cpus:            2,
+		},
+		{
+			name:            "valid",
+			mockGetHostCpus: func() (uint16, error) { return 8, nil },
+			pins:            "0:6,1:7",
+			cpus:            2,
+		},
+		{
+			name:            "noSuchVCPU",
+			mockGetHostCpus: func() (uint16, error) { return 8, nil },
+			pins:            "2:6",
+			cpus:            2,
+			wantErr:         errVMCPUPinsInvalid,
+		},
+		{
+			name:            "noSuchHostCPU",
+			mockGetHostCpus: func() (uint16, error) { return 8, nil },
+			pins:            "0:8",
+			cpus:            2,
+			wantErr:         errVMCPUPinsInvalid,
+		},
+		{
+			name:            "hostErr",
+			mockGetHostCpus: func() (uint16, error) { return 0, util.ErrInvalidNumCPUs },
+			pins:            "0:1",
+			cpus:            2,
+			wantErr:         util.ErrInvalidNumCPUs,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			util.GetHostCpusFunc = testCase.mockGetHostCpus
+
+			t.Cleanup(func() { util.GetHostCpusFunc = util.GetHostCpus })
+
+			err := ValidateCPUPins(testCase.pins, testCase.cpus)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("ValidateCPUPins() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"cirrina/cirrinad/config"
 	"cirrina/cirrinad/disk"
 	"cirrina/cirrinad/iso"
+	"cirrina/cirrinad/webhook"
 )
 
 type Singleton struct {
@@ -100,6 +101,8 @@ func (v *VM) SetRunning(pid uint32) {
 	if res.Error != nil {
 		slog.Error("error saving VM running", "err", res.Error)
 	}
+
+	v.emitState()
 }
 
 func (v *VM) SetStarting() {
@@ -117,6 +120,8 @@ func (v *VM) SetStarting() {
 	if res.Error != nil {
 		slog.Error("error saving VM start", "err", res.Error)
 	}
+
+	v.emitState()
 }
 
 // SetStopped this can in some cases get called on already stopped/deleted VMs and that's OK
@@ -125,6 +130,14 @@ func (v *VM) SetStopped() error {
 	defer v.mu.Unlock()
 	v.mu.Lock()
 	v.Status = STOPPED
+	v.stopStage = ""
+	v.ready = false
+	v.readyMatched = false
+	v.readyPattern = nil
+	v.serialLine = nil
+	v.livePattern = nil
+	v.liveState = LiveNone
+	v.liveFailures = 0
 	v.VNCPort = 0
 	v.DebugPort = 0
 	v.BhyvePid = 0
@@ -160,6 +173,8 @@ func (v *VM) SetStopped() error {
 		return fmt.Errorf("error saving VM: %w", res.Error)
 	}
 
+	v.emitState()
+
 	return nil
 }
 
@@ -178,6 +193,16 @@ func (v *VM) SetStopping() {
 	if res.Error != nil {
 		slog.Error("error saving VM stopping", "err", res.Error)
 	}
+
+	v.emitState()
+}
+
+// emitState sends a webhook event for the state the VM is now in, the caller must hold the VM lock
+func (v *VM) emitState() {
+	webhook.Emit(webhook.Payload{
+		Event: webhook.EventVMState,
+		VM:    &webhook.VMData{ID: v.ID, Name: v.Name, State: string(v.Status)},
+	})
 }
 
 func (v *VM) SetVNCPort(port uint16) {
@@ -223,6 +248,36 @@ func DBAutoMigrate() {
 		slog.Error("failed db migration", "err", err)
 		panic("failed to auto-migrate Configs")
 	}
+
+	err = vmdb.AutoMigrate(&Snapshot{})
+	if err != nil {
+		slog.Error("failed db migration", "err", err)
+		panic("failed to auto-migrate VM snapshots")
+	}
+
+	err = vmdb.AutoMigrate(&RestartRecord{})
+	if err != nil {
+		slog.Error("failed db migration", "err", err)
+		panic("failed to auto-migrate VM restart history")
+	}
+
+	err = vmdb.AutoMigrate(&Controller{}, &Attachment{})
+	if err != nil {
+		slog.Error("failed db migration", "err", err)
+		panic("failed to auto-migrate VM controllers")
+	}
+
+	err = vmdb.AutoMigrate(&Passthru{})
+	if err != nil {
+		slog.Error("failed db migration", "err", err)
+		panic("failed to auto-migrate VM passthru devices")
+	}
+
+	err = vmdb.AutoMigrate(&Share{})
+	if err != nil {
+		slog.Error("failed db migration", "err", err)
+		panic("failed to auto-migrate VM shares")
+	}
 }
 
 func CacheInit() {
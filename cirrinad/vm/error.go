@@ -27,9 +27,36 @@ var (
 	errVMInternalDB       = errors.New("internal VM database error")
 	errVMNotStopped       = errors.New("VM must be stopped first")
 	errVMStopFail         = errors.New("stop failed")
+	errVMNotRunning       = errors.New("VM not running")
+	errVMStopTimeout      = errors.New("timed out waiting for VM to stop")
+	errVMBhyvectlFail     = errors.New("bhyvectl failed")
 	errVMIDEmptyOrInvalid = errors.New("VM ID not specified or invalid")
 )
 
+var (
+	errVMDependsOnSelf      = errors.New("VM can not depend on itself")
+	errVMDependencyNotFound = errors.New("VM dependency not found")
+	errVMDependencyCycle    = errors.New("VM dependencies would create a cycle")
+)
+
+var errVMLabelInvalid = errors.New("invalid label, labels must be key=value")
+
+var (
+	errVMHookInvalid = errors.New("invalid hook")
+	errVMHookFailed  = errors.New("hook failed")
+)
+
+var (
+	errVMProbePatternInvalid = errors.New("invalid probe pattern")
+	errVMProbeTCPInvalid     = errors.New("invalid probe TCP address")
+	errVMProbeHTTPInvalid    = errors.New("invalid probe HTTP URL")
+)
+
+var (
+	errStopPolicyInvalid = errors.New("invalid stop policy")
+	errVMStopNoSerial    = errors.New("com1 not available")
+)
+
 var (
 	errVMComInvalid   = errors.New("invalid com port number")
 	errVMComDevNotSet = errors.New("com port enabled but comDev not set")
@@ -46,3 +73,79 @@ var (
 	errVMNicDupe     = errors.New("nic may only be added once")
 	errVMNicAttached = errors.New("nic already attached")
 )
+
+var (
+	errSnapshotInvalidName = errors.New("invalid snapshot name")
+	errSnapshotExists      = errors.New("snapshot with this name already exists")
+	errSnapshotNotFound    = errors.New("snapshot not found")
+	errSnapshotMissingItem = errors.New("no longer exists")
+	errSnapshotPartial     = errors.New("snapshot restore incomplete, retry the restore")
+	errSnapshotVMBusy      = errors.New("VM must be stopped or running to snapshot")
+)
+
+var (
+	errVMPCIAddrInvalid   = errors.New("invalid PCI address, must be slot, slot:function or bus:slot:function")
+	errVMPCISlotsInvalid  = errors.New("invalid PCI slot assignment")
+	errVMPCISlotCollision = errors.New("PCI address used more than once")
+	errVMPCISlotsFull     = errors.New("too many PCI devices")
+)
+
+var (
+	errVMCPUTopologyInvalid = errors.New("invalid CPU topology")
+	errVMCPUPinsInvalid     = errors.New("invalid CPU pins, must be comma separated vcpu:hostcpu pairs")
+)
+
+var (
+	errVMControllerInvalidName   = errors.New("invalid controller name")
+	errVMControllerInvalidType   = errors.New("invalid controller type")
+	errVMControllerInvalidOption = errors.New("invalid controller option")
+	errVMControllerExists        = errors.New("controller with this name already exists")
+	errVMControllerNotFound      = errors.New("controller not found")
+	errVMAttachmentDevice        = errors.New("device is not attached to the VM")
+	errVMAttachmentInvalid       = errors.New("invalid controller attachment")
+	errVMAttachmentPortUsed      = errors.New("controller port already used")
+	errVMAttachmentBootDupe      = errors.New("another device is already the boot device")
+	errVMAttachmentNotFound      = errors.New("device is not attached to a controller")
+)
+
+var (
+	errVMPassthruInvalid     = errors.New("invalid passthru device, must be bus/slot/function")
+	errVMPassthruNotFound    = errors.New("PCI device not found on host")
+	errVMPassthruNotPPT      = errors.New("PCI device is not bound to the ppt driver")
+	errVMPassthruExists      = errors.New("PCI device already assigned to this VM")
+	errVMPassthruNotAssigned = errors.New("PCI device not assigned to this VM")
+	errVMPassthruBusy        = errors.New("PCI device in use by another running VM")
+)
+
+var (
+	errVMShareInvalidName   = errors.New("invalid share name")
+	errVMSharePathInvalid   = errors.New("invalid share path")
+	errVMSharePathProtected = errors.New("share path not allowed")
+	errVMShareExists        = errors.New("share with this name already exists")
+	errVMShareNotFound      = errors.New("share not found")
+)
+
+var (
+	errVMConsolePortsInvalid = errors.New("invalid console ports")
+	errVMConsolePortNotFound = errors.New("console port not found")
+	errVMConsolePortBusy     = errors.New("console port already in use")
+	errVMGuestAgentDisabled  = errors.New("guest agent not enabled")
+	errVMGuestAgent          = errors.New("guest agent error")
+)
+
+var (
+	errVMFirmwareNotFound = errors.New("firmware not found")
+	errVMFirmwareMissing  = errors.New("firmware file missing")
+)
+
+var (
+	errVMUEFIVarsNotStored  = errors.New("VM does not store UEFI variables")
+	errVMUEFIVarsNotCreated = errors.New("UEFI vars file not created yet, start the VM once first")
+	errVMUEFIVarsInvalid    = errors.New("invalid UEFI vars file")
+	errVMUEFIVarsSize       = errors.New("UEFI vars file size does not match the firmware's vars template")
+	errVMUEFIVarsFull       = errors.New("UEFI variable store full")
+	errVMUEFIBootNotFound   = errors.New("UEFI boot entry not found")
+	errVMUEFIBootOrderDupe  = errors.New("UEFI boot entry may only be in the boot order once")
+	errVMUEFIBootISO        = errors.New("ISO not attached to the VM")
+	errVMUEFIBootFull       = errors.New("no free UEFI boot entry number")
+)
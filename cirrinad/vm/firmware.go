@@ -0,0 +1,97 @@
+package vm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"cirrina/cirrinad/config"
+)
+
+// FirmwareDefault is the name of the ROM configured by rom.path and rom.vars.template
+const FirmwareDefault = "uefi"
+
+// FirmwareNone boots without a ROM, the guest must already be loaded into the VM by a pre-start hook running
+// bhyveload or grub-bhyve
+const FirmwareNone = "none"
+
+// Firmwares returns the firmware catalog, the default ROM first followed by the firmware in the config
+func Firmwares() []config.Firmware {
+	defaultFirmware := config.Firmware{Name: FirmwareDefault, Path: config.Config.Rom.Path}
+	defaultFirmware.Vars.Template = config.Config.Rom.Vars.Template
+
+	return append([]config.Firmware{defaultFirmware}, config.Config.Rom.Firmware...)
+}
+
+// GetFirmware returns the firmware in the catalog with the name, an empty name is the default ROM
+func GetFirmware(name string) (config.Firmware, error) {
+	if name == "" {
+		name = FirmwareDefault
+	}
+
+	for _, firmware := range Firmwares() {
+		if firmware.Name == name {
+			return firmware, nil
+		}
+	}
+
+	return config.Firmware{}, fmt.Errorf("%w: %s", errVMFirmwareNotFound, name)
+}
+
+// ValidateFirmware checks the firmware is in the catalog and that its ROM and vars template are installed
+func ValidateFirmware(name string) error {
+	if name == FirmwareNone {
+		return nil
+	}
+
+	firmware, err := GetFirmware(name)
+	if err != nil {
+		return err
+	}
+
+	err = firmwareFileExists(firmware.Path)
+	if err != nil {
+		return err
+	}
+
+	if firmware.Vars.Template != "" {
+		err = firmwareFileExists(firmware.Vars.Template)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func firmwareFileExists(path string) error {
+	if !filepath.IsAbs(path) {
+		return fmt.Errorf("%w: %s is not an absolute path", errVMFirmwareMissing, path)
+	}
+
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errVMFirmwareMissing, err)
+	}
+
+	if fileInfo.IsDir() {
+		return fmt.Errorf("%w: %s is a directory", errVMFirmwareMissing, path)
+	}
+
+	return nil
+}
+
+// getFirmware returns the VM's firmware, or false if it boots without a ROM
+func (v *VM) getFirmware() (config.Firmware, bool) {
+	if v.Config.Firmware == FirmwareNone {
+		return config.Firmware{}, false
+	}
+
+	firmware, err := GetFirmware(v.Config.Firmware)
+	if err != nil {
+		// the firmware was removed from the config since the VM was saved, Start refuses to run the VM
+		return config.Firmware{}, false
+	}
+
+	return firmware, true
+}
@@ -0,0 +1,119 @@
+package vm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-test/deep"
+
+	"cirrina/cirrinad/config"
+)
+
+//nolint:paralleltest
+func TestGetFirmware(t *testing.T) {
+	config.Config.Rom.Path = "/usr/local/share/uefi-firmware/BHYVE_UEFI.fd"
+	config.Config.Rom.Vars.Template = "/usr/local/share/uefi-firmware/BHYVE_UEFI_VARS.fd"
+	config.Config.Rom.Firmware = []config.Firmware{
+		{Name: "csm", Path: "/usr/local/share/uefi-firmware/BHYVE_UEFI_CSM.fd"},
+	}
+
+	t.Cleanup(func() { config.Config.Rom.Firmware = nil })
+
+	defaultFirmware := config.Firmware{Name: "uefi", Path: "/usr/local/share/uefi-firmware/BHYVE_UEFI.fd"}
+	defaultFirmware.Vars.Template = "/usr/local/share/uefi-firmware/BHYVE_UEFI_VARS.fd"
+
+	tests := []struct {
+		name         string
+		firmwareName string
+		want         config.Firmware
+		wantErr      bool
+	}{
+		{
+			name:         "empty",
+			firmwareName: "",
+			want:         defaultFirmware,
+		},
+		{
+			name:         "default",
+			firmwareName: "uefi",
+			want:         defaultFirmware,
+		},
+		{
+			name:         "catalog",
+			firmwareName: "csm",
+			want:         config.Firmware{Name: "csm", Path: "/usr/local/share/uefi-firmware/BHYVE_UEFI_CSM.fd"},
+		},
+		{
+			name:         "unknown",
+			firmwareName: "removed",
+			want:         config.Firmware{},
+			wantErr:      true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := GetFirmware(testCase.firmwareName)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("GetFirmware() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestValidateFirmware(t *testing.T) {
+	firmwareDir := t.TempDir()
+	romPath := filepath.Join(firmwareDir, "ROM.fd")
+	varsPath := filepath.Join(firmwareDir, "VARS.fd")
+
+	for _, path := range []string{romPath, varsPath} {
+		err := os.WriteFile(path, []byte{0}, 0o644)
+		if err != nil {
+			t.Fatalf("failed writing test firmware: %v", err)
+		}
+	}
+
+	config.Config.Rom.Path = romPath
+	config.Config.Rom.Vars.Template = varsPath
+	config.Config.Rom.Firmware = []config.Firmware{
+		{Name: "noVars", Path: romPath},
+		{Name: "missingRom", Path: filepath.Join(firmwareDir, "MISSING.fd")},
+		{Name: "missingVars", Path: romPath},
+		{Name: "dirRom", Path: firmwareDir},
+		{Name: "relativeRom", Path: "ROM.fd"},
+	}
+	config.Config.Rom.Firmware[2].Vars.Template = filepath.Join(firmwareDir, "MISSING_VARS.fd")
+
+	t.Cleanup(func() { config.Config.Rom.Firmware = nil })
+
+	tests := []struct {
+		name         string
+		firmwareName string
+		wantErr      bool
+	}{
+		{name: "default", firmwareName: "uefi"},
+		{name: "none", firmwareName: "none"},
+		{name: "noVars", firmwareName: "noVars"},
+		{name: "unknown", firmwareName: "removed", wantErr: true},
+		{name: "missingRom", firmwareName: "missingRom", wantErr: true},
+		{name: "missingVars", firmwareName: "missingVars", wantErr: true},
+		{name: "dirRom", firmwareName: "dirRom", wantErr: true},
+		{name: "relativeRom", firmwareName: "relativeRom", wantErr: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateFirmware(testCase.firmwareName)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ValidateFirmware() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"cirrina/cirrinad/config"
+	vmswitch "cirrina/cirrinad/switch"
+	"cirrina/cirrinad/vmnic"
+)
+
+type HookType string
+
+const (
+	HookPreStart  HookType = "pre-start"  // before bhyve is started, failing aborts the start
+	HookPostStart HookType = "post-start" // after bhyve has started
+	HookPreStop   HookType = "pre-stop"   // before the VM is asked to stop
+	HookPostStop  HookType = "post-stop"  // after the VM has stopped, for any reason other than a guest reboot
+)
+
+const (
+	// hookOutputMax limits how much hook output is included in the error of a failed hook
+	hookOutputMax = 512
+	// hookWaitDelay is how long to wait for the output of a hook to close after it exited or was killed
+	hookWaitDelay = 5 * time.Second
+)
+
+// allow override for testing
+var hookCommand = exec.CommandContext
+
+// ValidateHook checks a hook is an absolute path to an executable file, empty hooks are valid and disable the hook
+func ValidateHook(hookPath string) error {
+	if hookPath == "" {
+		return nil
+	}
+
+	if !filepath.IsAbs(hookPath) {
+		return fmt.Errorf("%w: %s is not an absolute path", errVMHookInvalid, hookPath)
+	}
+
+	hookInfo, err := os.Stat(hookPath)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errVMHookInvalid, err)
+	}
+
+	if !hookInfo.Mode().IsRegular() || hookInfo.Mode().Perm()&0o111 == 0 {
+		return fmt.Errorf("%w: %s is not an executable file", errVMHookInvalid, hookPath)
+	}
+
+	return nil
+}
+
+// hookScripts returns the global hook and then the VM hook for the event, with how long each may run
+func (v *VM) hookScripts(hook HookType) ([2]string, [2]time.Duration) {
+	timeouts := [2]time.Duration{
+		time.Duration(config.Config.Hooks.Timeout) * time.Second,
+		time.Duration(v.Config.HookTimeout) * time.Second,
+	}
+
+	switch hook {
+	case HookPreStart:
+		return [2]string{config.Config.Hooks.PreStart, v.Config.HookPreStart}, timeouts
+	case HookPostStart:
+		return [2]string{config.Config.Hooks.PostStart, v.Config.HookPostStart}, timeouts
+	case HookPreStop:
+		return [2]string{config.Config.Hooks.PreStop, v.Config.HookPreStop}, timeouts
+	case HookPostStop:
+		return [2]string{config.Config.Hooks.PostStop, v.Config.HookPostStop}, timeouts
+	default:
+		return [2]string{}, timeouts
+	}
+}
+
+// hookEnv returns the environment hooks are run with, describing the VM and its nics
+func (v *VM) hookEnv(hook HookType) []string {
+	hookEnv := append(os.Environ(),
+		"CIRRINA_HOOK="+string(hook),
+		"CIRRINA_VM_ID="+v.ID,
+		"CIRRINA_VM_NAME="+v.Name,
+		"CIRRINA_VM_STATE="+string(v.Status),
+	)
+
+	vmNics, err := vmnic.GetNics(v.Config.ID)
+	if err != nil {
+		slog.Error("error getting VM nics for hook", "vm", v.Name, "err", err)
+	}
+
+	hookEnv = append(hookEnv, "CIRRINA_VM_NIC_COUNT="+strconv.Itoa(len(vmNics)))
+
+	for nicIdx, vmNic := range vmNics {
+		var switchName string
+
+		if vmNic.SwitchID != "" {
+			nicSwitch, err := vmswitch.GetByID(vmNic.SwitchID)
+			if err == nil {
+				switchName = nicSwitch.Name
+			}
+		}
+
+		prefix := "CIRRINA_VM_NIC" + strconv.Itoa(nicIdx) + "_"
+		hookEnv = append(hookEnv,
+			prefix+"NAME="+vmNic.Name,
+			prefix+"MAC="+vmNic.GetMAC(v.ID, v.Name),
+			prefix+"DEV="+vmNic.NetDev,
+			prefix+"SWITCH="+switchName,
+		)
+	}
+
+	return hookEnv
+}
+
+// runHooks runs the global and then the VM hook for the event, stopping at the first one which fails
+func (v *VM) runHooks(hook HookType) error {
+	scripts, timeouts := v.hookScripts(hook)
+
+	for scriptIdx, script := range scripts {
+		if script == "" {
+			continue
+		}
+
+		err := v.runHook(hook, script, timeouts[scriptIdx])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runHooksLogged runs the hooks for events where a failure can only be logged
+func (v *VM) runHooksLogged(hook HookType) {
+	err := v.runHooks(hook)
+	if err != nil {
+		slog.Error("VM hook failed", "vm", v.Name, "hook", hook, "err", err)
+	}
+}
+
+func (v *VM) runHook(hook HookType, script string, timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	hookCmd := hookCommand(ctx, script)
+	hookCmd.Dir = "/"
+	hookCmd.Env = v.hookEnv(hook)
+	// kill anything the hook started along with it on timeout, and don't wait forever on output held open by them
+	hookCmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	hookCmd.Cancel = func() error {
+		return syscall.Kill(-hookCmd.Process.Pid, syscall.SIGKILL)
+	}
+	hookCmd.WaitDelay = hookWaitDelay
+
+	slog.Debug("running VM hook", "vm", v.Name, "hook", hook, "script", script)
+
+	output, err := hookCmd.CombinedOutput()
+	v.log.Info("hook", "hook", hook, "script", script, "output", string(output), "err", err)
+
+	if ctx.Err() != nil {
+		return fmt.Errorf("%w: %s hook %s timed out after %s", errVMHookFailed, hook, script, timeout)
+	}
+
+	if err != nil {
+		outputStr := strings.TrimSpace(string(output))
+		if len(outputStr) > hookOutputMax {
+			outputStr = outputStr[:hookOutputMax]
+		}
+
+		return fmt.Errorf("%w: %s hook %s: %w: %s", errVMHookFailed, hook, script, err, outputStr)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package vm
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cirrina/cirrinad/config"
+)
+
+//nolint:paralleltest
+func TestValidateHook(t *testing.T) {
+	hookDir := t.TempDir()
+
+	execHook := filepath.Join(hookDir, "exec.sh")
+
+	err := os.WriteFile(execHook, []byte("#!/bin/sh\nexit 0\n"), 0o755) //nolint:gosec
+	if err != nil {
+		t.Fatalf("failed writing hook: %v", err)
+	}
+
+	plainHook := filepath.Join(hookDir, "plain.sh")
+
+	err = os.WriteFile(plainHook, []byte("#!/bin/sh\nexit 0\n"), 0o600)
+	if err != nil {
+		t.Fatalf("failed writing hook: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		hookPath string
+		wantErr  bool
+	}{
+		{name: "empty", hookPath: "", wantErr: false},
+		{name: "executable", hookPath: execHook, wantErr: false},
+		{name: "relative", hookPath: "exec.sh", wantErr: true},
+		{name: "missing", hookPath: filepath.Join(hookDir, "missing.sh"), wantErr: true},
+		{name: "notExecutable", hookPath: plainHook, wantErr: true},
+		{name: "directory", hookPath: hookDir, wantErr: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateHook(testCase.hookPath)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ValidateHook() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			if err != nil && !errors.Is(err, errVMHookInvalid) {
+				t.Errorf("ValidateHook() error = %v, want %v", err, errVMHookInvalid)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_hookScripts(t *testing.T) {
+	config.Config.Hooks.PreStart = "/usr/local/etc/cirrinad/pre-start"
+	config.Config.Hooks.PostStop = "/usr/local/etc/cirrinad/post-stop"
+	config.Config.Hooks.Timeout = 30
+
+	testVM := VM{
+		Config: Config{
+			HookPreStart: "/vms/test/pre-start",
+			HookPreStop:  "/vms/test/pre-stop",
+			HookTimeout:  10,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		hook        HookType
+		wantScripts [2]string
+	}{
+		{
+			name:        "preStart",
+			hook:        HookPreStart,
+			wantScripts: [2]string{"/usr/local/etc/cirrinad/pre-start", "/vms/test/pre-start"},
+		},
+		{name: "postStart", hook: HookPostStart, wantScripts: [2]string{"", ""}},
+		{name: "preStop", hook: HookPreStop, wantScripts: [2]string{"", "/vms/test/pre-stop"}},
+		{name: "postStop", hook: HookPostStop, wantScripts: [2]string{"/usr/local/etc/cirrinad/post-stop", ""}},
+		{name: "unknown", hook: HookType("junk"), wantScripts: [2]string{"", ""}},
+	}
+
+	wantTimeouts := [2]time.Duration{30 * time.Second, 10 * time.Second}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			gotScripts, gotTimeouts := testVM.hookScripts(testCase.hook)
+			if gotScripts != testCase.wantScripts {
+				t.Errorf("hookScripts() scripts = %v, want %v", gotScripts, testCase.wantScripts)
+			}
+
+			if gotTimeouts != wantTimeouts {
+				t.Errorf("hookScripts() timeouts = %v, want %v", gotTimeouts, wantTimeouts)
+			}
+		})
+	}
+
+	config.Config.Hooks.PreStart = ""
+	config.Config.Hooks.PostStop = ""
+	config.Config.Hooks.Timeout = 0
+}
@@ -60,9 +60,9 @@ func TestVM_AttachIsos(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
-					WithArgs(true, false, false, true, false, 0, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, 2, false, "AUTO", false, true, true, "", true, true, "us_unix", 120, 1024, 0, 10, false, 0, true, 1, 0, true, 1080, 1920, false, "/dev/dsp0", "/dev/dsp0", true, true, true, true, "AUTO", false, 0, 0, true, sqlmock.AnyArg(), 1). //nolint:lll
+					WithArgs(true, false, false, true, false, 0, 0, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, "", 0, 2, "", false, "AUTO", false, "", true, true, "", "", false, "", "", "", "", 0, true, true, "us_unix", "", "", 0, "", 0, "", "", 0, 120, 1024, false, "", 0, 10, false, 0, 0, "", 0, "", "", 0, true, 1, 0, "", 0, 0, true, 1080, 1920, 0, false, "/dev/dsp0", "/dev/dsp0", "", true, true, 0, true, true, "AUTO", false, 0, 0, true, sqlmock.AnyArg(), 1). //nolint:lll
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 
@@ -216,9 +216,9 @@ func TestVM_AttachIsos(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
-					WithArgs(true, false, false, true, false, 0, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, 2, false, "AUTO", false, true, true, "", true, true, "us_unix", 120, 1024, 0, 10, false, 0, true, 1, 0, true, 1080, 1920, false, "/dev/dsp0", "/dev/dsp0", true, true, true, true, "AUTO", false, 0, 0, true, sqlmock.AnyArg(), 1). //nolint:lll
+					WithArgs(true, false, false, true, false, 0, 0, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, "", 0, 2, "", false, "AUTO", false, "", true, true, "", "", false, "", "", "", "", 0, true, true, "us_unix", "", "", 0, "", 0, "", "", 0, 120, 1024, false, "", 0, 10, false, 0, 0, "", 0, "", "", 0, true, 1, 0, "", 0, 0, true, 1080, 1920, 0, false, "/dev/dsp0", "/dev/dsp0", "", true, true, 0, true, true, "AUTO", false, 0, 0, true, sqlmock.AnyArg(), 1). //nolint:lll
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
 
@@ -504,9 +504,9 @@ func TestVM_AttachIsos(t *testing.T) {
 
 				mock.ExpectBegin()
 				mock.ExpectExec(
-					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`cpu`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`max_wait`=?,`mem`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`restart`=?,`restart_delay`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`store_uefi_vars`=?,`tablet`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
+					regexp.QuoteMeta("UPDATE `configs` SET `com1`=?,`com2`=?,`com3`=?,`acpi`=?,`auto_start`=?,`auto_start_delay`=?,`boot_group`=?,`com1_dev`=?,`com1_log`=?,`com1_speed`=?,`com2_dev`=?,`com2_log`=?,`com2_speed`=?,`com3_dev`=?,`com3_log`=?,`com3_speed`=?,`com4`=?,`com4_dev`=?,`com4_log`=?,`com4_speed`=?,`console_ports`=?,`cores`=?,`cpu`=?,`cpu_pins`=?,`debug`=?,`debug_port`=?,`debug_wait`=?,`depends_on`=?,`destroy_power_off`=?,`exit_on_pause`=?,`extra_args`=?,`firmware`=?,`guest_agent`=?,`hook_post_start`=?,`hook_post_stop`=?,`hook_pre_start`=?,`hook_pre_stop`=?,`hook_timeout`=?,`host_bridge`=?,`ignore_unknown_msr`=?,`kbd_layout`=?,`labels`=?,`live_action`=?,`live_failures`=?,`live_http`=?,`live_interval`=?,`live_pattern`=?,`live_tcp`=?,`live_timeout`=?,`max_wait`=?,`mem`=?,`pci_pack`=?,`pci_slots`=?,`pcpu`=?,`priority`=?,`protect`=?,`rbps`=?,`ready_deadline`=?,`ready_http`=?,`ready_interval`=?,`ready_pattern`=?,`ready_tcp`=?,`ready_timeout`=?,`restart`=?,`restart_delay`=?,`restart_max`=?,`restart_policy`=?,`restart_window`=?,`riops`=?,`screen`=?,`screen_height`=?,`screen_width`=?,`sockets`=?,`sound`=?,`sound_in`=?,`sound_out`=?,`stop_policy`=?,`store_uefi_vars`=?,`tablet`=?,`threads`=?,`use_hlt`=?,`utc_time`=?,`vnc_port`=?,`vnc_wait`=?,`wbps`=?,`wiops`=?,`wire_guest_mem`=?,`updated_at`=? WHERE `configs`.`deleted_at` IS NULL AND `id` = ?"), //nolint:lll
 				).
-					WithArgs(true, false, false, true, false, 0, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, 2, false, "AUTO", false, true, true, "", true, true, "us_unix", 120, 1024, 0, 10, false, 0, true, 1, 0, true, 1080, 1920, false, "/dev/dsp0", "/dev/dsp0", true, true, true, true, "AUTO", false, 0, 0, true, sqlmock.AnyArg(), 1). //nolint:lll
+					WithArgs(true, false, false, true, false, 0, 0, "AUTO", false, 115200, "AUTO", false, 115200, "AUTO", false, 115200, false, "AUTO", false, 115200, "", 0, 2, "", false, "AUTO", false, "", true, true, "", "", false, "", "", "", "", 0, true, true, "us_unix", "", "", 0, "", 0, "", "", 0, 120, 1024, false, "", 0, 10, false, 0, 0, "", 0, "", "", 0, true, 1, 0, "", 0, 0, true, 1080, 1920, 0, false, "/dev/dsp0", "/dev/dsp0", "", true, true, 0, true, true, "AUTO", false, 0, 0, true, sqlmock.AnyArg(), 1). //nolint:lll
 					// does not matter what error is returned
 					WillReturnError(gorm.ErrInvalidField)
 				mock.ExpectRollback()
@@ -0,0 +1,75 @@
+package vm
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"cirrina/cirrinad/util"
+)
+
+// ParseLabels parses a comma separated list of key=value labels. Keys and values use the same characters as disk
+// names, values may be empty.
+func ParseLabels(labels string) (map[string]string, error) {
+	parsed := make(map[string]string)
+
+	for _, label := range strings.Split(labels, ",") {
+		label = strings.TrimSpace(label)
+		if label == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(label, "=")
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		if !found || !util.ValidDiskName(key) || (value != "" && !util.ValidDiskName(value)) {
+			return nil, fmt.Errorf("%w: %s", errVMLabelInvalid, label)
+		}
+
+		parsed[key] = value
+	}
+
+	return parsed, nil
+}
+
+// MatchLabels returns true if the VM has every label in the selector with the same value
+func (v *VM) MatchLabels(selector map[string]string) bool {
+	labels, err := ParseLabels(v.Config.Labels)
+	if err != nil {
+		return false
+	}
+
+	for key, value := range selector {
+		vmValue, exists := labels[key]
+		if !exists || vmValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetByLabels returns the VMs matching the selector sorted by name, an empty selector matches no VMs
+func GetByLabels(selector map[string]string) []*VM {
+	var matched []*VM
+
+	if len(selector) == 0 {
+		return matched
+	}
+
+	List.Mu.RLock()
+	for _, vmInst := range List.VMList {
+		if vmInst.MatchLabels(selector) {
+			matched = append(matched, vmInst)
+		}
+	}
+	List.Mu.RUnlock()
+
+	slices.SortFunc(matched, func(a, b *VM) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return matched
+}
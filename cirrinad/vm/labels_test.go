@@ -0,0 +1,109 @@
+package vm
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+//nolint:paralleltest
+func TestParseLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "empty",
+			labels: "",
+			want:   map[string]string{},
+		},
+		{
+			name:   "some",
+			labels: "env=dev, team=infra,backup=",
+			want:   map[string]string{"env": "dev", "team": "infra", "backup": ""},
+		},
+		{
+			name:    "noEquals",
+			labels:  "env",
+			wantErr: true,
+		},
+		{
+			name:    "badKey",
+			labels:  "e nv=dev",
+			wantErr: true,
+		},
+		{
+			name:    "badValue",
+			labels:  "env=d/ev",
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseLabels(testCase.labels)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseLabels() error = %v, wantErr %v", err, testCase.wantErr)
+
+				return
+			}
+
+			if testCase.wantErr {
+				return
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_MatchLabels(t *testing.T) {
+	tests := []struct {
+		name     string
+		labels   string
+		selector map[string]string
+		want     bool
+	}{
+		{
+			name:     "match",
+			labels:   "env=dev,team=infra",
+			selector: map[string]string{"env": "dev"},
+			want:     true,
+		},
+		{
+			name:     "wrongValue",
+			labels:   "env=prod,team=infra",
+			selector: map[string]string{"env": "dev"},
+			want:     false,
+		},
+		{
+			name:     "missingKey",
+			labels:   "env=dev",
+			selector: map[string]string{"env": "dev", "team": "infra"},
+			want:     false,
+		},
+		{
+			name:     "noLabels",
+			labels:   "",
+			selector: map[string]string{"env": "dev"},
+			want:     false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testVM := &VM{Config: Config{Labels: testCase.labels}}
+
+			got := testVM.MatchLabels(testCase.selector)
+			if got != testCase.want {
+				t.Errorf("MatchLabels() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
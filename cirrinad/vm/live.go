@@ -0,0 +1,243 @@
+package vm
+
+import (
+	"log/slog"
+	"regexp"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"cirrina/cirrinad/config"
+)
+
+type LiveStateType string
+
+const (
+	LiveNone      LiveStateType = ""          // no liveness checks configured or VM not running
+	LivePending   LiveStateType = "pending"   // waiting for the VM to be ready before checking
+	LiveHealthy   LiveStateType = "healthy"   // the last check passed
+	LiveFailing   LiveStateType = "failing"   // the last check failed, but fewer than LiveFailures in a row
+	LiveUnhealthy LiveStateType = "unhealthy" // LiveFailures checks failed in a row and the action was run
+)
+
+type LiveActionType string
+
+const (
+	LiveActionLog     LiveActionType = "log"     // only log that the VM is unhealthy
+	LiveActionReset   LiveActionType = "reset"   // reset the VM
+	LiveActionRestart LiveActionType = "restart" // stop the VM using its stop policy and start it again
+)
+
+// ExitLiveness is recorded in the restart history when a VM is restarted because its liveness checks failed
+const ExitLiveness ExitReason = "liveness"
+
+var (
+	liveFailuresGauge  *prometheus.GaugeVec
+	liveActionsCounter *prometheus.CounterVec
+)
+
+// ValidLiveAction checks the liveness action is one of the known actions
+func ValidLiveAction(action string) bool {
+	switch LiveActionType(action) {
+	case LiveActionLog, LiveActionReset, LiveActionRestart:
+		return true
+	default:
+		return false
+	}
+}
+
+// HasLiveProbes returns true if any liveness probe is configured
+func (v *VM) HasLiveProbes() bool {
+	return v.Config.LivePattern != "" || v.Config.LiveTCP != "" || v.Config.LiveHTTP != ""
+}
+
+// LiveState returns the liveness check state and the number of checks which have failed in a row
+func (v *VM) LiveState() (LiveStateType, uint32) {
+	defer v.mu.RUnlock()
+	v.mu.RLock()
+
+	return v.liveState, v.liveFailures
+}
+
+func (v *VM) setLiveState(state LiveStateType, failures uint32) {
+	v.mu.Lock()
+	v.liveState = state
+	v.liveFailures = failures
+	v.mu.Unlock()
+
+	if config.Config.Metrics.Enabled {
+		liveFailuresGauge.WithLabelValues(v.Name).Set(float64(failures))
+	}
+}
+
+// startLiveProbes starts the liveness checks, if the VM has any liveness probes. Checks begin once the VM is ready.
+func (v *VM) startLiveProbes() {
+	if !v.HasLiveProbes() {
+		return
+	}
+
+	var pattern *regexp.Regexp
+
+	if v.Config.LivePattern != "" {
+		var err error
+
+		pattern, err = regexp.Compile(v.Config.LivePattern)
+		if err != nil {
+			slog.Error("invalid liveness pattern, ignoring", "vm", v.Name, "err", err)
+		} else if !v.Config.Com1 || !v.Config.Com1Log {
+			slog.Warn("liveness pattern requires com1 logging, heartbeat will never be seen", "vm", v.Name)
+		}
+	}
+
+	v.mu.Lock()
+	v.liveGen++
+	gen := v.liveGen
+	v.livePattern = pattern
+	v.liveSeen = false
+	v.mu.Unlock()
+
+	v.setLiveState(LivePending, 0)
+
+	go v.liveProbe(gen)
+}
+
+// liveCurrent returns true while the liveness checks started as gen should keep running
+func (v *VM) liveCurrent(gen uint64) bool {
+	defer v.mu.RUnlock()
+	v.mu.RLock()
+
+	return v.liveGen == gen && v.Status == RUNNING
+}
+
+// liveProbe runs the liveness checks every LiveInterval while the VM is running, running LiveAction when LiveFailures
+// checks fail in a row
+func (v *VM) liveProbe(gen uint64) {
+	interval := max(time.Duration(v.Config.LiveInterval)*time.Second, time.Second)
+
+	for {
+		time.Sleep(interval)
+
+		if !v.liveCurrent(gen) {
+			return
+		}
+
+		if !v.Ready() {
+			continue
+		}
+
+		if v.liveProbesPass() {
+			v.setLiveState(LiveHealthy, 0)
+
+			continue
+		}
+
+		_, failures := v.LiveState()
+		failures++
+
+		if failures < max(v.Config.LiveFailures, 1) {
+			v.setLiveState(LiveFailing, failures)
+			v.log.Info("liveness check failed", "failures", failures)
+
+			continue
+		}
+
+		v.setLiveState(LiveUnhealthy, 0)
+
+		if v.runLiveAction(failures) {
+			return
+		}
+	}
+}
+
+// liveProbesPass runs each configured liveness probe once. The serial heartbeat passes if the pattern was seen since
+// the last check.
+func (v *VM) liveProbesPass() bool {
+	probeTimeout := max(time.Duration(v.Config.LiveTimeout)*time.Second, time.Second)
+
+	v.mu.Lock()
+	patternSet := v.livePattern != nil
+	seen := v.liveSeen
+	v.liveSeen = false
+	v.mu.Unlock()
+
+	if patternSet && !seen {
+		return false
+	}
+
+	if v.Config.LiveTCP != "" && !probeTCP(v.Config.LiveTCP, probeTimeout) {
+		return false
+	}
+
+	if v.Config.LiveHTTP != "" && !probeHTTP(v.Config.LiveHTTP, probeTimeout) {
+		return false
+	}
+
+	return true
+}
+
+// runLiveAction runs the liveness action of an unhealthy VM, returning true if the VM is being reset or restarted
+// and these checks should end
+func (v *VM) runLiveAction(failures uint32) bool {
+	action := LiveActionType(v.Config.LiveAction)
+
+	slog.Warn("VM liveness checks failed", "vm", v.Name, "failures", failures, "action", action)
+	v.log.Warn("liveness checks failed", "failures", failures, "action", action)
+
+	if config.Config.Metrics.Enabled {
+		liveActionsCounter.WithLabelValues(v.Name, string(action)).Inc()
+	}
+
+	if action != LiveActionReset && action != LiveActionRestart {
+		return false
+	}
+
+	if !v.recoveryAllowed() {
+		slog.Error("VM liveness recovery not allowed by restart policy or limit reached, not recovering", "vm", v.Name)
+		v.log.Error("liveness recovery not allowed by restart policy or limit reached")
+
+		return false
+	}
+
+	if action == LiveActionReset {
+		err := v.Reset()
+		if err != nil {
+			slog.Error("error resetting unhealthy VM", "vm", v.Name, "err", err)
+
+			return false
+		}
+
+		return true
+	}
+
+	v.saveRestartRecord(&RestartRecord{VMID: v.ID, ExitCode: -1, Reason: string(ExitLiveness), Restarted: true})
+
+	err := v.Reboot()
+	if err != nil {
+		slog.Error("error restarting unhealthy VM", "vm", v.Name, "err", err)
+	}
+
+	return true
+}
+
+// recoveryAllowed checks the restart policy allows recovering an unhealthy VM and counts the recovery against
+// RestartMax within RestartWindow, so that a broken guest is not recovered over and over
+func (v *VM) recoveryAllowed() bool {
+	if !v.Config.Restart || RestartPolicyType(v.Config.RestartPolicy) == RestartNever {
+		return false
+	}
+
+	now := time.Now()
+
+	defer v.mu.Unlock()
+	v.mu.Lock()
+
+	v.restartTimes = pruneRestarts(v.restartTimes, now, time.Duration(v.Config.RestartWindow)*time.Second)
+
+	if v.Config.RestartMax > 0 && len(v.restartTimes) >= int(v.Config.RestartMax) {
+		return false
+	}
+
+	v.restartTimes = append(v.restartTimes, now)
+
+	return true
+}
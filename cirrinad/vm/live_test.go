@@ -0,0 +1,79 @@
+package vm
+
+import (
+	"testing"
+	"time"
+)
+
+//nolint:paralleltest
+func TestValidLiveAction(t *testing.T) {
+	tests := []struct {
+		name   string
+		action string
+		want   bool
+	}{
+		{name: "log", action: "log", want: true},
+		{name: "reset", action: "reset", want: true},
+		{name: "restart", action: "restart", want: true},
+		{name: "empty", action: "", want: false},
+		{name: "junk", action: "reboot", want: false},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := ValidLiveAction(testCase.action)
+			if got != testCase.want {
+				t.Errorf("ValidLiveAction() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_recoveryAllowed(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       Config
+		restartTimes []time.Time
+		want         bool
+	}{
+		{
+			name:   "restartDisabled",
+			config: Config{Restart: false, RestartPolicy: "always"},
+			want:   false,
+		},
+		{
+			name:   "policyNever",
+			config: Config{Restart: true, RestartPolicy: "never"},
+			want:   false,
+		},
+		{
+			name:   "allowed",
+			config: Config{Restart: true, RestartPolicy: "always", RestartMax: 2, RestartWindow: 600},
+			want:   true,
+		},
+		{
+			name:         "limitReached",
+			config:       Config{Restart: true, RestartPolicy: "always", RestartMax: 2, RestartWindow: 600},
+			restartTimes: []time.Time{time.Now(), time.Now()},
+			want:         false,
+		},
+		{
+			name:         "oldRestartsPruned",
+			config:       Config{Restart: true, RestartPolicy: "always", RestartMax: 2, RestartWindow: 600},
+			restartTimes: []time.Time{time.Now().Add(-time.Hour), time.Now().Add(-time.Hour)},
+			want:         true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testVM := &VM{Config: testCase.config, restartTimes: testCase.restartTimes}
+
+			got := testVM.recoveryAllowed()
+			if got != testCase.want {
+				t.Errorf("recoveryAllowed() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
@@ -33,4 +33,25 @@ func SetupVMMetrics() {
 		Name:      "Mem",
 		Help:      "Megabytes of memory allocated to VMs",
 	})
+
+	crashLoopCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "cirrinad",
+		Subsystem: "VMs",
+		Name:      "crashloops",
+		Help:      "Number of times a VM stopped being restarted because it was crash looping",
+	})
+
+	liveFailuresGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "cirrinad",
+		Subsystem: "VMs",
+		Name:      "liveness_failures",
+		Help:      "Number of liveness checks of a VM which have failed in a row",
+	}, []string{"vm"})
+
+	liveActionsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "cirrinad",
+		Subsystem: "VMs",
+		Name:      "liveness_actions",
+		Help:      "Number of times the liveness action of a VM was run because its checks failed",
+	}, []string{"vm", "action"})
 }
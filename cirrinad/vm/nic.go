@@ -61,6 +61,45 @@ func (v *VM) netStart() error {
 	return nil
 }
 
+// NetReattach reconnects the nics of a re-attached VM to their switches, which may have been rebuilt since the VM was
+// started
+func (v *VM) NetReattach() {
+	vmNicsList, err := vmnic.GetNics(v.Config.ID)
+	if err != nil {
+		slog.Error("NetReattach failed to get nics", "err", err)
+
+		return
+	}
+
+	for _, vmNic := range vmNicsList {
+		if vmNic.SwitchID == "" {
+			continue
+		}
+
+		thisSwitch, err := vmswitch.GetByID(vmNic.SwitchID)
+		if err != nil {
+			slog.Error("bad switch id",
+				"err", err,
+				"nic.Name", vmNic.Name,
+				"nic.ID", vmNic.ID,
+				"switch.ID", vmNic.SwitchID,
+			)
+
+			continue
+		}
+
+		err = thisSwitch.ReconnectNic(&vmNic)
+		if err != nil {
+			slog.Error("error reconnecting nic",
+				"err", err,
+				"nic.Name", vmNic.Name,
+				"nic.ID", vmNic.ID,
+				"switch.ID", vmNic.SwitchID,
+			)
+		}
+	}
+}
+
 // validateNics check if nics can be attached to a VM
 func (v *VM) validateNics(nicIDs []string) error {
 	occurred := map[string]bool{}
@@ -0,0 +1,277 @@
+package vm
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cirrina/cirrinad/util"
+)
+
+// Passthru is a host PCI device bound to the ppt driver which is passed through to a VM. A device may be assigned
+// to several VMs, but only one of them can be running at a time.
+type Passthru struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	VMID      string `gorm:"uniqueIndex:idx_vm_passthru_device;not null;default:null"`
+	Device    string `gorm:"uniqueIndex:idx_vm_passthru_device;not null;default:null"` // host bus/slot/function
+}
+
+func (Passthru) TableName() string {
+	return "vm_passthrus"
+}
+
+// passthruHolders maps each host device held by a running VM to the ID of that VM
+var passthruHolders = struct {
+	mu      sync.Mutex
+	devices map[string]string
+}{devices: make(map[string]string)}
+
+// ParsePassthruAddr parses the host address of a PCI device given as bus/slot/function, bus:slot:function or the
+// pci0:bus:slot:function selector pciconf shows, and returns it as bus/slot/function
+func ParsePassthruAddr(addr string) (string, error) {
+	addr = strings.TrimPrefix(strings.TrimSpace(addr), "pci0:")
+
+	parts := strings.Split(strings.ReplaceAll(addr, ":", "/"), "/")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("%w: %s", errVMPassthruInvalid, addr)
+	}
+
+	limits := []uint64{pciMaxBus, pciMaxSlot, pciMaxFunction}
+	values := make([]string, 0, len(parts))
+
+	for partIdx, part := range parts {
+		value, err := strconv.ParseUint(part, 10, 8)
+		if err != nil || value > limits[partIdx] {
+			return "", fmt.Errorf("%w: %s", errVMPassthruInvalid, addr)
+		}
+
+		values = append(values, strconv.FormatUint(value, 10))
+	}
+
+	return strings.Join(values, "/"), nil
+}
+
+// hostPassthruDevice looks up the host device at the address and checks it can be passed through
+func hostPassthruDevice(device string) error {
+	hostDevices, err := util.GetHostPCIDevicesFunc()
+	if err != nil {
+		return fmt.Errorf("error getting host PCI devices: %w", err)
+	}
+
+	for _, hostDevice := range hostDevices {
+		if hostDevice.Address() != device {
+			continue
+		}
+
+		if !hostDevice.PPT() {
+			return fmt.Errorf("%w: %s is bound to %s", errVMPassthruNotPPT, device, hostDevice.Driver)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", errVMPassthruNotFound, device)
+}
+
+// AddPassthru assigns a host PCI device bound to ppt to the VM, it is passed through from the next start of the VM
+func (v *VM) AddPassthru(addr string) error {
+	device, err := ParsePassthruAddr(addr)
+	if err != nil {
+		return err
+	}
+
+	err = hostPassthruDevice(device)
+	if err != nil {
+		return err
+	}
+
+	passthrus, err := v.GetPassthrus()
+	if err != nil {
+		return err
+	}
+
+	for _, passthru := range passthrus {
+		if passthru.Device == device {
+			return errVMPassthruExists
+		}
+	}
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Create(&Passthru{VMID: v.ID, Device: device})
+	if res.Error != nil || res.RowsAffected != 1 {
+		slog.Error("error adding VM passthru device", "vm", v.ID, "device", device, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	return nil
+}
+
+// GetPassthrus returns the host PCI devices assigned to the VM in the order they were added
+func (v *VM) GetPassthrus() ([]Passthru, error) {
+	var passthrus []Passthru
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ?", v.ID).Order("id").Find(&passthrus)
+	if res.Error != nil {
+		slog.Error("error getting VM passthru devices", "vm", v.ID, "err", res.Error)
+
+		return nil, errVMInternalDB
+	}
+
+	return passthrus, nil
+}
+
+// RemovePassthru removes a host PCI device from the VM, a running VM keeps the device until it stops
+func (v *VM) RemovePassthru(addr string) error {
+	device, err := ParsePassthruAddr(addr)
+	if err != nil {
+		return err
+	}
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ? AND device = ?", v.ID, device).Delete(&Passthru{})
+	if res.Error != nil {
+		slog.Error("error removing VM passthru device", "vm", v.ID, "device", device, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	if res.RowsAffected == 0 {
+		return errVMPassthruNotAssigned
+	}
+
+	return nil
+}
+
+// DeletePassthrus removes all host PCI devices of the VM
+func (v *VM) DeletePassthrus() error {
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ?", v.ID).Delete(&Passthru{})
+	if res.Error != nil {
+		slog.Error("error deleting VM passthru devices", "vm", v.ID, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	return nil
+}
+
+// loadPassthrus reads the VM's host PCI devices for generating its arguments
+func (v *VM) loadPassthrus() error {
+	passthrus, err := v.GetPassthrus()
+	if err != nil {
+		return err
+	}
+
+	defer v.mu.Unlock()
+	v.mu.Lock()
+	v.passthrus = passthrus
+
+	return nil
+}
+
+// lockPassthrus checks each of the VM's host PCI devices is still bound to ppt and takes them for the VM, failing
+// without taking any if another running VM holds one of them
+func (v *VM) lockPassthrus() error {
+	for _, passthru := range v.passthrus {
+		err := hostPassthruDevice(passthru.Device)
+		if err != nil {
+			return err
+		}
+	}
+
+	return v.claimPassthrus()
+}
+
+func (v *VM) claimPassthrus() error {
+	defer passthruHolders.mu.Unlock()
+	passthruHolders.mu.Lock()
+
+	for _, passthru := range v.passthrus {
+		holder, held := passthruHolders.devices[passthru.Device]
+		if !held || holder == v.ID {
+			continue
+		}
+
+		return fmt.Errorf("%w: %s is held by VM %s", errVMPassthruBusy, passthru.Device, passthruHolderName(holder))
+	}
+
+	for _, passthru := range v.passthrus {
+		passthruHolders.devices[passthru.Device] = v.ID
+	}
+
+	return nil
+}
+
+// unlockPassthrus releases every host PCI device the VM holds
+func (v *VM) unlockPassthrus() {
+	defer passthruHolders.mu.Unlock()
+	passthruHolders.mu.Lock()
+
+	for device, holder := range passthruHolders.devices {
+		if holder == v.ID {
+			delete(passthruHolders.devices, device)
+		}
+	}
+}
+
+// adoptPassthrus takes the host PCI devices of a VM which kept running while cirrinad was not
+func (v *VM) adoptPassthrus() {
+	err := v.loadPassthrus()
+	if err != nil {
+		slog.Error("failed loading passthru devices of re-attached VM", "vm", v.Name, "err", err)
+
+		return
+	}
+
+	err = v.claimPassthrus()
+	if err != nil {
+		slog.Error("re-attached VM shares a passthru device", "vm", v.Name, "err", err)
+	}
+}
+
+// getPassthruArg returns a passthru device for each of the VM's host PCI devices
+func (v *VM) getPassthruArg(slot int) ([]string, int) {
+	var passthruArg []string
+
+	for _, passthru := range v.passthrus {
+		passthruArg = append(passthruArg, "-s",
+			v.pciSlotArg(pciPassthruPrefix+passthru.Device, strconv.FormatInt(int64(slot), 10))+
+				",passthru,"+passthru.Device)
+		slot++
+	}
+
+	return passthruArg, slot
+}
+
+// PassthruHolder returns the name of the running VM holding the host PCI device, empty if none holds it
+func PassthruHolder(device string) string {
+	defer passthruHolders.mu.Unlock()
+	passthruHolders.mu.Lock()
+
+	holder, held := passthruHolders.devices[device]
+	if !held {
+		return ""
+	}
+
+	return passthruHolderName(holder)
+}
+
+// passthruHolderName returns the name of the VM with the ID, or the ID if the VM no longer exists
+func passthruHolderName(holder string) string {
+	holderVM, err := GetByID(holder)
+	if err != nil {
+		return holder
+	}
+
+	return holderVM.Name
+}
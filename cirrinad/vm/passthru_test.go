@@ -0,0 +1,281 @@
+package vm
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-test/deep"
+	"gorm.io/gorm"
+
+	"cirrina/cirrinad/cirrinadtest"
+	"cirrina/cirrinad/util"
+)
+
+//nolint:paralleltest
+func TestParsePassthruAddr(t *testing.T) {
+	tests := []struct {
+		addr    string
+		want    string
+		wantErr bool
+	}{
+		{addr: "2/0/0", want: "2/0/0"},
+		{addr: "2:0:0", want: "2/0/0"},
+		{addr: "pci0:2:0:0", want: "2/0/0"},
+		{addr: " 02/00/1 ", want: "2/0/1"},
+		{addr: "2/0", wantErr: true},
+		{addr: "2/32/0", wantErr: true},
+		{addr: "2/0/8", wantErr: true},
+		{addr: "256/0/0", wantErr: true},
+		{addr: "a/0/0", wantErr: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.addr, func(t *testing.T) {
+			got, err := ParsePassthruAddr(testCase.addr)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParsePassthruAddr() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			if got != testCase.want {
+				t.Errorf("ParsePassthruAddr() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func Test_hostPassthruDevice(t *testing.T) {
+	hostDevices := func() ([]util.HostPCIDevice, error) {
+		return []util.HostPCIDevice{
+			{Driver: "hostb0"},
+			{Driver: "ppt0", Bus: 2},
+			{Driver: "em0", Bus: 3},
+		}, nil
+	}
+
+	tests := []struct {
+		name                  string
+		mockGetHostPCIDevices func() ([]util.HostPCIDevice, error)
+		device                string
+		wantErr               error
+	}{
+		{
+			name:                  "ppt",
+			mockGetHostPCIDevices: hostDevices,
+			device:                "2/0/0",
+		},
+		{
+			name:                  "notPPT",
+			mockGetHostPCIDevices: hostDevices,
+			device:                "3/0/0",
+			wantErr:               errVMPassthruNotPPT,
+		},
+		{
+			name:                  "notFound",
+			mockGetHostPCIDevices: hostDevices,
+			device:                "4/0/0",
+			wantErr:               errVMPassthruNotFound,
+		},
+		{
+			name:                  "pciconfErr",
+			mockGetHostPCIDevices: func() ([]util.HostPCIDevice, error) { return nil, errVMInternalDB },
+			device:                "2/0/0",
+			wantErr:               errVMInternalDB, // does not matter what error is returned
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			util.GetHostPCIDevicesFunc = testCase.mockGetHostPCIDevices
+
+			t.Cleanup(func() { util.GetHostPCIDevicesFunc = util.GetHostPCIDevices })
+
+			err := hostPassthruDevice(testCase.device)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("hostPassthruDevice() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_lockPassthrus(t *testing.T) {
+	util.GetHostPCIDevicesFunc = func() ([]util.HostPCIDevice, error) {
+		return []util.HostPCIDevice{{Driver: "ppt0", Bus: 2}, {Driver: "ppt1", Bus: 3}}, nil
+	}
+
+	t.Cleanup(func() { util.GetHostPCIDevicesFunc = util.GetHostPCIDevices })
+
+	firstVM := &VM{
+		ID:        "0c8e4d0a-5d8b-4b59-9e58-b3f1c5a7f0e1",
+		Name:      "first",
+		passthrus: []Passthru{{Device: "2/0/0"}},
+	}
+	secondVM := &VM{
+		ID:        "7f2b9c1e-3a44-4d6e-8f0b-9c2d1e5a6b7c",
+		Name:      "second",
+		passthrus: []Passthru{{Device: "3/0/0"}, {Device: "2/0/0"}},
+	}
+
+	List.VMList[firstVM.ID] = firstVM
+	List.VMList[secondVM.ID] = secondVM
+
+	t.Cleanup(func() {
+		delete(List.VMList, firstVM.ID)
+		delete(List.VMList, secondVM.ID)
+	})
+
+	err := firstVM.lockPassthrus()
+	if err != nil {
+		t.Fatalf("lockPassthrus() error = %v", err)
+	}
+
+	err = secondVM.lockPassthrus()
+	if !errors.Is(err, errVMPassthruBusy) {
+		t.Errorf("lockPassthrus() error = %v, wantErr %v", err, errVMPassthruBusy)
+	}
+
+	// a failed lock takes none of the devices
+	if got := PassthruHolder("3/0/0"); got != "" {
+		t.Errorf("PassthruHolder() = %v, want none", got)
+	}
+
+	if got := PassthruHolder("2/0/0"); got != "first" {
+		t.Errorf("PassthruHolder() = %v, want first", got)
+	}
+
+	firstVM.unlockPassthrus()
+
+	err = secondVM.lockPassthrus()
+	if err != nil {
+		t.Errorf("lockPassthrus() error = %v", err)
+	}
+
+	if got := PassthruHolder("2/0/0"); got != "second" {
+		t.Errorf("PassthruHolder() = %v, want second", got)
+	}
+
+	secondVM.unlockPassthrus()
+
+	if len(passthruHolders.devices) != 0 {
+		t.Errorf("devices still held: %v", passthruHolders.devices)
+	}
+}
+
+//nolint:paralleltest
+func TestVM_passthruArgs(t *testing.T) {
+	testVM := &VM{
+		passthrus: []Passthru{{Device: "2/0/0"}, {Device: "2/0/1"}},
+		pciSlots:  map[string]PCIAddr{pciPassthruPrefix + "2/0/1": {Slot: 9}},
+	}
+
+	gotArgs, gotSlot := testVM.getPassthruArg(5)
+
+	wantArgs := []string{"-s", "5,passthru,2/0/0", "-s", "0:9:0,passthru,2/0/1"}
+
+	diff := deep.Equal(gotArgs, wantArgs)
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	if gotSlot != 7 {
+		t.Errorf("getPassthruArg() gotSlot = %v, want 7", gotSlot)
+	}
+
+	diff = deep.Equal(testVM.getWireArg(), []string{"-S"})
+	if diff != nil {
+		t.Errorf("guest memory not wired with passthru devices: %v", diff)
+	}
+
+	if pciEmulation(pciPassthruPrefix+"2/0/0") != "passthru" {
+		t.Errorf("pciEmulation() = %v, want passthru", pciEmulation(pciPassthruPrefix+"2/0/0"))
+	}
+}
+
+//nolint:paralleltest
+func TestVM_GetPassthrus(t *testing.T) {
+	createTime := time.Now()
+
+	tests := []struct {
+		name        string
+		mockClosure func(testDB *gorm.DB, mock sqlmock.Sqlmock)
+		want        []Passthru
+		wantErr     bool
+	}{
+		{
+			name: "success",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					VMDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta("SELECT * FROM `vm_passthrus` WHERE vm_id = ? ORDER BY id"),
+				).
+					WithArgs("22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1").
+					WillReturnRows(sqlmock.NewRows(
+						[]string{"id", "created_at", "vm_id", "device"}).
+						AddRow(1, createTime, "22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1", "2/0/0"),
+					)
+			},
+			want: []Passthru{
+				{ID: 1, CreatedAt: createTime, VMID: "22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1", Device: "2/0/0"},
+			},
+		},
+		{
+			name: "error",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					VMDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta("SELECT * FROM `vm_passthrus` WHERE vm_id = ? ORDER BY id"),
+				).
+					WithArgs("22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1").
+					WillReturnError(gorm.ErrInvalidField) // does not matter what error is returned
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testDB, mock := cirrinadtest.NewMockDB(t.Name())
+			testCase.mockClosure(testDB, mock)
+
+			testVM := &VM{ID: "22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1"}
+
+			got, err := testVM.GetPassthrus()
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("GetPassthrus() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+
+			mock.ExpectClose()
+
+			db, err := testDB.DB()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = db.Close()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = mock.ExpectationsWereMet()
+			if err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,866 @@
+package vm
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cirrina/cirrinad/disk"
+	"cirrina/cirrinad/iso"
+	"cirrina/cirrinad/vmnic"
+)
+
+// limits of bhyve PCI addresses, see bhyve(8)
+const (
+	pciMaxBus      = 255
+	pciMaxSlot     = 31
+	pciMaxFunction = 7
+	pciLPCSlot     = 31
+	ahciMaxPorts   = 32
+)
+
+// keys of the built-in devices in the PCI slot assignments, attached devices use their type prefix and their ID
+const (
+	PCIHostBridge = "hostbridge"
+	PCIFbuf       = "fbuf"
+	PCITablet     = "tablet"
+	PCISound      = "sound"
+	PCILPC        = "lpc"
+	PCIAHCI       = "ahci" // the controller shared by AHCI disks and ISOs when the VM packs its devices
+	PCIConsole    = "console"
+	pciDiskPrefix = "disk:"
+	pciISOPrefix  = "iso:"
+	pciNicPrefix  = "nic:"
+	// controllers of the VM are given by name, their names are only unique within the VM
+	pciControllerPrefix = "ctl:"
+	// host PCI devices are given by their host bus/slot/function
+	pciPassthruPrefix = "ppt:"
+	// shared directories are given by name, their names are only unique within the VM
+	pciSharePrefix = "share:"
+)
+
+type PCIAddr struct {
+	Bus      uint8
+	Slot     uint8
+	Function uint8
+}
+
+func (a PCIAddr) String() string {
+	return fmt.Sprintf("%d:%d:%d", a.Bus, a.Slot, a.Function)
+}
+
+func (a PCIAddr) less(b PCIAddr) bool {
+	if a.Bus != b.Bus {
+		return a.Bus < b.Bus
+	}
+
+	if a.Slot != b.Slot {
+		return a.Slot < b.Slot
+	}
+
+	return a.Function < b.Function
+}
+
+// ParsePCIAddr parses a PCI address in any of the forms bhyve accepts: slot, slot:function or bus:slot:function
+func ParsePCIAddr(addr string) (PCIAddr, error) {
+	parts := strings.Split(strings.TrimSpace(addr), ":")
+
+	// slot and slot:function are on bus 0
+	if len(parts) == 1 {
+		parts = append(parts, "0")
+	}
+
+	if len(parts) == 2 {
+		parts = append([]string{"0"}, parts...)
+	}
+
+	if len(parts) != 3 {
+		return PCIAddr{}, fmt.Errorf("%w: %s", errVMPCIAddrInvalid, addr)
+	}
+
+	var values [3]uint8
+
+	for partIdx, limit := range []uint64{pciMaxBus, pciMaxSlot, pciMaxFunction} {
+		value, err := strconv.ParseUint(parts[partIdx], 10, 8)
+		if err != nil || value > limit {
+			return PCIAddr{}, fmt.Errorf("%w: %s", errVMPCIAddrInvalid, addr)
+		}
+
+		values[partIdx] = uint8(value)
+	}
+
+	return PCIAddr{Bus: values[0], Slot: values[1], Function: values[2]}, nil
+}
+
+func pciBuiltin(key string) bool {
+	switch key {
+	case PCIHostBridge, PCIFbuf, PCITablet, PCISound, PCILPC, PCIAHCI, PCIConsole:
+		return true
+	default:
+		return false
+	}
+}
+
+// parsePCISlots parses comma separated device=address pairs, an empty address is returned as nil
+func parsePCISlots(slots string) (map[string]*PCIAddr, error) {
+	parsed := make(map[string]*PCIAddr)
+
+	for _, pair := range strings.Split(slots, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		key, addrStr, found := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+
+		if !found || key == "" {
+			return nil, fmt.Errorf("%w: %s", errVMPCISlotsInvalid, pair)
+		}
+
+		if _, dupe := parsed[key]; dupe {
+			return nil, fmt.Errorf("%w: %s given more than once", errVMPCISlotsInvalid, key)
+		}
+
+		if strings.TrimSpace(addrStr) == "" {
+			parsed[key] = nil
+
+			continue
+		}
+
+		addr, err := ParsePCIAddr(addrStr)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed[key] = &addr
+	}
+
+	return parsed, nil
+}
+
+// pciAddrs drops the devices without an address
+func pciAddrs(slots map[string]*PCIAddr) map[string]PCIAddr {
+	addrs := make(map[string]PCIAddr)
+
+	for key, addr := range slots {
+		if addr != nil {
+			addrs[key] = *addr
+		}
+	}
+
+	return addrs
+}
+
+// formatPCISlots returns the assignments as comma separated device=address pairs, sorted by address
+func formatPCISlots(slots map[string]PCIAddr) string {
+	keys := make([]string, 0, len(slots))
+	for key := range slots {
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, func(a, b string) int {
+		switch {
+		case slots[a].less(slots[b]):
+			return -1
+		case slots[b].less(slots[a]):
+			return 1
+		default:
+			return strings.Compare(a, b)
+		}
+	})
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+slots[key].String())
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+// checkPCISlots checks no two devices share an address, that every slot using functions other than 0 also has
+// function 0, which guests need to find the others, and that the lpc is on bus 0 as bhyve requires
+func checkPCISlots(slots map[string]PCIAddr) error {
+	users := make(map[PCIAddr]string)
+
+	for key, addr := range slots {
+		other, used := users[addr]
+		if used {
+			first, second := min(key, other), max(key, other)
+
+			return fmt.Errorf("%w: %s and %s both use %s", errVMPCISlotCollision, first, second, addr)
+		}
+
+		users[addr] = key
+	}
+
+	for key, addr := range slots {
+		if addr.Function != 0 {
+			if _, found := users[PCIAddr{Bus: addr.Bus, Slot: addr.Slot}]; !found {
+				return fmt.Errorf("%w: %s uses %s but nothing uses function 0 of that slot",
+					errVMPCISlotsInvalid, key, addr)
+			}
+		}
+
+		if key == PCILPC && addr.Bus != 0 {
+			return fmt.Errorf("%w: lpc must be on bus 0", errVMPCISlotsInvalid)
+		}
+	}
+
+	return nil
+}
+
+// assignPCISlots keeps the address of every device which already has one and gives each other device the lowest free
+// slot on bus 0, so adding or removing a device never moves the others. With pack, disks without an address are
+// packed into the free functions of slots already used by disks before taking a new slot. Addresses of detached
+// devices are dropped, those of built-in devices are kept so they are reserved while the device is disabled.
+func assignPCISlots(devices []string, existing map[string]PCIAddr, pack bool) (map[string]PCIAddr, error) {
+	assigned := make(map[string]PCIAddr)
+
+	for key, addr := range existing {
+		if !pciBuiltin(key) && !slices.Contains(devices, key) {
+			continue
+		}
+
+		assigned[key] = addr
+	}
+
+	promoteFunctionZero(assigned)
+
+	usedAddrs := make(map[PCIAddr]bool)
+	usedSlots := make(map[PCIAddr]bool)
+
+	use := func(key string, addr PCIAddr) {
+		assigned[key] = addr
+		usedAddrs[addr] = true
+		usedSlots[PCIAddr{Bus: addr.Bus, Slot: addr.Slot}] = true
+	}
+
+	for key, addr := range assigned {
+		use(key, addr)
+	}
+
+	// the lpc keeps the slot it has always had, if something else was given that slot it is assigned like the rest
+	if _, found := assigned[PCILPC]; !found && slices.Contains(devices, PCILPC) && !usedSlots[PCIAddr{Slot: pciLPCSlot}] {
+		use(PCILPC, PCIAddr{Slot: pciLPCSlot})
+	}
+
+	for _, key := range devices {
+		if _, found := assigned[key]; found {
+			continue
+		}
+
+		if pack && strings.HasPrefix(key, pciDiskPrefix) {
+			addr, found := freeDiskFunction(assigned, usedAddrs)
+			if found {
+				use(key, addr)
+
+				continue
+			}
+		}
+
+		free := false
+
+		for slot := uint8(0); slot <= pciMaxSlot; slot++ {
+			if !usedSlots[PCIAddr{Slot: slot}] {
+				use(key, PCIAddr{Slot: slot})
+
+				free = true
+
+				break
+			}
+		}
+
+		if !free {
+			return nil, fmt.Errorf("%w: no slot left for %s", errVMPCISlotsFull, key)
+		}
+	}
+
+	err := checkPCISlots(assigned)
+	if err != nil {
+		return nil, err
+	}
+
+	return assigned, nil
+}
+
+// promoteFunctionZero moves the lowest function of a slot to function 0 when the device there was removed
+func promoteFunctionZero(assigned map[string]PCIAddr) {
+	lowest := make(map[PCIAddr]string)
+
+	for key, addr := range assigned {
+		slotAddr := PCIAddr{Bus: addr.Bus, Slot: addr.Slot}
+
+		other, found := lowest[slotAddr]
+		if !found || addr.Function < assigned[other].Function ||
+			(addr.Function == assigned[other].Function && key < other) {
+			lowest[slotAddr] = key
+		}
+	}
+
+	for slotAddr, key := range lowest {
+		assigned[key] = slotAddr
+	}
+}
+
+// freeDiskFunction returns the lowest free function of the first slot whose function 0 is a disk
+func freeDiskFunction(assigned map[string]PCIAddr, usedAddrs map[PCIAddr]bool) (PCIAddr, bool) {
+	var diskSlots []PCIAddr
+
+	for key, addr := range assigned {
+		if strings.HasPrefix(key, pciDiskPrefix) && addr.Function == 0 {
+			diskSlots = append(diskSlots, addr)
+		}
+	}
+
+	slices.SortFunc(diskSlots, func(a, b PCIAddr) int {
+		if a.less(b) {
+			return -1
+		}
+
+		return 1
+	})
+
+	for _, slotAddr := range diskSlots {
+		for function := uint8(1); function <= pciMaxFunction; function++ {
+			addr := PCIAddr{Bus: slotAddr.Bus, Slot: slotAddr.Slot, Function: function}
+			if !usedAddrs[addr] {
+				return addr, true
+			}
+		}
+	}
+
+	return PCIAddr{}, false
+}
+
+// isoPCIKeys returns the PCI slot assignment key of each ISO, an ISO attached more than once gets a numbered key for
+// each extra copy
+func isoPCIKeys(isos []*iso.ISO) []string {
+	keys := make([]string, len(isos))
+	seen := make(map[string]int)
+
+	for isoIdx, isoItem := range isos {
+		if isoItem == nil {
+			continue
+		}
+
+		seen[isoItem.ID]++
+		keys[isoIdx] = pciISOPrefix + isoItem.ID
+
+		if seen[isoItem.ID] > 1 {
+			keys[isoIdx] += "#" + strconv.Itoa(seen[isoItem.ID])
+		}
+	}
+
+	return keys
+}
+
+// pciDevices returns the keys of the devices the VM will be started with, in the order slots were historically given.
+// AHCI disks and ISOs each have their own slot unless the VM packs its devices, then they share one AHCI controller.
+func (v *VM) pciDevices() ([]string, error) {
+	var devices []string
+
+	if v.Config.HostBridge {
+		devices = append(devices, PCIHostBridge)
+	}
+
+	if v.Config.Screen {
+		devices = append(devices, PCIFbuf)
+
+		if v.Config.Tablet {
+			devices = append(devices, PCITablet)
+		}
+	}
+
+	nicList, err := vmnic.GetNics(v.Config.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting VM nics: %w", err)
+	}
+
+	for _, nicItem := range nicList {
+		devices = append(devices, pciNicPrefix+nicItem.ID)
+	}
+
+	ahciDevs := 0
+
+	for _, diskItem := range v.Disks {
+		if diskItem == nil || diskItem.ID == "" {
+			continue
+		}
+
+		thisDisk, err := disk.GetByID(diskItem.ID)
+		if err != nil {
+			continue
+		}
+
+		if v.placed(AttachDisk, thisDisk.ID) {
+			continue
+		}
+
+		if v.Config.PCIPack && thisDisk.Type == "AHCI-HD" {
+			ahciDevs++
+
+			continue
+		}
+
+		devices = append(devices, pciDiskPrefix+diskItem.ID)
+	}
+
+	isoKeys := isoPCIKeys(v.ISOs)
+
+	for isoIdx, isoItem := range v.ISOs {
+		if isoItem == nil || v.placed(AttachISO, isoItem.ID) {
+			continue
+		}
+
+		if v.Config.PCIPack {
+			ahciDevs++
+
+			continue
+		}
+
+		devices = append(devices, isoKeys[isoIdx])
+	}
+
+	if ahciDevs > ahciMaxPorts {
+		return nil, fmt.Errorf("%w: %d AHCI disks and ISOs, at most %d are supported",
+			errVMPCISlotsFull, ahciDevs, ahciMaxPorts)
+	}
+
+	if ahciDevs > 0 {
+		devices = append(devices, PCIAHCI)
+	}
+
+	for _, controller := range v.controllers {
+		if len(v.controllerAttachments(&controller)) > 0 {
+			devices = append(devices, pciControllerPrefix+controller.Name)
+		}
+	}
+
+	if v.Config.Sound {
+		devices = append(devices, PCISound)
+	}
+
+	for _, passthru := range v.passthrus {
+		devices = append(devices, pciPassthruPrefix+passthru.Device)
+	}
+
+	for _, share := range v.shares {
+		devices = append(devices, pciSharePrefix+share.Name)
+	}
+
+	if len(v.ConsolePorts()) > 0 {
+		devices = append(devices, PCIConsole)
+	}
+
+	devices = append(devices, PCILPC)
+
+	return devices, nil
+}
+
+// assignPCISlots works out the address of each of the VM's devices before it is started and persists them
+func (v *VM) assignPCISlots() error {
+	devices, err := v.pciDevices()
+	if err != nil {
+		return err
+	}
+
+	existing, err := parsePCISlots(v.Config.PCISlots)
+	if err != nil {
+		return err
+	}
+
+	assigned, err := assignPCISlots(devices, pciAddrs(existing), v.Config.PCIPack)
+	if err != nil {
+		return err
+	}
+
+	defer v.mu.Unlock()
+	v.mu.Lock()
+	v.pciSlots = assigned
+	v.Config.PCISlots = formatPCISlots(assigned)
+
+	return nil
+}
+
+// pciSlotArg returns the address for a device's -s argument, or the given sequential slot if addresses were not
+// assigned
+func (v *VM) pciSlotArg(key string, sequential string) string {
+	addr, found := v.pciSlots[key]
+	if !found {
+		return sequential
+	}
+
+	return addr.String()
+}
+
+// resolvePCIKey turns a device given by the user, with attached devices given by name or ID, into its assignment key
+func resolvePCIKey(key string) (string, error) {
+	if pciBuiltin(key) {
+		return key, nil
+	}
+
+	prefix, device, _ := strings.Cut(key, ":")
+	device, copyNum, hasCopy := strings.Cut(device, "#")
+
+	var deviceID string
+
+	switch prefix + ":" {
+	case pciControllerPrefix, pciSharePrefix:
+		deviceID = device
+	case pciPassthruPrefix:
+		deviceID, _ = ParsePassthruAddr(device)
+	case pciDiskPrefix:
+		aDisk, err := disk.GetByID(device)
+		if err != nil {
+			aDisk, err = disk.GetByName(device)
+		}
+
+		if err == nil {
+			deviceID = aDisk.ID
+		}
+	case pciISOPrefix:
+		anISO, err := iso.GetByID(device)
+		if err != nil || anISO.ID == "" {
+			anISO, err = iso.GetByName(device)
+		}
+
+		if err == nil {
+			deviceID = anISO.ID
+		}
+	case pciNicPrefix:
+		aNic, err := vmnic.GetByID(device)
+		if err != nil {
+			aNic, err = vmnic.GetByName(device)
+		}
+
+		if err == nil {
+			deviceID = aNic.ID
+		}
+	default:
+		return "", fmt.Errorf("%w: unknown device %s", errVMPCISlotsInvalid, key)
+	}
+
+	if deviceID == "" {
+		return "", fmt.Errorf("%w: %s not found", errVMPCISlotsInvalid, key)
+	}
+
+	if hasCopy {
+		return prefix + ":" + deviceID + "#" + copyNum, nil
+	}
+
+	return prefix + ":" + deviceID, nil
+}
+
+// MergePCISlots applies device=address pairs given by the user to the VM's PCI slot assignments and returns the
+// result. Disks, ISOs and nics may be given by name or ID, an empty address removes the device's assignment so it
+// gets the lowest free slot on the next start. Other devices using a given address lose their assignment.
+func (v *VM) MergePCISlots(slots string) (string, error) {
+	given, err := parsePCISlots(slots)
+	if err != nil {
+		return "", err
+	}
+
+	existing, err := parsePCISlots(v.Config.PCISlots)
+	if err != nil {
+		return "", err
+	}
+
+	merged := pciAddrs(existing)
+	pinned := make(map[string]PCIAddr)
+
+	for key, addr := range given {
+		resolved, err := resolvePCIKey(key)
+		if err != nil {
+			return "", err
+		}
+
+		delete(merged, resolved)
+
+		if addr != nil {
+			pinned[resolved] = *addr
+		}
+	}
+
+	err = checkPCISlots(pinned)
+	if err != nil {
+		return "", err
+	}
+
+	for key, addr := range merged {
+		for _, pinnedAddr := range pinned {
+			if addr == pinnedAddr {
+				delete(merged, key)
+			}
+		}
+	}
+
+	for key, addr := range pinned {
+		merged[key] = addr
+	}
+
+	err = checkPCISlots(merged)
+	if err != nil {
+		return "", err
+	}
+
+	return formatPCISlots(merged), nil
+}
+
+// PCISlotsNamed returns the PCI slot assignments with disks, ISOs and nics shown by name
+func (v *VM) PCISlotsNamed() string {
+	existing, err := parsePCISlots(v.Config.PCISlots)
+	if err != nil {
+		return v.Config.PCISlots
+	}
+
+	var pairs []string
+
+	for _, pair := range strings.Split(formatPCISlots(pciAddrs(existing)), ",") {
+		key, addr, _ := strings.Cut(pair, "=")
+		if key == "" {
+			continue
+		}
+
+		pairs = append(pairs, pciKeyName(key)+"="+addr)
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+// pciKeyName returns the assignment key with the device ID replaced by its name, if it still exists
+func pciKeyName(key string) string {
+	prefix, device, found := strings.Cut(key, ":")
+	if !found {
+		return key
+	}
+
+	device, copyNum, hasCopy := strings.Cut(device, "#")
+
+	var name string
+
+	switch prefix + ":" {
+	case pciDiskPrefix:
+		aDisk, err := disk.GetByID(device)
+		if err == nil {
+			name = aDisk.Name
+		}
+	case pciISOPrefix:
+		anISO, err := iso.GetByID(device)
+		if err == nil {
+			name = anISO.Name
+		}
+	case pciNicPrefix:
+		aNic, err := vmnic.GetByID(device)
+		if err == nil {
+			name = aNic.Name
+		}
+	}
+
+	if name == "" {
+		return key
+	}
+
+	if hasCopy {
+		return prefix + ":" + name + "#" + copyNum
+	}
+
+	return prefix + ":" + name
+}
+
+// PCIDevice is an entry in the device map of a VM, AHCI ports share the address of their controller
+type PCIDevice struct {
+	Addr      PCIAddr
+	Device    string
+	Emulation string
+	Port      int // controller port number, -1 for devices which are not controller ports
+}
+
+// DeviceMap returns the address each device has while the VM runs, or will be given when it is next started
+func (v *VM) DeviceMap() ([]PCIDevice, error) {
+	if v.Status == STOPPED {
+		err := v.loadControllers()
+		if err != nil {
+			return nil, err
+		}
+
+		err = v.loadPassthrus()
+		if err != nil {
+			return nil, err
+		}
+
+		err = v.loadShares()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	devices, err := v.pciDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	assigned := v.pciSlots
+	if v.Status == STOPPED || assigned == nil {
+		existing, err := parsePCISlots(v.Config.PCISlots)
+		if err != nil {
+			return nil, err
+		}
+
+		assigned, err = assignPCISlots(devices, pciAddrs(existing), v.Config.PCIPack)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	slices.SortFunc(devices, func(a, b string) int {
+		if assigned[a].less(assigned[b]) {
+			return -1
+		}
+
+		return 1
+	})
+
+	deviceMap := make([]PCIDevice, 0, len(devices))
+
+	for _, key := range devices {
+		if strings.HasPrefix(key, pciControllerPrefix) {
+			deviceMap = append(deviceMap, v.controllerDevices(key, assigned[key])...)
+
+			continue
+		}
+
+		deviceMap = append(deviceMap, PCIDevice{
+			Addr: assigned[key], Device: pciKeyName(key), Emulation: pciEmulation(key), Port: -1,
+		})
+
+		if key == PCIAHCI {
+			deviceMap = append(deviceMap, v.ahciPorts(assigned[key])...)
+		}
+	}
+
+	return deviceMap, nil
+}
+
+// controllerDevices returns one of the VM's controllers and the devices on its ports, numbered the way bhyve
+// numbers them
+func (v *VM) controllerDevices(key string, addr PCIAddr) []PCIDevice {
+	controllerName := strings.TrimPrefix(key, pciControllerPrefix)
+
+	controllerIdx := slices.IndexFunc(v.controllers, func(c Controller) bool { return c.Name == controllerName })
+	if controllerIdx < 0 {
+		return nil
+	}
+
+	controller := v.controllers[controllerIdx]
+	devices := []PCIDevice{{Addr: addr, Device: key, Emulation: strings.ToLower(controller.Type), Port: -1}}
+
+	for _, attachment := range v.controllerAttachments(&controller) {
+		var name string
+
+		emulation := "hd"
+
+		switch attachment.DeviceType {
+		case AttachDisk:
+			aDisk, err := disk.GetByID(attachment.DeviceID)
+			if err == nil {
+				name = aDisk.Name
+			}
+		case AttachISO:
+			emulation = "cd"
+
+			anISO := v.attachedISO(attachment.DeviceID)
+			if anISO != nil {
+				name = anISO.Name
+			}
+		}
+
+		if controller.Type == ControllerNVMe {
+			emulation = "namespace"
+		}
+
+		devices = append(devices, PCIDevice{
+			Addr: addr, Device: attachment.DeviceType + ":" + name, Emulation: emulation, Port: len(devices) - 1,
+		})
+	}
+
+	return devices
+}
+
+// ahciPorts returns the ports of the AHCI controller, in the order getAHCIArg adds them
+func (v *VM) ahciPorts(addr PCIAddr) []PCIDevice {
+	var ports []PCIDevice
+
+	for _, diskItem := range v.Disks {
+		if diskItem == nil || diskItem.ID == "" {
+			continue
+		}
+
+		thisDisk, err := disk.GetByID(diskItem.ID)
+		if err != nil || thisDisk.Type != "AHCI-HD" || v.placed(AttachDisk, thisDisk.ID) {
+			continue
+		}
+
+		ports = append(ports, PCIDevice{Addr: addr, Device: pciDiskPrefix + thisDisk.Name, Emulation: "hd", Port: len(ports)})
+	}
+
+	for _, isoItem := range v.ISOs {
+		if isoItem != nil && !v.placed(AttachISO, isoItem.ID) {
+			ports = append(ports, PCIDevice{Addr: addr, Device: pciISOPrefix + isoItem.Name, Emulation: "cd", Port: len(ports)})
+		}
+	}
+
+	return ports
+}
+
+// pciEmulation returns the bhyve device emulation used for the device
+func pciEmulation(key string) string {
+	switch key {
+	case PCITablet:
+		return "xhci"
+	case PCISound:
+		return "hda"
+	case PCIConsole:
+		return "virtio-console"
+	}
+
+	prefix, deviceID, found := strings.Cut(key, ":")
+	if !found {
+		return key
+	}
+
+	switch prefix + ":" {
+	case pciDiskPrefix:
+		aDisk, err := disk.GetByID(deviceID)
+
+		switch {
+		case err == nil && aDisk.Type == "AHCI-HD":
+			return "ahci-hd"
+		case err == nil && aDisk.Type == "VIRTIO-BLK":
+			return "virtio-blk"
+		default:
+			return "nvme"
+		}
+	case pciISOPrefix:
+		return "ahci-cd"
+	case pciNicPrefix:
+		aNic, err := vmnic.GetByID(deviceID)
+		if err != nil {
+			return ""
+		}
+
+		netType, err := getNetTypeArg(aNic.NetType)
+		if err != nil {
+			return ""
+		}
+
+		return netType
+	case pciPassthruPrefix:
+		return "passthru"
+	case pciSharePrefix:
+		return "virtio-9p"
+	default:
+		return ""
+	}
+}
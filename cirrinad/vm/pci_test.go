@@ -0,0 +1,306 @@
+package vm
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"cirrina/cirrinad/iso"
+)
+
+//nolint:paralleltest
+func TestParsePCIAddr(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    string
+		want    PCIAddr
+		wantErr bool
+	}{
+		{name: "slot", addr: "5", want: PCIAddr{Slot: 5}},
+		{name: "slotFunction", addr: "5:2", want: PCIAddr{Slot: 5, Function: 2}},
+		{name: "busSlotFunction", addr: "1:5:2", want: PCIAddr{Bus: 1, Slot: 5, Function: 2}},
+		{name: "spaces", addr: " 0:31:0 ", want: PCIAddr{Slot: 31}},
+		{name: "slotTooBig", addr: "32", wantErr: true},
+		{name: "functionTooBig", addr: "0:3:8", wantErr: true},
+		{name: "busTooBig", addr: "256:3:0", wantErr: true},
+		{name: "tooManyParts", addr: "0:0:3:0", wantErr: true},
+		{name: "empty", addr: "", wantErr: true},
+		{name: "junk", addr: "a:b", wantErr: true},
+		{name: "negative", addr: "-1", wantErr: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParsePCIAddr(testCase.addr)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParsePCIAddr() error = %v, wantErr %v", err, testCase.wantErr)
+
+				return
+			}
+
+			if got != testCase.want {
+				t.Errorf("ParsePCIAddr() got = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func Test_parsePCISlots(t *testing.T) {
+	tests := []struct {
+		name    string
+		slots   string
+		want    map[string]*PCIAddr
+		wantErr bool
+	}{
+		{name: "empty", slots: "", want: map[string]*PCIAddr{}},
+		{
+			name:  "some",
+			slots: "hostbridge=0:0:0, nic:abc=3,disk:def=",
+			want: map[string]*PCIAddr{
+				"hostbridge": {},
+				"nic:abc":    {Slot: 3},
+				"disk:def":   nil,
+			},
+		},
+		{name: "noAddr", slots: "hostbridge", wantErr: true},
+		{name: "noKey", slots: "=0:1:0", wantErr: true},
+		{name: "badAddr", slots: "fbuf=0:40:0", wantErr: true},
+		{name: "dupe", slots: "fbuf=1,fbuf=2", wantErr: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := parsePCISlots(testCase.slots)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("parsePCISlots() error = %v, wantErr %v", err, testCase.wantErr)
+
+				return
+			}
+
+			if !testCase.wantErr && !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("parsePCISlots() got = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func Test_formatPCISlots(t *testing.T) {
+	got := formatPCISlots(map[string]PCIAddr{
+		"lpc":      {Slot: 31},
+		"nic:abc":  {Slot: 3},
+		"disk:def": {Bus: 1, Slot: 0},
+		"fbuf":     {Slot: 3, Function: 1},
+	})
+
+	want := "nic:abc=0:3:0,fbuf=0:3:1,lpc=0:31:0,disk:def=1:0:0"
+	if got != want {
+		t.Errorf("formatPCISlots() got = %v, want %v", got, want)
+	}
+}
+
+//nolint:paralleltest
+func Test_checkPCISlots(t *testing.T) {
+	tests := []struct {
+		name    string
+		slots   map[string]PCIAddr
+		wantErr error
+	}{
+		{name: "ok", slots: map[string]PCIAddr{"hostbridge": {}, "fbuf": {Slot: 1}, "lpc": {Slot: 31}}},
+		{name: "multiFunction", slots: map[string]PCIAddr{"disk:a": {Slot: 4}, "disk:b": {Slot: 4, Function: 1}}},
+		{
+			name:    "collision",
+			slots:   map[string]PCIAddr{"disk:a": {Slot: 4}, "disk:b": {Slot: 4}},
+			wantErr: errVMPCISlotCollision,
+		},
+		{
+			name:    "noFunctionZero",
+			slots:   map[string]PCIAddr{"disk:a": {Slot: 4, Function: 1}},
+			wantErr: errVMPCISlotsInvalid,
+		},
+		{
+			name:    "lpcNotOnBusZero",
+			slots:   map[string]PCIAddr{"lpc": {Bus: 1, Slot: 31}},
+			wantErr: errVMPCISlotsInvalid,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkPCISlots(testCase.slots)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("checkPCISlots() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func Test_assignPCISlots(t *testing.T) {
+	manyDisks := []string{"hostbridge"}
+	for diskNum := range 30 * 8 {
+		manyDisks = append(manyDisks, "disk:"+strconv.Itoa(diskNum))
+	}
+
+	manyNics := []string{"hostbridge"}
+	for nicNum := range 31 {
+		manyNics = append(manyNics, "nic:"+strconv.Itoa(nicNum))
+	}
+
+	manyNics = append(manyNics, "lpc")
+
+	tests := []struct {
+		name     string
+		devices  []string
+		existing map[string]PCIAddr
+		pack     bool
+		want     map[string]PCIAddr
+		wantErr  bool
+	}{
+		{
+			name:    "freshMatchesSequentialOrder",
+			devices: []string{"hostbridge", "fbuf", "tablet", "nic:n1", "disk:d1", "iso:i1", "lpc"},
+			want: map[string]PCIAddr{
+				"hostbridge": {}, "fbuf": {Slot: 1}, "tablet": {Slot: 2}, "nic:n1": {Slot: 3},
+				"disk:d1": {Slot: 4}, "iso:i1": {Slot: 5}, "lpc": {Slot: 31},
+			},
+		},
+		{
+			name:    "freshPackedSharedAHCI",
+			devices: []string{"hostbridge", "fbuf", "tablet", "nic:n1", "disk:d1", "ahci", "lpc"},
+			pack:    true,
+			want: map[string]PCIAddr{
+				"hostbridge": {}, "fbuf": {Slot: 1}, "tablet": {Slot: 2}, "nic:n1": {Slot: 3},
+				"disk:d1": {Slot: 4}, "ahci": {Slot: 5}, "lpc": {Slot: 31},
+			},
+		},
+		{
+			name:    "disksNotPacked",
+			devices: []string{"hostbridge", "disk:d0", "disk:d1", "nic:n1", "lpc"},
+			want: map[string]PCIAddr{
+				"hostbridge": {}, "disk:d0": {Slot: 1}, "disk:d1": {Slot: 2}, "nic:n1": {Slot: 3}, "lpc": {Slot: 31},
+			},
+		},
+		{
+			name:    "disksPackedIntoFunctions",
+			devices: []string{"hostbridge", "disk:d0", "disk:d1", "disk:d2", "nic:n1", "lpc"},
+			pack:    true,
+			want: map[string]PCIAddr{
+				"hostbridge": {}, "disk:d0": {Slot: 1}, "disk:d1": {Slot: 1, Function: 1},
+				"disk:d2": {Slot: 1, Function: 2}, "nic:n1": {Slot: 2}, "lpc": {Slot: 31},
+			},
+		},
+		{
+			name:    "removedFunctionZeroPromoted",
+			devices: []string{"hostbridge", "disk:d1", "disk:d2", "lpc"},
+			existing: map[string]PCIAddr{
+				"hostbridge": {}, "disk:d0": {Slot: 1}, "disk:d1": {Slot: 1, Function: 1},
+				"disk:d2": {Slot: 1, Function: 2}, "lpc": {Slot: 31},
+			},
+			pack: true,
+			want: map[string]PCIAddr{
+				"hostbridge": {}, "disk:d1": {Slot: 1}, "disk:d2": {Slot: 1, Function: 2}, "lpc": {Slot: 31},
+			},
+		},
+		{
+			name:    "addedDiskDoesNotMoveNic",
+			devices: []string{"hostbridge", "nic:n1", "disk:d0", "disk:d1", "lpc"},
+			existing: map[string]PCIAddr{
+				"hostbridge": {}, "nic:n1": {Slot: 1}, "disk:d1": {Slot: 2}, "lpc": {Slot: 31},
+			},
+			want: map[string]PCIAddr{
+				"hostbridge": {}, "nic:n1": {Slot: 1}, "disk:d1": {Slot: 2}, "disk:d0": {Slot: 3}, "lpc": {Slot: 31},
+			},
+		},
+		{
+			name:    "addedDiskPackedKeepsExisting",
+			devices: []string{"hostbridge", "nic:n1", "disk:d0", "disk:d1", "lpc"},
+			existing: map[string]PCIAddr{
+				"hostbridge": {}, "nic:n1": {Slot: 1}, "disk:d1": {Slot: 2}, "lpc": {Slot: 31},
+			},
+			pack: true,
+			want: map[string]PCIAddr{
+				"hostbridge": {}, "nic:n1": {Slot: 1}, "disk:d1": {Slot: 2}, "disk:d0": {Slot: 2, Function: 1},
+				"lpc": {Slot: 31},
+			},
+		},
+		{
+			name:    "detachedDiskDroppedDisabledTabletKept",
+			devices: []string{"hostbridge", "fbuf", "nic:n1", "lpc"},
+			existing: map[string]PCIAddr{
+				"hostbridge": {}, "fbuf": {Slot: 1}, "tablet": {Slot: 2}, "nic:n1": {Slot: 3}, "disk:d1": {Slot: 4},
+				"lpc": {Slot: 31},
+			},
+			want: map[string]PCIAddr{
+				"hostbridge": {}, "fbuf": {Slot: 1}, "tablet": {Slot: 2}, "nic:n1": {Slot: 3}, "lpc": {Slot: 31},
+			},
+		},
+		{
+			name:     "pinnedSlotSkipped",
+			devices:  []string{"hostbridge", "nic:n1", "disk:d1", "lpc"},
+			existing: map[string]PCIAddr{"nic:n1": {Slot: 1, Function: 0}, "disk:d1": {Bus: 1, Slot: 0}},
+			want: map[string]PCIAddr{
+				"hostbridge": {}, "nic:n1": {Slot: 1}, "disk:d1": {Bus: 1}, "lpc": {Slot: 31},
+			},
+		},
+		{
+			name:     "lpcSlotTaken",
+			devices:  []string{"hostbridge", "disk:d1", "lpc"},
+			existing: map[string]PCIAddr{"disk:d1": {Slot: 31}},
+			want:     map[string]PCIAddr{"hostbridge": {}, "disk:d1": {Slot: 31}, "lpc": {Slot: 1}},
+		},
+		{
+			name:    "manyDisksFit",
+			devices: manyDisks,
+			pack:    true,
+			wantErr: false,
+		},
+		{
+			name:    "full",
+			devices: manyNics,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := assignPCISlots(testCase.devices, testCase.existing, testCase.pack)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("assignPCISlots() error = %v, wantErr %v", err, testCase.wantErr)
+
+				return
+			}
+
+			if testCase.want != nil && !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("assignPCISlots() got = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func Test_isoPCIKeys(t *testing.T) {
+	got := isoPCIKeys([]*iso.ISO{{ID: "a"}, nil, {ID: "b"}, {ID: "a"}})
+
+	want := []string{"iso:a", "", "iso:b", "iso:a#2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("isoPCIKeys() got = %v, want %v", got, want)
+	}
+}
+
+//nolint:paralleltest
+func TestVM_pciSlotArg(t *testing.T) {
+	testVM := VM{pciSlots: map[string]PCIAddr{"fbuf": {Slot: 7}}}
+
+	got := testVM.pciSlotArg("fbuf", "1")
+	if got != "0:7:0" {
+		t.Errorf("pciSlotArg() got = %v, want 0:7:0", got)
+	}
+
+	got = testVM.pciSlotArg("tablet", "2")
+	if got != "2" {
+		t.Errorf("pciSlotArg() got = %v, want 2", got)
+	}
+}
@@ -0,0 +1,95 @@
+package vm
+
+import (
+	"log/slog"
+	"time"
+
+	"cirrina/cirrinad/config"
+	"cirrina/cirrinad/util"
+)
+
+// powerOffWait is how long to wait for the bhyve process to exit and for Done() to clean up after a forced power off
+const powerOffWait = 30 * time.Second
+
+// PowerOff immediately powers off the VM without giving the guest a chance to shut down. The VM is marked stopping so
+// it is not restarted, and monitor() cleans up NICs, disks and com ports via Done()
+func (v *VM) PowerOff() error {
+	if !v.Running() {
+		return errVMNotRunning
+	}
+
+	v.SetStopping()
+
+	err := v.runBhyvectl("--force-poweroff")
+	if err != nil {
+		v.SetRunning(v.BhyvePid)
+
+		return err
+	}
+
+	return v.waitStopped(powerOffWait)
+}
+
+// Reset immediately resets the VM. bhyve exits as if the guest rebooted and monitor() starts it again in place, NICs
+// and disks are left in place and the restart policy does not apply
+func (v *VM) Reset() error {
+	if v.Status != RUNNING {
+		return errVMNotRunning
+	}
+
+	return v.runBhyvectl("--force-reset")
+}
+
+// Reboot stops the VM with an ACPI shutdown, waits for it to be cleaned up and starts it again
+func (v *VM) Reboot() error {
+	if v.Status != RUNNING {
+		return errVMNotRunning
+	}
+
+	err := v.Stop()
+	if err != nil {
+		return err
+	}
+
+	err = v.waitStopped(time.Duration(v.Config.MaxWait)*time.Second + powerOffWait)
+	if err != nil {
+		return err
+	}
+
+	return v.Start()
+}
+
+func (v *VM) runBhyvectl(arg string) error {
+	stdOutBytes, stdErrBytes, returnCode, err := util.RunCmd(
+		config.Config.Sys.Sudo,
+		[]string{"/usr/sbin/bhyvectl", arg, "--vm=" + v.Name},
+	)
+	if string(stdErrBytes) != "" || returnCode != 0 || err != nil {
+		slog.Error("error running bhyvectl",
+			"arg", arg,
+			"stdOutBytes", stdOutBytes,
+			"stdErrBytes", stdErrBytes,
+			"returnCode", returnCode,
+			"err", err,
+		)
+
+		return errVMBhyvectlFail
+	}
+
+	return nil
+}
+
+// waitStopped waits for Done() to mark the VM stopped
+func (v *VM) waitStopped(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for v.Status != STOPPED {
+		if time.Now().After(deadline) {
+			return errVMStopTimeout
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package vm
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"cirrina/cirrinad/cirrinadtest"
+	"cirrina/cirrinad/util"
+)
+
+//nolint:paralleltest
+func TestVM_Reset(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockCmdFunc string
+		status      StatusType
+		wantErr     bool
+	}{
+		{
+			name:        "Success",
+			mockCmdFunc: "TestVM_BhyvectlResetSuccess",
+			status:      RUNNING,
+			wantErr:     false,
+		},
+		{
+			name:        "ExecErr",
+			mockCmdFunc: "TestVM_BhyvectlResetError",
+			status:      RUNNING,
+			wantErr:     true,
+		},
+		{
+			name:        "NotRunning",
+			mockCmdFunc: "TestVM_BhyvectlResetSuccess",
+			status:      STOPPED,
+			wantErr:     true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			fakeCommand := cirrinadtest.MakeFakeCommand(testCase.mockCmdFunc)
+			util.SetupTestCmd(fakeCommand)
+
+			t.Cleanup(func() { util.TearDownTestCmd() })
+
+			testVM := &VM{
+				Name:   "untangledVM",
+				Status: testCase.status,
+			}
+
+			err := testVM.Reset()
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("Reset() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_PowerOffNotRunning(t *testing.T) {
+	testVM := &VM{
+		Name:   "untangledVM",
+		Status: STOPPED,
+	}
+
+	err := testVM.PowerOff()
+	if err == nil {
+		t.Errorf("PowerOff() expected error for stopped VM")
+	}
+}
+
+//nolint:paralleltest
+func TestVM_waitStopped(t *testing.T) {
+	testVM := &VM{
+		Name:   "untangledVM",
+		Status: RUNNING,
+	}
+
+	err := testVM.waitStopped(200 * time.Millisecond)
+	if err == nil {
+		t.Errorf("waitStopped() expected timeout")
+	}
+
+	testVM.Status = STOPPED
+
+	err = testVM.waitStopped(200 * time.Millisecond)
+	if err != nil {
+		t.Errorf("waitStopped() error = %v", err)
+	}
+}
+
+// test helpers from here down
+
+//nolint:paralleltest
+func TestVM_BhyvectlResetSuccess(_ *testing.T) {
+	if !cirrinadtest.IsTestEnv() {
+		return
+	}
+
+	cmdWithArgs := os.Args[3:]
+
+	if len(cmdWithArgs) >= 4 && cmdWithArgs[1] == "/usr/sbin/bhyvectl" && cmdWithArgs[2] == "--force-reset" &&
+		cmdWithArgs[3] == "--vm=untangledVM" {
+		os.Exit(0)
+	}
+
+	os.Exit(1)
+}
+
+//nolint:paralleltest
+func TestVM_BhyvectlResetError(_ *testing.T) {
+	if !cirrinadtest.IsTestEnv() {
+		return
+	}
+
+	os.Exit(1)
+}
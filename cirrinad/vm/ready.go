@@ -0,0 +1,239 @@
+package vm
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// serialLineMax limits how much com1 output is buffered while looking for the end of a line
+const serialLineMax = 4096
+
+// ValidateProbes checks readiness or liveness probe settings, empty settings are valid and disable that probe
+func ValidateProbes(pattern string, tcpAddr string, httpURL string) error {
+	if pattern != "" {
+		_, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errVMProbePatternInvalid, err)
+		}
+	}
+
+	if tcpAddr != "" {
+		_, _, err := net.SplitHostPort(tcpAddr)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errVMProbeTCPInvalid, err)
+		}
+	}
+
+	if httpURL != "" {
+		parsedURL, err := url.Parse(httpURL)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errVMProbeHTTPInvalid, err)
+		}
+
+		if (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+			return errVMProbeHTTPInvalid
+		}
+	}
+
+	return nil
+}
+
+// HasReadyProbes returns true if any readiness probe is configured
+func (v *VM) HasReadyProbes() bool {
+	return v.Config.ReadyPattern != "" || v.Config.ReadyTCP != "" || v.Config.ReadyHTTP != ""
+}
+
+// Ready returns true if the VM is running and all of its readiness probes have passed. VMs without readiness probes
+// are ready as soon as they are running.
+func (v *VM) Ready() bool {
+	if v.Status != RUNNING {
+		return false
+	}
+
+	if !v.HasReadyProbes() {
+		return true
+	}
+
+	defer v.mu.RUnlock()
+	v.mu.RLock()
+
+	return v.ready
+}
+
+// resetReady clears the readiness state, called when the VM starts and stops
+func (v *VM) resetReady() {
+	defer v.mu.Unlock()
+	v.mu.Lock()
+
+	v.ready = false
+	v.readyMatched = false
+	v.readyPattern = nil
+	v.serialLine = nil
+}
+
+// readyCurrent returns true while the readiness probes started as gen should keep running
+func (v *VM) readyCurrent(gen uint64) bool {
+	defer v.mu.RUnlock()
+	v.mu.RLock()
+
+	return v.readyGen == gen && v.Running()
+}
+
+// startReadyProbes starts probing the VM for readiness, if it has any readiness probes
+func (v *VM) startReadyProbes() {
+	if !v.HasReadyProbes() {
+		return
+	}
+
+	v.resetReady()
+
+	v.mu.Lock()
+	v.readyGen++
+	gen := v.readyGen
+	v.mu.Unlock()
+
+	if v.Config.ReadyPattern != "" {
+		pattern, err := regexp.Compile(v.Config.ReadyPattern)
+		if err != nil {
+			slog.Error("invalid readiness pattern, ignoring", "vm", v.Name, "err", err)
+		} else {
+			if !v.Config.Com1 || !v.Config.Com1Log {
+				slog.Warn("readiness pattern requires com1 logging, VM will not become ready", "vm", v.Name)
+			}
+
+			v.mu.Lock()
+			v.readyPattern = pattern
+			v.mu.Unlock()
+		}
+	}
+
+	go v.readyProbe(gen)
+}
+
+// readyProbe runs the readiness probes every ReadyInterval until they all pass, the VM stops or ReadyDeadline
+// expires. Probes from before an in-place reboot end when gen is no longer current.
+func (v *VM) readyProbe(gen uint64) {
+	deadline := time.Now().Add(time.Duration(v.Config.ReadyDeadline) * time.Second)
+	interval := max(time.Duration(v.Config.ReadyInterval)*time.Second, time.Second)
+
+	for v.readyCurrent(gen) {
+		if v.readyProbesPass() {
+			v.mu.Lock()
+			current := v.readyGen == gen
+			v.ready = v.ready || current
+			v.mu.Unlock()
+
+			if !current {
+				return
+			}
+
+			slog.Info("VM ready", "vm", v.Name)
+			v.log.Info("ready")
+
+			return
+		}
+
+		if v.Config.ReadyDeadline != 0 && time.Now().After(deadline) {
+			slog.Warn("timed out waiting for VM to become ready", "vm", v.Name)
+			v.log.Warn("readiness timed out")
+
+			return
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// readyProbesPass runs each configured readiness probe once
+func (v *VM) readyProbesPass() bool {
+	probeTimeout := max(time.Duration(v.Config.ReadyTimeout)*time.Second, time.Second)
+
+	if v.Config.ReadyPattern != "" {
+		v.mu.RLock()
+		matched := v.readyMatched
+		v.mu.RUnlock()
+
+		if !matched {
+			return false
+		}
+	}
+
+	if v.Config.ReadyTCP != "" && !probeTCP(v.Config.ReadyTCP, probeTimeout) {
+		return false
+	}
+
+	if v.Config.ReadyHTTP != "" && !probeHTTP(v.Config.ReadyHTTP, probeTimeout) {
+		return false
+	}
+
+	return true
+}
+
+func probeTCP(addr string, timeout time.Duration) bool {
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		slog.Debug("tcp probe failed", "addr", addr, "err", err)
+
+		return false
+	}
+
+	_ = conn.Close()
+
+	return true
+}
+
+// probeHTTP returns true if a GET of the URL returns a 2xx or 3xx status
+func probeHTTP(probeURL string, timeout time.Duration) bool {
+	client := http.Client{
+		Timeout: timeout,
+		// a redirect means the server is up, don't follow it
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Get(probeURL) //nolint:noctx
+	if err != nil {
+		slog.Debug("http probe failed", "url", probeURL, "err", err)
+
+		return false
+	}
+
+	_ = resp.Body.Close()
+
+	return resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusBadRequest
+}
+
+// serialFeed is called by the com1 logger with each byte of output, complete lines are checked against the readiness
+// and liveness patterns
+func (v *VM) serialFeed(outByte byte) {
+	defer v.mu.Unlock()
+	v.mu.Lock()
+
+	if v.readyPattern == nil && v.livePattern == nil {
+		return
+	}
+
+	if outByte != '\n' && outByte != '\r' {
+		if len(v.serialLine) < serialLineMax {
+			v.serialLine = append(v.serialLine, outByte)
+		}
+
+		return
+	}
+
+	if v.readyPattern != nil && v.readyPattern.Match(v.serialLine) {
+		v.readyMatched = true
+	}
+
+	if v.livePattern != nil && v.livePattern.Match(v.serialLine) {
+		v.liveSeen = true
+	}
+
+	v.serialLine = v.serialLine[:0]
+}
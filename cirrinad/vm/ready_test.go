@@ -0,0 +1,168 @@
+package vm
+
+import (
+	"io"
+	"log/slog"
+	"regexp"
+	"testing"
+)
+
+//nolint:paralleltest
+func TestValidateProbes(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		tcpAddr string
+		httpURL string
+		wantErr bool
+	}{
+		{
+			name:    "none",
+			wantErr: false,
+		},
+		{
+			name:    "all",
+			pattern: "^login:",
+			tcpAddr: "10.0.0.2:22",
+			httpURL: "http://10.0.0.2/health",
+			wantErr: false,
+		},
+		{
+			name:    "badPattern",
+			pattern: "login(",
+			wantErr: true,
+		},
+		{
+			name:    "tcpNoPort",
+			tcpAddr: "10.0.0.2",
+			wantErr: true,
+		},
+		{
+			name:    "httpBadScheme",
+			httpURL: "ftp://10.0.0.2/",
+			wantErr: true,
+		},
+		{
+			name:    "httpNoHost",
+			httpURL: "http:///health",
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateProbes(testCase.pattern, testCase.tcpAddr, testCase.httpURL)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ValidateProbes() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_serialFeed(t *testing.T) {
+	testVM := &VM{readyPattern: regexp.MustCompile("^FreeBSD/amd64 .* login:")}
+
+	for _, outByte := range []byte("booting\r\nFreeBSD/amd64 (test) (ttyu0)\r\n") {
+		testVM.serialFeed(outByte)
+	}
+
+	if testVM.readyMatched {
+		t.Errorf("readyMatched = true before login prompt")
+	}
+
+	for _, outByte := range []byte("FreeBSD/amd64 (test) (ttyu0) login: \r\n") {
+		testVM.serialFeed(outByte)
+	}
+
+	if !testVM.readyMatched {
+		t.Errorf("readyMatched = false after login prompt")
+	}
+}
+
+//nolint:paralleltest
+func TestVM_Ready(t *testing.T) {
+	tests := []struct {
+		name   string
+		status StatusType
+		config Config
+		ready  bool
+		want   bool
+	}{
+		{
+			name:   "stopped",
+			status: STOPPED,
+			want:   false,
+		},
+		{
+			name:   "runningNoProbes",
+			status: RUNNING,
+			want:   true,
+		},
+		{
+			name:   "runningProbesPending",
+			status: RUNNING,
+			config: Config{ReadyTCP: "10.0.0.2:22"},
+			want:   false,
+		},
+		{
+			name:   "runningProbesPassed",
+			status: RUNNING,
+			config: Config{ReadyTCP: "10.0.0.2:22"},
+			ready:  true,
+			want:   true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testVM := &VM{Status: testCase.status, Config: testCase.config, ready: testCase.ready}
+
+			got := testVM.Ready()
+			if got != testCase.want {
+				t.Errorf("Ready() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_startReadyProbesReboot(t *testing.T) {
+	testVM := &VM{
+		Status: RUNNING,
+		Config: Config{ReadyTCP: "127.0.0.1:1", LiveTCP: "127.0.0.1:1"},
+		log:    *slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	testVM.startReadyProbes()
+	testVM.startLiveProbes()
+
+	testVM.mu.Lock()
+	testVM.ready = true
+	testVM.readyMatched = true
+	testVM.liveState = LiveHealthy
+	testVM.mu.Unlock()
+
+	oldGen := testVM.readyGen
+
+	// as after an in-place reboot
+	testVM.startReadyProbes()
+	testVM.startLiveProbes()
+
+	if testVM.Ready() {
+		t.Errorf("Ready() = true after reboot")
+	}
+
+	if testVM.readyCurrent(oldGen) {
+		t.Errorf("readiness probes from before the reboot still current")
+	}
+
+	state, _ := testVM.LiveState()
+	if state != LivePending {
+		t.Errorf("LiveState() = %v, want %v", state, LivePending)
+	}
+
+	testVM.mu.Lock()
+	testVM.Status = STOPPED
+	testVM.mu.Unlock()
+}
@@ -0,0 +1,356 @@
+package vm
+
+import (
+	"log/slog"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"cirrina/cirrinad/config"
+	"cirrina/cirrinad/webhook"
+)
+
+type RestartPolicyType string
+
+const (
+	RestartNever     RestartPolicyType = "never"      // never restart the VM when it exits
+	RestartOnFailure RestartPolicyType = "on-failure" // restart unless the guest powered off
+	RestartAlways    RestartPolicyType = "always"     // restart whenever the VM exits
+)
+
+type ExitReason string
+
+// exit reasons, from the bhyve exit status as documented in bhyve(8)
+const (
+	ExitReset       ExitReason = "reset"
+	ExitPowerOff    ExitReason = "poweroff"
+	ExitHalt        ExitReason = "halt"
+	ExitTripleFault ExitReason = "triplefault"
+	ExitError       ExitReason = "error"
+	ExitUnknown     ExitReason = "unknown"
+)
+
+const (
+	// maxRestartBackoff caps the exponential backoff between restarts
+	maxRestartBackoff = 5 * time.Minute
+	// restartHistoryMax is how many restart records are kept per VM
+	restartHistoryMax = 100
+)
+
+// restartsDisabled stops pending restarts from starting VMs while the daemon shuts down
+var restartsDisabled atomic.Bool
+
+// RestartRecord is an entry in the history of a VM exiting without being asked to stop
+type RestartRecord struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	VMID      string `gorm:"index;not null;default:null"`
+	ExitCode  int
+	Reason    string
+	Restarted bool
+	Delay     uint32 // seconds waited before restarting
+	CrashLoop bool   // this exit put the VM into the crash loop state
+}
+
+func (RestartRecord) TableName() string {
+	return "vm_restarts"
+}
+
+// ValidRestartPolicy checks the restart policy is one of the known modes
+func ValidRestartPolicy(policy string) bool {
+	switch RestartPolicyType(policy) {
+	case RestartNever, RestartOnFailure, RestartAlways:
+		return true
+	default:
+		return false
+	}
+}
+
+// failure returns true if the VM exited for a reason other than the guest powering off or rebooting, or for a
+// reason which could not be worked out
+func (r ExitReason) failure() bool {
+	return r != ExitPowerOff && r != ExitReset && r != ExitUndetermined
+}
+
+func exitReasonFromCode(exitCode int) ExitReason {
+	switch exitCode {
+	case 0:
+		return ExitReset
+	case 1:
+		return ExitPowerOff
+	case 2:
+		return ExitHalt
+	case 3:
+		return ExitTripleFault
+	case 4:
+		return ExitError
+	default:
+		return ExitUnknown
+	}
+}
+
+// shouldRestart decides if the restart policy allows restarting after the VM exited for the given reason
+func shouldRestart(policy RestartPolicyType, reason ExitReason) bool {
+	switch policy {
+	case RestartNever:
+		return false
+	case RestartAlways:
+		return true
+	case RestartOnFailure:
+		return reason != ExitPowerOff && reason != ExitUndetermined
+	default:
+		return reason != ExitPowerOff && reason != ExitUndetermined
+	}
+}
+
+// pruneRestarts drops restart times which are outside the window
+func pruneRestarts(restartTimes []time.Time, now time.Time, window time.Duration) []time.Time {
+	var kept []time.Time
+
+	for _, restartTime := range restartTimes {
+		if now.Sub(restartTime) < window {
+			kept = append(kept, restartTime)
+		}
+	}
+
+	return kept
+}
+
+// restartBackoff doubles the restart delay for each restart already in the window
+func restartBackoff(base time.Duration, recentRestarts int) time.Duration {
+	if base == 0 {
+		return 0
+	}
+
+	if recentRestarts > 30 {
+		return maxRestartBackoff
+	}
+
+	backoff := base * time.Duration(math.Pow(2, float64(recentRestarts)))
+	if backoff > maxRestartBackoff || backoff <= 0 {
+		return maxRestartBackoff
+	}
+
+	return backoff
+}
+
+// CrashLooping returns true if the VM was restarted too many times within the restart window and is no longer
+// being restarted
+func (v *VM) CrashLooping() bool {
+	defer v.mu.RUnlock()
+	v.mu.RLock()
+
+	return v.crashLoop
+}
+
+// exitCode returns the exit code of the last bhyve run, or -1 if it is not known
+func (v *VM) exitCode() int {
+	if v.proc == nil {
+		return -1
+	}
+
+	state := v.proc.LastProcessState()
+	if state == nil {
+		return -1
+	}
+
+	return state.ExitCode()
+}
+
+// rebootInPlace starts bhyve again after the guest rebooted or was reset, leaving NICs, disks, com ports and hooks
+// alone. A reboot is not a failure, so it does not go through the restart policy or count towards crash loop
+// detection. It returns false if the VM should be cleaned up as usual instead.
+func (v *VM) rebootInPlace() bool {
+	if restartsDisabled.Load() {
+		return false
+	}
+
+	v.log.Info("guest rebooted, restarting bhyve in place")
+
+	err := v.spawn(v.procOpts, true)
+	if err != nil {
+		// the new process never started, so its monitor does not clean up and the caller has to
+		slog.Error("error restarting VM after reboot", "vm", v.Name, "err", err)
+
+		return false
+	}
+
+	record := RestartRecord{VMID: v.ID, ExitCode: 0, Reason: string(ExitReset), Restarted: true}
+	v.saveRestartRecord(&record)
+	v.emitExit(ExitReset, 0, &record)
+
+	return true
+}
+
+// handleExit is called after the VM exited without being asked to stop and has been cleaned up. A reboot which could
+// not be done in place starts the VM again straight away. Otherwise it records the exit and restarts the VM if the
+// restart policy allows it, backing off exponentially and giving up when the VM restarts more than RestartMax times
+// within RestartWindow.
+func (v *VM) handleExit(exitCode int) {
+	v.handleExitReason(exitReasonFromCode(exitCode), exitCode)
+}
+
+// handleExitReason is handleExit for an exit whose reason is already known
+func (v *VM) handleExitReason(reason ExitReason, exitCode int) {
+	record := RestartRecord{VMID: v.ID, ExitCode: exitCode, Reason: string(reason)}
+
+	defer v.emitExit(reason, exitCode, &record)
+
+	if reason == ExitReset {
+		record.Restarted = true
+		v.saveRestartRecord(&record)
+
+		go v.restartAfter(0, "")
+
+		return
+	}
+
+	if !v.Config.Restart || !shouldRestart(RestartPolicyType(v.Config.RestartPolicy), reason) {
+		v.log.Info("VM exited, not restarting", "reason", reason, "exitCode", exitCode)
+		v.saveRestartRecord(&record)
+
+		return
+	}
+
+	now := time.Now()
+
+	v.mu.Lock()
+	v.restartTimes = pruneRestarts(v.restartTimes, now, time.Duration(v.Config.RestartWindow)*time.Second)
+	recentRestarts := len(v.restartTimes)
+
+	if v.Config.RestartMax > 0 && recentRestarts >= int(v.Config.RestartMax) {
+		v.crashLoop = true
+		v.restartTimes = nil
+		v.mu.Unlock()
+
+		slog.Error("VM is crash looping, not restarting",
+			"vm", v.Name, "restarts", recentRestarts, "window", v.Config.RestartWindow, "reason", reason)
+		v.log.Error("crash loop detected, not restarting", "restarts", recentRestarts, "reason", reason)
+
+		if config.Config.Metrics.Enabled {
+			crashLoopCounter.Inc()
+		}
+
+		record.CrashLoop = true
+		v.saveRestartRecord(&record)
+
+		return
+	}
+
+	v.restartTimes = append(v.restartTimes, now)
+	v.mu.Unlock()
+
+	delay := restartBackoff(time.Duration(v.Config.RestartDelay)*time.Second, recentRestarts)
+
+	v.log.Info("VM exited, restarting", "reason", reason, "exitCode", exitCode, "delay", delay)
+
+	record.Restarted = true
+	record.Delay = uint32(delay.Seconds())
+	v.saveRestartRecord(&record)
+
+	go v.restartAfter(delay, reason)
+}
+
+// emitExit sends a webhook event for the VM exiting without being asked to, once the restart decision is recorded.
+// Failures are sent as crashes, the guest powering off or rebooting is sent as an exit.
+func (v *VM) emitExit(reason ExitReason, exitCode int, record *RestartRecord) {
+	event := webhook.EventVMExit
+	if reason.failure() {
+		event = webhook.EventVMCrash
+	}
+
+	webhook.Emit(webhook.Payload{
+		Event: event,
+		VM: &webhook.VMData{
+			ID: v.ID, Name: v.Name, State: string(v.Status),
+			Reason: string(reason), ExitCode: &exitCode, CrashLoop: record.CrashLoop,
+		},
+	})
+}
+
+// restartAfter starts the VM again after the delay, the exit reason is empty when the restart was asked for
+func (v *VM) restartAfter(delay time.Duration, reason ExitReason) {
+	v.mu.Lock()
+	v.restartPending = true
+	v.mu.Unlock()
+
+	time.Sleep(delay)
+
+	v.mu.Lock()
+	pending := v.restartPending
+	v.restartPending = false
+	v.mu.Unlock()
+
+	if !pending || restartsDisabled.Load() {
+		return
+	}
+
+	// the VM may have been deleted while waiting
+	_, err := GetByID(v.ID)
+	if err != nil {
+		return
+	}
+
+	err = v.Start()
+	if err != nil {
+		slog.Error("error restarting VM", "vm", v.Name, "err", err)
+
+		return
+	}
+
+	if reason != "" {
+		webhook.Emit(webhook.Payload{
+			Event: webhook.EventVMRespawn,
+			VM:    &webhook.VMData{ID: v.ID, Name: v.Name, State: string(v.Status), Reason: string(reason)},
+		})
+	}
+}
+
+func (v *VM) saveRestartRecord(record *RestartRecord) {
+	vmDB := GetVMDB()
+
+	res := vmDB.Create(record)
+	if res.Error != nil {
+		slog.Error("error saving VM restart record", "vm", v.ID, "err", res.Error)
+
+		return
+	}
+
+	// only keep the most recent records
+	res = vmDB.Where("vm_id = ? AND id NOT IN (?)", v.ID,
+		vmDB.Model(&RestartRecord{}).Select("id").Where("vm_id = ?", v.ID).Order("id desc").Limit(restartHistoryMax),
+	).Delete(&RestartRecord{})
+	if res.Error != nil {
+		slog.Error("error pruning VM restart records", "vm", v.ID, "err", res.Error)
+	}
+}
+
+// GetRestartHistory returns the recorded exits of the VM, oldest first
+func (v *VM) GetRestartHistory() ([]*RestartRecord, error) {
+	var records []*RestartRecord
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ?", v.ID).Order("id").Find(&records)
+	if res.Error != nil {
+		slog.Error("error getting VM restart history", "vm", v.ID, "err", res.Error)
+
+		return nil, errVMInternalDB
+	}
+
+	return records, nil
+}
+
+// DeleteRestartHistory removes all restart records of the VM
+func (v *VM) DeleteRestartHistory() error {
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ?", v.ID).Delete(&RestartRecord{})
+	if res.Error != nil {
+		slog.Error("error deleting VM restart history", "vm", v.ID, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	return nil
+}
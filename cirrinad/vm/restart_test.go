@@ -0,0 +1,173 @@
+package vm
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/kontera-technologies/go-supervisor/v2"
+)
+
+//nolint:paralleltest
+func Test_exitReasonFromCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		exitCode int
+		want     ExitReason
+	}{
+		{name: "reset", exitCode: 0, want: ExitReset},
+		{name: "poweroff", exitCode: 1, want: ExitPowerOff},
+		{name: "halt", exitCode: 2, want: ExitHalt},
+		{name: "tripleFault", exitCode: 3, want: ExitTripleFault},
+		{name: "error", exitCode: 4, want: ExitError},
+		{name: "signaled", exitCode: -1, want: ExitUnknown},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := exitReasonFromCode(testCase.exitCode)
+			if got != testCase.want {
+				t.Errorf("exitReasonFromCode() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestExitReason_failure(t *testing.T) {
+	tests := []struct {
+		reason ExitReason
+		want   bool
+	}{
+		{reason: ExitReset, want: false},
+		{reason: ExitPowerOff, want: false},
+		{reason: ExitHalt, want: true},
+		{reason: ExitTripleFault, want: true},
+		{reason: ExitError, want: true},
+		{reason: ExitUnknown, want: true},
+		{reason: ExitUndetermined, want: false},
+	}
+
+	for _, testCase := range tests {
+		t.Run(string(testCase.reason), func(t *testing.T) {
+			got := testCase.reason.failure()
+			if got != testCase.want {
+				t.Errorf("failure() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func Test_shouldRestart(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RestartPolicyType
+		reason ExitReason
+		want   bool
+	}{
+		{name: "neverReset", policy: RestartNever, reason: ExitReset, want: false},
+		{name: "onFailureReset", policy: RestartOnFailure, reason: ExitReset, want: true},
+		{name: "onFailureTripleFault", policy: RestartOnFailure, reason: ExitTripleFault, want: true},
+		{name: "onFailurePowerOff", policy: RestartOnFailure, reason: ExitPowerOff, want: false},
+		{name: "alwaysPowerOff", policy: RestartAlways, reason: ExitPowerOff, want: true},
+		{name: "emptyPolicy", policy: "", reason: ExitHalt, want: true},
+		{name: "onFailureUndetermined", policy: RestartOnFailure, reason: ExitUndetermined, want: false},
+		{name: "emptyPolicyUndetermined", policy: "", reason: ExitUndetermined, want: false},
+		{name: "alwaysUndetermined", policy: RestartAlways, reason: ExitUndetermined, want: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := shouldRestart(testCase.policy, testCase.reason)
+			if got != testCase.want {
+				t.Errorf("shouldRestart() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func Test_restartBackoff(t *testing.T) {
+	tests := []struct {
+		name           string
+		base           time.Duration
+		recentRestarts int
+		want           time.Duration
+	}{
+		{name: "first", base: time.Second, recentRestarts: 0, want: time.Second},
+		{name: "third", base: time.Second, recentRestarts: 2, want: 4 * time.Second},
+		{name: "capped", base: time.Minute, recentRestarts: 10, want: maxRestartBackoff},
+		{name: "huge", base: time.Second, recentRestarts: 100, want: maxRestartBackoff},
+		{name: "noDelay", base: 0, recentRestarts: 3, want: 0},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := restartBackoff(testCase.base, testCase.recentRestarts)
+			if got != testCase.want {
+				t.Errorf("restartBackoff() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func Test_pruneRestarts(t *testing.T) {
+	now := time.Date(2024, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	restartTimes := []time.Time{
+		now.Add(-10 * time.Minute),
+		now.Add(-4 * time.Minute),
+		now.Add(-time.Minute),
+	}
+
+	got := pruneRestarts(restartTimes, now, 5*time.Minute)
+
+	diff := deep.Equal(got, restartTimes[1:])
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	got = pruneRestarts(restartTimes, now, 0)
+	if len(got) != 0 {
+		t.Errorf("pruneRestarts() with no window = %v, want none", got)
+	}
+}
+
+//nolint:paralleltest
+func TestValidRestartPolicy(t *testing.T) {
+	for _, policy := range []string{"never", "on-failure", "always"} {
+		if !ValidRestartPolicy(policy) {
+			t.Errorf("ValidRestartPolicy(%s) = false, want true", policy)
+		}
+	}
+
+	if ValidRestartPolicy("sometimes") {
+		t.Errorf("ValidRestartPolicy(sometimes) = true, want false")
+	}
+}
+
+//nolint:paralleltest
+func TestVM_rebootInPlaceSpawnFails(t *testing.T) {
+	testVM := &VM{
+		ID:     "0e6d2b7a-4c1f-4a8e-b3d5-9f2a1c7e6b40",
+		Name:   "test2024062901",
+		Status: RUNNING,
+		log:    *slog.New(slog.NewTextHandler(io.Discard, nil)),
+		procOpts: supervisor.ProcessOptions{
+			Name:             "/nonexistent/bhyve",
+			OutputParser:     supervisor.MakeBytesParser,
+			ErrorParser:      supervisor.MakeBytesParser,
+			MaxSpawnAttempts: 1,
+			SpawnWait:        1,
+		},
+	}
+
+	// the caller does the cleanup when bhyve could not be started again
+	if testVM.rebootInPlace() {
+		t.Errorf("rebootInPlace() = true, want false")
+	}
+}
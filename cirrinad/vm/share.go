@@ -0,0 +1,247 @@
+package vm
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"cirrina/cirrinad/config"
+	"cirrina/cirrinad/util"
+)
+
+// Share is a host directory shared with a VM using virtio-9p, the guest mounts it by its name
+type Share struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	VMID      string `gorm:"uniqueIndex:idx_vm_share_name;not null;default:null"`
+	Name      string `gorm:"uniqueIndex:idx_vm_share_name;not null;default:null"`
+	Path      string `gorm:"not null;default:null"`
+	ReadOnly  bool
+}
+
+func (Share) TableName() string {
+	return "vm_shares"
+}
+
+// protectedShareDirs returns the directories the daemon keeps its own data in, which must not be shared with VMs
+func protectedShareDirs() []string {
+	protected := []string{
+		config.Config.Disk.VM.Path.Image,
+		config.Config.Disk.VM.Path.State,
+		config.Config.Disk.VM.Path.Iso,
+	}
+
+	// these are files, their whole directory is protected
+	for _, daemonFile := range []string{config.Config.DB.Path, config.Config.Log.Path, config.Config.Sys.PidFilePath} {
+		if daemonFile != "" {
+			protected = append(protected, filepath.Dir(daemonFile))
+		}
+	}
+
+	return protected
+}
+
+// pathWithin returns true if the path is the directory or under it
+func pathWithin(path string, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// resolveSharePath returns the path with symlinks resolved, or the cleaned path if it can not be resolved
+func resolveSharePath(path string) string {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return filepath.Clean(path)
+	}
+
+	return resolved
+}
+
+// ValidateSharePath checks the path is an absolute path to an existing directory which neither is under nor
+// contains any of the directories the daemon keeps its data in
+func ValidateSharePath(sharePath string) error {
+	if !filepath.IsAbs(sharePath) {
+		return fmt.Errorf("%w: %s is not an absolute path", errVMSharePathInvalid, sharePath)
+	}
+
+	if strings.Contains(sharePath, ",") {
+		return fmt.Errorf("%w: %s contains a comma", errVMSharePathInvalid, sharePath)
+	}
+
+	shareInfo, err := os.Stat(sharePath)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errVMSharePathInvalid, err)
+	}
+
+	if !shareInfo.IsDir() {
+		return fmt.Errorf("%w: %s is not a directory", errVMSharePathInvalid, sharePath)
+	}
+
+	resolved := resolveSharePath(sharePath)
+
+	for _, protectedDir := range protectedShareDirs() {
+		if protectedDir == "" {
+			continue
+		}
+
+		protectedDir = resolveSharePath(protectedDir)
+
+		if pathWithin(resolved, protectedDir) || pathWithin(protectedDir, resolved) {
+			return fmt.Errorf("%w: %s overlaps daemon directory %s", errVMSharePathProtected, sharePath, protectedDir)
+		}
+	}
+
+	return nil
+}
+
+func (s *Share) validate() error {
+	if !util.ValidVMName(s.Name) {
+		return errVMShareInvalidName
+	}
+
+	return ValidateSharePath(s.Path)
+}
+
+// AddShare shares a host directory with the VM, it is available from the next start of the VM
+func (v *VM) AddShare(share *Share) error {
+	err := share.validate()
+	if err != nil {
+		return err
+	}
+
+	_, err = v.GetShareByName(share.Name)
+	if err == nil {
+		return errVMShareExists
+	}
+
+	share.ID = 0
+	share.VMID = v.ID
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Create(share)
+	if res.Error != nil || res.RowsAffected != 1 {
+		slog.Error("error adding VM share", "vm", v.ID, "share", share.Name, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	return nil
+}
+
+// GetShares returns the shared directories of the VM sorted by name
+func (v *VM) GetShares() ([]Share, error) {
+	var shares []Share
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ?", v.ID).Order("name").Find(&shares)
+	if res.Error != nil {
+		slog.Error("error getting VM shares", "vm", v.ID, "err", res.Error)
+
+		return nil, errVMInternalDB
+	}
+
+	return shares, nil
+}
+
+// GetShareByName looks up a shared directory of the VM by name
+func (v *VM) GetShareByName(name string) (*Share, error) {
+	var share Share
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ? AND name = ?", v.ID, name).Limit(1).Find(&share)
+	if res.Error != nil {
+		slog.Error("error getting VM share", "vm", v.ID, "share", name, "err", res.Error)
+
+		return nil, errVMInternalDB
+	}
+
+	if res.RowsAffected != 1 {
+		return nil, errVMShareNotFound
+	}
+
+	return &share, nil
+}
+
+// RemoveShare stops sharing a directory with the VM, a running VM keeps it until it stops
+func (v *VM) RemoveShare(name string) error {
+	share, err := v.GetShareByName(name)
+	if err != nil {
+		return err
+	}
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Delete(share)
+	if res.Error != nil {
+		slog.Error("error removing VM share", "vm", v.ID, "share", name, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	return nil
+}
+
+// DeleteShares removes all shared directories of the VM
+func (v *VM) DeleteShares() error {
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ?", v.ID).Delete(&Share{})
+	if res.Error != nil {
+		slog.Error("error deleting VM shares", "vm", v.ID, "err", res.Error)
+
+		return errVMInternalDB
+	}
+
+	return nil
+}
+
+// loadShares reads the VM's shared directories for generating its arguments
+func (v *VM) loadShares() error {
+	shares, err := v.GetShares()
+	if err != nil {
+		return err
+	}
+
+	defer v.mu.Unlock()
+	v.mu.Lock()
+	v.shares = shares
+
+	return nil
+}
+
+// getShareArg returns a virtio-9p device for each of the VM's shared directories, skipping any which are no longer
+// valid so a missing directory does not stop the VM from starting
+func (v *VM) getShareArg(slot int) ([]string, int) {
+	var shareArg []string
+
+	for _, share := range v.shares {
+		err := ValidateSharePath(share.Path)
+		if err != nil {
+			slog.Error("not sharing directory", "vm", v.Name, "share", share.Name, "err", err)
+
+			continue
+		}
+
+		shareString := ",virtio-9p," + share.Name + "=" + share.Path
+		if share.ReadOnly {
+			shareString += ",ro"
+		}
+
+		shareArg = append(shareArg, "-s",
+			v.pciSlotArg(pciSharePrefix+share.Name, strconv.FormatInt(int64(slot), 10))+shareString)
+		slot++
+	}
+
+	return shareArg, slot
+}
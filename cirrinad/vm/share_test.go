@@ -0,0 +1,136 @@
+package vm
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-test/deep"
+
+	"cirrina/cirrinad/config"
+)
+
+//nolint:paralleltest
+func Test_pathWithin(t *testing.T) {
+	tests := []struct {
+		path string
+		dir  string
+		want bool
+	}{
+		{path: "/var/db/cirrinad", dir: "/var/db/cirrinad", want: true},
+		{path: "/var/db/cirrinad/disk", dir: "/var/db/cirrinad", want: true},
+		{path: "/var/db/cirrinad-shares", dir: "/var/db/cirrinad", want: false},
+		{path: "/var/db", dir: "/var/db/cirrinad", want: false},
+		{path: "/home/user/..data", dir: "/home/user", want: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.path, func(t *testing.T) {
+			if got := pathWithin(testCase.path, testCase.dir); got != testCase.want {
+				t.Errorf("pathWithin() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestValidateSharePath(t *testing.T) {
+	baseDir := t.TempDir()
+	daemonDir := filepath.Join(baseDir, "cirrinad")
+	shareDir := filepath.Join(baseDir, "artifacts")
+	shareFile := filepath.Join(baseDir, "artifacts.tar")
+
+	for _, dir := range []string{filepath.Join(daemonDir, "disk"), filepath.Join(daemonDir, "state"), shareDir} {
+		err := os.MkdirAll(dir, 0o755)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := os.WriteFile(shareFile, []byte{}, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.Symlink(filepath.Join(daemonDir, "disk"), filepath.Join(baseDir, "disklink"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config.Config.Disk.VM.Path.Image = filepath.Join(daemonDir, "disk")
+	config.Config.Disk.VM.Path.State = filepath.Join(daemonDir, "state")
+	config.Config.DB.Path = filepath.Join(daemonDir, "cirrina.sqlite")
+
+	t.Cleanup(func() {
+		config.Config.Disk.VM.Path.Image = ""
+		config.Config.Disk.VM.Path.State = ""
+		config.Config.DB.Path = ""
+	})
+
+	tests := []struct {
+		name      string
+		sharePath string
+		wantErr   error
+	}{
+		{name: "valid", sharePath: shareDir},
+		{name: "relative", sharePath: "artifacts", wantErr: errVMSharePathInvalid},
+		{name: "comma", sharePath: shareDir + ",ro", wantErr: errVMSharePathInvalid},
+		{name: "missing", sharePath: filepath.Join(baseDir, "missing"), wantErr: errVMSharePathInvalid},
+		{name: "file", sharePath: shareFile, wantErr: errVMSharePathInvalid},
+		{name: "daemonDir", sharePath: daemonDir, wantErr: errVMSharePathProtected},
+		{name: "underDaemonDir", sharePath: filepath.Join(daemonDir, "state"), wantErr: errVMSharePathProtected},
+		{name: "containsDaemonDir", sharePath: baseDir, wantErr: errVMSharePathProtected},
+		{name: "symlinkToDaemonDir", sharePath: filepath.Join(baseDir, "disklink"), wantErr: errVMSharePathProtected},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateSharePath(testCase.sharePath)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("ValidateSharePath() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestShare_validate(t *testing.T) {
+	share := Share{Name: "build artifacts", Path: t.TempDir()}
+
+	err := share.validate()
+	if !errors.Is(err, errVMShareInvalidName) {
+		t.Errorf("validate() error = %v, wantErr %v", err, errVMShareInvalidName)
+	}
+}
+
+//nolint:paralleltest
+func TestVM_getShareArg(t *testing.T) {
+	artifactsDir := t.TempDir()
+	srcDir := t.TempDir()
+
+	testVM := &VM{
+		shares: []Share{
+			{Name: "artifacts", Path: artifactsDir},
+			{Name: "gone", Path: filepath.Join(artifactsDir, "gone")},
+			{Name: "src", Path: srcDir, ReadOnly: true},
+		},
+		pciSlots: map[string]PCIAddr{pciSharePrefix + "src": {Slot: 12}},
+	}
+
+	gotArgs, gotSlot := testVM.getShareArg(6)
+
+	wantArgs := []string{
+		"-s", "6,virtio-9p,artifacts=" + artifactsDir,
+		"-s", "0:12:0,virtio-9p,src=" + srcDir + ",ro",
+	}
+
+	diff := deep.Equal(gotArgs, wantArgs)
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	if gotSlot != 8 {
+		t.Errorf("getShareArg() gotSlot = %v, want 8", gotSlot)
+	}
+}
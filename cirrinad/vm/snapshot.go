@@ -0,0 +1,717 @@
+package vm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cirrina/cirrinad/config"
+	"cirrina/cirrinad/disk"
+	"cirrina/cirrinad/iso"
+	"cirrina/cirrinad/util"
+	"cirrina/cirrinad/vmnic"
+)
+
+// Snapshot is a point-in-time copy of a whole VM. It records the VM's config row and its ISO, disk and NIC
+// attachments as JSON, takes a disk snapshot of every attached disk and keeps a copy of the UEFI vars file.
+type Snapshot struct {
+	ID            string `gorm:"uniqueIndex;not null;default:null"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
+	VMID          string         `gorm:"index;not null;default:null"`
+	Name          string         `gorm:"not null;default:null"`
+	Description   string
+	Config        string
+	ISOs          string `gorm:"column:isos"`
+	Nics          string
+	DiskSnapshots string
+	UEFIVars      bool `gorm:"column:uefi_vars"`
+}
+
+// SnapshotDisk maps an attached disk to the disk snapshot taken of it as part of a VM snapshot
+type SnapshotDisk struct {
+	DiskID     string `json:"disk_id"`
+	SnapshotID string `json:"snapshot_id"`
+}
+
+func (Snapshot) TableName() string {
+	return "vm_snapshots"
+}
+
+func (s *Snapshot) BeforeCreate(_ *gorm.DB) error {
+	if s == nil || s.Name == "" {
+		return errSnapshotInvalidName
+	}
+
+	err := uuid.Validate(s.ID)
+	if err != nil || len(s.ID) != 36 {
+		s.ID = uuid.NewString()
+	}
+
+	return nil
+}
+
+// GetDiskSnapshots returns the disk snapshots which are part of the VM snapshot, in attachment order
+func (s *Snapshot) GetDiskSnapshots() ([]SnapshotDisk, error) {
+	var diskSnapshots []SnapshotDisk
+
+	err := json.Unmarshal([]byte(s.DiskSnapshots), &diskSnapshots)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing snapshot disks: %w", err)
+	}
+
+	return diskSnapshots, nil
+}
+
+// snapshotData holds the decoded contents of a VM snapshot
+type snapshotData struct {
+	config        Config
+	isoIDs        []string
+	nicIDs        []string
+	diskSnapshots []SnapshotDisk
+}
+
+func (s *Snapshot) decode() (*snapshotData, error) {
+	var data snapshotData
+
+	var err error
+
+	err = json.Unmarshal([]byte(s.Config), &data.config)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing snapshot config: %w", err)
+	}
+
+	err = json.Unmarshal([]byte(s.ISOs), &data.isoIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing snapshot isos: %w", err)
+	}
+
+	err = json.Unmarshal([]byte(s.Nics), &data.nicIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing snapshot nics: %w", err)
+	}
+
+	data.diskSnapshots, err = s.GetDiskSnapshots()
+	if err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// getUEFIVarsPath returns the path of the VM's UEFI vars file
+func (v *VM) getUEFIVarsPath() string {
+	return config.Config.Disk.VM.Path.State + "/" + v.Name + "/BHYVE_UEFI_VARS.fd"
+}
+
+// getSnapshotUEFIVarsPath returns the path used to store the copy of the UEFI vars file for a VM snapshot
+func getSnapshotUEFIVarsPath(vmID string, snapshotID string) string {
+	return filepath.Join(config.Config.Disk.VM.Path.State, ".snapshots", vmID, snapshotID+"_UEFI_VARS.fd")
+}
+
+// GetSnapshots returns all snapshots of the VM, oldest first
+func (v *VM) GetSnapshots() ([]*Snapshot, error) {
+	var snapshots []*Snapshot
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ?", v.ID).Order("created_at").Find(&snapshots)
+	if res.Error != nil {
+		slog.Error("error getting VM snapshots", "vm", v.ID, "err", res.Error)
+
+		return nil, errVMInternalDB
+	}
+
+	return snapshots, nil
+}
+
+// GetSnapshotByID looks up a snapshot of the VM by ID
+func (v *VM) GetSnapshotByID(snapshotID string) (*Snapshot, error) {
+	var snapshot Snapshot
+
+	if snapshotID == "" {
+		return nil, errSnapshotNotFound
+	}
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Where("vm_id = ? AND id = ?", v.ID, snapshotID).Limit(1).Find(&snapshot)
+	if res.Error != nil {
+		slog.Error("error getting VM snapshot", "vm", v.ID, "snapshot", snapshotID, "err", res.Error)
+
+		return nil, errVMInternalDB
+	}
+
+	if res.RowsAffected != 1 {
+		return nil, errSnapshotNotFound
+	}
+
+	return &snapshot, nil
+}
+
+// ValidateNewSnapshotName checks that the name is usable for a new snapshot of the VM and of each of its disks
+func (v *VM) ValidateNewSnapshotName(name string) error {
+	if !util.ValidDiskName(name) {
+		return errSnapshotInvalidName
+	}
+
+	snapshots, err := v.GetSnapshots()
+	if err != nil {
+		return err
+	}
+
+	for _, aSnapshot := range snapshots {
+		if aSnapshot.Name == name {
+			return errSnapshotExists
+		}
+	}
+
+	for _, aDisk := range v.Disks {
+		if aDisk == nil {
+			continue
+		}
+
+		err = aDisk.ValidateNewSnapshotName(name)
+		if err != nil {
+			return fmt.Errorf("disk %s: %w", aDisk.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// CreateSnapshot snapshots the VM config, attachments, UEFI vars and every attached disk. A running VM should have
+// its filesystems frozen with QuiesceForSnapshot first so that the disks are consistent with each other.
+func (v *VM) CreateSnapshot(name string, description string) (*Snapshot, error) {
+	defer v.mu.Unlock()
+	v.mu.Lock()
+
+	if v.Status != STOPPED && v.Status != RUNNING {
+		return nil, errSnapshotVMBusy
+	}
+
+	err := v.ValidateNewSnapshotName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	newSnapshot := &Snapshot{
+		ID:          uuid.NewString(),
+		VMID:        v.ID,
+		Name:        name,
+		Description: description,
+	}
+
+	err = newSnapshot.recordVM(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var diskSnapshots []SnapshotDisk
+
+	for _, aDisk := range v.Disks {
+		var diskSnapshot *disk.Snapshot
+
+		aDisk.Lock()
+		diskSnapshot, err = aDisk.CreateSnapshot(name, "snapshot of VM "+v.Name, newSnapshot.ID)
+		aDisk.Unlock()
+
+		if err != nil {
+			slog.Error("error creating disk snapshot", "vm", v.ID, "disk", aDisk.ID, "err", err)
+			removeDiskSnapshots(diskSnapshots)
+
+			return nil, fmt.Errorf("error creating snapshot of disk %s: %w", aDisk.Name, err)
+		}
+
+		diskSnapshots = append(diskSnapshots, SnapshotDisk{DiskID: aDisk.ID, SnapshotID: diskSnapshot.ID})
+	}
+
+	diskSnapshotsJSON, err := json.Marshal(diskSnapshots)
+	if err != nil {
+		removeDiskSnapshots(diskSnapshots)
+
+		return nil, fmt.Errorf("error encoding snapshot disks: %w", err)
+	}
+
+	newSnapshot.DiskSnapshots = string(diskSnapshotsJSON)
+
+	newSnapshot.UEFIVars, err = v.saveSnapshotUEFIVars(newSnapshot.ID)
+	if err != nil {
+		removeDiskSnapshots(diskSnapshots)
+
+		return nil, err
+	}
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Create(newSnapshot)
+	if res.Error != nil || res.RowsAffected != 1 {
+		slog.Error("error saving VM snapshot", "res", res)
+		removeDiskSnapshots(diskSnapshots)
+		removeSnapshotUEFIVars(newSnapshot)
+
+		return nil, errVMInternalDB
+	}
+
+	return newSnapshot, nil
+}
+
+// recordVM stores the VM's config and ISO and NIC attachments in the snapshot
+func (s *Snapshot) recordVM(vmInst *VM) error {
+	configJSON, err := json.Marshal(vmInst.Config)
+	if err != nil {
+		return fmt.Errorf("error encoding VM config: %w", err)
+	}
+
+	isoIDs := make([]string, 0, len(vmInst.ISOs))
+	for _, anISO := range vmInst.ISOs {
+		isoIDs = append(isoIDs, anISO.ID)
+	}
+
+	isosJSON, err := json.Marshal(isoIDs)
+	if err != nil {
+		return fmt.Errorf("error encoding VM isos: %w", err)
+	}
+
+	vmNics, err := vmnic.GetNics(vmInst.Config.ID)
+	if err != nil {
+		return fmt.Errorf("error getting VM nics: %w", err)
+	}
+
+	nicIDs := make([]string, 0, len(vmNics))
+	for _, aNic := range vmNics {
+		nicIDs = append(nicIDs, aNic.ID)
+	}
+
+	nicsJSON, err := json.Marshal(nicIDs)
+	if err != nil {
+		return fmt.Errorf("error encoding VM nics: %w", err)
+	}
+
+	s.Config = string(configJSON)
+	s.ISOs = string(isosJSON)
+	s.Nics = string(nicsJSON)
+
+	return nil
+}
+
+// saveSnapshotUEFIVars copies the VM's UEFI vars file, if it has one, for the snapshot
+func (v *VM) saveSnapshotUEFIVars(snapshotID string) (bool, error) {
+	uvFileExists, err := PathExistsFunc(v.getUEFIVarsPath())
+	if err != nil {
+		return false, fmt.Errorf("error checking if UEFI state file exists: %w", err)
+	}
+
+	if !uvFileExists {
+		return false, nil
+	}
+
+	snapshotVarsPath := getSnapshotUEFIVarsPath(v.ID, snapshotID)
+
+	err = os.MkdirAll(filepath.Dir(snapshotVarsPath), 0o755)
+	if err != nil {
+		return false, fmt.Errorf("error creating snapshot directory: %w", err)
+	}
+
+	_, err = util.CopyFile(v.getUEFIVarsPath(), snapshotVarsPath)
+	if err != nil {
+		return false, fmt.Errorf("error copying UEFI state file: %w", err)
+	}
+
+	return true, nil
+}
+
+// removeSnapshotUEFIVars removes the snapshot's copy of the UEFI vars file
+func removeSnapshotUEFIVars(snapshot *Snapshot) {
+	if !snapshot.UEFIVars {
+		return
+	}
+
+	err := os.Remove(getSnapshotUEFIVarsPath(snapshot.VMID, snapshot.ID))
+	if err != nil && !os.IsNotExist(err) {
+		slog.Error("error removing snapshot UEFI state file", "snapshot", snapshot.ID, "err", err)
+	}
+}
+
+// removeDiskSnapshots removes disk snapshots, used to clean up after a failed or deleted VM snapshot
+func removeDiskSnapshots(diskSnapshots []SnapshotDisk) {
+	for _, aDiskSnapshot := range diskSnapshots {
+		aDisk, err := disk.GetByID(aDiskSnapshot.DiskID)
+		if err != nil {
+			slog.Error("error getting disk", "disk", aDiskSnapshot.DiskID, "err", err)
+
+			continue
+		}
+
+		snapshot, err := aDisk.GetSnapshotByID(aDiskSnapshot.SnapshotID)
+		if err != nil {
+			// may already be gone, for example if the disk was rolled back to an earlier snapshot
+			continue
+		}
+
+		aDisk.Lock()
+		err = aDisk.DeleteSnapshot(snapshot)
+		aDisk.Unlock()
+
+		if err != nil {
+			slog.Error("error removing disk snapshot", "disk", aDisk.ID, "snapshot", snapshot.ID, "err", err)
+		}
+	}
+}
+
+// validateSnapshotRestore checks that everything the snapshot refers to still exists and can be attached to the
+// VM, so that a restore does not fail half way through
+func (v *VM) validateSnapshotRestore(data *snapshotData, snapshot *Snapshot) ([]*iso.ISO, []*disk.Disk, error) {
+	isos := make([]*iso.ISO, 0, len(data.isoIDs))
+
+	for _, isoID := range data.isoIDs {
+		anISO, err := iso.GetByID(isoID)
+		if err != nil || anISO.Name == "" {
+			return nil, nil, fmt.Errorf("iso %s: %w", isoID, errSnapshotMissingItem)
+		}
+
+		isos = append(isos, anISO)
+	}
+
+	diskIDs := make([]string, 0, len(data.diskSnapshots))
+	for _, aDiskSnapshot := range data.diskSnapshots {
+		diskIDs = append(diskIDs, aDiskSnapshot.DiskID)
+	}
+
+	err := validateDisks(diskIDs, v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	disks := make([]*disk.Disk, 0, len(data.diskSnapshots))
+
+	for _, aDiskSnapshot := range data.diskSnapshots {
+		aDisk, err := disk.GetByID(aDiskSnapshot.DiskID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("disk %s: %w", aDiskSnapshot.DiskID, errSnapshotMissingItem)
+		}
+
+		aSnapshot, err := aDisk.GetSnapshotByID(aDiskSnapshot.SnapshotID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("snapshot of disk %s: %w", aDisk.Name, errSnapshotMissingItem)
+		}
+
+		// rolling back can't be undone, so make sure every disk can be rolled back before any is
+		backingExists, err := aDisk.SnapshotBackingExists(aSnapshot)
+		if err != nil || !backingExists {
+			return nil, nil, fmt.Errorf("snapshot backing of disk %s: %w", aDisk.Name, errSnapshotMissingItem)
+		}
+
+		disks = append(disks, aDisk)
+	}
+
+	err = v.validateNics(data.nicIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if snapshot.UEFIVars {
+		uvFileExists, err := PathExistsFunc(getSnapshotUEFIVarsPath(v.ID, snapshot.ID))
+		if err != nil || !uvFileExists {
+			return nil, nil, fmt.Errorf("UEFI state file: %w", errSnapshotMissingItem)
+		}
+	}
+
+	return isos, disks, nil
+}
+
+// RestoreSnapshot brings the VM's config, attachments, UEFI vars and disks back to the state captured by the
+// snapshot. Everything the snapshot refers to, including the backing of each disk snapshot, is checked and the UEFI
+// vars are staged before anything is changed. The config and attachments are then restored in a single transaction,
+// before the disks are rolled back, as rolling back a disk can not be undone. Should rolling back a disk still
+// fail, the error names the disks which were and were not rolled back, and restoring the snapshot again finishes
+// the job.
+func (v *VM) RestoreSnapshot(snapshot *Snapshot) error {
+	defer v.mu.Unlock()
+	v.mu.Lock()
+
+	if v.Status != STOPPED {
+		return errVMNotStopped
+	}
+
+	if snapshot == nil || snapshot.VMID != v.ID {
+		return errSnapshotNotFound
+	}
+
+	data, err := snapshot.decode()
+	if err != nil {
+		return err
+	}
+
+	isos, disks, err := v.validateSnapshotRestore(data, snapshot)
+	if err != nil {
+		return fmt.Errorf("snapshot can not be restored: %w", err)
+	}
+
+	err = v.stageSnapshotUEFIVars(snapshot)
+	if err != nil {
+		return err
+	}
+
+	// keep the identity of the config row, restore everything else
+	restoredConfig := data.config
+	restoredConfig.Model = v.Config.Model
+	restoredConfig.VMID = v.Config.VMID
+
+	err = v.restoreSnapshotDB(&restoredConfig, isos, disks, data.nicIDs)
+	if err != nil {
+		v.unstageSnapshotUEFIVars()
+
+		return err
+	}
+
+	v.Config = restoredConfig
+	v.ISOs = isos
+	v.Disks = disks
+
+	err = v.restoreSnapshotUEFIVars(snapshot)
+	if err != nil {
+		return err
+	}
+
+	err = rollbackDiskSnapshots(data.diskSnapshots, disks)
+
+	// rolling back zfs volumes destroys later disk snapshots, forget about VM snapshots which relied on those
+	v.pruneSnapshots()
+
+	return err
+}
+
+// rollbackDiskSnapshots rolls back each disk, on failure the error names the disks which were rolled back and
+// those which were not
+func rollbackDiskSnapshots(diskSnapshots []SnapshotDisk, disks []*disk.Disk) error {
+	for i, aDisk := range disks {
+		snapshot, err := aDisk.GetSnapshotByID(diskSnapshots[i].SnapshotID)
+		if err == nil {
+			aDisk.Lock()
+			err = aDisk.RollbackSnapshot(snapshot)
+			aDisk.Unlock()
+		}
+
+		if err != nil {
+			rolledBack := make([]string, 0, i)
+			for _, doneDisk := range disks[:i] {
+				rolledBack = append(rolledBack, doneDisk.Name)
+			}
+
+			notRolledBack := make([]string, 0, len(disks)-i)
+			for _, leftDisk := range disks[i:] {
+				notRolledBack = append(notRolledBack, leftDisk.Name)
+			}
+
+			slog.Error("error rolling back disk, VM snapshot partially restored",
+				"disk", aDisk.ID, "rolledBack", rolledBack, "notRolledBack", notRolledBack, "err", err,
+			)
+
+			return fmt.Errorf("%w: config restored, disks rolled back: [%s], disks not rolled back: [%s]: %w",
+				errSnapshotPartial, strings.Join(rolledBack, ", "), strings.Join(notRolledBack, ", "), err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// restoreSnapshotDB writes the restored config and attachments in one transaction
+func (v *VM) restoreSnapshotDB(restoredConfig *Config, isos []*iso.ISO, disks []*disk.Disk, nicIDs []string) error {
+	vmDB := GetVMDB()
+
+	err := vmDB.Transaction(func(txDB *gorm.DB) error {
+		res := txDB.Model(restoredConfig).Select("*").Omit("id", "created_at", "deleted_at").Updates(restoredConfig)
+		if res.Error != nil {
+			return fmt.Errorf("error updating config: %w", res.Error)
+		}
+
+		res = txDB.Exec("DELETE FROM `vm_isos` WHERE `vm_id` = ?", v.ID)
+		if res.Error != nil {
+			return fmt.Errorf("error updating isos: %w", res.Error)
+		}
+
+		for position, anISO := range isos {
+			res = txDB.Exec("INSERT INTO `vm_isos` (`vm_id`,`iso_id`, `position`) VALUES (?,?,?)", v.ID, anISO.ID, position)
+			if res.Error != nil || res.RowsAffected != 1 {
+				return fmt.Errorf("error updating isos: %w", res.Error)
+			}
+		}
+
+		res = txDB.Exec("DELETE FROM `vm_disks` WHERE `vm_id` = ?", v.ID)
+		if res.Error != nil {
+			return fmt.Errorf("error updating disks: %w", res.Error)
+		}
+
+		for position, aDisk := range disks {
+			res = txDB.Exec("INSERT INTO `vm_disks` (`vm_id`,`disk_id`, `position`) VALUES (?,?,?)", v.ID, aDisk.ID, position)
+			if res.Error != nil || res.RowsAffected != 1 {
+				return fmt.Errorf("error updating disks: %w", res.Error)
+			}
+		}
+
+		res = txDB.Exec("UPDATE `vm_nics` SET `config_id` = 0 WHERE `config_id` = ?", v.Config.ID)
+		if res.Error != nil {
+			return fmt.Errorf("error updating nics: %w", res.Error)
+		}
+
+		for _, nicID := range nicIDs {
+			res = txDB.Exec("UPDATE `vm_nics` SET `config_id` = ? WHERE `id` = ?", v.Config.ID, nicID)
+			if res.Error != nil || res.RowsAffected != 1 {
+				return fmt.Errorf("error updating nics: %w", res.Error)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		slog.Error("error restoring VM snapshot", "vm", v.ID, "err", err)
+
+		return fmt.Errorf("error restoring VM: %w", err)
+	}
+
+	return nil
+}
+
+// getStagedUEFIVarsPath returns the path the snapshot's UEFI vars are copied to before being put in place
+func (v *VM) getStagedUEFIVarsPath() string {
+	return v.getUEFIVarsPath() + ".restore"
+}
+
+// stageSnapshotUEFIVars copies the snapshot's UEFI vars next to the VM's vars file, so that putting them in
+// place is a rename which can't fail half way
+func (v *VM) stageSnapshotUEFIVars(snapshot *Snapshot) error {
+	if !snapshot.UEFIVars {
+		return nil
+	}
+
+	err := os.MkdirAll(filepath.Dir(v.getUEFIVarsPath()), 0o755)
+	if err != nil {
+		return fmt.Errorf("error creating UEFI state directory: %w", err)
+	}
+
+	_, err = util.CopyFile(getSnapshotUEFIVarsPath(v.ID, snapshot.ID), v.getStagedUEFIVarsPath())
+	if err != nil {
+		return fmt.Errorf("error copying UEFI state file: %w", err)
+	}
+
+	return nil
+}
+
+func (v *VM) unstageSnapshotUEFIVars() {
+	err := os.Remove(v.getStagedUEFIVarsPath())
+	if err != nil && !os.IsNotExist(err) {
+		slog.Error("error removing staged UEFI state file", "vm", v.ID, "err", err)
+	}
+}
+
+// restoreSnapshotUEFIVars puts the staged UEFI vars file in place, or removes the VM's vars file if it had none
+// when the snapshot was taken
+func (v *VM) restoreSnapshotUEFIVars(snapshot *Snapshot) error {
+	if !snapshot.UEFIVars {
+		return v.DeleteUEFIState()
+	}
+
+	err := os.Rename(v.getStagedUEFIVarsPath(), v.getUEFIVarsPath())
+	if err != nil {
+		return fmt.Errorf("error replacing UEFI state file: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSnapshot removes the VM snapshot along with its disk snapshots and its copy of the UEFI vars file
+func (v *VM) DeleteSnapshot(snapshot *Snapshot) error {
+	if snapshot == nil || snapshot.VMID != v.ID {
+		return errSnapshotNotFound
+	}
+
+	diskSnapshots, err := snapshot.GetDiskSnapshots()
+	if err != nil {
+		slog.Error("error getting snapshot disks", "snapshot", snapshot.ID, "err", err)
+	}
+
+	removeDiskSnapshots(diskSnapshots)
+	removeSnapshotUEFIVars(snapshot)
+
+	vmDB := GetVMDB()
+
+	res := vmDB.Limit(1).Unscoped().Delete(snapshot)
+	if res.Error != nil || res.RowsAffected != 1 {
+		slog.Error("error deleting VM snapshot", "res", res)
+
+		return errVMInternalDB
+	}
+
+	return nil
+}
+
+// DeleteSnapshots removes all snapshots of the VM, used when deleting the VM
+func (v *VM) DeleteSnapshots() error {
+	snapshots, err := v.GetSnapshots()
+	if err != nil {
+		return err
+	}
+
+	for _, aSnapshot := range snapshots {
+		err = v.DeleteSnapshot(aSnapshot)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneSnapshots removes VM snapshots which refer to disk snapshots that no longer exist, along with whatever
+// is left of them
+func (v *VM) pruneSnapshots() {
+	snapshots, err := v.GetSnapshots()
+	if err != nil {
+		return
+	}
+
+	for _, aSnapshot := range snapshots {
+		if aSnapshot.complete() {
+			continue
+		}
+
+		slog.Debug("removing incomplete VM snapshot", "vm", v.ID, "snapshot", aSnapshot.ID)
+
+		err = v.DeleteSnapshot(aSnapshot)
+		if err != nil {
+			slog.Error("error removing incomplete VM snapshot", "snapshot", aSnapshot.ID, "err", err)
+		}
+	}
+}
+
+// complete checks that all the disk snapshots which are part of the VM snapshot still exist
+func (s *Snapshot) complete() bool {
+	diskSnapshots, err := s.GetDiskSnapshots()
+	if err != nil {
+		return false
+	}
+
+	for _, aDiskSnapshot := range diskSnapshots {
+		aDisk, err := disk.GetByID(aDiskSnapshot.DiskID)
+		if err != nil {
+			return false
+		}
+
+		_, err = aDisk.GetSnapshotByID(aDiskSnapshot.SnapshotID)
+		if err != nil {
+			return false
+		}
+	}
+
+	return true
+}
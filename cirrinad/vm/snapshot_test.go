@@ -0,0 +1,315 @@
+package vm
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-test/deep"
+	"gorm.io/gorm"
+
+	"cirrina/cirrinad/cirrinadtest"
+	"cirrina/cirrinad/config"
+)
+
+var snapshotColumns = []string{
+	"id", "created_at", "updated_at", "deleted_at", "vm_id", "name", "description", "config", "isos", "nics",
+	"disk_snapshots", "uefi_vars",
+}
+
+//nolint:paralleltest
+func TestVM_GetSnapshots(t *testing.T) {
+	createUpdateTime := time.Now()
+
+	tests := []struct {
+		name        string
+		mockClosure func(testDB *gorm.DB, mock sqlmock.Sqlmock)
+		want        []*Snapshot
+		wantErr     bool
+	}{
+		{
+			name: "success",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					VMDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `vm_snapshots` WHERE vm_id = ? AND " +
+							"`vm_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1").
+					WillReturnRows(sqlmock.NewRows(snapshotColumns).
+						AddRow(
+							"0b4f9f56-5e8c-4b0e-8f0c-2a6c1c3e4d55",
+							createUpdateTime,
+							createUpdateTime,
+							nil,
+							"22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1",
+							"before-upgrade",
+							"a snapshot",
+							"{}",
+							"[]",
+							"[]",
+							`[{"disk_id":"e89be82f-25c7-42b9-823a-df432e64320e",`+
+								`"snapshot_id":"5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10"}]`,
+							true,
+						))
+			},
+			want: []*Snapshot{
+				{
+					ID:          "0b4f9f56-5e8c-4b0e-8f0c-2a6c1c3e4d55",
+					CreatedAt:   createUpdateTime,
+					UpdatedAt:   createUpdateTime,
+					VMID:        "22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1",
+					Name:        "before-upgrade",
+					Description: "a snapshot",
+					Config:      "{}",
+					ISOs:        "[]",
+					Nics:        "[]",
+					DiskSnapshots: `[{"disk_id":"e89be82f-25c7-42b9-823a-df432e64320e",` +
+						`"snapshot_id":"5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10"}]`,
+					UEFIVars: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "error",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					VMDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `vm_snapshots` WHERE vm_id = ? AND " +
+							"`vm_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1").
+					WillReturnError(gorm.ErrInvalidField) // does not matter what error is returned
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testDB, mock := cirrinadtest.NewMockDB(t.Name())
+			testCase.mockClosure(testDB, mock)
+
+			testVM := &VM{ID: "22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1"}
+
+			got, err := testVM.GetSnapshots()
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("GetSnapshots() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+
+			mock.ExpectClose()
+
+			db, err := testDB.DB()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = db.Close()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = mock.ExpectationsWereMet()
+			if err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_ValidateNewSnapshotName(t *testing.T) {
+	createUpdateTime := time.Now()
+
+	tests := []struct {
+		name         string
+		mockClosure  func(testDB *gorm.DB, mock sqlmock.Sqlmock)
+		snapshotName string
+		wantErr      bool
+	}{
+		{
+			name:         "invalidName",
+			mockClosure:  func(_ *gorm.DB, _ sqlmock.Sqlmock) {},
+			snapshotName: "not/valid",
+			wantErr:      true,
+		},
+		{
+			name: "duplicateName",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					VMDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `vm_snapshots` WHERE vm_id = ? AND " +
+							"`vm_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1").
+					WillReturnRows(sqlmock.NewRows(snapshotColumns).
+						AddRow(
+							"0b4f9f56-5e8c-4b0e-8f0c-2a6c1c3e4d55",
+							createUpdateTime,
+							createUpdateTime,
+							nil,
+							"22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1",
+							"before-upgrade",
+							"a snapshot",
+							"{}",
+							"[]",
+							"[]",
+							"[]",
+							false,
+						))
+			},
+			snapshotName: "before-upgrade",
+			wantErr:      true,
+		},
+		{
+			name: "success",
+			mockClosure: func(testDB *gorm.DB, mock sqlmock.Sqlmock) {
+				Instance = &Singleton{ // prevents parallel testing
+					VMDB: testDB,
+				}
+
+				mock.ExpectQuery(
+					regexp.QuoteMeta(
+						"SELECT * FROM `vm_snapshots` WHERE vm_id = ? AND " +
+							"`vm_snapshots`.`deleted_at` IS NULL ORDER BY created_at"),
+				).
+					WithArgs("22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1").
+					WillReturnRows(sqlmock.NewRows(snapshotColumns))
+			},
+			snapshotName: "after-upgrade",
+			wantErr:      false,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testDB, mock := cirrinadtest.NewMockDB(t.Name())
+			testCase.mockClosure(testDB, mock)
+
+			testVM := &VM{ID: "22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1"}
+
+			err := testVM.ValidateNewSnapshotName(testCase.snapshotName)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ValidateNewSnapshotName() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			mock.ExpectClose()
+
+			db, err := testDB.DB()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = db.Close()
+			if err != nil {
+				t.Error(err)
+			}
+
+			err = mock.ExpectationsWereMet()
+			if err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestSnapshot_decode(t *testing.T) {
+	tests := []struct {
+		name     string
+		snapshot Snapshot
+		want     *snapshotData
+		wantErr  bool
+	}{
+		{
+			name: "success",
+			snapshot: Snapshot{
+				Config: `{"CPU":2,"Mem":1024}`,
+				ISOs:   `["a3b8c3a4-5f44-4d2b-9c63-76b6d7e0c0a1"]`,
+				Nics:   `["f2d3c1b0-9a8e-4c7d-8b6a-5e4f3d2c1b0a"]`,
+				DiskSnapshots: `[{"disk_id":"e89be82f-25c7-42b9-823a-df432e64320e",` +
+					`"snapshot_id":"5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10"}]`,
+			},
+			want: &snapshotData{
+				config: Config{CPU: 2, Mem: 1024},
+				isoIDs: []string{"a3b8c3a4-5f44-4d2b-9c63-76b6d7e0c0a1"},
+				nicIDs: []string{"f2d3c1b0-9a8e-4c7d-8b6a-5e4f3d2c1b0a"},
+				diskSnapshots: []SnapshotDisk{
+					{
+						DiskID:     "e89be82f-25c7-42b9-823a-df432e64320e",
+						SnapshotID: "5b3b7c1e-2b0b-4f5e-9a43-3a1e1f0e7d10",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "badConfig",
+			snapshot: Snapshot{
+				Config:        `{`,
+				ISOs:          `[]`,
+				Nics:          `[]`,
+				DiskSnapshots: `[]`,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "badDisks",
+			snapshot: Snapshot{
+				Config:        `{}`,
+				ISOs:          `[]`,
+				Nics:          `[]`,
+				DiskSnapshots: `{}`,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := testCase.snapshot.decode()
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("decode() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestGetSnapshotUEFIVarsPath(t *testing.T) {
+	config.Config.Disk.VM.Path.State = "/bhyve/state"
+
+	got := getSnapshotUEFIVarsPath("22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1", "0b4f9f56-5e8c-4b0e-8f0c-2a6c1c3e4d55")
+	want := "/bhyve/state/.snapshots/22a719c6-a4e7-4a1e-9a2a-4e2b0e2ed3a1/0b4f9f56-5e8c-4b0e-8f0c-2a6c1c3e4d55_UEFI_VARS.fd"
+
+	if got != want {
+		t.Errorf("getSnapshotUEFIVarsPath() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,220 @@
+package vm
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+type StopStageType string
+
+const (
+	StopStageACPI     StopStageType = "acpi"     // ACPI power button, same as the default stop
+	StopStageSerial   StopStageType = "serial"   // type the shutdown command on com1
+	StopStageAgent    StopStageType = "agent"    // ask the guest agent to power off
+	StopStagePowerOff StopStageType = "poweroff" // bhyvectl --force-poweroff
+)
+
+// serialShutdownCmd is typed on com1 by the serial stop stage
+const serialShutdownCmd = "shutdown -p now"
+
+type StopStage struct {
+	Type StopStageType
+	Wait uint32 // seconds to wait for the VM to stop after running this stage
+}
+
+// ParseStopPolicy parses a stop policy in the form "agent:30,acpi:60,serial:30,poweroff:10". The wait may be left off
+// the poweroff stage. An empty policy means the VM is stopped the default way, using MaxWait.
+func ParseStopPolicy(policy string) ([]StopStage, error) {
+	var stages []StopStage
+
+	if policy == "" {
+		return stages, nil
+	}
+
+	for _, stageStr := range strings.Split(policy, ",") {
+		stageType, waitStr, hasWait := strings.Cut(strings.TrimSpace(stageStr), ":")
+
+		stage := StopStage{Type: StopStageType(stageType)}
+
+		switch stage.Type {
+		case StopStageACPI, StopStageSerial, StopStageAgent:
+			if !hasWait {
+				return nil, fmt.Errorf("%w: stage %s requires a wait", errStopPolicyInvalid, stageType)
+			}
+		case StopStagePowerOff:
+			stage.Wait = uint32(powerOffWait.Seconds())
+		default:
+			return nil, fmt.Errorf("%w: unknown stage %s", errStopPolicyInvalid, stageType)
+		}
+
+		if hasWait {
+			wait, err := strconv.ParseUint(waitStr, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid wait %s", errStopPolicyInvalid, waitStr)
+			}
+
+			stage.Wait = uint32(wait)
+		}
+
+		stages = append(stages, stage)
+	}
+
+	return stages, nil
+}
+
+// StopStage returns the stop stage currently running, or an empty string if the VM is not stopping
+func (v *VM) StopStage() StopStageType {
+	defer v.mu.RUnlock()
+	v.mu.RLock()
+
+	return v.stopStage
+}
+
+func (v *VM) setStopStage(stage StopStageType) {
+	defer v.mu.Unlock()
+	v.mu.Lock()
+	v.stopStage = stage
+}
+
+// runStopPolicy runs each stage of the stop policy in turn, moving on to the next stage when the VM has not stopped
+// within the stage wait. If the VM is still running after the last stage, the process is stopped the default way.
+func (v *VM) runStopPolicy(stages []StopStage) error {
+	for _, stage := range stages {
+		v.setStopStage(stage.Type)
+		v.log.Info("stop stage", "stage", stage.Type, "wait", stage.Wait)
+
+		err := v.runStopStage(stage.Type)
+		if err != nil {
+			slog.Error("stop stage failed", "vm", v.Name, "stage", stage.Type, "err", err)
+
+			continue
+		}
+
+		err = v.waitStopped(time.Duration(stage.Wait) * time.Second)
+		if err == nil {
+			return nil
+		}
+	}
+
+	slog.Warn("VM still running after stop policy, killing", "vm", v.Name)
+
+	return v.stopProc()
+}
+
+func (v *VM) runStopStage(stageType StopStageType) error {
+	switch stageType {
+	case StopStageACPI:
+		if v.proc == nil {
+			return v.termAdopted()
+		}
+
+		// bhyve turns SIGTERM into an ACPI power button press, sudo passes it along
+		err := syscall.Kill(v.proc.Pid(), syscall.SIGTERM)
+		if err != nil {
+			return fmt.Errorf("error signaling VM: %w", err)
+		}
+	case StopStageSerial:
+		if !v.Config.Com1 || v.Com1 == nil {
+			return errVMStopNoSerial
+		}
+
+		_, err := v.Com1.Write([]byte("\r" + serialShutdownCmd + "\r"))
+		if err != nil {
+			return fmt.Errorf("error writing to com1: %w", err)
+		}
+	case StopStageAgent:
+		return v.AgentShutdown()
+	case StopStagePowerOff:
+		return v.runBhyvectl("--force-poweroff")
+	default:
+		return errStopPolicyInvalid
+	}
+
+	return nil
+}
+
+// StopAll stops all running VMs using their stop policies, in the reverse of boot order. The VMs of each boot stage
+// are stopped in parallel. Any VM still running when the timeout expires is forcibly powered off.
+func StopAll(timeout time.Duration) {
+	var runningVMs []*VM
+
+	restartsDisabled.Store(true)
+
+	deadline := time.Now().Add(timeout)
+
+	List.Mu.RLock()
+	for _, vmInst := range List.VMList {
+		if vmInst.Status != STOPPED {
+			runningVMs = append(runningVMs, vmInst)
+		}
+	}
+	List.Mu.RUnlock()
+
+	stages := bootStages(runningVMs)
+	slices.Reverse(stages)
+
+	for _, stage := range stages {
+		if !stopVMs(stage, time.Until(deadline)) {
+			slog.Warn("timed out stopping VMs, powering off remaining VMs", "timeout", timeout)
+			powerOffAll()
+
+			return
+		}
+	}
+}
+
+// stopVMs stops the VMs in parallel, returning false if they have not all stopped within the timeout
+func stopVMs(vms []*VM, timeout time.Duration) bool {
+	var stopWaitGroup sync.WaitGroup
+
+	for _, vmInst := range vms {
+		stopWaitGroup.Add(1)
+
+		go func(aVMInst *VM) {
+			defer stopWaitGroup.Done()
+
+			err := aVMInst.Stop()
+			if err != nil {
+				slog.Error("error stopping VM", "vm", aVMInst.Name, "err", err)
+			}
+		}(vmInst)
+	}
+
+	stopped := make(chan struct{})
+
+	go func() {
+		stopWaitGroup.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// powerOffAll forcibly powers off all VMs which are still running
+func powerOffAll() {
+	List.Mu.RLock()
+	for _, vmInst := range List.VMList {
+		if !vmInst.Running() {
+			continue
+		}
+
+		go func(aVMInst *VM) {
+			err := aVMInst.PowerOff()
+			if err != nil {
+				slog.Error("error powering off VM", "vm", aVMInst.Name, "err", err)
+			}
+		}(vmInst)
+	}
+	List.Mu.RUnlock()
+}
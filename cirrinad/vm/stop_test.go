@@ -0,0 +1,88 @@
+package vm
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+//nolint:paralleltest
+func TestParseStopPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		want    []StopStage
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			policy:  "",
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:   "allStages",
+			policy: "agent:30,acpi:60,serial:30,poweroff:10",
+			want: []StopStage{
+				{Type: StopStageAgent, Wait: 30},
+				{Type: StopStageACPI, Wait: 60},
+				{Type: StopStageSerial, Wait: 30},
+				{Type: StopStagePowerOff, Wait: 10},
+			},
+			wantErr: false,
+		},
+		{
+			name:   "powerOffDefaultWait",
+			policy: "acpi:120, poweroff",
+			want: []StopStage{
+				{Type: StopStageACPI, Wait: 120},
+				{Type: StopStagePowerOff, Wait: 30},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missingWait",
+			policy:  "acpi,poweroff",
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "badWait",
+			policy:  "acpi:soon",
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "unknownStage",
+			policy:  "acpi:60,reset:10",
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := ParseStopPolicy(testCase.policy)
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("ParseStopPolicy() error = %v, wantErr %v", err, testCase.wantErr)
+
+				return
+			}
+
+			diff := deep.Equal(got, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_runStopStageSerialNoCom(t *testing.T) {
+	testVM := VM{Name: "test2024101801"}
+
+	err := testVM.runStopStage(StopStageSerial)
+	if err == nil {
+		t.Errorf("runStopStage() expected error without com1")
+	}
+}
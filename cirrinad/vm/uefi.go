@@ -10,6 +10,11 @@ import (
 )
 
 func (v *VM) createUefiVarsFile() {
+	firmware, hasROM := v.getFirmware()
+	if !hasROM || firmware.Vars.Template == "" {
+		return
+	}
+
 	uefiVarsFilePath := config.Config.Disk.VM.Path.State + "/" + v.Name
 	uefiVarsFile := uefiVarsFilePath + "/BHYVE_UEFI_VARS.fd"
 
@@ -33,7 +38,7 @@ func (v *VM) createUefiVarsFile() {
 	}
 
 	if !uvFileExists {
-		_, err = util.CopyFile(config.Config.Rom.Vars.Template, uefiVarsFile)
+		_, err = util.CopyFile(firmware.Vars.Template, uefiVarsFile)
 		if err != nil {
 			slog.Error("failed to copy uefiVars template", "err", err)
 		}
@@ -0,0 +1,428 @@
+package vm
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cirrina/cirrinad/iso"
+)
+
+const (
+	uefiBootOrderVar         = "BootOrder"
+	uefiBootNextVar          = "BootNext"
+	uefiLoadOptionActive     = 0x1
+	uefiLoadOptionHeaderSize = 6
+	uefiBootISODescription   = "cirrina ISO "
+)
+
+// UEFIBootEntry is one of the Boot#### load options in a VM's UEFI variables
+type UEFIBootEntry struct {
+	Number      uint16
+	Description string
+	Active      bool
+	DevicePath  string
+}
+
+// uefiLoadOption is an EFI_LOAD_OPTION, the data of a Boot#### variable
+type uefiLoadOption struct {
+	attributes  uint32
+	description string
+	devicePath  []byte
+}
+
+func uefiBootVarName(number uint16) string {
+	return fmt.Sprintf("Boot%04X", number)
+}
+
+// parseUEFIBootVarName returns the number of a Boot#### variable, or false for other variables
+func parseUEFIBootVarName(name string) (uint16, bool) {
+	if len(name) != len("Boot####") || !strings.HasPrefix(name, "Boot") {
+		return 0, false
+	}
+
+	number, err := strconv.ParseUint(name[len("Boot"):], 16, 16)
+	if err != nil {
+		return 0, false
+	}
+
+	return uint16(number), true
+}
+
+func parseUEFILoadOption(data []byte) (uefiLoadOption, error) {
+	if len(data) < uefiLoadOptionHeaderSize {
+		return uefiLoadOption{}, fmt.Errorf("%w: load option too short", errVMUEFIVarsInvalid)
+	}
+
+	devicePathLen := int(binary.LittleEndian.Uint16(data[4:]))
+
+	descriptionEnd := uefiLoadOptionHeaderSize
+	for descriptionEnd+1 < len(data) && binary.LittleEndian.Uint16(data[descriptionEnd:]) != 0 {
+		descriptionEnd += 2
+	}
+
+	devicePathStart := descriptionEnd + 2
+	if devicePathStart+devicePathLen > len(data) {
+		return uefiLoadOption{}, fmt.Errorf("%w: load option device path overruns the option", errVMUEFIVarsInvalid)
+	}
+
+	return uefiLoadOption{
+		attributes:  binary.LittleEndian.Uint32(data),
+		description: decodeUCS2(data[uefiLoadOptionHeaderSize:descriptionEnd]),
+		devicePath:  data[devicePathStart : devicePathStart+devicePathLen],
+	}, nil
+}
+
+func (o uefiLoadOption) encode() []byte {
+	description := encodeUCS2(o.description)
+
+	data := make([]byte, uefiLoadOptionHeaderSize, uefiLoadOptionHeaderSize+len(description)+len(o.devicePath))
+	binary.LittleEndian.PutUint32(data, o.attributes)
+	binary.LittleEndian.PutUint16(data[4:], uint16(len(o.devicePath)))
+	data = append(data, description...)
+
+	return append(data, o.devicePath...)
+}
+
+func encodeUEFIBootNumbers(numbers []uint16) []byte {
+	data := make([]byte, 2*len(numbers))
+	for i, number := range numbers {
+		binary.LittleEndian.PutUint16(data[2*i:], number)
+	}
+
+	return data
+}
+
+func decodeUEFIBootNumbers(data []byte) []uint16 {
+	numbers := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		numbers = append(numbers, binary.LittleEndian.Uint16(data[i:]))
+	}
+
+	return numbers
+}
+
+// bootOptions returns the store's Boot#### load options by number, options which fail to parse are skipped
+func (s *uefiVarStore) bootOptions() map[uint16]uefiLoadOption {
+	options := make(map[uint16]uefiLoadOption)
+
+	for _, variable := range s.vars {
+		if variable.vendor != uefiGlobalVariableGUID {
+			continue
+		}
+
+		number, isBootVar := parseUEFIBootVarName(variable.name)
+		if !isBootVar {
+			continue
+		}
+
+		option, err := parseUEFILoadOption(variable.data)
+		if err != nil {
+			slog.Debug("skipping invalid boot entry", "name", variable.name, "err", err)
+
+			continue
+		}
+
+		options[number] = option
+	}
+
+	return options
+}
+
+func (s *uefiVarStore) bootEntries() []UEFIBootEntry {
+	options := s.bootOptions()
+	entries := make([]UEFIBootEntry, 0, len(options))
+
+	for number, option := range options {
+		entries = append(entries, UEFIBootEntry{
+			Number:      number,
+			Description: option.description,
+			Active:      option.attributes&uefiLoadOptionActive != 0,
+			DevicePath:  devicePathText(option.devicePath),
+		})
+	}
+
+	slices.SortFunc(entries, func(a, b UEFIBootEntry) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+
+	return entries
+}
+
+func (s *uefiVarStore) bootOrder() []uint16 {
+	data, _ := s.get(uefiGlobalVariableGUID, uefiBootOrderVar)
+
+	return decodeUEFIBootNumbers(data)
+}
+
+func (s *uefiVarStore) bootNext() *uint16 {
+	data, found := s.get(uefiGlobalVariableGUID, uefiBootNextVar)
+	if !found || len(data) != 2 {
+		return nil
+	}
+
+	next := binary.LittleEndian.Uint16(data)
+
+	return &next
+}
+
+func (s *uefiVarStore) setBootOrder(order []uint16) error {
+	options := s.bootOptions()
+
+	for i, number := range order {
+		if _, found := options[number]; !found {
+			return fmt.Errorf("%w: %s", errVMUEFIBootNotFound, uefiBootVarName(number))
+		}
+
+		if slices.Contains(order[:i], number) {
+			return fmt.Errorf("%w: %s", errVMUEFIBootOrderDupe, uefiBootVarName(number))
+		}
+	}
+
+	return s.set(uefiGlobalVariableGUID, uefiBootOrderVar, uefiVarBootAttributes, encodeUEFIBootNumbers(order))
+}
+
+// setBootNext sets the entry booted on the next boot only, nil clears it
+func (s *uefiVarStore) setBootNext(next *uint16) error {
+	if next == nil {
+		s.remove(uefiGlobalVariableGUID, uefiBootNextVar)
+
+		return nil
+	}
+
+	if _, found := s.bootOptions()[*next]; !found {
+		return fmt.Errorf("%w: %s", errVMUEFIBootNotFound, uefiBootVarName(*next))
+	}
+
+	return s.set(uefiGlobalVariableGUID, uefiBootNextVar, uefiVarBootAttributes, encodeUEFIBootNumbers([]uint16{*next}))
+}
+
+// bootOnce makes the device the next boot only, using the boot entry the firmware already has for it or adding one
+func (s *uefiVarStore) bootOnce(description string, devicePath []byte) (uint16, error) {
+	options := s.bootOptions()
+
+	number, found := uint16(0), false
+
+	for optionNumber, option := range options {
+		if bytes.Equal(option.devicePath, devicePath) && (!found || optionNumber < number) {
+			number, found = optionNumber, true
+		}
+	}
+
+	if !found {
+		for number = 0; ; number++ {
+			if _, used := options[number]; !used {
+				break
+			}
+
+			if number == 0xFFFF {
+				return 0, errVMUEFIBootFull
+			}
+		}
+
+		option := uefiLoadOption{attributes: uefiLoadOptionActive, description: description, devicePath: devicePath}
+
+		err := s.set(uefiGlobalVariableGUID, uefiBootVarName(number), uefiVarBootAttributes, option.encode())
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return number, s.setBootNext(&number)
+}
+
+// readUEFIVars reads and parses the VM's UEFI vars file
+func (v *VM) readUEFIVars() (*uefiVarStore, error) {
+	if !v.Config.StoreUEFIVars {
+		return nil, errVMUEFIVarsNotStored
+	}
+
+	raw, err := os.ReadFile(v.getUEFIVarsPath())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errVMUEFIVarsNotCreated
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading UEFI vars file: %w", err)
+	}
+
+	return parseUEFIVarStore(raw)
+}
+
+// changeUEFIVars applies the change to the VM's UEFI vars file, creating the file from the firmware's template if
+// the VM was never started. The VM must be stopped, bhyve maps the file into the guest while it runs.
+func (v *VM) changeUEFIVars(change func(store *uefiVarStore) error) error {
+	defer vmStartLock.Unlock()
+	vmStartLock.Lock()
+
+	if v.Status != STOPPED {
+		return errVMNotStopped
+	}
+
+	if !v.Config.StoreUEFIVars {
+		return errVMUEFIVarsNotStored
+	}
+
+	v.createUefiVarsFile()
+
+	store, err := v.readUEFIVars()
+	if err != nil {
+		return err
+	}
+
+	err = change(store)
+	if err != nil {
+		return err
+	}
+
+	return writeUEFIVarsFile(v.getUEFIVarsPath(), store.raw)
+}
+
+// writeUEFIVarsFile replaces the vars file, so that it is never left half written
+func writeUEFIVarsFile(uefiVarsPath string, raw []byte) error {
+	newPath := uefiVarsPath + ".new"
+
+	err := os.WriteFile(newPath, raw, 0o600)
+	if err != nil {
+		return fmt.Errorf("error writing UEFI vars file: %w", err)
+	}
+
+	err = os.Rename(newPath, uefiVarsPath)
+	if err != nil {
+		_ = os.Remove(newPath)
+
+		return fmt.Errorf("error replacing UEFI vars file: %w", err)
+	}
+
+	return nil
+}
+
+// GetUEFIBootEntries returns the Boot#### entries in the VM's UEFI variables, sorted by number
+func (v *VM) GetUEFIBootEntries() ([]UEFIBootEntry, error) {
+	store, err := v.readUEFIVars()
+	if err != nil {
+		return nil, err
+	}
+
+	return store.bootEntries(), nil
+}
+
+// GetUEFIBootOrder returns the boot entry numbers the firmware tries in order, and the one it boots next time only
+// or nil if none is set
+func (v *VM) GetUEFIBootOrder() ([]uint16, *uint16, error) {
+	store, err := v.readUEFIVars()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return store.bootOrder(), store.bootNext(), nil
+}
+
+// SetUEFIBootOrder sets the boot entry numbers the firmware tries in order
+func (v *VM) SetUEFIBootOrder(order []uint16) error {
+	return v.changeUEFIVars(func(store *uefiVarStore) error {
+		return store.setBootOrder(order)
+	})
+}
+
+// SetUEFIBootNext sets the boot entry the firmware boots the next time only, nil clears it
+func (v *VM) SetUEFIBootNext(next *uint16) error {
+	return v.changeUEFIVars(func(store *uefiVarStore) error {
+		return store.setBootNext(next)
+	})
+}
+
+// BootISOOnce makes the next boot of the VM boot from one of its ISOs, or its first ISO if the ID is empty, and
+// returns the number of the boot entry used
+func (v *VM) BootISOOnce(isoID string) (uint16, error) {
+	isoIdx := slices.IndexFunc(v.ISOs, func(isoItem *iso.ISO) bool {
+		return isoItem != nil && (isoID == "" || isoItem.ID == isoID)
+	})
+	if isoIdx < 0 {
+		return 0, fmt.Errorf("%w: %s", errVMUEFIBootISO, isoID)
+	}
+
+	isoName := v.ISOs[isoIdx].Name
+
+	deviceMap, err := v.DeviceMap()
+	if err != nil {
+		return 0, err
+	}
+
+	deviceIdx := slices.IndexFunc(deviceMap, func(device PCIDevice) bool {
+		return device.Device == AttachISO+":"+isoName && device.Port >= 0
+	})
+	if deviceIdx < 0 {
+		return 0, fmt.Errorf("%w: %s", errVMUEFIBootISO, isoName)
+	}
+
+	devicePath := sataCDDevicePath(deviceMap[deviceIdx].Addr, deviceMap[deviceIdx].Port)
+
+	var number uint16
+
+	err = v.changeUEFIVars(func(store *uefiVarStore) error {
+		number, err = store.bootOnce(uefiBootISODescription+isoName, devicePath)
+
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	slog.Debug("VM will boot ISO once", "vm", v.Name, "iso", isoName, "bootEntry", uefiBootVarName(number))
+
+	return number, nil
+}
+
+// GetUEFIVars returns the contents of the VM's UEFI vars file
+func (v *VM) GetUEFIVars() ([]byte, error) {
+	store, err := v.readUEFIVars()
+	if err != nil {
+		return nil, err
+	}
+
+	return store.raw, nil
+}
+
+// SetUEFIVars replaces the VM's UEFI vars file, with one backed up by GetUEFIVars
+func (v *VM) SetUEFIVars(raw []byte) error {
+	_, err := parseUEFIVarStore(raw)
+	if err != nil {
+		return err
+	}
+
+	firmware, hasROM := v.getFirmware()
+	if hasROM && firmware.Vars.Template != "" {
+		templateInfo, statErr := os.Stat(firmware.Vars.Template)
+		if statErr == nil && templateInfo.Size() != int64(len(raw)) {
+			return fmt.Errorf("%w: %d bytes, template has %d", errVMUEFIVarsSize, len(raw), templateInfo.Size())
+		}
+	}
+
+	defer vmStartLock.Unlock()
+	vmStartLock.Lock()
+
+	if v.Status != STOPPED {
+		return errVMNotStopped
+	}
+
+	if !v.Config.StoreUEFIVars {
+		return errVMUEFIVarsNotStored
+	}
+
+	err = os.MkdirAll(filepath.Dir(v.getUEFIVarsPath()), 0o755)
+	if err != nil {
+		return fmt.Errorf("error creating UEFI vars path: %w", err)
+	}
+
+	return writeUEFIVarsFile(v.getUEFIVarsPath(), raw)
+}
@@ -0,0 +1,321 @@
+package vm
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-test/deep"
+
+	"cirrina/cirrinad/config"
+)
+
+// newTestUEFIBootVars returns a vars file with the boot entries the firmware adds for a VM with a disk and a CD
+func newTestUEFIBootVars(t *testing.T) []byte {
+	t.Helper()
+
+	raw := newTestUEFIVars(true, 4096)
+
+	store, err := parseUEFIVarStore(raw)
+	if err != nil {
+		t.Fatalf("parseUEFIVarStore() error = %v", err)
+	}
+
+	options := []uefiLoadOption{
+		{attributes: uefiLoadOptionActive, description: "UEFI BHYVE SATA DISK", devicePath: sataCDDevicePath(
+			PCIAddr{Slot: 2}, 0,
+		)},
+		{attributes: uefiLoadOptionActive, description: "UEFI BHYVE SATA DVD ROM", devicePath: sataCDDevicePath(
+			PCIAddr{Slot: 2}, 1,
+		)},
+		{attributes: 0, description: "EFI Internal Shell", devicePath: nil},
+	}
+
+	for number, option := range options {
+		err = store.set(uefiGlobalVariableGUID, uefiBootVarName(uint16(number)), uefiVarBootAttributes, option.encode())
+		if err != nil {
+			t.Fatalf("set() error = %v", err)
+		}
+	}
+
+	err = store.set(uefiGlobalVariableGUID, "BootOrder", uefiVarBootAttributes, []byte{0, 0, 1, 0, 2, 0})
+	if err != nil {
+		t.Fatalf("set() error = %v", err)
+	}
+
+	return raw
+}
+
+//nolint:paralleltest
+func Test_parseUEFIBootVarName(t *testing.T) {
+	tests := []struct {
+		name      string
+		wantNum   uint16
+		wantFound bool
+	}{
+		{name: "Boot0000", wantNum: 0, wantFound: true},
+		{name: "Boot001A", wantNum: 0x1A, wantFound: true},
+		{name: "BootNext"},
+		{name: "BootOrder"},
+		{name: "Boot00001"},
+		{name: "Lang"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			gotNum, gotFound := parseUEFIBootVarName(testCase.name)
+			if gotNum != testCase.wantNum || gotFound != testCase.wantFound {
+				t.Errorf("parseUEFIBootVarName() = %v, %v, want %v, %v",
+					gotNum, gotFound, testCase.wantNum, testCase.wantFound)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestUEFIVarStore_bootEntries(t *testing.T) {
+	store, err := parseUEFIVarStore(newTestUEFIBootVars(t))
+	if err != nil {
+		t.Fatalf("parseUEFIVarStore() error = %v", err)
+	}
+
+	// a corrupt entry is skipped
+	err = store.set(uefiGlobalVariableGUID, "Boot0003", uefiVarBootAttributes, []byte{1, 0})
+	if err != nil {
+		t.Fatalf("set() error = %v", err)
+	}
+
+	want := []UEFIBootEntry{
+		{
+			Number:      0,
+			Description: "UEFI BHYVE SATA DISK",
+			Active:      true,
+			DevicePath:  "PciRoot(0x0)/Pci(0x2,0x0)/Sata(0x0,0xFFFF,0x0)",
+		},
+		{
+			Number:      1,
+			Description: "UEFI BHYVE SATA DVD ROM",
+			Active:      true,
+			DevicePath:  "PciRoot(0x0)/Pci(0x2,0x0)/Sata(0x1,0xFFFF,0x0)",
+		},
+		{Number: 2, Description: "EFI Internal Shell"},
+	}
+
+	diff := deep.Equal(store.bootEntries(), want)
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	diff = deep.Equal(store.bootOrder(), []uint16{0, 1, 2})
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	if store.bootNext() != nil {
+		t.Errorf("bootNext() = %v, want nil", *store.bootNext())
+	}
+}
+
+//nolint:paralleltest
+func TestUEFIVarStore_setBootOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   []uint16
+		wantErr error
+	}{
+		{name: "reorder", order: []uint16{1, 0}},
+		{name: "empty", order: []uint16{}},
+		{name: "unknownEntry", order: []uint16{1, 7}, wantErr: errVMUEFIBootNotFound},
+		{name: "duplicate", order: []uint16{1, 0, 1}, wantErr: errVMUEFIBootOrderDupe},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			store, err := parseUEFIVarStore(newTestUEFIBootVars(t))
+			if err != nil {
+				t.Fatalf("parseUEFIVarStore() error = %v", err)
+			}
+
+			err = store.setBootOrder(testCase.order)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("setBootOrder() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			want := testCase.order
+			if err != nil {
+				want = []uint16{0, 1, 2}
+			}
+
+			diff := deep.Equal(store.bootOrder(), want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestUEFIVarStore_bootOnce(t *testing.T) {
+	tests := []struct {
+		name            string
+		devicePath      []byte
+		wantNum         uint16
+		wantDescription string
+	}{
+		{
+			name:            "existingEntry",
+			devicePath:      sataCDDevicePath(PCIAddr{Slot: 2}, 1),
+			wantNum:         1,
+			wantDescription: "UEFI BHYVE SATA DVD ROM",
+		},
+		{
+			name:            "newEntry",
+			devicePath:      sataCDDevicePath(PCIAddr{Slot: 5}, 0),
+			wantNum:         3,
+			wantDescription: "cirrina ISO install.iso",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			raw := newTestUEFIBootVars(t)
+
+			store, err := parseUEFIVarStore(raw)
+			if err != nil {
+				t.Fatalf("parseUEFIVarStore() error = %v", err)
+			}
+
+			gotNum, err := store.bootOnce("cirrina ISO install.iso", testCase.devicePath)
+			if err != nil {
+				t.Fatalf("bootOnce() error = %v", err)
+			}
+
+			if gotNum != testCase.wantNum {
+				t.Errorf("bootOnce() = %v, want %v", gotNum, testCase.wantNum)
+			}
+
+			reparsed, err := parseUEFIVarStore(raw)
+			if err != nil {
+				t.Fatalf("parseUEFIVarStore() error = %v", err)
+			}
+
+			bootNext := reparsed.bootNext()
+			if bootNext == nil || *bootNext != testCase.wantNum {
+				t.Errorf("bootNext() = %v, want %v", bootNext, testCase.wantNum)
+			}
+
+			option := reparsed.bootOptions()[testCase.wantNum]
+			if option.description != testCase.wantDescription || option.attributes&uefiLoadOptionActive == 0 {
+				t.Errorf("boot entry = %v, want active %v", option, testCase.wantDescription)
+			}
+
+			// booting once leaves the boot order alone
+			diff := deep.Equal(reparsed.bootOrder(), []uint16{0, 1, 2})
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVM_UEFIVars(t *testing.T) {
+	stateDir := t.TempDir()
+	templatePath := filepath.Join(stateDir, "BHYVE_UEFI_VARS.fd")
+
+	err := os.WriteFile(templatePath, newTestUEFIBootVars(t), 0o600)
+	if err != nil {
+		t.Fatalf("failed writing vars template: %v", err)
+	}
+
+	config.Config.Disk.VM.Path.State = stateDir
+	config.Config.Rom.Path = templatePath
+	config.Config.Rom.Vars.Template = templatePath
+
+	t.Cleanup(func() {
+		config.Config.Disk.VM.Path.State = ""
+		config.Config.Rom.Path = ""
+		config.Config.Rom.Vars.Template = ""
+	})
+
+	testVM := &VM{Name: "uefiTestVM", Status: STOPPED, Config: Config{StoreUEFIVars: true}}
+
+	// the vars file is only created from the template when first needed
+	_, err = testVM.GetUEFIBootEntries()
+	if !errors.Is(err, errVMUEFIVarsNotCreated) {
+		t.Errorf("GetUEFIBootEntries() error = %v, want %v", err, errVMUEFIVarsNotCreated)
+	}
+
+	err = testVM.SetUEFIBootOrder([]uint16{2, 1})
+	if err != nil {
+		t.Fatalf("SetUEFIBootOrder() error = %v", err)
+	}
+
+	bootNext := uint16(1)
+
+	err = testVM.SetUEFIBootNext(&bootNext)
+	if err != nil {
+		t.Fatalf("SetUEFIBootNext() error = %v", err)
+	}
+
+	gotOrder, gotNext, err := testVM.GetUEFIBootOrder()
+	if err != nil {
+		t.Fatalf("GetUEFIBootOrder() error = %v", err)
+	}
+
+	diff := deep.Equal([]any{gotOrder, gotNext}, []any{[]uint16{2, 1}, &bootNext})
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	backup, err := testVM.GetUEFIVars()
+	if err != nil {
+		t.Fatalf("GetUEFIVars() error = %v", err)
+	}
+
+	err = testVM.SetUEFIBootNext(nil)
+	if err != nil {
+		t.Fatalf("SetUEFIBootNext() error = %v", err)
+	}
+
+	err = testVM.SetUEFIVars(backup)
+	if err != nil {
+		t.Fatalf("SetUEFIVars() error = %v", err)
+	}
+
+	_, gotNext, _ = testVM.GetUEFIBootOrder()
+	if gotNext == nil || *gotNext != bootNext {
+		t.Errorf("GetUEFIBootOrder() after restore next = %v, want %v", gotNext, bootNext)
+	}
+
+	err = testVM.SetUEFIVars(backup[:2048])
+	if !errors.Is(err, errVMUEFIVarsInvalid) {
+		t.Errorf("SetUEFIVars() of truncated file error = %v, want %v", err, errVMUEFIVarsInvalid)
+	}
+
+	err = testVM.SetUEFIVars(append(backup, make([]byte, 4096)...))
+	if !errors.Is(err, errVMUEFIVarsSize) {
+		t.Errorf("SetUEFIVars() of larger file error = %v, want %v", err, errVMUEFIVarsSize)
+	}
+
+	testVM.Status = RUNNING
+
+	err = testVM.SetUEFIBootOrder([]uint16{1})
+	if !errors.Is(err, errVMNotStopped) {
+		t.Errorf("SetUEFIBootOrder() of running VM error = %v, want %v", err, errVMNotStopped)
+	}
+
+	err = testVM.SetUEFIVars(backup)
+	if !errors.Is(err, errVMNotStopped) {
+		t.Errorf("SetUEFIVars() of running VM error = %v, want %v", err, errVMNotStopped)
+	}
+
+	testVM.Status = STOPPED
+	testVM.Config.StoreUEFIVars = false
+
+	_, err = testVM.GetUEFIVars()
+	if !errors.Is(err, errVMUEFIVarsNotStored) {
+		t.Errorf("GetUEFIVars() without stored vars error = %v, want %v", err, errVMUEFIVarsNotStored)
+	}
+}
@@ -0,0 +1,174 @@
+package vm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// device path node types and sub types, see MdePkg/Include/Protocol/DevicePath.h
+const (
+	devPathHardware      = 0x01
+	devPathACPI          = 0x02
+	devPathMessaging     = 0x03
+	devPathMedia         = 0x04
+	devPathEnd           = 0x7F
+	devPathHardwarePCI   = 0x01
+	devPathACPIHID       = 0x01
+	devPathMsgSCSI       = 0x02
+	devPathMsgMAC        = 0x0B
+	devPathMsgIPv4       = 0x0C
+	devPathMsgIPv6       = 0x0D
+	devPathMsgSATA       = 0x12
+	devPathMsgNVMe       = 0x17
+	devPathMsgURI        = 0x18
+	devPathMediaHD       = 0x01
+	devPathMediaCDROM    = 0x02
+	devPathMediaFile     = 0x04
+	devPathMediaFvFile   = 0x06
+	devPathMediaFv       = 0x07
+	devPathEndInstance   = 0x01
+	devPathEndEntire     = 0xFF
+	devPathNodeHeader    = 4
+	devPathPCIRootHID    = 0x0A0341D0 // PNP0A03 in EISA ID form
+	devPathPCIeRootHID   = 0x0A0841D0 // PNP0A08
+	devPathSATANoPortMul = 0xFFFF
+)
+
+// devicePathText returns the device path in the text form EDK2 shows it, nodes this does not know are shown by
+// type, sub type and data
+func devicePathText(devicePath []byte) string {
+	var text strings.Builder
+
+	separator := ""
+
+	for len(devicePath) >= devPathNodeHeader {
+		nodeLen := int(binary.LittleEndian.Uint16(devicePath[2:]))
+		if nodeLen < devPathNodeHeader || nodeLen > len(devicePath) {
+			break
+		}
+
+		node := devicePath[:nodeLen]
+		devicePath = devicePath[nodeLen:]
+
+		if node[0] == devPathEnd {
+			// the end of one instance of a device path with several is followed by the next
+			if node[1] != devPathEndInstance {
+				break
+			}
+
+			text.WriteString(",")
+
+			separator = ""
+
+			continue
+		}
+
+		text.WriteString(separator + devicePathNodeText(node))
+
+		separator = "/"
+	}
+
+	return text.String()
+}
+
+//nolint:cyclop
+func devicePathNodeText(node []byte) string {
+	data := node[devPathNodeHeader:]
+
+	switch {
+	case node[0] == devPathHardware && node[1] == devPathHardwarePCI && len(data) >= 2:
+		return fmt.Sprintf("Pci(0x%X,0x%X)", data[1], data[0])
+	case node[0] == devPathACPI && node[1] == devPathACPIHID && len(data) >= 8:
+		return acpiNodeText(binary.LittleEndian.Uint32(data), binary.LittleEndian.Uint32(data[4:]))
+	case node[0] == devPathMessaging && node[1] == devPathMsgSCSI && len(data) >= 4:
+		return fmt.Sprintf("Scsi(0x%X,0x%X)", binary.LittleEndian.Uint16(data), binary.LittleEndian.Uint16(data[2:]))
+	case node[0] == devPathMessaging && node[1] == devPathMsgSATA && len(data) >= 6:
+		return fmt.Sprintf("Sata(0x%X,0x%X,0x%X)", binary.LittleEndian.Uint16(data),
+			binary.LittleEndian.Uint16(data[2:]), binary.LittleEndian.Uint16(data[4:]))
+	case node[0] == devPathMessaging && node[1] == devPathMsgNVMe && len(data) >= 12:
+		return fmt.Sprintf("NVMe(0x%X,%X-%X-%X-%X-%X-%X-%X-%X)", binary.LittleEndian.Uint32(data),
+			data[11], data[10], data[9], data[8], data[7], data[6], data[5], data[4])
+	case node[0] == devPathMessaging && node[1] == devPathMsgMAC && len(data) >= 33:
+		return fmt.Sprintf("MAC(%X,0x%X)", data[:6], data[32])
+	case node[0] == devPathMessaging && node[1] == devPathMsgIPv4 && len(data) >= 8:
+		return fmt.Sprintf("IPv4(%s)", net.IP(data[4:8]))
+	case node[0] == devPathMessaging && node[1] == devPathMsgIPv6 && len(data) >= 32:
+		return fmt.Sprintf("IPv6(%s)", net.IP(data[16:32]))
+	case node[0] == devPathMessaging && node[1] == devPathMsgURI:
+		return fmt.Sprintf("Uri(%s)", data)
+	case node[0] == devPathMedia && node[1] == devPathMediaHD && len(data) >= 38:
+		return hdNodeText(data)
+	case node[0] == devPathMedia && node[1] == devPathMediaCDROM && len(data) >= 20:
+		return fmt.Sprintf("CDROM(0x%X,0x%X,0x%X)", binary.LittleEndian.Uint32(data),
+			binary.LittleEndian.Uint64(data[4:]), binary.LittleEndian.Uint64(data[12:]))
+	case node[0] == devPathMedia && node[1] == devPathMediaFile:
+		return decodeUCS2(data)
+	case node[0] == devPathMedia && node[1] == devPathMediaFvFile && len(data) >= 16:
+		return fmt.Sprintf("FvFile(%s)", efiGUID(data[:16]))
+	case node[0] == devPathMedia && node[1] == devPathMediaFv && len(data) >= 16:
+		return fmt.Sprintf("Fv(%s)", efiGUID(data[:16]))
+	}
+
+	return fmt.Sprintf("Path(%d,%d,%X)", node[0], node[1], data)
+}
+
+func acpiNodeText(hid uint32, uid uint32) string {
+	switch hid {
+	case devPathPCIRootHID:
+		return fmt.Sprintf("PciRoot(0x%X)", uid)
+	case devPathPCIeRootHID:
+		return fmt.Sprintf("PcieRoot(0x%X)", uid)
+	}
+
+	return fmt.Sprintf("Acpi(0x%08X,0x%X)", hid, uid)
+}
+
+func hdNodeText(data []byte) string {
+	partition := binary.LittleEndian.Uint32(data)
+	start := binary.LittleEndian.Uint64(data[4:])
+	size := binary.LittleEndian.Uint64(data[12:])
+
+	// signature type 1 is an MBR disk signature, 2 a GPT partition GUID
+	switch data[37] {
+	case 1:
+		return fmt.Sprintf("HD(%d,MBR,0x%08X,0x%X,0x%X)", partition, binary.LittleEndian.Uint32(data[20:]), start, size)
+	case 2:
+		return fmt.Sprintf("HD(%d,GPT,%s,0x%X,0x%X)", partition, efiGUID(data[20:36]), start, size)
+	}
+
+	return fmt.Sprintf("HD(%d,0x%X,0x%X)", partition, start, size)
+}
+
+// sataCDDevicePath returns the device path the firmware gives a CD drive on an AHCI controller port, as found by
+// the firmware it has no media node, booting it boots the removable media boot file on the disc
+func sataCDDevicePath(addr PCIAddr, port int) []byte {
+	var devicePath []byte
+
+	acpiNode := devicePathNode(devPathACPI, devPathACPIHID, 8)
+	binary.LittleEndian.PutUint32(acpiNode[4:], devPathPCIRootHID)
+	binary.LittleEndian.PutUint32(acpiNode[8:], uint32(addr.Bus))
+	devicePath = append(devicePath, acpiNode...)
+
+	pciNode := devicePathNode(devPathHardware, devPathHardwarePCI, 2)
+	pciNode[4] = addr.Function
+	pciNode[5] = addr.Slot
+	devicePath = append(devicePath, pciNode...)
+
+	sataNode := devicePathNode(devPathMessaging, devPathMsgSATA, 6)
+	binary.LittleEndian.PutUint16(sataNode[4:], uint16(port))
+	binary.LittleEndian.PutUint16(sataNode[6:], devPathSATANoPortMul)
+	devicePath = append(devicePath, sataNode...)
+
+	return append(devicePath, devicePathNode(devPathEnd, devPathEndEntire, 0)...)
+}
+
+func devicePathNode(nodeType byte, subType byte, dataLen int) []byte {
+	node := make([]byte, devPathNodeHeader+dataLen)
+	node[0] = nodeType
+	node[1] = subType
+	binary.LittleEndian.PutUint16(node[2:], uint16(devPathNodeHeader+dataLen))
+
+	return node
+}
@@ -0,0 +1,342 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"unicode/utf16"
+)
+
+// layout of the EDK2 variable store kept in a firmware volume, see MdePkg/Include/Pi/PiFirmwareVolume.h and
+// MdeModulePkg/Include/Guid/VariableFormat.h
+const (
+	uefiFVSignature            = "_FVH"
+	uefiFVSignatureOffset      = 40
+	uefiFVHeaderLengthOffset   = 48
+	uefiFVMinHeaderSize        = 56
+	uefiVarStoreHeaderSize     = 28
+	uefiVarStoreFormatted      = 0x5A
+	uefiVarStoreHealthy        = 0xFE
+	uefiVarStartID             = 0x55AA
+	uefiVarAdded               = 0x3F
+	uefiVarDeleted             = 0xFD
+	uefiVarInDeletedTransition = 0xFE
+	uefiVarHeaderSize          = 32
+	uefiAuthVarHeaderSize      = 60
+	uefiVarHeaderAlign         = 4
+)
+
+// attributes of the boot variables, non-volatile and visible to both boot services and the OS
+const uefiVarBootAttributes = 0x7
+
+// efiGUID is a GUID in the mixed endian form EFI stores them
+type efiGUID [16]byte
+
+var (
+	uefiAuthVarStoreGUID = newEFIGUID(0xaaf32c78, 0x947b, 0x439a,
+		[8]byte{0xa1, 0x80, 0x2e, 0x14, 0x4e, 0xc3, 0x77, 0x92},
+	)
+	uefiVarStoreGUID = newEFIGUID(0xddcf3616, 0x3275, 0x4164,
+		[8]byte{0x98, 0xb6, 0xfe, 0x85, 0x70, 0x7f, 0xfe, 0x7d},
+	)
+	uefiGlobalVariableGUID = newEFIGUID(0x8be4df61, 0x93ca, 0x11d2,
+		[8]byte{0xaa, 0x0d, 0x00, 0xe0, 0x98, 0x03, 0x2b, 0x8c},
+	)
+)
+
+func newEFIGUID(data1 uint32, data2 uint16, data3 uint16, data4 [8]byte) efiGUID {
+	var guid efiGUID
+
+	binary.LittleEndian.PutUint32(guid[0:], data1)
+	binary.LittleEndian.PutUint16(guid[4:], data2)
+	binary.LittleEndian.PutUint16(guid[6:], data3)
+	copy(guid[8:], data4[:])
+
+	return guid
+}
+
+func (g efiGUID) String() string {
+	return fmt.Sprintf("%08X-%04X-%04X-%X-%X",
+		binary.LittleEndian.Uint32(g[0:]), binary.LittleEndian.Uint16(g[4:]), binary.LittleEndian.Uint16(g[6:]),
+		g[8:10], g[10:],
+	)
+}
+
+// uefiVar is a variable in the store, with its name and data copied out of the store
+type uefiVar struct {
+	offset     int // of the header in the vars file
+	size       int // of the header, name and data
+	state      byte
+	attributes uint32
+	vendor     efiGUID
+	name       string
+	data       []byte
+	header     []byte // as read from the store, keeps the monotonic count, timestamp and key index of authenticated ones
+}
+
+// uefiVarStore is the variable store of a UEFI vars file. Variables are only ever added after the last one, the
+// firmware marks the old copy of a changed variable deleted and reclaims the space once the store is full.
+type uefiVarStore struct {
+	raw           []byte
+	authenticated bool
+	start         int // offset of the first variable
+	end           int // offset of the end of the store
+	free          int // offset the next variable is added at
+	vars          []uefiVar
+}
+
+func alignUEFIVar(offset int) int {
+	return (offset + uefiVarHeaderAlign - 1) &^ (uefiVarHeaderAlign - 1)
+}
+
+// uefiVarValid returns whether the state is that of a variable in use, one in deleted transition is still in use
+// until the copy replacing it is added
+func uefiVarValid(state byte) bool {
+	return state == uefiVarAdded || state == uefiVarAdded&uefiVarInDeletedTransition
+}
+
+// parseUEFIVarStore parses the variable store of a UEFI vars file, the store keeps using raw
+func parseUEFIVarStore(raw []byte) (*uefiVarStore, error) {
+	if len(raw) < uefiFVMinHeaderSize ||
+		string(raw[uefiFVSignatureOffset:uefiFVSignatureOffset+len(uefiFVSignature)]) != uefiFVSignature {
+		return nil, fmt.Errorf("%w: no firmware volume header", errVMUEFIVarsInvalid)
+	}
+
+	storeOffset := int(binary.LittleEndian.Uint16(raw[uefiFVHeaderLengthOffset:]))
+	if storeOffset+uefiVarStoreHeaderSize > len(raw) {
+		return nil, fmt.Errorf("%w: no variable store header", errVMUEFIVarsInvalid)
+	}
+
+	store := uefiVarStore{raw: raw}
+
+	switch efiGUID(raw[storeOffset : storeOffset+16]) {
+	case uefiAuthVarStoreGUID:
+		store.authenticated = true
+	case uefiVarStoreGUID:
+	default:
+		return nil, fmt.Errorf("%w: unknown variable store format", errVMUEFIVarsInvalid)
+	}
+
+	storeSize := int(binary.LittleEndian.Uint32(raw[storeOffset+16:]))
+	if storeSize < uefiVarStoreHeaderSize || storeOffset+storeSize > len(raw) {
+		return nil, fmt.Errorf("%w: variable store size %d out of range", errVMUEFIVarsInvalid, storeSize)
+	}
+
+	if raw[storeOffset+20] != uefiVarStoreFormatted || raw[storeOffset+21] != uefiVarStoreHealthy {
+		return nil, fmt.Errorf("%w: variable store not formatted or not healthy", errVMUEFIVarsInvalid)
+	}
+
+	store.start = alignUEFIVar(storeOffset + uefiVarStoreHeaderSize)
+	store.end = storeOffset + storeSize
+
+	offset := store.start
+	for offset+store.headerSize() <= store.end && binary.LittleEndian.Uint16(raw[offset:]) == uefiVarStartID {
+		variable, err := store.parseVar(offset)
+		if err != nil {
+			return nil, err
+		}
+
+		if uefiVarValid(variable.state) {
+			store.vars = append(store.vars, variable)
+		}
+
+		offset = alignUEFIVar(offset + variable.size)
+	}
+
+	store.free = min(offset, store.end)
+
+	// a variable in deleted transition is only still in use if the copy replacing it was never added
+	store.vars = slices.DeleteFunc(store.vars, func(variable uefiVar) bool {
+		return variable.state != uefiVarAdded && slices.ContainsFunc(store.vars, func(other uefiVar) bool {
+			return other.state == uefiVarAdded && other.vendor == variable.vendor && other.name == variable.name
+		})
+	})
+
+	return &store, nil
+}
+
+func (s *uefiVarStore) headerSize() int {
+	if s.authenticated {
+		return uefiAuthVarHeaderSize
+	}
+
+	return uefiVarHeaderSize
+}
+
+// sizesOffset returns the offset in the variable header of the name size, followed by the data size and vendor GUID
+func (s *uefiVarStore) sizesOffset() int {
+	if s.authenticated {
+		// after the monotonic count, timestamp and public key index
+		return 36
+	}
+
+	return 8
+}
+
+func (s *uefiVarStore) parseVar(offset int) (uefiVar, error) {
+	header := s.raw[offset : offset+s.headerSize()]
+	sizes := s.sizesOffset()
+	nameSize := int(binary.LittleEndian.Uint32(header[sizes:]))
+	dataSize := int(binary.LittleEndian.Uint32(header[sizes+4:]))
+
+	nameOffset := offset + s.headerSize()
+	dataOffset := nameOffset + nameSize
+
+	if dataOffset+dataSize > s.end {
+		return uefiVar{}, fmt.Errorf("%w: variable at offset %d overruns the store", errVMUEFIVarsInvalid, offset)
+	}
+
+	return uefiVar{
+		offset:     offset,
+		size:       s.headerSize() + nameSize + dataSize,
+		state:      header[2],
+		attributes: binary.LittleEndian.Uint32(header[4:]),
+		vendor:     efiGUID(header[sizes+8 : sizes+24]),
+		name:       decodeUCS2(s.raw[nameOffset:dataOffset]),
+		data:       bytes.Clone(s.raw[dataOffset : dataOffset+dataSize]),
+		header:     bytes.Clone(header),
+	}, nil
+}
+
+// get returns the data of the variable
+func (s *uefiVarStore) get(vendor efiGUID, name string) ([]byte, bool) {
+	for _, variable := range s.vars {
+		if variable.vendor == vendor && variable.name == name {
+			return variable.data, true
+		}
+	}
+
+	return nil, false
+}
+
+// remove marks every copy of the variable deleted
+func (s *uefiVarStore) remove(vendor efiGUID, name string) {
+	s.vars = slices.DeleteFunc(s.vars, func(variable uefiVar) bool {
+		if variable.vendor != vendor || variable.name != name {
+			return false
+		}
+
+		s.raw[variable.offset+2] &= uefiVarDeleted
+
+		return true
+	})
+}
+
+// set replaces the variable, keeping the attributes of the old copy if there is one. The store is left unchanged if
+// there is no room for it.
+func (s *uefiVarStore) set(vendor efiGUID, name string, attributes uint32, data []byte) error {
+	for _, variable := range s.vars {
+		if variable.vendor == vendor && variable.name == name {
+			attributes = variable.attributes
+		}
+	}
+
+	newVar := uefiVar{
+		size:       s.headerSize() + len(encodeUCS2(name)) + len(data),
+		state:      uefiVarAdded,
+		attributes: attributes,
+		vendor:     vendor,
+		name:       name,
+		data:       data,
+	}
+
+	if s.free+newVar.size > s.end && s.reclaimedFree(vendor, name)+newVar.size > s.end {
+		return fmt.Errorf("%w: no room for %s", errVMUEFIVarsFull, name)
+	}
+
+	s.remove(vendor, name)
+
+	if s.free+newVar.size > s.end {
+		s.reclaim()
+	}
+
+	newVar.offset = s.free
+	s.writeVar(newVar)
+	s.vars = append(s.vars, newVar)
+	s.free = min(alignUEFIVar(s.free+newVar.size), s.end)
+
+	return nil
+}
+
+// writeVar writes the variable at its offset, starting from the header it was read with if it has one
+func (s *uefiVarStore) writeVar(variable uefiVar) {
+	header := make([]byte, s.headerSize())
+	copy(header, variable.header)
+
+	sizes := s.sizesOffset()
+	name := encodeUCS2(variable.name)
+
+	binary.LittleEndian.PutUint16(header[0:], uefiVarStartID)
+	header[2] = variable.state
+	binary.LittleEndian.PutUint32(header[4:], variable.attributes)
+	binary.LittleEndian.PutUint32(header[sizes:], uint32(len(name)))
+	binary.LittleEndian.PutUint32(header[sizes+4:], uint32(len(variable.data)))
+	copy(header[sizes+8:], variable.vendor[:])
+
+	offset := variable.offset
+	offset += copy(s.raw[offset:], header)
+	offset += copy(s.raw[offset:], name)
+	copy(s.raw[offset:], variable.data)
+}
+
+// reclaimedFree returns the offset the next variable would be added at after reclaiming, with the copies of the
+// given variable removed
+func (s *uefiVarStore) reclaimedFree(vendor efiGUID, name string) int {
+	free := s.start
+
+	for _, variable := range s.vars {
+		if variable.vendor == vendor && variable.name == name {
+			continue
+		}
+
+		free = alignUEFIVar(free + variable.size)
+	}
+
+	return free
+}
+
+// reclaim drops the deleted variables from the store, as the firmware does when the store is full. The live ones
+// are moved with their headers, so authenticated variables keep what their next update is checked against.
+func (s *uefiVarStore) reclaim() {
+	for i := range s.end - s.start {
+		s.raw[s.start+i] = 0xFF
+	}
+
+	s.free = s.start
+
+	for i := range s.vars {
+		s.vars[i].offset = s.free
+		s.vars[i].state = uefiVarAdded
+		s.writeVar(s.vars[i])
+		s.free = min(alignUEFIVar(s.free+s.vars[i].size), s.end)
+	}
+}
+
+// decodeUCS2 decodes a little endian, NUL terminated UCS-2 string as used for EFI variable names and descriptions
+func decodeUCS2(raw []byte) string {
+	chars := make([]uint16, 0, len(raw)/2)
+
+	for i := 0; i+1 < len(raw); i += 2 {
+		char := binary.LittleEndian.Uint16(raw[i:])
+		if char == 0 {
+			break
+		}
+
+		chars = append(chars, char)
+	}
+
+	return string(utf16.Decode(chars))
+}
+
+// encodeUCS2 encodes a string as little endian, NUL terminated UCS-2
+func encodeUCS2(str string) []byte {
+	chars := utf16.Encode([]rune(str))
+	raw := make([]byte, 2*(len(chars)+1))
+
+	for i, char := range chars {
+		binary.LittleEndian.PutUint16(raw[2*i:], char)
+	}
+
+	return raw
+}
@@ -0,0 +1,458 @@
+package vm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+const testUEFIVarsFVHeaderLen = 72
+
+// newTestUEFIVars returns an empty vars file laid out as the EDK2 templates are, a firmware volume header followed
+// by the variable store
+func newTestUEFIVars(authenticated bool, size int) []byte {
+	raw := make([]byte, size)
+	for i := range raw {
+		raw[i] = 0xFF
+	}
+
+	for i := range testUEFIVarsFVHeaderLen {
+		raw[i] = 0
+	}
+
+	copy(raw[uefiFVSignatureOffset:], uefiFVSignature)
+	binary.LittleEndian.PutUint16(raw[uefiFVHeaderLengthOffset:], testUEFIVarsFVHeaderLen)
+
+	storeGUID := uefiVarStoreGUID
+	if authenticated {
+		storeGUID = uefiAuthVarStoreGUID
+	}
+
+	copy(raw[testUEFIVarsFVHeaderLen:], storeGUID[:])
+	binary.LittleEndian.PutUint32(raw[testUEFIVarsFVHeaderLen+16:], uint32(size-testUEFIVarsFVHeaderLen))
+	raw[testUEFIVarsFVHeaderLen+20] = uefiVarStoreFormatted
+	raw[testUEFIVarsFVHeaderLen+21] = uefiVarStoreHealthy
+
+	for i := testUEFIVarsFVHeaderLen + 22; i < testUEFIVarsFVHeaderLen+uefiVarStoreHeaderSize; i++ {
+		raw[i] = 0
+	}
+
+	return raw
+}
+
+// addTestUEFIVar appends a variable in the given state, as the firmware would have left it
+func addTestUEFIVar(t *testing.T, store *uefiVarStore, state byte, name string, data []byte) {
+	t.Helper()
+
+	variable := uefiVar{
+		offset:     store.free,
+		size:       store.headerSize() + len(encodeUCS2(name)) + len(data),
+		state:      state,
+		attributes: uefiVarBootAttributes,
+		vendor:     uefiGlobalVariableGUID,
+		name:       name,
+		data:       data,
+	}
+
+	if variable.offset+variable.size > store.end {
+		t.Fatalf("test variable %s does not fit", name)
+	}
+
+	store.writeVar(variable)
+	store.free = alignUEFIVar(store.free + variable.size)
+}
+
+//nolint:paralleltest
+func Test_parseUEFIVarStore(t *testing.T) {
+	tests := []struct {
+		name       string
+		raw        func() []byte
+		wantAuth   bool
+		wantVars   map[string][]byte
+		wantErr    error
+		wantFreeAt int
+	}{
+		{
+			name:       "emptyAuthenticated",
+			raw:        func() []byte { return newTestUEFIVars(true, 4096) },
+			wantAuth:   true,
+			wantVars:   map[string][]byte{},
+			wantFreeAt: testUEFIVarsFVHeaderLen + uefiVarStoreHeaderSize,
+		},
+		{
+			name:       "emptyPlain",
+			raw:        func() []byte { return newTestUEFIVars(false, 4096) },
+			wantVars:   map[string][]byte{},
+			wantFreeAt: testUEFIVarsFVHeaderLen + uefiVarStoreHeaderSize,
+		},
+		{
+			name: "variableStates",
+			raw: func() []byte {
+				raw := newTestUEFIVars(true, 4096)
+				store, _ := parseUEFIVarStore(raw)
+				addTestUEFIVar(t, store, uefiVarAdded&uefiVarDeleted, "Lang", []byte("fr"))
+				addTestUEFIVar(t, store, uefiVarAdded&uefiVarInDeletedTransition, "Timeout", []byte{5, 0})
+				addTestUEFIVar(t, store, uefiVarAdded&uefiVarInDeletedTransition, "BootNext", []byte{1, 0})
+				addTestUEFIVar(t, store, uefiVarAdded, "BootNext", []byte{2, 0})
+				addTestUEFIVar(t, store, uefiVarAdded, "Lang", []byte("en"))
+
+				return raw
+			},
+			wantAuth: true,
+			wantVars: map[string][]byte{
+				"Timeout":  {5, 0},
+				"BootNext": {2, 0},
+				"Lang":     []byte("en"),
+			},
+			wantFreeAt: 484,
+		},
+		{
+			name: "noFirmwareVolume",
+			raw: func() []byte {
+				raw := newTestUEFIVars(true, 4096)
+				copy(raw[uefiFVSignatureOffset:], "_BAD")
+
+				return raw
+			},
+			wantErr: errVMUEFIVarsInvalid,
+		},
+		{
+			name:    "tooShort",
+			raw:     func() []byte { return []byte("_FVH") },
+			wantErr: errVMUEFIVarsInvalid,
+		},
+		{
+			name: "unknownStore",
+			raw: func() []byte {
+				raw := newTestUEFIVars(true, 4096)
+				copy(raw[testUEFIVarsFVHeaderLen:], uefiGlobalVariableGUID[:])
+
+				return raw
+			},
+			wantErr: errVMUEFIVarsInvalid,
+		},
+		{
+			name: "storeTooLarge",
+			raw: func() []byte {
+				raw := newTestUEFIVars(true, 4096)
+				binary.LittleEndian.PutUint32(raw[testUEFIVarsFVHeaderLen+16:], 8192)
+
+				return raw
+			},
+			wantErr: errVMUEFIVarsInvalid,
+		},
+		{
+			name: "notHealthy",
+			raw: func() []byte {
+				raw := newTestUEFIVars(true, 4096)
+				raw[testUEFIVarsFVHeaderLen+21] = 0xFF
+
+				return raw
+			},
+			wantErr: errVMUEFIVarsInvalid,
+		},
+		{
+			name: "variableOverruns",
+			raw: func() []byte {
+				raw := newTestUEFIVars(false, 4096)
+				store, _ := parseUEFIVarStore(raw)
+				addTestUEFIVar(t, store, uefiVarAdded, "Lang", []byte("en"))
+				binary.LittleEndian.PutUint32(raw[store.start+12:], 8192)
+
+				return raw
+			},
+			wantErr: errVMUEFIVarsInvalid,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := parseUEFIVarStore(testCase.raw())
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("parseUEFIVarStore() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.authenticated != testCase.wantAuth {
+				t.Errorf("parseUEFIVarStore() authenticated = %v, want %v", got.authenticated, testCase.wantAuth)
+			}
+
+			if got.free != testCase.wantFreeAt {
+				t.Errorf("parseUEFIVarStore() free = %d, want %d", got.free, testCase.wantFreeAt)
+			}
+
+			gotVars := make(map[string][]byte)
+			for _, variable := range got.vars {
+				gotVars[variable.name] = variable.data
+			}
+
+			diff := deep.Equal(gotVars, testCase.wantVars)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestUEFIVarStore_set(t *testing.T) {
+	for _, authenticated := range []bool{true, false} {
+		raw := newTestUEFIVars(authenticated, 4096)
+
+		store, err := parseUEFIVarStore(raw)
+		if err != nil {
+			t.Fatalf("parseUEFIVarStore() error = %v", err)
+		}
+
+		err = store.set(uefiGlobalVariableGUID, "BootOrder", 0x3, []byte{1, 0, 0, 0})
+		if err != nil {
+			t.Fatalf("set() error = %v", err)
+		}
+
+		// a changed variable keeps its attributes
+		err = store.set(uefiGlobalVariableGUID, "BootOrder", uefiVarBootAttributes, []byte{0, 0, 1, 0})
+		if err != nil {
+			t.Fatalf("set() error = %v", err)
+		}
+
+		reparsed, err := parseUEFIVarStore(raw)
+		if err != nil {
+			t.Fatalf("parseUEFIVarStore() after set error = %v", err)
+		}
+
+		if len(reparsed.vars) != 1 {
+			t.Fatalf("parseUEFIVarStore() after set found %d variables, want 1", len(reparsed.vars))
+		}
+
+		diff := deep.Equal(reparsed.vars[0], uefiVar{
+			offset:     store.vars[0].offset,
+			size:       store.vars[0].size,
+			state:      uefiVarAdded,
+			attributes: 0x3,
+			vendor:     uefiGlobalVariableGUID,
+			name:       "BootOrder",
+			data:       []byte{0, 0, 1, 0},
+		})
+		if diff != nil {
+			t.Errorf("authenticated %v compare failed: %v", authenticated, diff)
+		}
+
+		store.remove(uefiGlobalVariableGUID, "BootOrder")
+
+		reparsed, err = parseUEFIVarStore(raw)
+		if err != nil || len(reparsed.vars) != 0 {
+			t.Errorf("parseUEFIVarStore() after remove = %v, %v, want no variables", reparsed, err)
+		}
+	}
+}
+
+//nolint:paralleltest
+func TestUEFIVarStore_reclaim(t *testing.T) {
+	raw := newTestUEFIVars(true, 512)
+
+	store, err := parseUEFIVarStore(raw)
+	if err != nil {
+		t.Fatalf("parseUEFIVarStore() error = %v", err)
+	}
+
+	err = store.set(uefiGlobalVariableGUID, "Lang", uefiVarBootAttributes, []byte("en"))
+	if err != nil {
+		t.Fatalf("set() error = %v", err)
+	}
+
+	// without reclaiming the deleted copies the store fills after a few changes
+	for i := range 20 {
+		err = store.set(uefiGlobalVariableGUID, "BootNext", uefiVarBootAttributes, []byte{byte(i), 0})
+		if err != nil {
+			t.Fatalf("set() %d error = %v", i, err)
+		}
+	}
+
+	reparsed, err := parseUEFIVarStore(raw)
+	if err != nil {
+		t.Fatalf("parseUEFIVarStore() error = %v", err)
+	}
+
+	lang, _ := reparsed.get(uefiGlobalVariableGUID, "Lang")
+	bootNext, _ := reparsed.get(uefiGlobalVariableGUID, "BootNext")
+
+	diff := deep.Equal([][]byte{lang, bootNext}, [][]byte{[]byte("en"), {19, 0}})
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	err = store.set(uefiGlobalVariableGUID, "Big", uefiVarBootAttributes, make([]byte, 512))
+	if !errors.Is(err, errVMUEFIVarsFull) {
+		t.Errorf("set() of too large variable error = %v, want %v", err, errVMUEFIVarsFull)
+	}
+}
+
+//nolint:paralleltest
+func TestUEFIVarStore_reclaimAuthenticated(t *testing.T) {
+	raw := newTestUEFIVars(true, 1024)
+
+	store, err := parseUEFIVarStore(raw)
+	if err != nil {
+		t.Fatalf("parseUEFIVarStore() error = %v", err)
+	}
+
+	// a time based authenticated variable as the firmware writes it, with a monotonic count, timestamp and public
+	// key index which later updates are checked against
+	header := make([]byte, uefiAuthVarHeaderSize)
+	binary.LittleEndian.PutUint64(header[8:], 7)
+	copy(header[16:32], []byte{0xea, 0x07, 10, 18, 3, 4, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	binary.LittleEndian.PutUint32(header[32:], 2)
+
+	pk := uefiVar{
+		offset:     store.free,
+		size:       uefiAuthVarHeaderSize + len(encodeUCS2("PK")) + 16,
+		state:      uefiVarAdded,
+		attributes: 0x27,
+		vendor:     uefiGlobalVariableGUID,
+		name:       "PK",
+		data:       []byte("a platform key.."),
+		header:     header,
+	}
+
+	addTestUEFIVar(t, store, uefiVarDeleted&uefiVarAdded, "BootNext", []byte{1, 0})
+	pk.offset = store.free
+	store.writeVar(pk)
+	store.free = alignUEFIVar(store.free + pk.size)
+	addTestUEFIVar(t, store, uefiVarDeleted&uefiVarAdded, "BootNext", []byte{2, 0})
+
+	store, err = parseUEFIVarStore(raw)
+	if err != nil {
+		t.Fatalf("parseUEFIVarStore() error = %v", err)
+	}
+
+	wantHeader := bytes.Clone(raw[store.vars[0].offset : store.vars[0].offset+uefiAuthVarHeaderSize])
+
+	store.reclaim()
+
+	reparsed, err := parseUEFIVarStore(raw)
+	if err != nil {
+		t.Fatalf("parseUEFIVarStore() after reclaim error = %v", err)
+	}
+
+	if len(reparsed.vars) != 1 || reparsed.vars[0].offset != reparsed.start {
+		t.Fatalf("parseUEFIVarStore() after reclaim found %v, want only PK at the start", reparsed.vars)
+	}
+
+	gotHeader := raw[reparsed.vars[0].offset : reparsed.vars[0].offset+uefiAuthVarHeaderSize]
+	if !bytes.Equal(gotHeader, wantHeader) {
+		t.Errorf("reclaim() header = %x, want %x", gotHeader, wantHeader)
+	}
+
+	if !bytes.Equal(reparsed.vars[0].data, pk.data) {
+		t.Errorf("reclaim() data = %v, want %v", reparsed.vars[0].data, pk.data)
+	}
+}
+
+//nolint:paralleltest
+func TestUEFIVarStore_setFull(t *testing.T) {
+	raw := newTestUEFIVars(false, 256)
+
+	store, err := parseUEFIVarStore(raw)
+	if err != nil {
+		t.Fatalf("parseUEFIVarStore() error = %v", err)
+	}
+
+	err = store.set(uefiGlobalVariableGUID, "BootOrder", uefiVarBootAttributes, []byte{1, 0, 0, 0})
+	if err != nil {
+		t.Fatalf("set() error = %v", err)
+	}
+
+	wantRaw := bytes.Clone(raw)
+
+	// even reclaiming, with the old BootOrder gone, there is no room, so the old one must be kept
+	err = store.set(uefiGlobalVariableGUID, "BootOrder", uefiVarBootAttributes, make([]byte, 120))
+	if !errors.Is(err, errVMUEFIVarsFull) {
+		t.Fatalf("set() error = %v, want %v", err, errVMUEFIVarsFull)
+	}
+
+	if !bytes.Equal(raw, wantRaw) {
+		t.Errorf("set() changed the store although there was no room")
+	}
+
+	bootOrder, found := store.get(uefiGlobalVariableGUID, "BootOrder")
+	if !found || !bytes.Equal(bootOrder, []byte{1, 0, 0, 0}) {
+		t.Errorf("get() = %v, %v, want the old BootOrder", bootOrder, found)
+	}
+
+	// the space taken by the old copy is enough once it is reclaimed
+	err = store.set(uefiGlobalVariableGUID, "BootOrder", uefiVarBootAttributes, make([]byte, 100))
+	if err != nil {
+		t.Errorf("set() error = %v", err)
+	}
+}
+
+//nolint:paralleltest
+func Test_devicePathText(t *testing.T) {
+	hdNode := devicePathNode(devPathMedia, devPathMediaHD, 38)
+	binary.LittleEndian.PutUint32(hdNode[4:], 1)
+	binary.LittleEndian.PutUint64(hdNode[8:], 0x800)
+	binary.LittleEndian.PutUint64(hdNode[16:], 0x64000)
+	copy(hdNode[24:], uefiGlobalVariableGUID[:])
+	hdNode[40] = 2
+	hdNode[41] = 2
+
+	fileName := encodeUCS2(`\EFI\BOOT\BOOTX64.EFI`)
+	fileNode := append(devicePathNode(devPathMedia, devPathMediaFile, len(fileName))[:devPathNodeHeader], fileName...)
+
+	fvFileNode := devicePathNode(devPathMedia, devPathMediaFvFile, 16)
+	copy(fvFileNode[4:], uefiVarStoreGUID[:])
+
+	unknownNode := devicePathNode(devPathHardware, 0x05, 2)
+	unknownNode[4] = 0xAB
+
+	endInstance := devicePathNode(devPathEnd, devPathEndInstance, 0)
+	end := devicePathNode(devPathEnd, devPathEndEntire, 0)
+
+	tests := []struct {
+		name       string
+		devicePath []byte
+		want       string
+	}{
+		{
+			name:       "sataCD",
+			devicePath: sataCDDevicePath(PCIAddr{Bus: 0, Slot: 3, Function: 0}, 1),
+			want:       "PciRoot(0x0)/Pci(0x3,0x0)/Sata(0x1,0xFFFF,0x0)",
+		},
+		{
+			name:       "sataCDFunction",
+			devicePath: sataCDDevicePath(PCIAddr{Bus: 1, Slot: 4, Function: 2}, 0),
+			want:       "PciRoot(0x1)/Pci(0x4,0x2)/Sata(0x0,0xFFFF,0x0)",
+		},
+		{
+			name:       "hardDriveFile",
+			devicePath: slices.Concat(hdNode, fileNode, end),
+			want:       `HD(1,GPT,8BE4DF61-93CA-11D2-AA0D-00E098032B8C,0x800,0x64000)/\EFI\BOOT\BOOTX64.EFI`,
+		},
+		{
+			name:       "instances",
+			devicePath: slices.Concat(fvFileNode, endInstance, unknownNode, end),
+			want:       "FvFile(DDCF3616-3275-4164-98B6-FE85707FFE7D),Path(1,5,AB00)",
+		},
+		{
+			name:       "truncated",
+			devicePath: sataCDDevicePath(PCIAddr{Slot: 3}, 1)[:15],
+			want:       "PciRoot(0x0)",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := devicePathText(testCase.devicePath)
+			if got != testCase.want {
+				t.Errorf("devicePathText() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
@@ -383,14 +383,6 @@ func startSerialPort(comDev string, comSpeed uint) (*serial.Port, error) {
 	return comReader, nil
 }
 
-func AutoStartVMs() {
-	for _, vmInst := range List.VMList {
-		if vmInst.Config.AutoStart {
-			go vmInst.doAutostart()
-		}
-	}
-}
-
 func GetAll() []*VM {
 	allVMs := make([]*VM, 0, len(List.VMList))
 	for _, value := range List.VMList {
@@ -491,21 +483,6 @@ func LogAllVMStatus() {
 	}
 }
 
-func StopAll() {
-	defer List.Mu.RUnlock()
-	List.Mu.RLock()
-	for _, vmInst := range List.VMList {
-		if vmInst.Status != STOPPED {
-			go func(aVmInst *VM) {
-				err := aVmInst.Stop()
-				if err != nil {
-					slog.Error("error stopping VM", "err", err)
-				}
-			}(vmInst)
-		}
-	}
-}
-
 func (v *VM) Kill() {
 	var err error
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"regexp"
 	"slices"
 	"strings"
 	"sync"
@@ -88,45 +89,100 @@ type Config struct {
 	Wbps             uint32
 	Riops            uint32
 	Wiops            uint32
+	StopPolicy       string
+	RestartPolicy    string `gorm:"default:on-failure"`
+	RestartMax       uint32 `gorm:"default:5;check:restart_max>=0"`
+	RestartWindow    uint32 `gorm:"default:300;check:restart_window>=0"`
+	BootGroup        uint32 `gorm:"default:0;check:boot_group>=0"`
+	DependsOn        string // comma separated IDs of VMs which must be up before this VM is started
+	ReadyPattern     string // regex matched against com1 output
+	ReadyTCP         string // host:port to connect to
+	ReadyHTTP        string // URL to GET
+	ReadyDeadline    uint32 `gorm:"default:300;check:ready_deadline>=0"`
+	ReadyInterval    uint32 `gorm:"default:5;check:ready_interval>=0"`
+	ReadyTimeout     uint32 `gorm:"default:2;check:ready_timeout>=0"`
+	LivePattern      string // regex heartbeat expected on com1 between liveness checks
+	LiveTCP          string // host:port to connect to
+	LiveHTTP         string // URL to GET
+	LiveInterval     uint32 `gorm:"default:30;check:live_interval>=0"`
+	LiveTimeout      uint32 `gorm:"default:5;check:live_timeout>=0"`
+	LiveFailures     uint32 `gorm:"default:3;check:live_failures>=0"`
+	LiveAction       string `gorm:"default:log"`
+	Labels           string // comma separated key=value pairs, used to select VMs for schedules
+	HookPreStart     string // scripts run around the lifecycle of this VM, after the global hooks
+	HookPostStart    string
+	HookPreStop      string
+	HookPostStop     string
+	HookTimeout      uint32 `gorm:"default:60;check:hook_timeout>=0"`
+	PCISlots         string // comma separated device=bus:slot:function, kept so devices never move between slots
+	PCIPack          bool   `gorm:"column:pci_pack;default:False;check:pci_pack IN(0,1)"` // share AHCI, pack disks
+	Sockets          uint32 // CPU topology, all 0 leaves it to bhyve
+	Cores            uint32
+	Threads          uint32
+	CPUPins          string // comma separated vcpu:hostcpu pairs
+	ConsolePorts     string // comma separated virtio-console port names
+	GuestAgent       bool   `gorm:"default:False;check:guest_agent IN(0,1)"`
+	Firmware         string `gorm:"default:uefi"` // name in the firmware catalog, or none
 }
 
 type VM struct {
-	ID          string `gorm:"uniqueIndex;not null;default:null"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
-	Name        string         `gorm:"not null"`
-	Description string
-	Status      StatusType `gorm:"type:status_type"`
-	BhyvePid    uint32     `gorm:"check:bhyve_pid>=0"`
-	VNCPort     int32      // should be uint16 but changing requires db migration
-	DebugPort   int32      // should be uint16 but changing requires db migration
-	proc        *supervisor.Process
-	mu          sync.RWMutex
-	log         slog.Logger
-	Config      Config
-	ISOs        []*iso.ISO   `gorm:"-:all"` // -- ignore this, we're doing it ourselves
-	Disks       []*disk.Disk `gorm:"-:all"` // -- ignore this, we're doing it ourselves
-	Com1Dev     string       // TODO make a com struct and put these in it?
-	Com2Dev     string
-	Com3Dev     string
-	Com4Dev     string
-	Com1        *serial.Port `gorm:"-:all"`
-	Com2        *serial.Port `gorm:"-:all"`
-	Com3        *serial.Port `gorm:"-:all"`
-	Com4        *serial.Port `gorm:"-:all"`
-	Com1lock    sync.Mutex   `gorm:"-:all"`
-	Com2lock    sync.Mutex   `gorm:"-:all"`
-	Com3lock    sync.Mutex   `gorm:"-:all"`
-	Com4lock    sync.Mutex   `gorm:"-:all"`
-	Com1rchan   chan byte    `gorm:"-:all"`
-	Com1write   bool         `gorm:"-:all"`
-	Com2rchan   chan byte    `gorm:"-:all"`
-	Com2write   bool         `gorm:"-:all"`
-	Com3rchan   chan byte    `gorm:"-:all"`
-	Com3write   bool         `gorm:"-:all"`
-	Com4rchan   chan byte    `gorm:"-:all"`
-	Com4write   bool         `gorm:"-:all"`
+	ID             string `gorm:"uniqueIndex;not null;default:null"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
+	Name           string         `gorm:"not null"`
+	Description    string
+	Status         StatusType `gorm:"type:status_type"`
+	BhyvePid       uint32     `gorm:"check:bhyve_pid>=0"`
+	VNCPort        int32      // should be uint16 but changing requires db migration
+	DebugPort      int32      // should be uint16 but changing requires db migration
+	proc           *supervisor.Process
+	procOpts       supervisor.ProcessOptions
+	stopStage      StopStageType
+	crashLoop      bool
+	restartPending bool
+	restartTimes   []time.Time
+	adopted        bool
+	ready          bool
+	readyPattern   *regexp.Regexp
+	readyMatched   bool
+	readyGen       uint64
+	serialLine     []byte
+	livePattern    *regexp.Regexp
+	liveSeen       bool
+	liveGen        uint64
+	liveState      LiveStateType
+	liveFailures   uint32
+	pciSlots       map[string]PCIAddr
+	controllers    []Controller
+	attachments    []Attachment
+	passthrus      []Passthru
+	shares         []Share
+	mu             sync.RWMutex
+	log            slog.Logger
+	Config         Config
+	ISOs           []*iso.ISO   `gorm:"-:all"` // -- ignore this, we're doing it ourselves
+	Disks          []*disk.Disk `gorm:"-:all"` // -- ignore this, we're doing it ourselves
+	Com1Dev        string       // TODO make a com struct and put these in it?
+	Com2Dev        string
+	Com3Dev        string
+	Com4Dev        string
+	Com1           *serial.Port `gorm:"-:all"`
+	Com2           *serial.Port `gorm:"-:all"`
+	Com3           *serial.Port `gorm:"-:all"`
+	Com4           *serial.Port `gorm:"-:all"`
+	Com1lock       sync.Mutex   `gorm:"-:all"`
+	Com2lock       sync.Mutex   `gorm:"-:all"`
+	Com3lock       sync.Mutex   `gorm:"-:all"`
+	Com4lock       sync.Mutex   `gorm:"-:all"`
+	Com1rchan      chan byte    `gorm:"-:all"`
+	Com1write      bool         `gorm:"-:all"`
+	Com2rchan      chan byte    `gorm:"-:all"`
+	Com2write      bool         `gorm:"-:all"`
+	Com3rchan      chan byte    `gorm:"-:all"`
+	Com3write      bool         `gorm:"-:all"`
+	Com4rchan      chan byte    `gorm:"-:all"`
+	Com4write      bool         `gorm:"-:all"`
 }
 
 type ListType struct {
@@ -140,13 +196,16 @@ var (
 		VMList: make(map[string]*VM),
 	}
 
-	runningVMsGauge prometheus.Gauge
-	totalVMsGauge   prometheus.Gauge
-	cpuVMGauge      prometheus.Gauge
-	memVMGauge      prometheus.Gauge
+	runningVMsGauge  prometheus.Gauge
+	totalVMsGauge    prometheus.Gauge
+	cpuVMGauge       prometheus.Gauge
+	memVMGauge       prometheus.Gauge
+	crashLoopCounter prometheus.Counter
 )
 
-func (v *VM) monitor(events chan supervisor.Event) {
+// monitor follows the bhyve process, reboot is true when it was respawned after the guest rebooted so the VM is
+// already set up and only the pid changed
+func (v *VM) monitor(events chan supervisor.Event, reboot bool) {
 	for {
 		select {
 		case msg := <-v.proc.Stdout():
@@ -177,20 +236,45 @@ func (v *VM) monitor(events chan supervisor.Event) {
 					"bhyvePid", v.BhyvePid,
 					"sudoPid", v.proc.Pid(),
 				)
+
+				if reboot {
+					// the guest is booting again, it is not ready until the probes pass again
+					v.startReadyProbes()
+					v.startLiveProbes()
+					v.applyResourceLimits()
+
+					continue
+				}
+
+				// before the com loggers so no output is missed by the readiness pattern
+				v.startReadyProbes()
+				v.startLiveProbes()
 				v.setupComLoggers()
 				v.applyResourceLimits()
-			case "ProcessDone":
-				v.log.Info("event", "code", event.Code, "message", event.Message)
-			case "ProcessCrashed":
-				v.log.Info("exited, destroying") // will be respawned, leave disks/nics alone
-				v.killComLoggers()
-				v.BhyvectlDestroy()
+
+				go v.runHooksLogged(HookPostStart)
 			default:
 				v.log.Info("event", "code", event.Code, "message", event.Message)
 			}
 		case <-v.proc.DoneNotifier():
+			// the process never started, whoever called spawn() cleans up
+			if v.proc.Pid() == 0 {
+				return
+			}
+
+			stopRequested := v.Status == STOPPING
+			exitCode := v.exitCode()
+
+			if !stopRequested && exitReasonFromCode(exitCode) == ExitReset && v.rebootInPlace() {
+				return
+			}
+
 			v.Done()
 
+			if !stopRequested {
+				v.handleExit(exitCode)
+			}
+
 			return
 		}
 	}
@@ -210,6 +294,7 @@ func (v *VM) Done() {
 	}
 
 	v.unlockDisks()
+	v.unlockPassthrus()
 	v.NetStop()
 
 	slog.Debug("VM Stop finalized", "vm_name", v.Name)
@@ -220,6 +305,8 @@ func (v *VM) Done() {
 		cpuVMGauge.Sub(float64(v.Config.CPU))
 		memVMGauge.Sub(float64(v.Config.Mem))
 	}
+
+	v.runHooksLogged(HookPostStop)
 }
 
 func Exists(vmName string) bool {
@@ -279,6 +366,10 @@ func (v *VM) Save() error {
 	res := vmDB.Model(&v.Config).
 		Updates(map[string]interface{}{
 			"cpu":                &v.Config.CPU,
+			"sockets":            &v.Config.Sockets,
+			"cores":              &v.Config.Cores,
+			"threads":            &v.Config.Threads,
+			"cpu_pins":           &v.Config.CPUPins,
 			"mem":                &v.Config.Mem,
 			"max_wait":           &v.Config.MaxWait,
 			"restart":            &v.Config.Restart,
@@ -331,6 +422,36 @@ func (v *VM) Save() error {
 			"wbps":               &v.Config.Wbps,
 			"riops":              &v.Config.Riops,
 			"wiops":              &v.Config.Wiops,
+			"stop_policy":        &v.Config.StopPolicy,
+			"restart_policy":     &v.Config.RestartPolicy,
+			"restart_max":        &v.Config.RestartMax,
+			"restart_window":     &v.Config.RestartWindow,
+			"boot_group":         &v.Config.BootGroup,
+			"depends_on":         &v.Config.DependsOn,
+			"ready_pattern":      &v.Config.ReadyPattern,
+			"ready_tcp":          &v.Config.ReadyTCP,
+			"ready_http":         &v.Config.ReadyHTTP,
+			"ready_deadline":     &v.Config.ReadyDeadline,
+			"ready_interval":     &v.Config.ReadyInterval,
+			"ready_timeout":      &v.Config.ReadyTimeout,
+			"live_pattern":       &v.Config.LivePattern,
+			"live_tcp":           &v.Config.LiveTCP,
+			"live_http":          &v.Config.LiveHTTP,
+			"live_interval":      &v.Config.LiveInterval,
+			"live_timeout":       &v.Config.LiveTimeout,
+			"live_failures":      &v.Config.LiveFailures,
+			"live_action":        &v.Config.LiveAction,
+			"labels":             &v.Config.Labels,
+			"hook_pre_start":     &v.Config.HookPreStart,
+			"hook_post_start":    &v.Config.HookPostStart,
+			"hook_pre_stop":      &v.Config.HookPreStop,
+			"hook_post_stop":     &v.Config.HookPostStop,
+			"hook_timeout":       &v.Config.HookTimeout,
+			"pci_slots":          &v.Config.PCISlots,
+			"pci_pack":           &v.Config.PCIPack,
+			"console_ports":      &v.Config.ConsolePorts,
+			"guest_agent":        &v.Config.GuestAgent,
+			"firmware":           &v.Config.Firmware,
 		},
 		)
 
@@ -501,20 +622,51 @@ func (v *VM) Start() error {
 		return errVMNotStopped
 	}
 
+	err = ValidateFirmware(v.Config.Firmware)
+	if err != nil {
+		slog.Error("firmware unavailable, not starting VM", "vm", v.Name, "err", err)
+
+		return err
+	}
+
+	err = v.runHooks(HookPreStart)
+	if err != nil {
+		slog.Error("pre-start hook failed, not starting VM", "vm", v.Name, "err", err)
+
+		return err
+	}
+
+	err = v.prepareStart()
+	if err != nil {
+		// whatever the pre-start hook set up is left to the post-stop hook
+		v.runHooksLogged(HookPostStop)
+
+		return err
+	}
+
 	v.SetStarting()
 
-	events := make(chan supervisor.Event)
+	v.mu.Lock()
+	v.crashLoop = false
+	v.restartPending = false
+	v.mu.Unlock()
 
 	v.lockDisks()
+	v.removeConsoleSockets()
 
 	cmdName, cmdArgs := v.generateCommandLine()
 	v.log.Info("start", "cmd", cmdName, "args", cmdArgs)
+
+	// bhyve must be able to outlive cirrinad to be re-attached
+	if config.Config.Sys.KeepVMs {
+		cmdName, cmdArgs = v.detachedCommand(cmdName, cmdArgs)
+	}
 	v.createUefiVarsFile()
 
 	err = v.netStart()
 	if err != nil {
 		slog.Error("Failed VM net startup, cleaning up", "err", err)
-		v.NetStop()
+		v.startFailed()
 
 		return err
 	}
@@ -522,6 +674,7 @@ func (v *VM) Start() error {
 	err = v.Save()
 	if err != nil {
 		slog.Error("Failed saving VM", "err", err)
+		v.startFailed()
 
 		return err
 	}
@@ -541,15 +694,14 @@ func (v *VM) Start() error {
 		processDebug = true
 	}
 
-	vmProc := supervisor.NewProcess(supervisor.ProcessOptions{
+	err = v.spawn(supervisor.ProcessOptions{
 		Name:                    cmdName,
 		Args:                    cmdArgs,
 		Dir:                     "/",
 		Id:                      v.Name,
-		EventNotifier:           events,
 		OutputParser:            supervisor.MakeBytesParser,
 		ErrorParser:             supervisor.MakeBytesParser,
-		MaxSpawns:               -1,
+		MaxSpawns:               1, // reboots are handled by monitor(), other restarts by handleExit()
 		MaxSpawnAttempts:        -1,
 		RespawnWait:             respawnWait,
 		SpawnWait:               respawnWait,
@@ -558,15 +710,12 @@ func (v *VM) Start() error {
 		IdleTimeout:             -1,
 		TerminationGraceTimeout: time.Duration(v.Config.MaxWait) * time.Second,
 		Debug:                   processDebug,
-	})
-
-	v.proc = vmProc
-	go v.monitor(events)
-
-	err = vmProc.Start()
+	}, false)
 	if err != nil {
 		slog.Error("failed to start process", "err", err)
-		panic(fmt.Sprintf("failed to start process: %s", err))
+		v.startFailed()
+
+		return err
 	}
 
 	if config.Config.Metrics.Enabled {
@@ -578,17 +727,98 @@ func (v *VM) Start() error {
 	return nil
 }
 
-func (v *VM) Stop() error {
-	var err error
+// prepareStart loads what the command line is generated from and takes the VM's passthru devices, nothing needs
+// releasing if it fails
+func (v *VM) prepareStart() error {
+	err := v.loadControllers()
+	if err != nil {
+		slog.Error("failed loading storage controllers, not starting VM", "vm", v.Name, "err", err)
+
+		return err
+	}
+
+	err = v.loadPassthrus()
+	if err != nil {
+		slog.Error("failed loading passthru devices, not starting VM", "vm", v.Name, "err", err)
+
+		return err
+	}
+
+	err = v.loadShares()
+	if err != nil {
+		slog.Error("failed loading shared directories, not starting VM", "vm", v.Name, "err", err)
+
+		return err
+	}
+
+	err = v.assignPCISlots()
+	if err != nil {
+		slog.Error("failed assigning PCI slots, not starting VM", "vm", v.Name, "err", err)
 
+		return err
+	}
+
+	err = v.lockPassthrus()
+	if err != nil {
+		slog.Error("passthru device unavailable, not starting VM", "vm", v.Name, "err", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// startFailed releases everything Start took once the passthru devices and disks were locked, marks the VM stopped
+// again and runs the post-stop hook so whatever the pre-start hook set up is not left behind
+func (v *VM) startFailed() {
+	v.NetStop()
+	v.unlockDisks()
+	v.unlockPassthrus()
+
+	err := v.SetStopped()
+	if err != nil {
+		slog.Error("error stopping VM", "err", err)
+	}
+
+	v.runHooksLogged(HookPostStop)
+}
+
+// spawn starts bhyve under a new supervisor with a monitor following it, the options are kept for rebootInPlace()
+func (v *VM) spawn(procOpts supervisor.ProcessOptions, reboot bool) error {
+	events := make(chan supervisor.Event)
+	procOpts.EventNotifier = events
+
+	v.procOpts = procOpts
+	v.proc = supervisor.NewProcess(procOpts)
+
+	go v.monitor(events, reboot)
+
+	err := v.proc.Start()
+	if err != nil {
+		return fmt.Errorf("error starting bhyve: %w", err)
+	}
+
+	return nil
+}
+
+func (v *VM) Stop() error {
 	if v.Status == STOPPED {
+		// cancel any pending restart
+		v.mu.Lock()
+		v.restartPending = false
+		v.mu.Unlock()
+
 		return nil
 	}
 
+	if v.Status == RUNNING {
+		v.runHooksLogged(HookPreStop)
+	}
+
 	v.SetStopping()
 
-	if v.proc == nil {
-		err = v.SetStopped()
+	if v.proc == nil && !v.Adopted() {
+		err := v.SetStopped()
 		if err != nil {
 			slog.Error("error stopping VM", "err", err)
 
@@ -598,7 +828,32 @@ func (v *VM) Stop() error {
 		return nil
 	}
 
-	err = v.proc.Stop()
+	stages, err := ParseStopPolicy(v.Config.StopPolicy)
+	if err != nil {
+		slog.Error("invalid stop policy, using default", "vm", v.Name, "err", err)
+
+		stages = nil
+	}
+
+	if len(stages) > 0 {
+		return v.runStopPolicy(stages)
+	}
+
+	v.setStopStage(StopStageACPI)
+
+	return v.stopProc()
+}
+
+// stopProc stops the bhyve process the default way, waiting up to MaxWait for it to exit before killing it
+func (v *VM) stopProc() error {
+	// re-attached VMs have no supervisor
+	if v.proc == nil {
+		v.Kill()
+
+		return nil
+	}
+
+	err := v.proc.Stop()
 	if err != nil {
 		slog.Error("Failed to stop VM", "vm", v.Name, "pid", v.proc.Pid(), "err", err)
 
@@ -686,7 +941,9 @@ func (v *VM) initVM() {
 	}
 }
 
-func (v *VM) doAutostart() {
+func (v *VM) doAutostart() error {
+	v.waitDependencies()
+
 	slog.Debug(
 		"AutoStartVMs sleeping for auto start delay",
 		"vm", v.Name,
@@ -697,7 +954,11 @@ func (v *VM) doAutostart() {
 	err := v.Start()
 	if err != nil {
 		slog.Error("auto start failed", "vm", v.ID, "name", v.Name, "err", err)
+
+		return err
 	}
+
+	return nil
 }
 
 // CheckAll gets all disks/nics/isos and ensure the VM they are attached in the join table exists
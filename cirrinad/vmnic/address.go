@@ -0,0 +1,197 @@
+package vmnic
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"cirrina/cirrinad/util"
+)
+
+// sources of guest addresses
+const (
+	AddressSourceARP   = "arp"
+	AddressSourceNDP   = "ndp"
+	AddressSourceAgent = "agent"
+)
+
+// addressForget is how long an address is still reported after no source has seen it
+const addressForget = 24 * time.Hour
+
+// Address is an address the guest is using on a NIC
+type Address struct {
+	Address  string
+	Source   string
+	LastSeen time.Time
+}
+
+// nicAddresses are the addresses seen on a NIC while it had the MAC address, keyed by source and address
+type nicAddresses struct {
+	macAddress string
+	addresses  map[string]Address
+}
+
+// addressesSeen holds the addresses seen on each NIC, keyed by NIC ID, so that addresses which have dropped out of
+// the neighbor tables are still reported with when they were last seen
+var addressesSeen = struct {
+	mu   sync.Mutex
+	nics map[string]*nicAddresses
+}{nics: make(map[string]*nicAddresses)}
+
+// NormalizeMac returns the MAC address in the form net.HardwareAddr prints it, or an empty string if it is not valid
+func NormalizeMac(macAddress string) string {
+	hwAddr, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return ""
+	}
+
+	return hwAddr.String()
+}
+
+// NeighborAddresses returns the addresses in the host's ARP and NDP tables, keyed by normalized MAC address
+func NeighborAddresses() (map[string][]Address, error) {
+	seen := time.Now()
+
+	arpOut, err := runNeighborCmd("/usr/sbin/arp")
+	if err != nil {
+		return nil, err
+	}
+
+	ndpOut, err := runNeighborCmd("/usr/sbin/ndp")
+	if err != nil {
+		return nil, err
+	}
+
+	neighbors := parseARP(arpOut, seen)
+	for macAddress, addresses := range parseNDP(ndpOut, seen) {
+		neighbors[macAddress] = append(neighbors[macAddress], addresses...)
+	}
+
+	return neighbors, nil
+}
+
+func runNeighborCmd(cmdName string) (string, error) {
+	stdOutBytes, stdErrBytes, returnCode, err := util.RunCmd(cmdName, []string{"-an"})
+	if err != nil || returnCode != 0 {
+		slog.Error("error reading neighbor table",
+			"cmdName", cmdName,
+			"stdErrBytes", stdErrBytes,
+			"returnCode", returnCode,
+			"err", err,
+		)
+
+		return "", fmt.Errorf("%w: %s", errNeighborTable, cmdName)
+	}
+
+	return string(stdOutBytes), nil
+}
+
+// parseARP parses the output of arp -an, lines look like:
+// ? (10.0.0.5) at 58:9c:fc:0a:1b:2c on bridge0 expires in 1195 seconds [ethernet]
+func parseARP(output string, seen time.Time) map[string][]Address {
+	neighbors := make(map[string][]Address)
+
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || fields[2] != "at" {
+			continue
+		}
+
+		macAddress := NormalizeMac(fields[3])
+		if macAddress == "" {
+			// incomplete entries have no MAC
+			continue
+		}
+
+		address := strings.Trim(fields[1], "()")
+		if net.ParseIP(address) == nil {
+			continue
+		}
+
+		neighbors[macAddress] = append(neighbors[macAddress],
+			Address{Address: address, Source: AddressSourceARP, LastSeen: seen},
+		)
+	}
+
+	return neighbors
+}
+
+// parseNDP parses the output of ndp -an, after a header line the lines look like:
+// fe80::5a9c:fcff:fe0a:1b2c%bridge0    58:9c:fc:0a:1b:2c  bridge0 23h59m54s S R
+func parseNDP(output string, seen time.Time) map[string][]Address {
+	neighbors := make(map[string][]Address)
+
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		macAddress := NormalizeMac(fields[1])
+		if macAddress == "" {
+			continue
+		}
+
+		// link local addresses carry the interface as the zone
+		ipAddress, _, _ := strings.Cut(fields[0], "%")
+		if net.ParseIP(ipAddress) == nil {
+			continue
+		}
+
+		neighbors[macAddress] = append(neighbors[macAddress],
+			Address{Address: fields[0], Source: AddressSourceNDP, LastSeen: seen},
+		)
+	}
+
+	return neighbors
+}
+
+// RecordAddresses adds the addresses just found on the NIC to those seen on it before and returns them all, sorted
+// by address. Addresses not seen for addressForget, or seen while the NIC had another MAC address, are dropped.
+func (vmNic *VMNic) RecordAddresses(macAddress string, found []Address) []Address {
+	addressesSeen.mu.Lock()
+	defer addressesSeen.mu.Unlock()
+
+	seen, ok := addressesSeen.nics[vmNic.ID]
+	if !ok || seen.macAddress != macAddress {
+		seen = &nicAddresses{macAddress: macAddress, addresses: make(map[string]Address)}
+		addressesSeen.nics[vmNic.ID] = seen
+	}
+
+	for _, address := range found {
+		seen.addresses[address.Source+"/"+address.Address] = address
+	}
+
+	addresses := make([]Address, 0, len(seen.addresses))
+
+	for key, address := range seen.addresses {
+		if time.Since(address.LastSeen) > addressForget {
+			delete(seen.addresses, key)
+
+			continue
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	slices.SortFunc(addresses, func(a, b Address) int {
+		if a.Address != b.Address {
+			return strings.Compare(a.Address, b.Address)
+		}
+
+		return strings.Compare(a.Source, b.Source)
+	})
+
+	return addresses
+}
+
+// forgetAddresses drops the addresses seen on the NIC
+func (vmNic *VMNic) forgetAddresses() {
+	addressesSeen.mu.Lock()
+	delete(addressesSeen.nics, vmNic.ID)
+	addressesSeen.mu.Unlock()
+}
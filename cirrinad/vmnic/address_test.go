@@ -0,0 +1,182 @@
+package vmnic
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+
+	"cirrina/cirrinad/cirrinadtest"
+	"cirrina/cirrinad/util"
+)
+
+const testARPOutput = `? (10.0.0.5) at 58:9c:fc:0a:1b:2c on bridge0 expires in 1195 seconds [ethernet]
+? (10.0.0.6) at (incomplete) on bridge0 expired [ethernet]
+? (10.0.0.1) at 58:9c:fc:00:00:01 on bridge0 permanent [ethernet]
+? (10.0.0.7) at 58:9C:FC:0A:1B:2C on bridge0 expires in 20 seconds [ethernet]
+`
+
+const testNDPOutput = `Neighbor                             Linklayer Address  Netif Expire    1s 5s
+fe80::5a9c:fcff:fe0a:1b2c%bridge0    58:9c:fc:0a:1b:2c  bridge0 23h59m54s S R
+2001:db8::5                          58:9c:fc:0a:1b:2c  bridge0 23h59m58s S
+2001:db8::6                          (incomplete)       bridge0 expired   N
+`
+
+//nolint:paralleltest
+func Test_parseARP(t *testing.T) {
+	seen := time.Now()
+
+	got := parseARP(testARPOutput, seen)
+	want := map[string][]Address{
+		"58:9c:fc:0a:1b:2c": {
+			{Address: "10.0.0.5", Source: AddressSourceARP, LastSeen: seen},
+			{Address: "10.0.0.7", Source: AddressSourceARP, LastSeen: seen},
+		},
+		"58:9c:fc:00:00:01": {
+			{Address: "10.0.0.1", Source: AddressSourceARP, LastSeen: seen},
+		},
+	}
+
+	diff := deep.Equal(got, want)
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+}
+
+//nolint:paralleltest
+func Test_parseNDP(t *testing.T) {
+	seen := time.Now()
+
+	got := parseNDP(testNDPOutput, seen)
+	want := map[string][]Address{
+		"58:9c:fc:0a:1b:2c": {
+			{Address: "fe80::5a9c:fcff:fe0a:1b2c%bridge0", Source: AddressSourceNDP, LastSeen: seen},
+			{Address: "2001:db8::5", Source: AddressSourceNDP, LastSeen: seen},
+		},
+	}
+
+	diff := deep.Equal(got, want)
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+}
+
+//nolint:paralleltest
+func TestNeighborAddresses(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockCmdFunc string
+		want        []string
+		wantErr     bool
+	}{
+		{
+			name:        "success",
+			mockCmdFunc: "TestNeighborAddressesSuccess",
+			want:        []string{"10.0.0.5", "10.0.0.7", "fe80::5a9c:fcff:fe0a:1b2c%bridge0", "2001:db8::5"},
+		},
+		{
+			name:        "ndpFails",
+			mockCmdFunc: "TestNeighborAddressesNDPFail",
+			wantErr:     true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			fakeCommand := cirrinadtest.MakeFakeCommand(testCase.mockCmdFunc)
+			util.SetupTestCmd(fakeCommand)
+
+			t.Cleanup(func() { util.TearDownTestCmd() })
+
+			got, err := NeighborAddresses()
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("NeighborAddresses() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+
+			var gotAddresses []string
+			for _, address := range got["58:9c:fc:0a:1b:2c"] {
+				gotAddresses = append(gotAddresses, address.Address)
+			}
+
+			diff := deep.Equal(gotAddresses, testCase.want)
+			if diff != nil {
+				t.Errorf("compare failed: %v", diff)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestVMNic_RecordAddresses(t *testing.T) {
+	testNic := &VMNic{ID: "f4a1c7b2-0d3e-4b5a-8c6d-7e8f9a0b1c2d"}
+
+	t.Cleanup(testNic.forgetAddresses)
+
+	now := time.Now()
+	old := now.Add(-2 * time.Hour)
+	forgotten := now.Add(-25 * time.Hour)
+
+	testNic.RecordAddresses("58:9c:fc:0a:1b:2c", []Address{
+		{Address: "10.0.0.9", Source: AddressSourceARP, LastSeen: old},
+		{Address: "10.0.0.5", Source: AddressSourceARP, LastSeen: old},
+		{Address: "10.0.0.8", Source: AddressSourceARP, LastSeen: forgotten},
+	})
+
+	got := testNic.RecordAddresses("58:9c:fc:0a:1b:2c", []Address{
+		{Address: "10.0.0.5", Source: AddressSourceARP, LastSeen: now},
+		{Address: "10.0.0.5", Source: AddressSourceAgent, LastSeen: now},
+	})
+	want := []Address{
+		{Address: "10.0.0.5", Source: AddressSourceAgent, LastSeen: now},
+		{Address: "10.0.0.5", Source: AddressSourceARP, LastSeen: now},
+		{Address: "10.0.0.9", Source: AddressSourceARP, LastSeen: old},
+	}
+
+	diff := deep.Equal(got, want)
+	if diff != nil {
+		t.Errorf("compare failed: %v", diff)
+	}
+
+	// a new MAC address means the old addresses belong to some other guest
+	got = testNic.RecordAddresses("58:9c:fc:0a:1b:2d", nil)
+	if len(got) != 0 {
+		t.Errorf("RecordAddresses() after MAC change = %v, want none", got)
+	}
+}
+
+//nolint:paralleltest
+func TestNeighborAddressesSuccess(_ *testing.T) {
+	if !cirrinadtest.IsTestEnv() {
+		return
+	}
+
+	cmdWithArgs := os.Args[3:]
+
+	switch cmdWithArgs[0] {
+	case "/usr/sbin/arp":
+		_, _ = os.Stdout.WriteString(testARPOutput)
+	case "/usr/sbin/ndp":
+		_, _ = os.Stdout.WriteString(testNDPOutput)
+	default:
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+//nolint:paralleltest
+func TestNeighborAddressesNDPFail(_ *testing.T) {
+	if !cirrinadtest.IsTestEnv() {
+		return
+	}
+
+	cmdWithArgs := os.Args[3:]
+
+	if cmdWithArgs[0] == "/usr/sbin/arp" {
+		_, _ = os.Stdout.WriteString(testARPOutput)
+		os.Exit(0)
+	}
+
+	os.Exit(1)
+}
@@ -15,4 +15,5 @@ var (
 	ErrInvalidNic           = errors.New("invalid NIC")
 	ErrNicInUse             = errors.New("nic in use")
 	ErrNicUnknownNetDevType = errors.New("unknown net dev type")
+	errNeighborTable        = errors.New("error reading neighbor table")
 )
@@ -170,6 +170,8 @@ func (vmNic *VMNic) Delete() error {
 		return errNicInternalDB
 	}
 
+	vmNic.forgetAddresses()
+
 	return nil
 }
 
@@ -6,6 +6,7 @@ import (
 	"reflect"
 
 	"cirrina/cirrinad/requests"
+	"cirrina/cirrinad/schedule"
 	"cirrina/cirrinad/vm"
 )
 
@@ -44,7 +45,7 @@ func startVM(request *requests.Request) {
 	err = vmInst.Start()
 	if err != nil {
 		slog.Error("failed to start VM", "vm", vmInst.ID, "err", err)
-		request.Failed()
+		request.FailedWithError(err)
 
 		return
 	}
@@ -141,6 +142,286 @@ func deleteVM(request *requests.Request) {
 		return
 	}
 
+	// snapshots hold disk snapshots which would otherwise prevent deleting the disks, they are only removed once
+	// the VM is gone so that a failure to delete it leaves them in place
+	err = vmInst.DeleteSnapshots()
+	if err != nil {
+		slog.Error("failed to delete VM snapshots", "vm", vmInst.ID, "err", err)
+	}
+
+	err = vmInst.DeleteRestartHistory()
+	if err != nil {
+		// not failing the request, the VM is already gone
+		slog.Error("failed to delete VM restart history", "vm", vmInst.ID, "err", err)
+	}
+
+	err = vmInst.DeleteControllers()
+	if err != nil {
+		slog.Error("failed to delete VM controllers", "vm", vmInst.ID, "err", err)
+	}
+
+	err = vmInst.DeletePassthrus()
+	if err != nil {
+		slog.Error("failed to delete VM passthru devices", "vm", vmInst.ID, "err", err)
+	}
+
+	err = vmInst.DeleteShares()
+	if err != nil {
+		slog.Error("failed to delete VM shares", "vm", vmInst.ID, "err", err)
+	}
+
+	err = vmInst.RemoveFromDependents()
+	if err != nil {
+		slog.Error("failed to remove VM from dependencies", "vm", vmInst.ID, "err", err)
+	}
+
+	err = schedule.DeleteByVMID(vmInst.ID)
+	if err != nil {
+		slog.Error("failed to delete VM schedules", "vm", vmInst.ID, "err", err)
+	}
+
 	request.Succeeded()
 	delete(vm.List.VMList, vmInst.ID)
 }
+
+func vmSnapshot(request *requests.Request) {
+	var err error
+
+	var reqData requests.VMSnapshotReqData
+
+	if request.Type != requests.VMSNAPSHOT {
+		slog.Error("vm snapshot request called for wrong request type")
+		request.Failed()
+
+		return
+	}
+
+	err = json.Unmarshal([]byte(request.Data), &reqData)
+	if err != nil {
+		slog.Error("failed unmarshalling request data",
+			"rsData", request.Data, "reqType", reflect.TypeOf(reqData), "err", err)
+		request.Failed()
+
+		return
+	}
+
+	vmInst, err := vm.GetByID(reqData.VMID)
+	if err != nil {
+		slog.Error("vmSnapshot error getting vm", "vm", reqData.VMID, "err", err)
+		request.Failed()
+
+		return
+	}
+
+	if vmHasPendingReq(request.ID, vmInst.ID) {
+		slog.Error("failing request to snapshot VM which has pending request", "vm", vmInst.ID)
+		request.Failed()
+
+		return
+	}
+
+	// a running VM's filesystems are frozen through its guest agent so the disk snapshots are consistent
+	thaw := vmInst.QuiesceForSnapshot()
+	defer thaw()
+
+	newSnapshot, err := vmInst.CreateSnapshot(reqData.SnapshotName, reqData.SnapshotDesc)
+	if err != nil {
+		slog.Error("failed to snapshot VM", "vm", vmInst.ID, "err", err)
+		request.Failed()
+
+		return
+	}
+
+	slog.Debug("created VM snapshot", "vm", vmInst.ID, "snapshot", newSnapshot.ID)
+
+	request.Succeeded()
+}
+
+func vmRestore(request *requests.Request) {
+	var err error
+
+	var reqData requests.VMSnapshotReqData
+
+	if request.Type != requests.VMRESTORE {
+		slog.Error("vm restore request called for wrong request type")
+		request.Failed()
+
+		return
+	}
+
+	err = json.Unmarshal([]byte(request.Data), &reqData)
+	if err != nil {
+		slog.Error("failed unmarshalling request data",
+			"rsData", request.Data, "reqType", reflect.TypeOf(reqData), "err", err)
+		request.Failed()
+
+		return
+	}
+
+	vmInst, err := vm.GetByID(reqData.VMID)
+	if err != nil {
+		slog.Error("vmRestore error getting vm", "vm", reqData.VMID, "err", err)
+		request.Failed()
+
+		return
+	}
+
+	if vmHasPendingReq(request.ID, vmInst.ID) {
+		slog.Error("failing request to restore VM which has pending request", "vm", vmInst.ID)
+		request.Failed()
+
+		return
+	}
+
+	targetSnapshot, err := vmInst.GetSnapshotByID(reqData.SnapshotID)
+	if err != nil {
+		slog.Error("vmRestore error getting snapshot", "vm", vmInst.ID, "err", err)
+		request.Failed()
+
+		return
+	}
+
+	err = vmInst.RestoreSnapshot(targetSnapshot)
+	if err != nil {
+		slog.Error("failed to restore VM snapshot", "vm", vmInst.ID, "snapshot", targetSnapshot.ID, "err", err)
+		request.Failed()
+
+		return
+	}
+
+	slog.Debug("restored VM snapshot", "vm", vmInst.ID, "snapshot", targetSnapshot.ID)
+
+	request.Succeeded()
+}
+
+// vmHasPendingReq check if the VM has pending requests other than this one
+func vmHasPendingReq(thisReqID string, vmID string) bool {
+	pendingReqIDs := requests.PendingReqExists(vmID)
+	for _, pendingReqID := range pendingReqIDs {
+		if pendingReqID != thisReqID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// vmHasPendingNonStopReq check if the VM has pending requests other than this one, ignoring stop requests so that a
+// VM which is hanging while stopping can still be powered off
+func vmHasPendingNonStopReq(thisReqID string, vmID string) bool {
+	pendingReqIDs := requests.PendingReqExists(vmID)
+	for _, pendingReqID := range pendingReqIDs {
+		if pendingReqID == thisReqID {
+			continue
+		}
+
+		pendingReq, err := requests.GetByID(pendingReqID)
+		if err != nil || pendingReq.Type != requests.VMSTOP {
+			return true
+		}
+	}
+
+	return false
+}
+
+func powerOffVM(request *requests.Request) {
+	vmInst, ok := getVMFromReq(request)
+	if !ok {
+		return
+	}
+
+	if vmHasPendingNonStopReq(request.ID, vmInst.ID) {
+		slog.Error("failing request to power off VM which has pending request", "vm", vmInst.ID)
+		request.Failed()
+
+		return
+	}
+
+	slog.Debug("powering off VM", "vm", vmInst.ID)
+
+	err := vmInst.PowerOff()
+	if err != nil {
+		slog.Error("failed to power off VM", "vm", vmInst.ID, "err", err)
+		request.Failed()
+
+		return
+	}
+
+	request.Succeeded()
+}
+
+func resetVM(request *requests.Request) {
+	vmInst, ok := getVMFromReq(request)
+	if !ok {
+		return
+	}
+
+	if vmHasPendingReq(request.ID, vmInst.ID) {
+		slog.Error("failing request to reset VM which has pending request", "vm", vmInst.ID)
+		request.Failed()
+
+		return
+	}
+
+	slog.Debug("resetting VM", "vm", vmInst.ID)
+
+	err := vmInst.Reset()
+	if err != nil {
+		slog.Error("failed to reset VM", "vm", vmInst.ID, "err", err)
+		request.Failed()
+
+		return
+	}
+
+	request.Succeeded()
+}
+
+func rebootVM(request *requests.Request) {
+	vmInst, ok := getVMFromReq(request)
+	if !ok {
+		return
+	}
+
+	if vmHasPendingReq(request.ID, vmInst.ID) {
+		slog.Error("failing request to reboot VM which has pending request", "vm", vmInst.ID)
+		request.Failed()
+
+		return
+	}
+
+	slog.Debug("rebooting VM", "vm", vmInst.ID)
+
+	err := vmInst.Reboot()
+	if err != nil {
+		slog.Error("failed to reboot VM", "vm", vmInst.ID, "err", err)
+		request.FailedWithError(err)
+
+		return
+	}
+
+	request.Succeeded()
+}
+
+// getVMFromReq decodes the request data and looks up the VM, failing the request if either fails
+func getVMFromReq(request *requests.Request) (*vm.VM, bool) {
+	var reqData requests.VMReqData
+
+	err := json.Unmarshal([]byte(request.Data), &reqData)
+	if err != nil {
+		slog.Error("failed unmarshalling request data",
+			"rsData", request.Data, "reqType", reflect.TypeOf(reqData), "err", err)
+		request.Failed()
+
+		return nil, false
+	}
+
+	vmInst, err := vm.GetByID(reqData.VMID)
+	if err != nil {
+		slog.Error("error getting vm", "vm", reqData.VMID, "err", err)
+		request.Failed()
+
+		return nil, false
+	}
+
+	return vmInst, true
+}
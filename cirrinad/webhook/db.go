@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"log"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"cirrina/cirrinad/config"
+)
+
+type Singleton struct {
+	WebhookDB *gorm.DB
+}
+
+var Instance *Singleton
+
+var once sync.Once
+
+func GetWebhookDB() *gorm.DB {
+	noColorLogger := logger.New(
+		log.New(os.Stdout, "WebhookDb: ", log.LstdFlags),
+		logger.Config{
+			SlowThreshold:             200 * time.Millisecond,
+			LogLevel:                  logger.Warn,
+			IgnoreRecordNotFoundError: false,
+			Colorful:                  false,
+		},
+	)
+
+	once.Do(func() {
+		// allow override for testing
+		if Instance != nil {
+			return
+		}
+
+		Instance = &Singleton{}
+
+		webhookDB, err := gorm.Open(
+			sqlite.Open(config.Config.DB.Path),
+			&gorm.Config{
+				Logger:      noColorLogger,
+				PrepareStmt: true,
+			},
+		)
+		if err != nil {
+			slog.Error("failed to connect to database", "err", err)
+			panic("failed to connect database, err: " + err.Error())
+		}
+
+		sqlDB, err := webhookDB.DB()
+		if err != nil {
+			slog.Error("failed to create sqlDB database", "err", err)
+			panic("failed to create sqlDB database, err: " + err.Error())
+		}
+
+		sqlDB.SetMaxIdleConns(1)
+		sqlDB.SetMaxOpenConns(1)
+
+		Instance.WebhookDB = webhookDB
+	})
+
+	return Instance.WebhookDB
+}
+
+func (e *Endpoint) BeforeCreate(_ *gorm.DB) error {
+	if e == nil || e.Name == "" {
+		return errWebhookInvalidName
+	}
+
+	err := uuid.Validate(e.ID)
+	if err != nil || len(e.ID) != 36 {
+		e.ID = uuid.NewString()
+	}
+
+	return nil
+}
+
+func DBAutoMigrate() {
+	db := GetWebhookDB()
+
+	err := db.AutoMigrate(&Endpoint{}, &Delivery{})
+	if err != nil {
+		slog.Error("failed to auto-migrate Webhooks", "err", err)
+		panic("failed to auto-migrate Webhooks, err: " + err.Error())
+	}
+}
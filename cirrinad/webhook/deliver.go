@@ -0,0 +1,253 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PayloadVersion is bumped whenever the payload changes in a way receivers need to know about
+const PayloadVersion = 1
+
+const (
+	// deliveryTimeout is how long each attempt to deliver an event may take
+	deliveryTimeout = 10 * time.Second
+	// maxRetryBackoff caps the exponential backoff between delivery attempts
+	maxRetryBackoff = 5 * time.Minute
+	// deliveryHistoryMax is how many delivery records are kept per endpoint
+	deliveryHistoryMax = 100
+)
+
+const (
+	HeaderEvent     = "X-Cirrina-Event"
+	HeaderDelivery  = "X-Cirrina-Delivery"
+	HeaderSignature = "X-Cirrina-Signature"
+)
+
+// allow override for testing
+var retryBase = time.Second
+
+// Payload is the JSON body posted to endpoints, only the section for the kind of event is included
+type Payload struct {
+	Version int          `json:"version"`
+	ID      string       `json:"id"` // unique per event, the same for every endpoint and retry
+	Event   EventType    `json:"event"`
+	Time    time.Time    `json:"time"`
+	VM      *VMData      `json:"vm,omitempty"`
+	Request *RequestData `json:"request,omitempty"`
+	Disk    *DiskData    `json:"disk,omitempty"`
+}
+
+type VMData struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	Reason    string `json:"reason,omitempty"`    // why the VM exited, for crash, exit and respawn events
+	ExitCode  *int   `json:"exit_code,omitempty"` // bhyve exit code, for crash and exit events
+	CrashLoop bool   `json:"crash_loop,omitempty"`
+}
+
+type RequestData struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Result string `json:"result,omitempty"`
+}
+
+type DiskData struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Delivery is an entry in the log of events sent to an endpoint
+type Delivery struct {
+	ID         uint `gorm:"primarykey"`
+	CreatedAt  time.Time
+	EndpointID string `gorm:"index;not null;default:null"`
+	EventID    string
+	Event      string
+	Attempts   uint32
+	StatusCode int // HTTP status of the last attempt, 0 if no response was received
+	Success    bool
+	Error      string
+}
+
+func (Delivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// Emit sends the event to every endpoint which wants it, in the background
+func Emit(payload Payload) {
+	endpointsMu.RLock()
+
+	var targets []*Endpoint
+
+	for _, endpoint := range endpoints {
+		if endpoint.Wants(payload.Event) {
+			targets = append(targets, endpoint)
+		}
+	}
+
+	endpointsMu.RUnlock()
+
+	send(targets, payload)
+}
+
+// SendTest emits a test event to only this endpoint
+func (e *Endpoint) SendTest() {
+	send([]*Endpoint{e}, Payload{Event: EventTest})
+}
+
+func send(targets []*Endpoint, payload Payload) {
+	if len(targets) == 0 {
+		return
+	}
+
+	payload.Version = PayloadVersion
+	payload.ID = uuid.NewString()
+	payload.Time = time.Now().UTC()
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		slog.Error("error marshalling webhook payload", "event", payload.Event, "err", err)
+
+		return
+	}
+
+	for _, endpoint := range targets {
+		go func(endpoint *Endpoint) {
+			delivery := endpoint.deliver(payload.ID, payload.Event, body)
+			endpoint.saveDelivery(delivery)
+		}(endpoint)
+	}
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the body using the secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryBackoff doubles the wait before each retry
+func retryBackoff(attempt uint32) time.Duration {
+	if attempt > 30 {
+		return maxRetryBackoff
+	}
+
+	backoff := retryBase * time.Duration(1<<attempt)
+	if backoff > maxRetryBackoff || backoff <= 0 {
+		return maxRetryBackoff
+	}
+
+	return backoff
+}
+
+// deliver posts the body to the endpoint, retrying with backoff until it succeeds or the retries run out
+func (e *Endpoint) deliver(eventID string, event EventType, body []byte) *Delivery {
+	delivery := &Delivery{EndpointID: e.ID, EventID: eventID, Event: string(event)}
+
+	for delivery.Attempts <= e.MaxRetries {
+		if delivery.Attempts > 0 {
+			time.Sleep(retryBackoff(delivery.Attempts - 1))
+		}
+
+		delivery.Attempts++
+
+		statusCode, err := e.post(eventID, event, body)
+		delivery.StatusCode = statusCode
+
+		if err == nil {
+			delivery.Success = true
+			delivery.Error = ""
+
+			return delivery
+		}
+
+		delivery.Error = err.Error()
+		slog.Debug("webhook delivery failed",
+			"webhook", e.Name, "event", event, "attempt", delivery.Attempts, "err", err)
+	}
+
+	slog.Error("webhook delivery failed, giving up",
+		"webhook", e.Name, "event", event, "attempts", delivery.Attempts, "err", delivery.Error)
+
+	return delivery
+}
+
+// post makes a single delivery attempt, any non 2xx status is a failure
+func (e *Endpoint) post(eventID string, event EventType, body []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), deliveryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("error creating webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "cirrinad")
+	req.Header.Set(HeaderEvent, string(event))
+	req.Header.Set(HeaderDelivery, eventID)
+
+	if e.Secret != "" {
+		req.Header.Set(HeaderSignature, "sha256="+Sign(e.Secret, body))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error sending webhook: %w", err)
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("%w: %s", errWebhookStatus, resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func (e *Endpoint) saveDelivery(delivery *Delivery) {
+	db := GetWebhookDB()
+
+	res := db.Create(delivery)
+	if res.Error != nil {
+		slog.Error("error saving webhook delivery", "webhook", e.ID, "err", res.Error)
+
+		return
+	}
+
+	// only keep the most recent records
+	res = db.Where("endpoint_id = ? AND id NOT IN (?)", e.ID,
+		db.Model(&Delivery{}).Select("id").Where("endpoint_id = ?", e.ID).Order("id desc").Limit(deliveryHistoryMax),
+	).Delete(&Delivery{})
+	if res.Error != nil {
+		slog.Error("error pruning webhook deliveries", "webhook", e.ID, "err", res.Error)
+	}
+}
+
+// GetDeliveries returns the delivery log of the endpoint, oldest first
+func (e *Endpoint) GetDeliveries() ([]*Delivery, error) {
+	var deliveries []*Delivery
+
+	db := GetWebhookDB()
+
+	res := db.Where("endpoint_id = ?", e.ID).Order("id").Find(&deliveries)
+	if res.Error != nil {
+		slog.Error("error getting webhook deliveries", "webhook", e.ID, "err", res.Error)
+
+		return nil, errWebhookInternalDB
+	}
+
+	return deliveries, nil
+}
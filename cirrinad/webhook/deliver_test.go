@@ -0,0 +1,144 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+//nolint:paralleltest
+func TestSign(t *testing.T) {
+	// from RFC 4231 test case 2
+	got := Sign("Jefe", []byte("what do ya want for nothing?"))
+
+	want := "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"
+	if got != want {
+		t.Errorf("Sign() = %v, want %v", got, want)
+	}
+}
+
+//nolint:paralleltest
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		attempt uint32
+		want    time.Duration
+	}{
+		{name: "first", attempt: 0, want: time.Second},
+		{name: "second", attempt: 1, want: 2 * time.Second},
+		{name: "fifth", attempt: 4, want: 16 * time.Second},
+		{name: "capped", attempt: 12, want: maxRetryBackoff},
+		{name: "huge", attempt: 100, want: maxRetryBackoff},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := retryBackoff(testCase.attempt)
+			if got != testCase.want {
+				t.Errorf("retryBackoff() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestEndpoint_deliver(t *testing.T) {
+	savedRetryBase := retryBase
+	retryBase = time.Millisecond
+
+	t.Cleanup(func() { retryBase = savedRetryBase })
+
+	body := []byte(`{"version":1,"id":"an-event-id","event":"vm.crash"}`)
+
+	tests := []struct {
+		name           string
+		secret         string
+		maxRetries     uint32
+		failFirst      int32
+		wantAttempts   uint32
+		wantSuccess    bool
+		wantStatusCode int
+	}{
+		{
+			name: "firstTry", secret: "s3cret", maxRetries: 3, failFirst: 0,
+			wantAttempts: 1, wantSuccess: true, wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "afterRetries", secret: "", maxRetries: 3, failFirst: 2,
+			wantAttempts: 3, wantSuccess: true, wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "givesUp", secret: "s3cret", maxRetries: 2, failFirst: 10,
+			wantAttempts: 3, wantSuccess: false, wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var requests atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+				gotBody, _ := io.ReadAll(req.Body)
+				if string(gotBody) != string(body) {
+					t.Errorf("body = %s, want %s", gotBody, body)
+				}
+
+				if req.Header.Get(HeaderEvent) != string(EventVMCrash) {
+					t.Errorf("event header = %s, want %s", req.Header.Get(HeaderEvent), EventVMCrash)
+				}
+
+				if req.Header.Get(HeaderDelivery) != "an-event-id" {
+					t.Errorf("delivery header = %s, want an-event-id", req.Header.Get(HeaderDelivery))
+				}
+
+				wantSignature := ""
+				if testCase.secret != "" {
+					wantSignature = "sha256=" + Sign(testCase.secret, body)
+				}
+
+				if req.Header.Get(HeaderSignature) != wantSignature {
+					t.Errorf("signature header = %s, want %s", req.Header.Get(HeaderSignature), wantSignature)
+				}
+
+				if requests.Add(1) <= testCase.failFirst {
+					writer.WriteHeader(http.StatusInternalServerError)
+
+					return
+				}
+
+				writer.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			endpoint := Endpoint{
+				ID: "an-endpoint-id", Name: "test", URL: server.URL,
+				Secret: testCase.secret, MaxRetries: testCase.maxRetries,
+			}
+
+			got := endpoint.deliver("an-event-id", EventVMCrash, body)
+
+			if got.Attempts != testCase.wantAttempts {
+				t.Errorf("deliver() attempts = %v, want %v", got.Attempts, testCase.wantAttempts)
+			}
+
+			if got.Success != testCase.wantSuccess {
+				t.Errorf("deliver() success = %v, want %v", got.Success, testCase.wantSuccess)
+			}
+
+			if got.StatusCode != testCase.wantStatusCode {
+				t.Errorf("deliver() status = %v, want %v", got.StatusCode, testCase.wantStatusCode)
+			}
+
+			if got.Success != (got.Error == "") {
+				t.Errorf("deliver() error = %v, success %v", got.Error, got.Success)
+			}
+
+			if got.EndpointID != endpoint.ID || got.EventID != "an-event-id" || got.Event != string(EventVMCrash) {
+				t.Errorf("deliver() = %+v, wrong endpoint or event", got)
+			}
+		})
+	}
+}
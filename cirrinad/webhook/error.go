@@ -0,0 +1,14 @@
+package webhook
+
+import "errors"
+
+var (
+	errWebhookInvalidName  = errors.New("invalid webhook name")
+	errWebhookExists       = errors.New("webhook exists")
+	errWebhookInvalidURL   = errors.New("invalid webhook url, must be an http or https url")
+	errWebhookInvalidEvent = errors.New("invalid webhook event")
+	errWebhookInternalDB   = errors.New("internal webhook database error")
+	errWebhookIDEmpty      = errors.New("webhook id not specified or invalid")
+	errWebhookNotFound     = errors.New("webhook not found")
+	errWebhookStatus       = errors.New("webhook endpoint returned error status")
+)
@@ -0,0 +1,222 @@
+package webhook
+
+import (
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"cirrina/cirrinad/util"
+)
+
+type EventType string
+
+const (
+	EventVMState       EventType = "vm.state"       // a VM changed state
+	EventVMCrash       EventType = "vm.crash"       // a VM halted, triple faulted or failed without being asked to stop
+	EventVMExit        EventType = "vm.exit"        // the guest powered off or rebooted the VM itself
+	EventVMRespawn     EventType = "vm.respawn"     // a VM was started again by its restart policy
+	EventRequestFailed EventType = "request.failed" // a request completed without succeeding
+	EventDiskWiped     EventType = "disk.wiped"     // a disk wipe request completed
+	EventTest          EventType = "test"           // sent on demand to check an endpoint works
+)
+
+// defaultRetries is used when an endpoint does not set how many times a failed delivery is retried
+const defaultRetries = 5
+
+// Endpoint is a URL which is sent a signed JSON payload for each event matching its event filter
+type Endpoint struct {
+	ID          string `gorm:"uniqueIndex;not null;default:null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	Name        string         `gorm:"uniqueIndex;not null;default:null"`
+	Description string
+	URL         string `gorm:"not null;default:null"`
+	Secret      string // key for the HMAC-SHA256 signature of the payload, empty to not sign
+	Events      string // comma separated events to send, empty for all events
+	MaxRetries  uint32 `gorm:"default:5"`
+}
+
+// endpoints caches the endpoints so emitting an event does not need a database lookup
+var (
+	endpoints   []*Endpoint
+	endpointsMu sync.RWMutex
+)
+
+// ValidEvent checks the event is one of the known events
+func ValidEvent(event string) bool {
+	switch EventType(event) {
+	case EventVMState, EventVMCrash, EventVMExit, EventVMRespawn, EventRequestFailed, EventDiskWiped, EventTest:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseEvents splits a comma separated event filter, checking each event is known
+func ParseEvents(events string) ([]EventType, error) {
+	var parsed []EventType
+
+	for _, event := range strings.Split(events, ",") {
+		event = strings.TrimSpace(event)
+		if event == "" {
+			continue
+		}
+
+		if !ValidEvent(event) {
+			return nil, fmt.Errorf("%w: %s", errWebhookInvalidEvent, event)
+		}
+
+		parsed = append(parsed, EventType(event))
+	}
+
+	return parsed, nil
+}
+
+// Wants returns true if the event passes the endpoint's event filter, test events are always wanted
+func (e *Endpoint) Wants(event EventType) bool {
+	if event == EventTest {
+		return true
+	}
+
+	events, err := ParseEvents(e.Events)
+	if err != nil {
+		return false
+	}
+
+	return len(events) == 0 || slices.Contains(events, event)
+}
+
+func (e *Endpoint) validate() error {
+	if !util.ValidDiskName(e.Name) {
+		return errWebhookInvalidName
+	}
+
+	endpointURL, err := url.Parse(e.URL)
+	if err != nil || (endpointURL.Scheme != "http" && endpointURL.Scheme != "https") || endpointURL.Host == "" {
+		return errWebhookInvalidURL
+	}
+
+	_, err = ParseEvents(e.Events)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Create validates and saves a new endpoint
+func Create(endpointInst *Endpoint) error {
+	err := endpointInst.validate()
+	if err != nil {
+		return err
+	}
+
+	_, err = GetByName(endpointInst.Name)
+	if err == nil {
+		return errWebhookExists
+	}
+
+	if endpointInst.MaxRetries == 0 {
+		endpointInst.MaxRetries = defaultRetries
+	}
+
+	db := GetWebhookDB()
+
+	res := db.Create(&endpointInst)
+	if res.Error != nil {
+		return fmt.Errorf("error creating webhook: %w", res.Error)
+	}
+
+	if res.RowsAffected != 1 {
+		return errWebhookInternalDB
+	}
+
+	Load()
+
+	return nil
+}
+
+// GetAll returns all endpoints, sorted by name
+func GetAll() []*Endpoint {
+	var result []*Endpoint
+
+	db := GetWebhookDB()
+	db.Order("name").Find(&result)
+
+	return result
+}
+
+func GetByID(endpointID string) (*Endpoint, error) {
+	if endpointID == "" {
+		return nil, errWebhookIDEmpty
+	}
+
+	var result *Endpoint
+
+	db := GetWebhookDB()
+
+	res := db.Limit(1).Find(&result, "id = ?", endpointID)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	if res.RowsAffected != 1 {
+		return nil, errWebhookNotFound
+	}
+
+	return result, nil
+}
+
+func GetByName(name string) (*Endpoint, error) {
+	var result *Endpoint
+
+	db := GetWebhookDB()
+
+	res := db.Limit(1).Find(&result, "name = ?", name)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	if res.RowsAffected != 1 {
+		return nil, errWebhookNotFound
+	}
+
+	return result, nil
+}
+
+// Delete removes the endpoint and its delivery log
+func (e *Endpoint) Delete() error {
+	db := GetWebhookDB()
+
+	res := db.Where("endpoint_id = ?", e.ID).Delete(&Delivery{})
+	if res.Error != nil {
+		slog.Error("error deleting webhook deliveries", "webhook", e.ID, "err", res.Error)
+	}
+
+	res = db.Limit(1).Unscoped().Delete(&e)
+	if res.RowsAffected != 1 {
+		slog.Error("webhook delete error", "RowsAffected", res.RowsAffected)
+
+		return errWebhookInternalDB
+	}
+
+	Load()
+
+	return nil
+}
+
+// Load reads the endpoints into the cache used when emitting events
+func Load() {
+	allEndpoints := GetAll()
+
+	endpointsMu.Lock()
+	endpoints = allEndpoints
+	endpointsMu.Unlock()
+}
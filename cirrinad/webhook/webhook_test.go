@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"testing"
+)
+
+//nolint:paralleltest
+func TestEndpoint_validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint Endpoint
+		wantErr  bool
+	}{
+		{
+			name:     "allEvents",
+			endpoint: Endpoint{Name: "chatops", URL: "https://bot.example.com/hook"},
+		},
+		{
+			name:     "someEvents",
+			endpoint: Endpoint{Name: "chatops", URL: "http://10.0.0.1:8080/hook", Events: "vm.crash, request.failed"},
+		},
+		{
+			name:     "badName",
+			endpoint: Endpoint{Name: "chat ops", URL: "https://bot.example.com/hook"},
+			wantErr:  true,
+		},
+		{
+			name:     "badScheme",
+			endpoint: Endpoint{Name: "chatops", URL: "ftp://bot.example.com/hook"},
+			wantErr:  true,
+		},
+		{
+			name:     "noHost",
+			endpoint: Endpoint{Name: "chatops", URL: "https:///hook"},
+			wantErr:  true,
+		},
+		{
+			name:     "badEvent",
+			endpoint: Endpoint{Name: "chatops", URL: "https://bot.example.com/hook", Events: "vm.crash,vm.boom"},
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.endpoint.validate()
+			if (err != nil) != testCase.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest
+func TestEndpoint_Wants(t *testing.T) {
+	tests := []struct {
+		name   string
+		events string
+		event  EventType
+		want   bool
+	}{
+		{name: "emptyFilter", events: "", event: EventVMState, want: true},
+		{name: "inFilter", events: "vm.crash,vm.respawn", event: EventVMRespawn, want: true},
+		{name: "notInFilter", events: "vm.crash,vm.respawn", event: EventDiskWiped, want: false},
+		{name: "testAlways", events: "vm.crash", event: EventTest, want: true},
+		{name: "exitNotCrash", events: "vm.crash", event: EventVMExit, want: false},
+		{name: "badFilter", events: "vm.boom", event: EventVMCrash, want: false},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			endpoint := Endpoint{Events: testCase.events}
+
+			got := endpoint.Wants(testCase.event)
+			if got != testCase.want {
+				t.Errorf("Wants() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
@@ -12,4 +12,5 @@ type Disk struct {
 	DevType     string
 	Cache       bool
 	Direct      bool
+	Purge       bool
 }
@@ -7,4 +7,5 @@ type ISO struct {
 	Description string
 	Size        string
 	VMs         []VM
+	Purge       bool
 }
@@ -12,4 +12,5 @@ type NIC struct {
 	RateLimited bool
 	RateIn      string
 	RateOut     string
+	Addresses   []string
 }
@@ -3,6 +3,7 @@ package components
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cirrina/cirrinactl/rpc"
 	"cirrina/cirrinaweb/util"
@@ -30,6 +31,28 @@ type AdvancedSettings struct {
 	WaitDebugConn          bool
 	DebugPort              string
 	ExtraArgs              string
+	Sockets                uint32
+	Cores                  uint32
+	Threads                uint32
+	CPUPins                string
+	ConsolePorts           string
+	GuestAgent             bool
+	Firmware               string
+}
+
+type Share struct {
+	Name     string
+	Path     string
+	ReadOnly bool
+}
+
+type VMSnapshot struct {
+	ID          string
+	Name        string
+	Description string
+	Created     time.Time
+	DiskCount   int
+	UEFIVars    bool
 }
 
 type VM struct {
@@ -44,6 +67,9 @@ type VM struct {
 	Disks            []Disk
 	ISOs             []ISO
 	NICs             []NIC
+	Shares           []Share
+	Snapshots        []VMSnapshot
+	UEFIBootEntries  []UEFIBootEntry
 	COM1             COM
 	COM2             COM
 	COM3             COM
@@ -82,6 +108,48 @@ func (v VM) Stop(ctx context.Context) error {
 	return nil
 }
 
+func (v VM) PowerOff(ctx context.Context) error {
+	err := util.InitRPCConn()
+	if err != nil {
+		return fmt.Errorf("error powering off VM, failed to get connection: %w", err)
+	}
+
+	_, err = rpc.PowerOffVM(ctx, v.ID)
+	if err != nil {
+		return fmt.Errorf("error powering off VM: %w", err)
+	}
+
+	return nil
+}
+
+func (v VM) Reset(ctx context.Context) error {
+	err := util.InitRPCConn()
+	if err != nil {
+		return fmt.Errorf("error resetting VM, failed to get connection: %w", err)
+	}
+
+	_, err = rpc.ResetVM(ctx, v.ID)
+	if err != nil {
+		return fmt.Errorf("error resetting VM: %w", err)
+	}
+
+	return nil
+}
+
+func (v VM) Reboot(ctx context.Context) error {
+	err := util.InitRPCConn()
+	if err != nil {
+		return fmt.Errorf("error rebooting VM, failed to get connection: %w", err)
+	}
+
+	_, err = rpc.RebootVM(ctx, v.ID)
+	if err != nil {
+		return fmt.Errorf("error rebooting VM: %w", err)
+	}
+
+	return nil
+}
+
 func (v VM) ClearUEFIVars(ctx context.Context) error {
 	err := util.InitRPCConn()
 	if err != nil {
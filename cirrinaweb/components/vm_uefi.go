@@ -0,0 +1,10 @@
+package components
+
+type UEFIBootEntry struct {
+	Number      string
+	Description string
+	Active      bool
+	Order       int // position in the boot order, 0 if not in it
+	Next        bool
+	DevicePath  string
+}
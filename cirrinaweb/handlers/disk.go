@@ -63,6 +63,11 @@ func GetDisk(ctx context.Context, nameOrID string) (components.Disk, error) {
 	returnDisk.Cache = diskInfo.Cache
 	returnDisk.Direct = diskInfo.Direct
 
+	returnDisk.Purge, err = rpc.GetPurgeDefault(ctx)
+	if err != nil {
+		return components.Disk{}, fmt.Errorf("error getting Disk: %w", err)
+	}
+
 	var diskSizeUsage rpc.DiskSizeUsage
 
 	diskSizeUsage, err = rpc.GetDiskSizeUsage(ctx, returnDisk.ID)
@@ -90,7 +95,8 @@ func GetDisk(ctx context.Context, nameOrID string) (components.Disk, error) {
 	return returnDisk, nil
 }
 
-func DeleteDisk(ctx context.Context, nameOrID string) error {
+// DeleteDisk deletes the disk, purging its backing storage only if the user confirmed that
+func DeleteDisk(ctx context.Context, nameOrID string, purge bool) error {
 	var err error
 
 	var diskID string
@@ -105,7 +111,7 @@ func DeleteDisk(ctx context.Context, nameOrID string) error {
 		diskID = parsedUUID.String()
 	}
 
-	err = rpc.RmDisk(ctx, diskID)
+	err = rpc.RmDisk(ctx, diskID, &purge)
 	if err != nil {
 		return fmt.Errorf("failed removing disk: %w", err)
 	}
@@ -113,6 +119,74 @@ func DeleteDisk(ctx context.Context, nameOrID string) error {
 	return nil
 }
 
+func ResizeDisk(ctx context.Context, nameOrID string, newSize string, force bool) error {
+	var err error
+
+	var diskID string
+
+	parsedUUID, err := uuid.Parse(nameOrID)
+	if err != nil {
+		diskID, err = rpc.DiskNameToID(ctx, nameOrID)
+		if err != nil {
+			return fmt.Errorf("error getting disk: %w", err)
+		}
+	} else {
+		diskID = parsedUUID.String()
+	}
+
+	err = rpc.ResizeDisk(ctx, diskID, newSize, force)
+	if err != nil {
+		return fmt.Errorf("failed resizing disk: %w", err)
+	}
+
+	return nil
+}
+
+type DiskResizeHandler struct{}
+
+func NewDiskResizeHandler() DiskResizeHandler {
+	return DiskResizeHandler{}
+}
+
+func (d DiskResizeHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	nameOrID := request.PathValue("nameOrID")
+
+	err := request.ParseForm()
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+		serveErrorDisk(writer, request, err)
+
+		return
+	}
+
+	diskSizeNum := request.PostForm["size-number"]
+	diskSizeUnit := request.PostForm["size-unit"]
+
+	if len(diskSizeNum) != 1 || len(diskSizeUnit) != 1 {
+		http.Redirect(writer, request, "/media/disk/"+nameOrID, http.StatusSeeOther)
+
+		return
+	}
+
+	err = util.InitRPCConn()
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+		serveErrorDisk(writer, request, err)
+
+		return
+	}
+
+	err = ResizeDisk(request.Context(), nameOrID, diskSizeNum[0]+diskSizeUnit[0], request.PostForm["force"] != nil)
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+		serveErrorDisk(writer, request, err)
+
+		return
+	}
+
+	http.Redirect(writer, request, "/media/disk/"+nameOrID, http.StatusSeeOther)
+}
+
 //nolint:cyclop,funlen
 func (d DiskHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 	var err error
@@ -123,7 +197,7 @@ func (d DiskHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request
 
 		nameOrID = request.PathValue("nameOrID")
 
-		err = DeleteDisk(request.Context(), nameOrID)
+		err = DeleteDisk(request.Context(), nameOrID, request.URL.Query().Get("purge") == "true")
 		if err != nil {
 			writer.Header().Set("HX-Redirect", "/media/disk/"+nameOrID)
 			writer.WriteHeader(http.StatusInternalServerError)
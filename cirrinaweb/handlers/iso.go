@@ -60,6 +60,11 @@ func GetISO(ctx context.Context, nameOrID string) (components.ISO, error) {
 	returnISO.Description = isoInfo.Descr
 	returnISO.Size = humanize.IBytes(isoInfo.Size)
 
+	returnISO.Purge, err = rpc.GetPurgeDefault(ctx)
+	if err != nil {
+		return components.ISO{}, fmt.Errorf("error getting ISO: %w", err)
+	}
+
 	var VMIDs []string
 
 	VMIDs, err = rpc.ISOGetVMIDs(ctx, returnISO.ID)
@@ -79,7 +84,8 @@ func GetISO(ctx context.Context, nameOrID string) (components.ISO, error) {
 	return returnISO, nil
 }
 
-func DeleteISO(ctx context.Context, nameOrID string) error {
+// DeleteISO deletes the ISO, removing its file only if the user confirmed that
+func DeleteISO(ctx context.Context, nameOrID string, purge bool) error {
 	var err error
 
 	var isoID string
@@ -94,7 +100,7 @@ func DeleteISO(ctx context.Context, nameOrID string) error {
 		isoID = parsedUUID.String()
 	}
 
-	err = rpc.RmIso(ctx, isoID)
+	err = rpc.RmIso(ctx, isoID, &purge)
 	if err != nil {
 		return fmt.Errorf("failed removing ISO: %w", err)
 	}
@@ -105,7 +111,7 @@ func DeleteISO(ctx context.Context, nameOrID string) error {
 func (d ISOHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 	nameOrID := request.PathValue("nameOrID")
 	if request.Method == http.MethodDelete {
-		err := DeleteISO(request.Context(), nameOrID)
+		err := DeleteISO(request.Context(), nameOrID, request.URL.Query().Get("purge") == "true")
 		if err != nil {
 			writer.Header().Set("HX-Redirect", "/media/iso/"+nameOrID)
 			writer.WriteHeader(http.StatusInternalServerError)
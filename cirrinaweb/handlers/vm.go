@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/a-h/templ"
+	"github.com/dustin/go-humanize"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,6 +23,11 @@ type VMHandler struct {
 	GetVMDisks func(context.Context, string) ([]components.Disk, error)
 	GetVMISOs  func(context.Context, string) ([]components.ISO, error)
 	GetVMNICs  func(context.Context, string) ([]components.NIC, error)
+
+	GetVMShares    func(context.Context, string) ([]components.Share, error)
+	GetVMSnapshots func(context.Context, string) ([]components.VMSnapshot, error)
+
+	GetVMUEFIBootEntries func(context.Context, string) ([]components.UEFIBootEntry, error)
 }
 
 func NewVMHandler() VMHandler {
@@ -31,6 +37,11 @@ func NewVMHandler() VMHandler {
 		GetVMDisks: GetVMDisks,
 		GetVMISOs:  GetVMISOs,
 		GetVMNICs:  GetVMNICs,
+
+		GetVMShares:    GetVMShares,
+		GetVMSnapshots: GetVMSnapshots,
+
+		GetVMUEFIBootEntries: GetVMUEFIBootEntries,
 	}
 }
 
@@ -189,6 +200,32 @@ func (v VMHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request)
 				return
 			}
 
+			aVM.Shares, err = v.GetVMShares(request.Context(), aVM.ID)
+			if err != nil {
+				util.LogError(err, request.RemoteAddr)
+
+				serveErrorVM(writer, request, err)
+
+				return
+			}
+
+			aVM.Snapshots, err = v.GetVMSnapshots(request.Context(), aVM.ID)
+			if err != nil {
+				util.LogError(err, request.RemoteAddr)
+
+				serveErrorVM(writer, request, err)
+
+				return
+			}
+
+			if aVM.AdvancedSettings.StoreUEFI {
+				// there are no boot entries to show until the VM has started once, so this is not fatal
+				aVM.UEFIBootEntries, err = v.GetVMUEFIBootEntries(request.Context(), aVM.ID)
+				if err != nil {
+					util.LogError(err, request.RemoteAddr)
+				}
+			}
+
 			websockifyHost := util.GetWebsockifyHost()
 			websockifyPort := util.GetWebsockifyPort()
 
@@ -270,6 +307,102 @@ func (v VMStopPostHandler) ServeHTTP(writer http.ResponseWriter, request *http.R
 	templ.Handler(components.StopButton(aVM)).ServeHTTP(writer, request) //nolint:contextcheck
 }
 
+type VMPowerOffPostHandler struct {
+	GetVM func(context.Context, string) (components.VM, error)
+}
+
+func NewVMPowerOffHandler() VMPowerOffPostHandler {
+	return VMPowerOffPostHandler{
+		GetVM: GetVM,
+	}
+}
+
+func (v VMPowerOffPostHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	aVM, err := v.GetVM(request.Context(), request.PathValue("nameOrID"))
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	err = aVM.PowerOff(request.Context())
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	templ.Handler(components.PowerOffButton(aVM)).ServeHTTP(writer, request) //nolint:contextcheck
+}
+
+type VMResetPostHandler struct {
+	GetVM func(context.Context, string) (components.VM, error)
+}
+
+func NewVMResetHandler() VMResetPostHandler {
+	return VMResetPostHandler{
+		GetVM: GetVM,
+	}
+}
+
+func (v VMResetPostHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	aVM, err := v.GetVM(request.Context(), request.PathValue("nameOrID"))
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	err = aVM.Reset(request.Context())
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	templ.Handler(components.ResetButton(aVM)).ServeHTTP(writer, request) //nolint:contextcheck
+}
+
+type VMRebootPostHandler struct {
+	GetVM func(context.Context, string) (components.VM, error)
+}
+
+func NewVMRebootHandler() VMRebootPostHandler {
+	return VMRebootPostHandler{
+		GetVM: GetVM,
+	}
+}
+
+func (v VMRebootPostHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	aVM, err := v.GetVM(request.Context(), request.PathValue("nameOrID"))
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	err = aVM.Reboot(request.Context())
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	templ.Handler(components.RebootButton(aVM)).ServeHTTP(writer, request) //nolint:contextcheck
+}
+
 type VMClearUEFIHandler struct{}
 
 func NewVMClearUEFIHandler() VMClearUEFIHandler {
@@ -403,6 +536,9 @@ func GetVMNICs(ctx context.Context, vmID string) ([]components.NIC, error) {
 		return []components.NIC{}, fmt.Errorf("error getting VM NICs: %w", err)
 	}
 
+	// addresses are left out if they can't be looked up rather than failing the page
+	vmAddresses, _ := rpc.GetVMAddresses(ctx, vmID)
+
 	returnNICs := make([]components.NIC, 0, len(vmNICs))
 
 	for _, NICID := range vmNICs {
@@ -413,12 +549,85 @@ func GetVMNICs(ctx context.Context, vmID string) ([]components.NIC, error) {
 			return []components.NIC{}, fmt.Errorf("error getting VM NICs: %w", err)
 		}
 
-		returnNICs = append(returnNICs, components.NIC{Name: aNIC.Name, ID: NICID, NameOrID: aNIC.Name})
+		returnNICs = append(returnNICs, components.NIC{
+			Name:      aNIC.Name,
+			ID:        NICID,
+			NameOrID:  aNIC.Name,
+			Addresses: nicAddresses(vmAddresses[NICID]),
+		})
 	}
 
 	return returnNICs, nil
 }
 
+// nicAddresses returns the addresses the guest has been seen using on a NIC with where and when each was seen
+func nicAddresses(addresses []rpc.NicAddress) []string {
+	returnAddresses := make([]string, 0, len(addresses))
+
+	for _, address := range addresses {
+		returnAddresses = append(returnAddresses,
+			address.Address+" ("+address.Source+", "+humanize.Time(address.LastSeen)+")",
+		)
+	}
+
+	return returnAddresses
+}
+
+func GetVMShares(ctx context.Context, vmID string) ([]components.Share, error) {
+	var err error
+
+	err = util.InitRPCConn()
+	if err != nil {
+		return []components.Share{}, fmt.Errorf("error getting VM shares: %w", err)
+	}
+
+	shares, err := rpc.GetVMShares(ctx, vmID)
+	if err != nil {
+		return []components.Share{}, fmt.Errorf("error getting VM shares: %w", err)
+	}
+
+	returnShares := make([]components.Share, 0, len(shares))
+
+	for _, aShare := range shares {
+		returnShares = append(returnShares, components.Share{
+			Name:     aShare.Name,
+			Path:     aShare.Path,
+			ReadOnly: aShare.ReadOnly,
+		})
+	}
+
+	return returnShares, nil
+}
+
+func GetVMSnapshots(ctx context.Context, vmID string) ([]components.VMSnapshot, error) {
+	var err error
+
+	err = util.InitRPCConn()
+	if err != nil {
+		return []components.VMSnapshot{}, fmt.Errorf("error getting VM snapshots: %w", err)
+	}
+
+	snapshots, err := rpc.GetVMSnapshots(ctx, vmID)
+	if err != nil {
+		return []components.VMSnapshot{}, fmt.Errorf("error getting VM snapshots: %w", err)
+	}
+
+	returnSnapshots := make([]components.VMSnapshot, 0, len(snapshots))
+
+	for _, aSnapshot := range snapshots {
+		returnSnapshots = append(returnSnapshots, components.VMSnapshot{
+			ID:          aSnapshot.ID,
+			Name:        aSnapshot.Name,
+			Description: aSnapshot.Descr,
+			Created:     aSnapshot.Created,
+			DiskCount:   len(aSnapshot.DiskSnapshotIDs),
+			UEFIVars:    aSnapshot.UEFIVars,
+		})
+	}
+
+	return returnSnapshots, nil
+}
+
 //nolint:funlen
 func GetVM(ctx context.Context, nameOrID string) (components.VM, error) {
 	var returnVM components.VM
@@ -518,6 +727,13 @@ func GetVM(ctx context.Context, nameOrID string) (components.VM, error) {
 	returnVM.AdvancedSettings.WaitDebugConn = vmConfig.DebugWait
 	returnVM.AdvancedSettings.DebugPort = vmConfig.DebugPort
 	returnVM.AdvancedSettings.ExtraArgs = vmConfig.ExtraArgs
+	returnVM.AdvancedSettings.Sockets = vmConfig.Sockets
+	returnVM.AdvancedSettings.Cores = vmConfig.Cores
+	returnVM.AdvancedSettings.Threads = vmConfig.Threads
+	returnVM.AdvancedSettings.CPUPins = vmConfig.CPUPins
+	returnVM.AdvancedSettings.ConsolePorts = vmConfig.ConsolePorts
+	returnVM.AdvancedSettings.GuestAgent = vmConfig.GuestAgent
+	returnVM.AdvancedSettings.Firmware = vmConfig.Firmware
 
 	var vmState string
 
@@ -550,6 +766,8 @@ type VMDataHandler struct {
 	GetVMDisks func(context.Context, string) ([]components.Disk, error)
 	GetVMISOs  func(context.Context, string) ([]components.ISO, error)
 	GetVMNICs  func(context.Context, string) ([]components.NIC, error)
+
+	GetVMShares func(context.Context, string) ([]components.Share, error)
 }
 
 func NewVMDataHandler() VMDataHandler {
@@ -559,6 +777,8 @@ func NewVMDataHandler() VMDataHandler {
 		GetVMDisks: GetVMDisks,
 		GetVMISOs:  GetVMISOs,
 		GetVMNICs:  GetVMNICs,
+
+		GetVMShares: GetVMShares,
 	}
 }
 
@@ -608,6 +828,15 @@ func (v VMDataHandler) ServeHTTP(writer http.ResponseWriter, request *http.Reque
 		return
 	}
 
+	aVM.Shares, err = v.GetVMShares(request.Context(), aVM.ID)
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
 	templ.Handler(
 		components.VmDataOnly( //nolint:contextcheck
 			VMs,
@@ -1305,3 +1534,126 @@ func (v VMNICAddHandler) ServeHTTP(writer http.ResponseWriter, request *http.Req
 		return
 	}
 }
+
+type VMShareHandler struct{}
+
+func NewVMShareHandler() VMShareHandler {
+	return VMShareHandler{}
+}
+
+func (v VMShareHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	vmNameOrID := request.PathValue("vmNameOrID")
+	shareName := request.PathValue("shareName")
+
+	var err error
+
+	var aVM components.VM
+
+	aVM, err = GetVM(request.Context(), vmNameOrID)
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	switch request.Method {
+	case http.MethodDelete:
+		err = util.InitRPCConn()
+		if err == nil {
+			err = rpc.RmVMShare(request.Context(), aVM.ID, shareName)
+		}
+
+		if err != nil {
+			util.LogError(err, request.RemoteAddr)
+		}
+
+		writer.Header().Set("HX-Redirect", "/vm/"+vmNameOrID)
+		writer.WriteHeader(http.StatusOK)
+
+		return
+	default:
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+}
+
+type VMShareAddHandler struct{}
+
+func NewVMShareAddHandler() VMShareAddHandler {
+	return VMShareAddHandler{}
+}
+
+func (v VMShareAddHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	var err error
+
+	nameOrID := request.PathValue("nameOrID")
+
+	var aVM components.VM
+
+	aVM, err = GetVM(request.Context(), nameOrID)
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	switch request.Method {
+	case http.MethodGet:
+		var VMs []components.VM
+
+		VMs, err = GetVMs(request.Context())
+		if err != nil {
+			util.LogError(err, request.RemoteAddr)
+
+			serveErrorVM(writer, request, err)
+
+			return
+		}
+
+		templ.Handler(components.VmShareAdd(nameOrID, VMs, aVM)).ServeHTTP(writer, request) //nolint:contextcheck
+	case http.MethodPost:
+		err = request.ParseForm()
+		if err != nil {
+			util.LogError(err, request.RemoteAddr)
+			serveErrorVM(writer, request, err)
+
+			return
+		}
+
+		err = util.InitRPCConn()
+		if err != nil {
+			util.LogError(err, request.RemoteAddr)
+			serveErrorVM(writer, request, err)
+
+			return
+		}
+
+		err = rpc.AddVMShare(request.Context(), aVM.ID, rpc.VMShareInfo{
+			Name:     request.PostForm.Get("name"),
+			Path:     request.PostForm.Get("path"),
+			ReadOnly: request.PostForm.Has("readonly"),
+		})
+		if err != nil {
+			util.LogError(err, request.RemoteAddr)
+
+			serveErrorVM(writer, request, err)
+
+			return
+		}
+
+		http.Redirect(writer, request, "/vm/"+nameOrID, http.StatusSeeOther)
+	default:
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+}
@@ -309,6 +309,58 @@ func (v VMEditNICHandler) ServeHTTP(writer http.ResponseWriter, request *http.Re
 	}
 }
 
+type VMEditShareHandler struct{}
+
+func NewVMEditShareHandler() VMEditShareHandler {
+	return VMEditShareHandler{}
+}
+
+func (v VMEditShareHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	var err error
+
+	switch request.Method {
+	case http.MethodGet:
+		nameOrID := request.PathValue("nameOrID")
+
+		var aVM components.VM
+
+		aVM, err = GetVM(request.Context(), nameOrID)
+		if err != nil {
+			util.LogError(err, request.RemoteAddr)
+
+			serveErrorVM(writer, request, err)
+
+			return
+		}
+
+		aVM.Shares, err = GetVMShares(request.Context(), aVM.ID)
+		if err != nil {
+			util.LogError(err, request.RemoteAddr)
+
+			serveErrorVM(writer, request, err)
+
+			return
+		}
+
+		var VMs []components.VM
+
+		VMs, err = GetVMs(request.Context())
+		if err != nil {
+			util.LogError(err, request.RemoteAddr)
+
+			serveErrorVM(writer, request, err)
+
+			return
+		}
+
+		templ.Handler(components.VMEditShare(VMs, aVM)).ServeHTTP(writer, request) //nolint:contextcheck
+
+		return
+	default:
+		http.Redirect(writer, request, "/vm/", http.StatusSeeOther)
+	}
+}
+
 type VMEditSerialHandler struct{}
 
 func NewVMEditSerialHandler() VMEditSerialHandler {
@@ -1406,6 +1458,104 @@ func (v VMEditAdvancedHandler) ServeHTTP(writer http.ResponseWriter, request *ht
 			}
 		}
 
+		// sockets
+		newSockets := request.PostForm["sockets"]
+		if len(newSockets) > 0 {
+			var newSocketsNum uint64
+
+			newSocketsNum, err = strconv.ParseUint(newSockets[0], 10, 32)
+			if err != nil {
+				util.LogError(err, request.RemoteAddr)
+
+				serveErrorVM(writer, request, err)
+
+				return
+			}
+
+			if oldVMConfig.Sockets != uint32(newSocketsNum) {
+				newSocketsI := uint32(newSocketsNum)
+				newConfig.Sockets = &newSocketsI
+				haveChanges = true
+			}
+		}
+
+		// cores
+		newCores := request.PostForm["cores"]
+		if len(newCores) > 0 {
+			var newCoresNum uint64
+
+			newCoresNum, err = strconv.ParseUint(newCores[0], 10, 32)
+			if err != nil {
+				util.LogError(err, request.RemoteAddr)
+
+				serveErrorVM(writer, request, err)
+
+				return
+			}
+
+			if oldVMConfig.Cores != uint32(newCoresNum) {
+				newCoresI := uint32(newCoresNum)
+				newConfig.Cores = &newCoresI
+				haveChanges = true
+			}
+		}
+
+		// threads
+		newThreads := request.PostForm["threads"]
+		if len(newThreads) > 0 {
+			var newThreadsNum uint64
+
+			newThreadsNum, err = strconv.ParseUint(newThreads[0], 10, 32)
+			if err != nil {
+				util.LogError(err, request.RemoteAddr)
+
+				serveErrorVM(writer, request, err)
+
+				return
+			}
+
+			if oldVMConfig.Threads != uint32(newThreadsNum) {
+				newThreadsI := uint32(newThreadsNum)
+				newConfig.Threads = &newThreadsI
+				haveChanges = true
+			}
+		}
+
+		// cpu pins
+		cpuPins := request.PostForm["cpupins"]
+		if len(cpuPins) > 0 {
+			if oldVMConfig.CPUPins != cpuPins[0] {
+				newConfig.CpuPins = &cpuPins[0]
+				haveChanges = true
+			}
+		}
+
+		// console ports
+		consolePorts := request.PostForm["consoleports"]
+		if len(consolePorts) > 0 {
+			if oldVMConfig.ConsolePorts != consolePorts[0] {
+				newConfig.ConsolePorts = &consolePorts[0]
+				haveChanges = true
+			}
+		}
+
+		// guest agent
+		guestAgentEnabled := request.PostForm.Has("guestagentenabled")
+
+		if oldVMConfig.GuestAgent != guestAgentEnabled {
+			newConfig.GuestAgent = &guestAgentEnabled
+			haveChanges = true
+		}
+
+		// firmware
+		firmware := request.PostForm["firmware"]
+		if len(firmware) > 0 {
+			if oldVMConfig.Firmware != firmware[0] {
+				newConfig.Firmware = &firmware[0]
+				haveChanges = true
+			}
+		}
+
 		if haveChanges {
 			err = rpc.UpdateVMConfig(request.Context(), &newConfig)
 			if err != nil {
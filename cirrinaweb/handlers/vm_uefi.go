@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"cirrina/cirrinactl/rpc"
+	"cirrina/cirrinaweb/components"
+	"cirrina/cirrinaweb/util"
+)
+
+// GetVMUEFIBootEntries returns the VM's UEFI boot entries, the ones in the boot order first and in that order
+func GetVMUEFIBootEntries(ctx context.Context, vmID string) ([]components.UEFIBootEntry, error) {
+	var err error
+
+	err = util.InitRPCConn()
+	if err != nil {
+		return []components.UEFIBootEntry{}, fmt.Errorf("error getting VM UEFI boot entries: %w", err)
+	}
+
+	entries, err := rpc.GetVMUEFIBootEntries(ctx, vmID)
+	if err != nil {
+		return []components.UEFIBootEntry{}, fmt.Errorf("error getting VM UEFI boot entries: %w", err)
+	}
+
+	order, next, err := rpc.GetVMUEFIBootOrder(ctx, vmID)
+	if err != nil {
+		return []components.UEFIBootEntry{}, fmt.Errorf("error getting VM UEFI boot order: %w", err)
+	}
+
+	returnEntries := make([]components.UEFIBootEntry, 0, len(entries))
+
+	for _, anEntry := range entries {
+		returnEntries = append(returnEntries, components.UEFIBootEntry{
+			Number:      fmt.Sprintf("%04X", anEntry.Number),
+			Description: anEntry.Description,
+			Active:      anEntry.Active,
+			Order:       slices.Index(order, anEntry.Number) + 1,
+			Next:        next != nil && *next == anEntry.Number,
+			DevicePath:  anEntry.DevicePath,
+		})
+	}
+
+	// entries are sorted by number, stable sorting keeps that order for those not in the boot order
+	slices.SortStableFunc(returnEntries, func(a, b components.UEFIBootEntry) int {
+		switch {
+		case a.Order == 0 || b.Order == 0:
+			return b.Order - a.Order
+		default:
+			return a.Order - b.Order
+		}
+	})
+
+	return returnEntries, nil
+}
+
+func parseUEFIBootNumber(number string) (uint16, error) {
+	parsed, err := strconv.ParseUint(number, 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid UEFI boot entry number %s: %w", number, err)
+	}
+
+	return uint16(parsed), nil
+}
+
+type VMUEFIBootNextHandler struct{}
+
+func NewVMUEFIBootNextHandler() VMUEFIBootNextHandler {
+	return VMUEFIBootNextHandler{}
+}
+
+func (v VMUEFIBootNextHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	aVM, err := GetVM(request.Context(), request.PathValue("nameOrID"))
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	// deleting boot next clears it
+	var next *uint16
+
+	if request.Method == http.MethodPost {
+		var number uint16
+
+		number, err = parseUEFIBootNumber(request.PathValue("number"))
+		if err != nil {
+			util.LogError(err, request.RemoteAddr)
+
+			serveErrorVM(writer, request, err)
+
+			return
+		}
+
+		next = &number
+	}
+
+	err = util.InitRPCConn()
+	if err == nil {
+		err = rpc.SetVMUEFIBootNext(request.Context(), aVM.ID, next)
+	}
+
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	http.Redirect(writer, request, "/vm/"+aVM.Name, http.StatusSeeOther)
+}
+
+type VMUEFIBootOrderUpHandler struct{}
+
+func NewVMUEFIBootOrderUpHandler() VMUEFIBootOrderUpHandler {
+	return VMUEFIBootOrderUpHandler{}
+}
+
+// ServeHTTP moves the boot entry one place earlier in the boot order, or adds it to the end if it is not in it
+func (v VMUEFIBootOrderUpHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	aVM, err := GetVM(request.Context(), request.PathValue("nameOrID"))
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	number, err := parseUEFIBootNumber(request.PathValue("number"))
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	err = util.InitRPCConn()
+	if err == nil {
+		var order []uint16
+
+		order, _, err = rpc.GetVMUEFIBootOrder(request.Context(), aVM.ID)
+		if err == nil {
+			orderIdx := slices.Index(order, number)
+
+			switch {
+			case orderIdx < 0:
+				order = append(order, number)
+			case orderIdx > 0:
+				order[orderIdx-1], order[orderIdx] = order[orderIdx], order[orderIdx-1]
+			}
+
+			err = rpc.SetVMUEFIBootOrder(request.Context(), aVM.ID, order)
+		}
+	}
+
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	http.Redirect(writer, request, "/vm/"+aVM.Name, http.StatusSeeOther)
+}
+
+type VMUEFIBootISOHandler struct{}
+
+func NewVMUEFIBootISOHandler() VMUEFIBootISOHandler {
+	return VMUEFIBootISOHandler{}
+}
+
+func (v VMUEFIBootISOHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	aVM, err := GetVM(request.Context(), request.PathValue("nameOrID"))
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	err = util.InitRPCConn()
+	if err == nil {
+		err = rpc.VMBootISOOnce(request.Context(), aVM.ID, request.PathValue("isoID"))
+	}
+
+	if err != nil {
+		util.LogError(err, request.RemoteAddr)
+
+		serveErrorVM(writer, request, err)
+
+		return
+	}
+
+	http.Redirect(writer, request, "/vm/"+aVM.Name, http.StatusSeeOther)
+}
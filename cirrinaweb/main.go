@@ -155,13 +155,21 @@ func main() {
 
 	setupMux(mux, "POST /vm/{nameOrID}/start", handlers.NewVMStartHandler(), mdlw)
 	setupMux(mux, "POST /vm/{nameOrID}/stop", handlers.NewVMStopHandler(), mdlw)
+	setupMux(mux, "POST /vm/{nameOrID}/poweroff", handlers.NewVMPowerOffHandler(), mdlw)
+	setupMux(mux, "POST /vm/{nameOrID}/reset", handlers.NewVMResetHandler(), mdlw)
+	setupMux(mux, "POST /vm/{nameOrID}/reboot", handlers.NewVMRebootHandler(), mdlw)
 	setupMux(mux, "POST /vm/{nameOrID}/clearuefi", handlers.NewVMClearUEFIHandler(), mdlw)
+	setupMux(mux, "POST /vm/{nameOrID}/uefi/bootnext/{number}", handlers.NewVMUEFIBootNextHandler(), mdlw)
+	setupMux(mux, "DELETE /vm/{nameOrID}/uefi/bootnext", handlers.NewVMUEFIBootNextHandler(), mdlw)
+	setupMux(mux, "POST /vm/{nameOrID}/uefi/bootorder/{number}/up", handlers.NewVMUEFIBootOrderUpHandler(), mdlw)
+	setupMux(mux, "POST /vm/{nameOrID}/uefi/bootiso/{isoID}", handlers.NewVMUEFIBootISOHandler(), mdlw)
 
 	setupMux(mux, "GET /vm/{nameOrID}/editBasic", handlers.NewVMEditBasicHandler(), mdlw)
 	setupMux(mux, "POST /vm/{nameOrID}/editBasic", handlers.NewVMEditBasicHandler(), mdlw)
 	setupMux(mux, "GET /vm/{nameOrID}/editDisk", handlers.NewVMEditDiskHandler(), mdlw)
 	setupMux(mux, "GET /vm/{nameOrID}/editISOs", handlers.NewVMEditISOHandler(), mdlw)
 	setupMux(mux, "GET /vm/{nameOrID}/editNICs", handlers.NewVMEditNICHandler(), mdlw)
+	setupMux(mux, "GET /vm/{nameOrID}/editShares", handlers.NewVMEditShareHandler(), mdlw)
 	setupMux(mux, "GET /vm/{nameOrID}/editSerial", handlers.NewVMEditSerialHandler(), mdlw)
 	setupMux(mux, "POST /vm/{nameOrID}/editSerial", handlers.NewVMEditSerialHandler(), mdlw)
 	setupMux(mux, "GET /vm/{nameOrID}/editDisplay", handlers.NewVMEditDisplayHandler(), mdlw)
@@ -179,10 +187,13 @@ func main() {
 	setupMux(mux, "POST /vm/{nameOrID}/iso/add", handlers.NewVMISOAddHandler(), mdlw)
 	setupMux(mux, "GET /vm/{nameOrID}/nic/add", handlers.NewVMNICAddHandler(), mdlw)
 	setupMux(mux, "POST /vm/{nameOrID}/nic/add", handlers.NewVMNICAddHandler(), mdlw)
+	setupMux(mux, "GET /vm/{nameOrID}/share/add", handlers.NewVMShareAddHandler(), mdlw)
+	setupMux(mux, "POST /vm/{nameOrID}/share/add", handlers.NewVMShareAddHandler(), mdlw)
 
 	setupMux(mux, "DELETE /vm/{vmNameOrID}/disk/{diskNameOrID}", handlers.NewVMDiskHandler(), mdlw)
 	setupMux(mux, "DELETE /vm/{vmNameOrID}/iso/{isoNameOrID}", handlers.NewVMISOHandler(), mdlw)
 	setupMux(mux, "DELETE /vm/{vmNameOrID}/nic/{nicNameOrID}", handlers.NewVMNICHandler(), mdlw)
+	setupMux(mux, "DELETE /vm/{vmNameOrID}/share/{shareName}", handlers.NewVMShareHandler(), mdlw)
 
 	setupMux(mux, "GET /vmdata/{nameOrID}", handlers.NewVMDataHandler(), mdlw)
 
@@ -192,6 +203,7 @@ func main() {
 	setupMux(mux, "POST /media/disk", handlers.NewDiskHandler(), mdlw)
 	setupMux(mux, "GET /media/disk/{nameOrID}", handlers.NewDiskHandler(), mdlw)
 	setupMux(mux, "DELETE /media/disk/{nameOrID}", handlers.NewDiskHandler(), mdlw)
+	setupMux(mux, "POST /media/disk/{nameOrID}/resize", handlers.NewDiskResizeHandler(), mdlw)
 
 	setupMux(mux, "GET /media/isos", handlers.NewISOsHandler(), mdlw)
 	setupMux(mux, "GET /media/iso/{nameOrID}", handlers.NewISOHandler(), mdlw)